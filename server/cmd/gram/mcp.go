@@ -0,0 +1,161 @@
+package gram
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/speakeasy-api/gram/server/internal/mcp/bridge"
+)
+
+func newMCPCommand() *cli.Command {
+	return &cli.Command{
+		Name:        "mcp",
+		Usage:       "Connect local MCP clients to Gram hosted MCP servers",
+		Subcommands: []*cli.Command{newMCPStdioCommand()},
+	}
+}
+
+func newMCPStdioCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "stdio",
+		Usage:     "Bridge an MCP client that speaks stdio to a hosted Gram MCP server",
+		ArgsUsage: "<mcp-url>",
+		Description: "Reads JSON-RPC messages from stdin, forwards them to the MCP server over " +
+			"Streamable HTTP and writes the server's messages to stdout. Environment " +
+			"variables named MCP_<NAME> are sent as MCP-<NAME> headers to supply values " +
+			"for the server's environment variables.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "api-key",
+				Usage:   "A Gram API key to authenticate with",
+				EnvVars: []string{"GRAM_API_KEY"},
+			},
+			&cli.BoolFlag{
+				Name:  "login",
+				Usage: "Sign in with OAuth when the server asks for credentials",
+				Value: false,
+			},
+			&cli.StringFlag{
+				Name:  "login-method",
+				Usage: "How to sign in with OAuth: browser or device",
+				Value: bridge.LoginBrowser,
+				Action: func(c *cli.Context, val string) error {
+					if val != bridge.LoginBrowser && val != bridge.LoginDevice {
+						return fmt.Errorf("invalid login method: %s", val)
+					}
+					return nil
+				},
+			},
+			&cli.StringFlag{
+				Name:    "credentials-file",
+				Usage:   "Where to keep OAuth credentials between runs (defaults to the user config directory)",
+				EnvVars: []string{"GRAM_MCP_CREDENTIALS_FILE"},
+			},
+			&cli.StringFlag{
+				Name:    "environment",
+				Usage:   "The Gram environment to use for tool calls",
+				EnvVars: []string{"GRAM_ENVIRONMENT"},
+			},
+			&cli.StringSliceFlag{
+				Name:  "header",
+				Usage: "An additional header to send, formatted as Name:Value",
+			},
+			&cli.IntFlag{
+				Name:  "max-retries",
+				Usage: "How many times to retry a message when the server cannot be reached",
+				Value: 5,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			logger := PullLogger(c.Context)
+
+			mcpURL := c.Args().First()
+			if mcpURL == "" {
+				return fmt.Errorf("an mcp server url is required")
+			}
+			if u, err := url.Parse(mcpURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+				return fmt.Errorf("invalid mcp server url: %s", mcpURL)
+			}
+
+			headers, err := mcpBridgeHeaders(c.StringSlice("header"), os.Environ())
+			if err != nil {
+				return err
+			}
+			if env := c.String("environment"); env != "" {
+				headers.Set("Gram-Environment", env)
+			}
+
+			var auth bridge.Authenticator
+			switch {
+			case c.String("api-key") != "":
+				auth = bridge.APIKey(c.String("api-key"))
+			case c.Bool("login"):
+				path := c.String("credentials-file")
+				if path == "" {
+					path, err = bridge.DefaultTokenStorePath()
+					if err != nil {
+						return err
+					}
+				}
+
+				auth = bridge.NewOAuth(bridge.OAuthOptions{
+					MCPURL:     mcpURL,
+					Method:     c.String("login-method"),
+					ClientName: "Gram MCP bridge",
+					Store:      bridge.NewTokenStore(path),
+					Prompt:     os.Stderr,
+				})
+			}
+
+			ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			b := bridge.New(logger, bridge.Options{
+				URL:        mcpURL,
+				Headers:    headers,
+				Auth:       auth,
+				HTTPClient: nil,
+				MaxRetries: c.Int("max-retries"),
+			})
+
+			if err := b.Run(ctx, os.Stdin, os.Stdout); err != nil {
+				return fmt.Errorf("mcp bridge: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// mcpBridgeHeaders collects the headers passed with --header and the MCP-*
+// headers derived from MCP_* environment variables. Explicit headers win.
+func mcpBridgeHeaders(flags []string, environ []string) (http.Header, error) {
+	headers := http.Header{}
+
+	for _, kv := range environ {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(key, "MCP_") || value == "" {
+			continue
+		}
+
+		headers.Set("MCP-"+strings.ReplaceAll(strings.TrimPrefix(key, "MCP_"), "_", "-"), value)
+	}
+
+	for _, header := range flags {
+		name, value, ok := strings.Cut(header, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid header %q, expected Name:Value", header)
+		}
+
+		headers.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	return headers, nil
+}
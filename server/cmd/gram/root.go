@@ -37,7 +37,7 @@ func newApp() *cli.App {
 				EnvVars: []string{"GRAM_LOG_PRETTY"},
 			},
 		},
-		Commands: []*cli.Command{newStartCommand(), newWorkerCommand(), newVersionCommand()},
+		Commands: []*cli.Command{newStartCommand(), newWorkerCommand(), newMCPCommand(), newVersionCommand()},
 		Before: func(c *cli.Context) error {
 			c.Context = o11y.PushAppInfo(c.Context, &o11y.AppInfo{
 				Name:    "gram",
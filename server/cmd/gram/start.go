@@ -42,6 +42,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/k8s"
 	"github.com/speakeasy-api/gram/server/internal/keys"
 	"github.com/speakeasy-api/gram/server/internal/mcp"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
 	"github.com/speakeasy-api/gram/server/internal/middleware"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oauth"
@@ -296,6 +297,27 @@ func newStartCommand() *cli.Command {
 			EnvVars:  []string{"GRAM_LOCAL_FEATURE_FLAGS_CSV"},
 			Required: false,
 		},
+		&cli.IntFlag{
+			Name:     "mcp-list-page-size",
+			Value:    mcp.DefaultListPageSize,
+			Usage:    "Maximum number of tools or prompts returned per page of an MCP list request. Set to 0 to disable pagination.",
+			EnvVars:  []string{"GRAM_MCP_LIST_PAGE_SIZE"},
+			Required: false,
+		},
+		&cli.IntFlag{
+			Name:     "mcp-max-batch-size",
+			Value:    mcp.DefaultMaxBatchSize,
+			Usage:    "Maximum number of messages accepted in an MCP JSON-RPC batch. Set to 0 to remove the limit.",
+			EnvVars:  []string{"GRAM_MCP_MAX_BATCH_SIZE"},
+			Required: false,
+		},
+		&cli.IntFlag{
+			Name:     "mcp-batch-concurrency",
+			Value:    mcp.DefaultBatchConcurrency,
+			Usage:    "Number of messages in an MCP JSON-RPC batch that are handled at the same time.",
+			EnvVars:  []string{"GRAM_MCP_BATCH_CONCURRENCY"},
+			Required: false,
+		},
 		&cli.PathFlag{
 			Name:     "config-file",
 			Usage:    "Path to a config file to load. Supported formats are JSON, TOML and YAML.",
@@ -475,7 +497,7 @@ func newStartCommand() *cli.Command {
 
 			slackClient := slack_client.NewSlackClient(slack.SlackClientID(c.String("environment")), c.String("slack-client-secret"), db, encryptionClient)
 			baseChatClient := openrouter.NewChatClient(logger, openRouter)
-			chatClient := chat.NewChatClient(logger, tracerProvider, meterProvider, db, openRouter, baseChatClient, env, cache.NewRedisCacheAdapter(redisClient), guardianPolicy)
+			chatClient := chat.NewChatClient(logger, tracerProvider, meterProvider, db, openRouter, baseChatClient, env, cache.NewRedisCacheAdapter(redisClient), guardianPolicy, assetStorage)
 			mux := goahttp.NewMuxer()
 
 			mux.Use(middleware.CORSMiddleware(c.String("environment"), c.String("server-url")))
@@ -497,14 +519,20 @@ func newStartCommand() *cli.Command {
 			templates.Attach(mux, templates.NewService(logger, db, sessionManager))
 			assets.Attach(mux, assets.NewService(logger, db, sessionManager, assetStorage))
 			deployments.Attach(mux, deployments.NewService(logger, tracerProvider, db, temporalClient, sessionManager, assetStorage))
-			toolsets.Attach(mux, toolsets.NewService(logger, db, sessionManager))
+			sessionRelay := relay.NewBroker(logger, redisClient)
+			toolsets.Attach(mux, toolsets.NewService(logger, db, sessionManager, sessionRelay))
 			keys.Attach(mux, keys.NewService(logger, db, sessionManager, c.String("environment")))
 			environments.Attach(mux, environments.NewService(logger, db, sessionManager, encryptionClient))
 			tools.Attach(mux, tools.NewService(logger, db, sessionManager))
 			oauthService := oauth.NewService(logger, tracerProvider, meterProvider, db, serverURL, cache.NewRedisCacheAdapter(redisClient), encryptionClient, env)
 			oauth.Attach(mux, oauthService)
-			instances.Attach(mux, instances.NewService(logger, tracerProvider, meterProvider, db, sessionManager, env, cache.NewRedisCacheAdapter(redisClient), guardianPolicy, posthogClient, billingTracker))
-			mcp.Attach(mux, mcp.NewService(logger, tracerProvider, meterProvider, db, sessionManager, env, posthogClient, serverURL, cache.NewRedisCacheAdapter(redisClient), guardianPolicy, oauthService, billingTracker, billingRepo))
+			instances.Attach(mux, instances.NewService(logger, tracerProvider, meterProvider, db, sessionManager, env, cache.NewRedisCacheAdapter(redisClient), guardianPolicy, posthogClient, billingTracker, assetStorage))
+			mcpService := mcp.NewService(logger, tracerProvider, meterProvider, db, sessionManager, env, posthogClient, serverURL, cache.NewRedisCacheAdapter(redisClient), guardianPolicy, oauthService, billingTracker, billingRepo, assetStorage, sessionRelay, mcp.ServiceOptions{
+				ListPageSize:     c.Int("mcp-list-page-size"),
+				MaxBatchSize:     c.Int("mcp-max-batch-size"),
+				BatchConcurrency: c.Int("mcp-batch-concurrency"),
+			})
+			mcp.Attach(mux, mcpService)
 			chat.Attach(mux, chat.NewService(logger, db, sessionManager, openRouter))
 			if slackClient.Enabled() {
 				slack.Attach(mux, slack.NewService(logger, db, sessionManager, encryptionClient, redisClient, slackClient, temporalClient, slack.Configurations{
@@ -559,6 +587,10 @@ func newStartCommand() *cli.Command {
 				})
 			}
 
+			group.Go(func() {
+				mcpService.ListenForSessionRelay(sigctx)
+			})
+
 			group.Go(func() {
 				<-sigctx.Done()
 
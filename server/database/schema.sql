@@ -267,6 +267,12 @@ CREATE TABLE IF NOT EXISTS http_tool_definitions (
   confirm_prompt TEXT,
   summarizer TEXT,
 
+  title TEXT CHECK (title <> '' AND CHAR_LENGTH(title) <= 100),
+  read_only_hint BOOLEAN,
+  destructive_hint BOOLEAN,
+  idempotent_hint BOOLEAN,
+  open_world_hint BOOLEAN,
+
   name TEXT NOT NULL CHECK (name <> '' AND CHAR_LENGTH(name) <= 100),
   untruncated_name TEXT,
   summary TEXT NOT NULL,
@@ -291,7 +297,13 @@ CREATE TABLE IF NOT EXISTS http_tool_definitions (
   query_settings JSONB,
   path_settings JSONB,
   request_content_type TEXT,
+  body_settings JSONB,
   response_filter JSONB NULL,
+  output_schema JSONB,
+  xml_response_schema JSONB,
+  response_budget BIGINT CHECK (response_budget IS NULL OR response_budget > 0),
+  retry_policy JSONB,
+  cache_policy JSONB,
 
   created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
   updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
@@ -455,6 +467,15 @@ CREATE TABLE IF NOT EXISTS toolsets (
   ),
   mcp_is_public BOOLEAN NOT NULL DEFAULT FALSE,
   mcp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  -- static lists every tool in tools/list while dynamic lists meta-tools
+  -- that let the model search for and call tools on demand.
+  tool_selection_mode TEXT NOT NULL DEFAULT 'static' CHECK (tool_selection_mode IN ('static', 'dynamic')),
+  -- binary tool responses larger than this many bytes are returned as a link
+  -- to a short-lived download instead of being embedded in the result.
+  resource_link_threshold BIGINT CHECK (resource_link_threshold IS NULL OR resource_link_threshold >= 0),
+  -- text tool responses larger than this many bytes are truncated before
+  -- they reach the model. Tools may set their own budget.
+  response_budget BIGINT CHECK (response_budget IS NULL OR response_budget > 0),
   custom_domain_id uuid,
 
   -- OAuth configuration - mutually exclusive
@@ -668,6 +689,13 @@ CREATE TABLE IF NOT EXISTS tool_variations (
   description TEXT,
   tags TEXT[],
   summarizer TEXT,
+  title TEXT CHECK (title <> '' AND CHAR_LENGTH(title) <= 100),
+  read_only_hint BOOLEAN,
+  destructive_hint BOOLEAN,
+  idempotent_hint BOOLEAN,
+  open_world_hint BOOLEAN,
+  response_budget BIGINT CHECK (response_budget IS NULL OR response_budget > 0),
+  retry_policy JSONB,
 
   created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
   updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
@@ -737,6 +765,80 @@ CREATE TABLE IF NOT EXISTS toolset_prompts (
 CREATE UNIQUE INDEX IF NOT EXISTS toolset_prompts_toolset_id_prompt_name_key
 ON toolset_prompts (toolset_id, prompt_name);
 
+CREATE TABLE IF NOT EXISTS toolset_resources (
+  id UUID NOT NULL DEFAULT generate_uuidv7(),
+  project_id UUID NOT NULL,
+  toolset_id UUID NOT NULL,
+  kind TEXT NOT NULL CHECK (kind IN ('asset', 'http_template')),
+  name TEXT NOT NULL CHECK (name <> '' AND CHAR_LENGTH(name) <= 60),
+  description TEXT CHECK (description <> '' AND CHAR_LENGTH(description) <= 1000),
+  mime_type TEXT CHECK (mime_type <> '' AND CHAR_LENGTH(mime_type) <= 100),
+  -- static documents are served from an uploaded asset
+  asset_id UUID,
+  -- templated resources are read by calling a GET tool from the active deployment
+  http_tool_name TEXT CHECK (http_tool_name <> '' AND CHAR_LENGTH(http_tool_name) <= 100),
+  uri_template TEXT CHECK (uri_template <> '' AND CHAR_LENGTH(uri_template) <= 500),
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT toolset_resources_pkey PRIMARY KEY (id),
+  CONSTRAINT toolset_resources_toolset_id_fkey FOREIGN KEY (toolset_id) REFERENCES toolsets (id) ON DELETE CASCADE,
+  CONSTRAINT toolset_resources_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  CONSTRAINT toolset_resources_asset_id_fkey FOREIGN KEY (asset_id) REFERENCES assets (id) ON DELETE CASCADE,
+  CONSTRAINT toolset_resources_source_check CHECK (
+    (kind = 'asset' AND asset_id IS NOT NULL AND http_tool_name IS NULL AND uri_template IS NULL)
+    OR
+    (kind = 'http_template' AND asset_id IS NULL AND http_tool_name IS NOT NULL AND uri_template IS NOT NULL)
+  )
+);
+
+-- Ensure a toolset can only have one resource per name
+CREATE UNIQUE INDEX IF NOT EXISTS toolset_resources_toolset_id_name_key
+ON toolset_resources (toolset_id, name);
+
+-- Every edit to a toolset's MCP server instructions is kept as a new revision.
+-- The highest version is the one served to MCP clients.
+CREATE TABLE IF NOT EXISTS toolset_instructions (
+  id UUID NOT NULL DEFAULT generate_uuidv7(),
+  project_id UUID NOT NULL,
+  toolset_id UUID NOT NULL,
+  version INTEGER NOT NULL CHECK (version > 0),
+  -- NULL records that the instructions were removed in this revision.
+  content TEXT CHECK (content <> '' AND CHAR_LENGTH(content) <= 20000),
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT toolset_instructions_pkey PRIMARY KEY (id),
+  CONSTRAINT toolset_instructions_toolset_id_fkey FOREIGN KEY (toolset_id) REFERENCES toolsets (id) ON DELETE CASCADE,
+  CONSTRAINT toolset_instructions_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS toolset_instructions_toolset_id_version_key
+ON toolset_instructions (toolset_id, version DESC);
+
+-- Rules that decide which MCP clients may connect to a toolset's MCP server.
+-- Clients are matched on the name they send in initialize. When any allow
+-- rule exists only the listed clients may connect.
+CREATE TABLE IF NOT EXISTS toolset_client_rules (
+  id UUID NOT NULL DEFAULT generate_uuidv7(),
+  project_id UUID NOT NULL,
+  toolset_id UUID NOT NULL,
+  client_name TEXT NOT NULL CHECK (client_name <> '' AND CHAR_LENGTH(client_name) <= 100),
+  kind TEXT NOT NULL CHECK (kind IN ('allow', 'block', 'minimum_version')),
+  min_version TEXT CHECK (min_version <> '' AND CHAR_LENGTH(min_version) <= 50),
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT toolset_client_rules_pkey PRIMARY KEY (id),
+  CONSTRAINT toolset_client_rules_toolset_id_fkey FOREIGN KEY (toolset_id) REFERENCES toolsets (id) ON DELETE CASCADE,
+  CONSTRAINT toolset_client_rules_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  CONSTRAINT toolset_client_rules_version_kind_check CHECK ((kind = 'minimum_version') = (min_version IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS toolset_client_rules_toolset_id_client_name_kind_key
+ON toolset_client_rules (toolset_id, client_name, kind);
+
 CREATE TABLE IF NOT EXISTS users (
   id TEXT NOT NULL,
   email TEXT NOT NULL,
@@ -65,6 +65,28 @@ var _ = Service("assets", func() {
 		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UploadImage"}`)
 	})
 
+	Method("uploadDocument", func() {
+		Description("Upload a document, such as a markdown runbook, that a toolset can publish as an MCP resource.")
+
+		Payload(UploadDocumentForm)
+
+		Result(UploadDocumentResult)
+
+		HTTP(func() {
+			POST("/rpc/assets.uploadDocument")
+			Header("content_type:Content-Type")
+			Header("content_length:Content-Length")
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			security.SessionHeader()
+			SkipRequestBodyEncodeDecode()
+		})
+
+		Meta("openapi:operationId", "uploadDocument")
+		Meta("openapi:extension:x-speakeasy-name-override", "uploadDocument")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UploadDocument"}`)
+	})
+
 	Method("uploadFunctions", func() {
 		Description("Upload functions to Gram.")
 
@@ -218,6 +240,22 @@ var UploadImageResult = Type("UploadImageResult", func() {
 	Attribute("asset", Asset, "The asset entry that was created in Gram")
 })
 
+var UploadDocumentForm = Type("UploadDocumentForm", func() {
+	Required("content_type", "content_length")
+	security.ByKeyPayload()
+	security.SessionPayload()
+	security.ProjectPayload()
+
+	Attribute("content_type", String)
+	Attribute("content_length", Int64)
+})
+
+var UploadDocumentResult = Type("UploadDocumentResult", func() {
+	Required("asset")
+
+	Attribute("asset", Asset, "The asset entry that was created in Gram")
+})
+
 var UploadFunctionsForm = Type("UploadFunctionsForm", func() {
 	Required("content_type", "content_length")
 	security.ByKeyPayload()
@@ -16,6 +16,52 @@ var ResponseFilter = Type("ResponseFilter", func() {
 	Required("content_types")
 })
 
+var ToolAnnotations = Type("ToolAnnotations", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("Hints that describe a tool's behavior to MCP clients")
+	Attribute("title", String, "A human-readable title for the tool", func() {
+		MaxLength(100)
+	})
+	Attribute("read_only_hint", Boolean, "If true, the tool does not modify its environment")
+	Attribute("destructive_hint", Boolean, "If true, the tool may perform destructive updates")
+	Attribute("idempotent_hint", Boolean, "If true, calling the tool repeatedly with the same arguments has no additional effect")
+	Attribute("open_world_hint", Boolean, "If true, the tool interacts with external entities")
+})
+
+var ToolRetryPolicy = Type("ToolRetryPolicy", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("Controls how calls to a tool's upstream API are timed out and retried. Unset fields fall back to the gateway's defaults.")
+	Attribute("max_attempts", Int, "The number of attempts made including the first. A value of 1 disables retries.", func() {
+		Minimum(1)
+		Maximum(10)
+	})
+	Attribute("status_codes", ArrayOf(String, func() {
+		Pattern(`^[1-5]([0-9]{2}|XX)$`)
+	}), "Response status codes that are retried, such as 429 or 5XX")
+	Attribute("methods", ArrayOf(String, func() {
+		Enum("GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS")
+	}), "HTTP methods that may be retried")
+	Attribute("retry_connection_errors", Boolean, "Whether requests that fail before a response is received are retried")
+	Attribute("initial_interval_ms", Int64, "The delay before the first retry in milliseconds", func() {
+		Minimum(1)
+	})
+	Attribute("max_interval_ms", Int64, "The maximum delay between two attempts in milliseconds", func() {
+		Minimum(1)
+	})
+	Attribute("exponent", Float64, "The factor the delay grows by after each retry", func() {
+		Minimum(1)
+		Maximum(10)
+	})
+	Attribute("max_elapsed_time_ms", Int64, "The overall deadline for a call across all of its attempts in milliseconds", func() {
+		Minimum(1)
+	})
+	Attribute("timeout_ms", Int64, "The time allowed for a single attempt, including reading the response, in milliseconds", func() {
+		Minimum(1)
+	})
+})
+
 var HTTPToolDefinition = Type("HTTPToolDefinition", func() {
 	Meta("struct:pkg:path", "types")
 
@@ -31,6 +77,11 @@ var HTTPToolDefinition = Type("HTTPToolDefinition", func() {
 	Attribute("confirm_prompt", String, "Prompt for the confirmation")
 	Attribute("summarizer", String, "Summarizer for the tool")
 	Attribute("response_filter", ResponseFilter, "Response filter metadata for the tool")
+	Attribute("annotations", ToolAnnotations, "Behavioral hints for the tool, derived from its HTTP method unless overridden")
+	Attribute("response_budget", Int64, "The maximum size in bytes of a response returned to the model before it is truncated. Falls back to the toolset's budget when unset.", func() {
+		Minimum(1)
+	})
+	Attribute("retry_policy", ToolRetryPolicy, "How calls to the tool's upstream API are timed out and retried")
 
 	Attribute("openapiv3_document_id", String, "The ID of the OpenAPI v3 document")
 	Attribute("openapiv3_operation", String, "OpenAPI v3 operation")
@@ -41,6 +92,7 @@ var HTTPToolDefinition = Type("HTTPToolDefinition", func() {
 	Attribute("path", String, "Path for the request")
 	Attribute("schema_version", String, "Version of the schema")
 	Attribute("schema", String, "JSON schema for the request")
+	Attribute("output_schema", String, "JSON schema for the structured output of the tool, derived from its successful response")
 	Attribute("package_name", String, "The name of the source package")
 
 	Attribute("created_at", String, func() {
@@ -79,6 +131,11 @@ var CanonicalToolAttributes = Type("CanonicalToolAttributes", func() {
 	Attribute("confirm_prompt", String, "Prompt for the confirmation")
 	Attribute("summarizer", String, "Summarizer for the tool")
 	Attribute("tags", ArrayOf(String), "The tags list for this http tool")
+	Attribute("annotations", ToolAnnotations, "Behavioral hints set for the tool in its source document")
+	Attribute("response_budget", Int64, "The response budget set for the tool in its source document", func() {
+		Minimum(1)
+	})
+	Attribute("retry_policy", ToolRetryPolicy, "The retry policy set for the tool in its source document")
 })
 
 var Environment = Type("Environment", func() {
@@ -36,14 +36,27 @@ var Toolset = Type("Toolset", func() {
 	Attribute("name", String, "The name of the toolset")
 	Attribute("slug", Slug, "The slug of the toolset")
 	Attribute("description", String, "Description of the toolset")
+	Attribute("instructions", String, "Long-form guidance returned to MCP clients when they connect, such as the intended order of tool calls and a glossary of domain terms")
+	Attribute("instructions_version", Int32, "The revision number of the current instructions. Every edit creates a new revision.")
 	Attribute("default_environment_slug", Slug, "The slug of the environment to use as the default for the toolset")
 	Attribute("security_variables", ArrayOf(SecurityVariable), "The security variables that are relevant to the toolset")
 	Attribute("server_variables", ArrayOf(ServerVariable), "The server variables that are relevant to the toolset")
 	Attribute("http_tools", ArrayOf(HTTPToolDefinition), "The HTTP tools in this toolset")
 	Attribute("prompt_templates", ArrayOf(PromptTemplate), "The prompt templates in this toolset")
+	Attribute("resources", ArrayOf(ToolsetResource), "The MCP resources published by this toolset")
+	Attribute("client_rules", ArrayOf(ToolsetClientRule), "Rules that decide which MCP clients may connect to the toolset")
 	Attribute("mcp_slug", Slug, "The slug of the MCP to use for the toolset")
 	Attribute("mcp_is_public", Boolean, "Whether the toolset is public in MCP")
 	Attribute("mcp_enabled", Boolean, "Whether the toolset is enabled for MCP")
+	Attribute("tool_selection_mode", String, "How MCP clients discover tools. static lists every tool while dynamic lists meta-tools to search for, describe and execute tools on demand.", func() {
+		Enum("static", "dynamic")
+	})
+	Attribute("resource_link_threshold", Int64, "Size in bytes above which binary and document tool responses are returned as a short-lived download link instead of being embedded in the result.", func() {
+		Minimum(0)
+	})
+	Attribute("response_budget", Int64, "Size in bytes above which text tool responses are truncated before they are returned to the model. Tools may set their own budget.", func() {
+		Minimum(1)
+	})
 	Attribute("custom_domain_id", String, "The ID of the custom domain to use for the toolset")
 	Attribute("external_oauth_server", ExternalOAuthServer, "The external OAuth server details")
 	Attribute("oauth_proxy_server", OAuthProxyServer, "The OAuth proxy server details")
@@ -87,6 +100,64 @@ var ToolsetEntry = Type("ToolsetEntry", func() {
 	Required("id", "project_id", "organization_id", "name", "slug", "http_tools", "prompt_templates", "created_at", "updated_at")
 })
 
+var ToolsetResource = Type("ToolsetResource", func() {
+	Meta("struct:pkg:path", "types")
+
+	Attribute("id", String, "The ID of the resource")
+	Attribute("kind", String, "The source of the resource", func() {
+		Enum("asset", "http_template")
+	})
+	Attribute("name", Slug, "The name of the resource, unique within the toolset")
+	Attribute("description", String, "Description of the resource")
+	Attribute("mime_type", String, "The MIME type of the resource contents")
+	Attribute("uri", String, "The URI of a static resource")
+	Attribute("uri_template", String, "The RFC 6570 URI template of a templated resource")
+	Attribute("asset_id", String, "The ID of the asset backing a static resource")
+	Attribute("http_tool_name", String, "The name of the GET tool backing a templated resource")
+	Attribute("created_at", String, func() {
+		Description("When the resource was created.")
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, func() {
+		Description("When the resource was last updated.")
+		Format(FormatDateTime)
+	})
+	Required("id", "kind", "name", "created_at", "updated_at")
+})
+
+var ToolsetResourceForm = Type("ToolsetResourceForm", func() {
+	Meta("struct:pkg:path", "types")
+
+	Attribute("kind", String, "The source of the resource", func() {
+		Enum("asset", "http_template")
+	})
+	Attribute("name", Slug, "The name of the resource, unique within the toolset")
+	Attribute("description", String, "Description of the resource", func() {
+		MaxLength(1000)
+	})
+	Attribute("mime_type", String, "The MIME type of the resource contents. Defaults to the content type of the asset or the response.")
+	Attribute("asset_id", String, "The ID of the asset backing a static resource. Required for asset resources.")
+	Attribute("http_tool_name", String, "The name of a GET tool backing a templated resource. Required for http_template resources.")
+	Attribute("uri_template", String, "The RFC 6570 URI template for a templated resource. Template variables must match the tool's path or query parameters. Defaults to a template covering every path and query parameter.")
+	Required("kind", "name")
+})
+
+var ToolsetClientRule = Type("ToolsetClientRule", func() {
+	Meta("struct:pkg:path", "types")
+
+	Attribute("client_name", String, "The client name to match, as sent in clientInfo.name when the client initializes. Matching ignores case.", func() {
+		MinLength(1)
+		MaxLength(100)
+	})
+	Attribute("kind", String, "allow adds the client to an allow-list so that only listed clients may connect, block rejects the client and minimum_version rejects versions of the client older than min_version.", func() {
+		Enum("allow", "block", "minimum_version")
+	})
+	Attribute("min_version", String, "The oldest semantic version of the client that may connect. Required for minimum_version rules.", func() {
+		MaxLength(50)
+	})
+	Required("client_name", "kind")
+})
+
 var ExternalOAuthServer = Type("ExternalOAuthServer", func() {
 	Meta("struct:pkg:path", "types")
 
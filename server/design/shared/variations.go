@@ -17,6 +17,11 @@ var ToolVariation = Type("ToolVariation", func() {
 	Attribute("description", String, "The description of the tool variation")
 	Attribute("tags", ArrayOf(String), "The tags of the tool variation")
 	Attribute("summarizer", String, "The summarizer of the tool variation")
+	Attribute("annotations", ToolAnnotations, "The behavioral hints of the tool variation")
+	Attribute("response_budget", Int64, "The response budget of the tool variation in bytes", func() {
+		Minimum(1)
+	})
+	Attribute("retry_policy", ToolRetryPolicy, "The retry policy of the tool variation. Fields that are set override the tool's own policy.")
 	Attribute("created_at", String, "The creation date of the tool variation")
 	Attribute("updated_at", String, "The last update date of the tool variation")
 
@@ -218,10 +218,24 @@ var UpdateToolsetForm = Type("UpdateToolsetForm", func() {
 	Attribute("slug", shared.Slug, "The slug of the toolset to update")
 	Attribute("name", String, "The new name of the toolset")
 	Attribute("description", String, "The new description of the toolset")
+	Attribute("instructions", String, "The new MCP server instructions for the toolset. An empty string removes them.", func() {
+		MaxLength(20000)
+	})
 	Attribute("default_environment_slug", shared.Slug, "The slug of the environment to use as the default for the toolset")
 	Attribute("http_tool_names", ArrayOf(String), "List of HTTP tool names to include")
 	Attribute("prompt_template_names", ArrayOf(String), "List of prompt template names to include")
+	Attribute("resources", ArrayOf(shared.ToolsetResourceForm), "The MCP resources to publish. Replaces all existing resources when set.")
+	Attribute("client_rules", ArrayOf(shared.ToolsetClientRule), "Rules that decide which MCP clients may connect. Replaces all existing rules when set.")
 	Attribute("mcp_enabled", Boolean, "Whether the toolset is enabled for MCP")
+	Attribute("tool_selection_mode", String, "How MCP clients discover tools. static lists every tool while dynamic lists meta-tools to search for, describe and execute tools on demand.", func() {
+		Enum("static", "dynamic")
+	})
+	Attribute("resource_link_threshold", Int64, "Size in bytes above which binary and document tool responses are returned as a short-lived download link instead of being embedded in the result.", func() {
+		Minimum(0)
+	})
+	Attribute("response_budget", Int64, "Size in bytes above which text tool responses are truncated before they are returned to the model. Tools may set their own budget.", func() {
+		Minimum(1)
+	})
 	Attribute("mcp_slug", shared.Slug, "The slug of the MCP to use for the toolset")
 	Attribute("mcp_is_public", Boolean, "Whether the toolset is public in MCP")
 	Attribute("custom_domain_id", String, "The ID of the custom domain to use for the toolset")
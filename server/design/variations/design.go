@@ -104,6 +104,11 @@ var UpsertGlobalToolVariationForm = Type("UpsertGlobalToolVariationForm", func()
 	Attribute("description", String, "The description of the tool variation")
 	Attribute("tags", ArrayOf(String), "The tags of the tool variation")
 	Attribute("summarizer", String, "The summarizer of the tool variation")
+	Attribute("annotations", shared.ToolAnnotations, "The behavioral hints of the tool variation")
+	Attribute("response_budget", Int64, "The response budget of the tool variation in bytes", func() {
+		Minimum(1)
+	})
+	Attribute("retry_policy", shared.ToolRetryPolicy, "The retry policy of the tool variation. Fields that are set override the tool's own policy.")
 })
 
 var UpsertGlobalToolVariationResult = Type("UpsertGlobalToolVariationResult", func() {
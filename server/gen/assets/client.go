@@ -18,6 +18,7 @@ import (
 type Client struct {
 	ServeImageEndpoint      goa.Endpoint
 	UploadImageEndpoint     goa.Endpoint
+	UploadDocumentEndpoint  goa.Endpoint
 	UploadFunctionsEndpoint goa.Endpoint
 	UploadOpenAPIv3Endpoint goa.Endpoint
 	ServeOpenAPIv3Endpoint  goa.Endpoint
@@ -25,10 +26,11 @@ type Client struct {
 }
 
 // NewClient initializes a "assets" service client given the endpoints.
-func NewClient(serveImage, uploadImage, uploadFunctions, uploadOpenAPIv3, serveOpenAPIv3, listAssets goa.Endpoint) *Client {
+func NewClient(serveImage, uploadImage, uploadDocument, uploadFunctions, uploadOpenAPIv3, serveOpenAPIv3, listAssets goa.Endpoint) *Client {
 	return &Client{
 		ServeImageEndpoint:      serveImage,
 		UploadImageEndpoint:     uploadImage,
+		UploadDocumentEndpoint:  uploadDocument,
 		UploadFunctionsEndpoint: uploadFunctions,
 		UploadOpenAPIv3Endpoint: uploadOpenAPIv3,
 		ServeOpenAPIv3Endpoint:  serveOpenAPIv3,
@@ -81,6 +83,28 @@ func (c *Client) UploadImage(ctx context.Context, p *UploadImageForm, req io.Rea
 	return ires.(*UploadImageResult), nil
 }
 
+// UploadDocument calls the "uploadDocument" endpoint of the "assets" service.
+// UploadDocument may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) UploadDocument(ctx context.Context, p *UploadDocumentForm, req io.ReadCloser) (res *UploadDocumentResult, err error) {
+	var ires any
+	ires, err = c.UploadDocumentEndpoint(ctx, &UploadDocumentRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*UploadDocumentResult), nil
+}
+
 // UploadFunctions calls the "uploadFunctions" endpoint of the "assets" service.
 // UploadFunctions may return the following errors:
 //   - "unauthorized" (type *goa.ServiceError): unauthorized access
@@ -25,6 +25,9 @@ type Service interface {
 	ServeImage(context.Context, *ServeImageForm) (res *ServeImageResult, body io.ReadCloser, err error)
 	// Upload an image to Gram.
 	UploadImage(context.Context, *UploadImageForm, io.ReadCloser) (res *UploadImageResult, err error)
+	// Upload a document, such as a markdown runbook, that a toolset can publish as
+	// an MCP resource.
+	UploadDocument(context.Context, *UploadDocumentForm, io.ReadCloser) (res *UploadDocumentResult, err error)
 	// Upload functions to Gram.
 	UploadFunctions(context.Context, *UploadFunctionsForm, io.ReadCloser) (res *UploadFunctionsResult, err error)
 	// Upload an OpenAPI v3 document to Gram.
@@ -59,7 +62,7 @@ const ServiceName = "assets"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [6]string{"serveImage", "uploadImage", "uploadFunctions", "uploadOpenAPIv3", "serveOpenAPIv3", "listAssets"}
+var MethodNames = [7]string{"serveImage", "uploadImage", "uploadDocument", "uploadFunctions", "uploadOpenAPIv3", "serveOpenAPIv3", "listAssets"}
 
 type Asset struct {
 	// The ID of the asset
@@ -125,6 +128,23 @@ type ServeOpenAPIv3Result struct {
 	LastModified  string
 }
 
+// UploadDocumentForm is the payload type of the assets service uploadDocument
+// method.
+type UploadDocumentForm struct {
+	ApikeyToken      *string
+	SessionToken     *string
+	ProjectSlugInput *string
+	ContentType      string
+	ContentLength    int64
+}
+
+// UploadDocumentResult is the result type of the assets service uploadDocument
+// method.
+type UploadDocumentResult struct {
+	// The asset entry that was created in Gram
+	Asset *Asset
+}
+
 // UploadFunctionsForm is the payload type of the assets service
 // uploadFunctions method.
 type UploadFunctionsForm struct {
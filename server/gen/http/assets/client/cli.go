@@ -84,6 +84,49 @@ func BuildUploadImagePayload(assetsUploadImageContentType string, assetsUploadIm
 	return v, nil
 }
 
+// BuildUploadDocumentPayload builds the payload for the assets uploadDocument
+// endpoint from CLI flags.
+func BuildUploadDocumentPayload(assetsUploadDocumentContentType string, assetsUploadDocumentContentLength string, assetsUploadDocumentApikeyToken string, assetsUploadDocumentProjectSlugInput string, assetsUploadDocumentSessionToken string) (*assets.UploadDocumentForm, error) {
+	var err error
+	var contentType string
+	{
+		contentType = assetsUploadDocumentContentType
+	}
+	var contentLength int64
+	{
+		contentLength, err = strconv.ParseInt(assetsUploadDocumentContentLength, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for contentLength, must be INT64")
+		}
+	}
+	var apikeyToken *string
+	{
+		if assetsUploadDocumentApikeyToken != "" {
+			apikeyToken = &assetsUploadDocumentApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if assetsUploadDocumentProjectSlugInput != "" {
+			projectSlugInput = &assetsUploadDocumentProjectSlugInput
+		}
+	}
+	var sessionToken *string
+	{
+		if assetsUploadDocumentSessionToken != "" {
+			sessionToken = &assetsUploadDocumentSessionToken
+		}
+	}
+	v := &assets.UploadDocumentForm{}
+	v.ContentType = contentType
+	v.ContentLength = contentLength
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
 // BuildUploadFunctionsPayload builds the payload for the assets
 // uploadFunctions endpoint from CLI flags.
 func BuildUploadFunctionsPayload(assetsUploadFunctionsContentType string, assetsUploadFunctionsContentLength string, assetsUploadFunctionsApikeyToken string, assetsUploadFunctionsProjectSlugInput string, assetsUploadFunctionsSessionToken string) (*assets.UploadFunctionsForm, error) {
@@ -26,6 +26,10 @@ type Client struct {
 	// endpoint.
 	UploadImageDoer goahttp.Doer
 
+	// UploadDocument Doer is the HTTP client used to make requests to the
+	// uploadDocument endpoint.
+	UploadDocumentDoer goahttp.Doer
+
 	// UploadFunctions Doer is the HTTP client used to make requests to the
 	// uploadFunctions endpoint.
 	UploadFunctionsDoer goahttp.Doer
@@ -64,6 +68,7 @@ func NewClient(
 	return &Client{
 		ServeImageDoer:      doer,
 		UploadImageDoer:     doer,
+		UploadDocumentDoer:  doer,
 		UploadFunctionsDoer: doer,
 		UploadOpenAPIv3Doer: doer,
 		ServeOpenAPIv3Doer:  doer,
@@ -129,6 +134,30 @@ func (c *Client) UploadImage() goa.Endpoint {
 	}
 }
 
+// UploadDocument returns an endpoint that makes HTTP requests to the assets
+// service uploadDocument server.
+func (c *Client) UploadDocument() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUploadDocumentRequest(c.encoder)
+		decodeResponse = DecodeUploadDocumentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUploadDocumentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UploadDocumentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("assets", "uploadDocument", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // UploadFunctions returns an endpoint that makes HTTP requests to the assets
 // service uploadFunctions server.
 func (c *Client) UploadFunctions() goa.Endpoint {
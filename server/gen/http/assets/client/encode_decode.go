@@ -543,6 +543,275 @@ func BuildUploadImageStreamPayload(payload any, fpath string) (*assets.UploadIma
 	}, nil
 }
 
+// BuildUploadDocumentRequest instantiates a HTTP request object with method
+// and path set to call the "assets" service "uploadDocument" endpoint
+func (c *Client) BuildUploadDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		body io.Reader
+	)
+	rd, ok := v.(*assets.UploadDocumentRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("assets", "uploadDocument", "assets.UploadDocumentRequestData", v)
+	}
+	body = rd.Body
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadDocumentAssetsPath()}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("assets", "uploadDocument", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUploadDocumentRequest returns an encoder for requests sent to the
+// assets uploadDocument server.
+func EncodeUploadDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*assets.UploadDocumentRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("assets", "uploadDocument", "*assets.UploadDocumentRequestData", v)
+		}
+		p := data.Payload
+		{
+			head := p.ContentType
+			req.Header.Set("Content-Type", head)
+		}
+		{
+			head := p.ContentLength
+			headStr := strconv.FormatInt(head, 10)
+			req.Header.Set("Content-Length", headStr)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		return nil
+	}
+}
+
+// DecodeUploadDocumentResponse returns a decoder for responses returned by the
+// assets uploadDocument endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUploadDocumentResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeUploadDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UploadDocumentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			res := NewUploadDocumentResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body UploadDocumentUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			return nil, NewUploadDocumentUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body UploadDocumentForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			return nil, NewUploadDocumentForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body UploadDocumentBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			return nil, NewUploadDocumentBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body UploadDocumentNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			return nil, NewUploadDocumentNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body UploadDocumentConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			return nil, NewUploadDocumentConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body UploadDocumentUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			return nil, NewUploadDocumentUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body UploadDocumentInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			return nil, NewUploadDocumentInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body UploadDocumentInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+				}
+				err = ValidateUploadDocumentInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+				}
+				return nil, NewUploadDocumentInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body UploadDocumentUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+				}
+				err = ValidateUploadDocumentUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+				}
+				return nil, NewUploadDocumentUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("assets", "uploadDocument", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body UploadDocumentGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("assets", "uploadDocument", err)
+			}
+			err = ValidateUploadDocumentGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("assets", "uploadDocument", err)
+			}
+			return nil, NewUploadDocumentGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("assets", "uploadDocument", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildUploadDocumentStreamPayload creates a streaming endpoint request
+// payload from the method payload and the path to the file to be streamed
+func BuildUploadDocumentStreamPayload(payload any, fpath string) (*assets.UploadDocumentRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &assets.UploadDocumentRequestData{
+		Payload: payload.(*assets.UploadDocumentForm),
+		Body:    f,
+	}, nil
+}
+
 // BuildUploadFunctionsRequest instantiates a HTTP request object with method
 // and path set to call the "assets" service "uploadFunctions" endpoint
 func (c *Client) BuildUploadFunctionsRequest(ctx context.Context, v any) (*http.Request, error) {
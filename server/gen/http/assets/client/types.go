@@ -19,6 +19,13 @@ type UploadImageResponseBody struct {
 	Asset *AssetResponseBody `form:"asset,omitempty" json:"asset,omitempty" xml:"asset,omitempty"`
 }
 
+// UploadDocumentResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body.
+type UploadDocumentResponseBody struct {
+	// The asset entry that was created in Gram
+	Asset *AssetResponseBody `form:"asset,omitempty" json:"asset,omitempty" xml:"asset,omitempty"`
+}
+
 // UploadFunctionsResponseBody is the type of the "assets" service
 // "uploadFunctions" endpoint HTTP response body.
 type UploadFunctionsResponseBody struct {
@@ -401,6 +408,188 @@ type UploadImageGatewayErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// UploadDocumentUnauthorizedResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "unauthorized" error.
+type UploadDocumentUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentForbiddenResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "forbidden" error.
+type UploadDocumentForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentBadRequestResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "bad_request" error.
+type UploadDocumentBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentNotFoundResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "not_found" error.
+type UploadDocumentNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentConflictResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "conflict" error.
+type UploadDocumentConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentUnsupportedMediaResponseBody is the type of the "assets"
+// service "uploadDocument" endpoint HTTP response body for the
+// "unsupported_media" error.
+type UploadDocumentUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentInvalidResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "invalid" error.
+type UploadDocumentInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentInvariantViolationResponseBody is the type of the "assets"
+// service "uploadDocument" endpoint HTTP response body for the
+// "invariant_violation" error.
+type UploadDocumentInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentUnexpectedResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "unexpected" error.
+type UploadDocumentUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadDocumentGatewayErrorResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "gateway_error" error.
+type UploadDocumentGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // UploadFunctionsUnauthorizedResponseBody is the type of the "assets" service
 // "uploadFunctions" endpoint HTTP response body for the "unauthorized" error.
 type UploadFunctionsUnauthorizedResponseBody struct {
@@ -1464,18 +1653,18 @@ func NewUploadImageGatewayError(body *UploadImageGatewayErrorResponseBody) *goa.
 	return v
 }
 
-// NewUploadFunctionsResultOK builds a "assets" service "uploadFunctions"
+// NewUploadDocumentResultOK builds a "assets" service "uploadDocument"
 // endpoint result from a HTTP "OK" response.
-func NewUploadFunctionsResultOK(body *UploadFunctionsResponseBody) *assets.UploadFunctionsResult {
-	v := &assets.UploadFunctionsResult{}
+func NewUploadDocumentResultOK(body *UploadDocumentResponseBody) *assets.UploadDocumentResult {
+	v := &assets.UploadDocumentResult{}
 	v.Asset = unmarshalAssetResponseBodyToAssetsAsset(body.Asset)
 
 	return v
 }
 
-// NewUploadFunctionsUnauthorized builds a assets service uploadFunctions
+// NewUploadDocumentUnauthorized builds a assets service uploadDocument
 // endpoint unauthorized error.
-func NewUploadFunctionsUnauthorized(body *UploadFunctionsUnauthorizedResponseBody) *goa.ServiceError {
+func NewUploadDocumentUnauthorized(body *UploadDocumentUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1488,9 +1677,9 @@ func NewUploadFunctionsUnauthorized(body *UploadFunctionsUnauthorizedResponseBod
 	return v
 }
 
-// NewUploadFunctionsForbidden builds a assets service uploadFunctions endpoint
+// NewUploadDocumentForbidden builds a assets service uploadDocument endpoint
 // forbidden error.
-func NewUploadFunctionsForbidden(body *UploadFunctionsForbiddenResponseBody) *goa.ServiceError {
+func NewUploadDocumentForbidden(body *UploadDocumentForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1503,9 +1692,9 @@ func NewUploadFunctionsForbidden(body *UploadFunctionsForbiddenResponseBody) *go
 	return v
 }
 
-// NewUploadFunctionsBadRequest builds a assets service uploadFunctions
-// endpoint bad_request error.
-func NewUploadFunctionsBadRequest(body *UploadFunctionsBadRequestResponseBody) *goa.ServiceError {
+// NewUploadDocumentBadRequest builds a assets service uploadDocument endpoint
+// bad_request error.
+func NewUploadDocumentBadRequest(body *UploadDocumentBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1518,9 +1707,9 @@ func NewUploadFunctionsBadRequest(body *UploadFunctionsBadRequestResponseBody) *
 	return v
 }
 
-// NewUploadFunctionsNotFound builds a assets service uploadFunctions endpoint
+// NewUploadDocumentNotFound builds a assets service uploadDocument endpoint
 // not_found error.
-func NewUploadFunctionsNotFound(body *UploadFunctionsNotFoundResponseBody) *goa.ServiceError {
+func NewUploadDocumentNotFound(body *UploadDocumentNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1533,9 +1722,9 @@ func NewUploadFunctionsNotFound(body *UploadFunctionsNotFoundResponseBody) *goa.
 	return v
 }
 
-// NewUploadFunctionsConflict builds a assets service uploadFunctions endpoint
+// NewUploadDocumentConflict builds a assets service uploadDocument endpoint
 // conflict error.
-func NewUploadFunctionsConflict(body *UploadFunctionsConflictResponseBody) *goa.ServiceError {
+func NewUploadDocumentConflict(body *UploadDocumentConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1548,9 +1737,9 @@ func NewUploadFunctionsConflict(body *UploadFunctionsConflictResponseBody) *goa.
 	return v
 }
 
-// NewUploadFunctionsUnsupportedMedia builds a assets service uploadFunctions
+// NewUploadDocumentUnsupportedMedia builds a assets service uploadDocument
 // endpoint unsupported_media error.
-func NewUploadFunctionsUnsupportedMedia(body *UploadFunctionsUnsupportedMediaResponseBody) *goa.ServiceError {
+func NewUploadDocumentUnsupportedMedia(body *UploadDocumentUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1563,9 +1752,9 @@ func NewUploadFunctionsUnsupportedMedia(body *UploadFunctionsUnsupportedMediaRes
 	return v
 }
 
-// NewUploadFunctionsInvalid builds a assets service uploadFunctions endpoint
+// NewUploadDocumentInvalid builds a assets service uploadDocument endpoint
 // invalid error.
-func NewUploadFunctionsInvalid(body *UploadFunctionsInvalidResponseBody) *goa.ServiceError {
+func NewUploadDocumentInvalid(body *UploadDocumentInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1578,9 +1767,9 @@ func NewUploadFunctionsInvalid(body *UploadFunctionsInvalidResponseBody) *goa.Se
 	return v
 }
 
-// NewUploadFunctionsInvariantViolation builds a assets service uploadFunctions
+// NewUploadDocumentInvariantViolation builds a assets service uploadDocument
 // endpoint invariant_violation error.
-func NewUploadFunctionsInvariantViolation(body *UploadFunctionsInvariantViolationResponseBody) *goa.ServiceError {
+func NewUploadDocumentInvariantViolation(body *UploadDocumentInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1593,9 +1782,9 @@ func NewUploadFunctionsInvariantViolation(body *UploadFunctionsInvariantViolatio
 	return v
 }
 
-// NewUploadFunctionsUnexpected builds a assets service uploadFunctions
-// endpoint unexpected error.
-func NewUploadFunctionsUnexpected(body *UploadFunctionsUnexpectedResponseBody) *goa.ServiceError {
+// NewUploadDocumentUnexpected builds a assets service uploadDocument endpoint
+// unexpected error.
+func NewUploadDocumentUnexpected(body *UploadDocumentUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1608,9 +1797,9 @@ func NewUploadFunctionsUnexpected(body *UploadFunctionsUnexpectedResponseBody) *
 	return v
 }
 
-// NewUploadFunctionsGatewayError builds a assets service uploadFunctions
+// NewUploadDocumentGatewayError builds a assets service uploadDocument
 // endpoint gateway_error error.
-func NewUploadFunctionsGatewayError(body *UploadFunctionsGatewayErrorResponseBody) *goa.ServiceError {
+func NewUploadDocumentGatewayError(body *UploadDocumentGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1623,18 +1812,18 @@ func NewUploadFunctionsGatewayError(body *UploadFunctionsGatewayErrorResponseBod
 	return v
 }
 
-// NewUploadOpenAPIv3ResultOK builds a "assets" service "uploadOpenAPIv3"
+// NewUploadFunctionsResultOK builds a "assets" service "uploadFunctions"
 // endpoint result from a HTTP "OK" response.
-func NewUploadOpenAPIv3ResultOK(body *UploadOpenAPIv3ResponseBody) *assets.UploadOpenAPIv3Result {
-	v := &assets.UploadOpenAPIv3Result{}
+func NewUploadFunctionsResultOK(body *UploadFunctionsResponseBody) *assets.UploadFunctionsResult {
+	v := &assets.UploadFunctionsResult{}
 	v.Asset = unmarshalAssetResponseBodyToAssetsAsset(body.Asset)
 
 	return v
 }
 
-// NewUploadOpenAPIv3Unauthorized builds a assets service uploadOpenAPIv3
+// NewUploadFunctionsUnauthorized builds a assets service uploadFunctions
 // endpoint unauthorized error.
-func NewUploadOpenAPIv3Unauthorized(body *UploadOpenAPIv3UnauthorizedResponseBody) *goa.ServiceError {
+func NewUploadFunctionsUnauthorized(body *UploadFunctionsUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1647,9 +1836,9 @@ func NewUploadOpenAPIv3Unauthorized(body *UploadOpenAPIv3UnauthorizedResponseBod
 	return v
 }
 
-// NewUploadOpenAPIv3Forbidden builds a assets service uploadOpenAPIv3 endpoint
+// NewUploadFunctionsForbidden builds a assets service uploadFunctions endpoint
 // forbidden error.
-func NewUploadOpenAPIv3Forbidden(body *UploadOpenAPIv3ForbiddenResponseBody) *goa.ServiceError {
+func NewUploadFunctionsForbidden(body *UploadFunctionsForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1662,9 +1851,9 @@ func NewUploadOpenAPIv3Forbidden(body *UploadOpenAPIv3ForbiddenResponseBody) *go
 	return v
 }
 
-// NewUploadOpenAPIv3BadRequest builds a assets service uploadOpenAPIv3
+// NewUploadFunctionsBadRequest builds a assets service uploadFunctions
 // endpoint bad_request error.
-func NewUploadOpenAPIv3BadRequest(body *UploadOpenAPIv3BadRequestResponseBody) *goa.ServiceError {
+func NewUploadFunctionsBadRequest(body *UploadFunctionsBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1677,9 +1866,9 @@ func NewUploadOpenAPIv3BadRequest(body *UploadOpenAPIv3BadRequestResponseBody) *
 	return v
 }
 
-// NewUploadOpenAPIv3NotFound builds a assets service uploadOpenAPIv3 endpoint
+// NewUploadFunctionsNotFound builds a assets service uploadFunctions endpoint
 // not_found error.
-func NewUploadOpenAPIv3NotFound(body *UploadOpenAPIv3NotFoundResponseBody) *goa.ServiceError {
+func NewUploadFunctionsNotFound(body *UploadFunctionsNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1692,9 +1881,9 @@ func NewUploadOpenAPIv3NotFound(body *UploadOpenAPIv3NotFoundResponseBody) *goa.
 	return v
 }
 
-// NewUploadOpenAPIv3Conflict builds a assets service uploadOpenAPIv3 endpoint
+// NewUploadFunctionsConflict builds a assets service uploadFunctions endpoint
 // conflict error.
-func NewUploadOpenAPIv3Conflict(body *UploadOpenAPIv3ConflictResponseBody) *goa.ServiceError {
+func NewUploadFunctionsConflict(body *UploadFunctionsConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1707,9 +1896,9 @@ func NewUploadOpenAPIv3Conflict(body *UploadOpenAPIv3ConflictResponseBody) *goa.
 	return v
 }
 
-// NewUploadOpenAPIv3UnsupportedMedia builds a assets service uploadOpenAPIv3
+// NewUploadFunctionsUnsupportedMedia builds a assets service uploadFunctions
 // endpoint unsupported_media error.
-func NewUploadOpenAPIv3UnsupportedMedia(body *UploadOpenAPIv3UnsupportedMediaResponseBody) *goa.ServiceError {
+func NewUploadFunctionsUnsupportedMedia(body *UploadFunctionsUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1722,9 +1911,9 @@ func NewUploadOpenAPIv3UnsupportedMedia(body *UploadOpenAPIv3UnsupportedMediaRes
 	return v
 }
 
-// NewUploadOpenAPIv3Invalid builds a assets service uploadOpenAPIv3 endpoint
+// NewUploadFunctionsInvalid builds a assets service uploadFunctions endpoint
 // invalid error.
-func NewUploadOpenAPIv3Invalid(body *UploadOpenAPIv3InvalidResponseBody) *goa.ServiceError {
+func NewUploadFunctionsInvalid(body *UploadFunctionsInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1737,9 +1926,9 @@ func NewUploadOpenAPIv3Invalid(body *UploadOpenAPIv3InvalidResponseBody) *goa.Se
 	return v
 }
 
-// NewUploadOpenAPIv3InvariantViolation builds a assets service uploadOpenAPIv3
+// NewUploadFunctionsInvariantViolation builds a assets service uploadFunctions
 // endpoint invariant_violation error.
-func NewUploadOpenAPIv3InvariantViolation(body *UploadOpenAPIv3InvariantViolationResponseBody) *goa.ServiceError {
+func NewUploadFunctionsInvariantViolation(body *UploadFunctionsInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1752,9 +1941,9 @@ func NewUploadOpenAPIv3InvariantViolation(body *UploadOpenAPIv3InvariantViolatio
 	return v
 }
 
-// NewUploadOpenAPIv3Unexpected builds a assets service uploadOpenAPIv3
+// NewUploadFunctionsUnexpected builds a assets service uploadFunctions
 // endpoint unexpected error.
-func NewUploadOpenAPIv3Unexpected(body *UploadOpenAPIv3UnexpectedResponseBody) *goa.ServiceError {
+func NewUploadFunctionsUnexpected(body *UploadFunctionsUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1767,9 +1956,9 @@ func NewUploadOpenAPIv3Unexpected(body *UploadOpenAPIv3UnexpectedResponseBody) *
 	return v
 }
 
-// NewUploadOpenAPIv3GatewayError builds a assets service uploadOpenAPIv3
+// NewUploadFunctionsGatewayError builds a assets service uploadFunctions
 // endpoint gateway_error error.
-func NewUploadOpenAPIv3GatewayError(body *UploadOpenAPIv3GatewayErrorResponseBody) *goa.ServiceError {
+func NewUploadFunctionsGatewayError(body *UploadFunctionsGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1782,20 +1971,18 @@ func NewUploadOpenAPIv3GatewayError(body *UploadOpenAPIv3GatewayErrorResponseBod
 	return v
 }
 
-// NewServeOpenAPIv3ResultOK builds a "assets" service "serveOpenAPIv3"
+// NewUploadOpenAPIv3ResultOK builds a "assets" service "uploadOpenAPIv3"
 // endpoint result from a HTTP "OK" response.
-func NewServeOpenAPIv3ResultOK(contentType string, contentLength int64, lastModified string) *assets.ServeOpenAPIv3Result {
-	v := &assets.ServeOpenAPIv3Result{}
-	v.ContentType = contentType
-	v.ContentLength = contentLength
-	v.LastModified = lastModified
+func NewUploadOpenAPIv3ResultOK(body *UploadOpenAPIv3ResponseBody) *assets.UploadOpenAPIv3Result {
+	v := &assets.UploadOpenAPIv3Result{}
+	v.Asset = unmarshalAssetResponseBodyToAssetsAsset(body.Asset)
 
 	return v
 }
 
-// NewServeOpenAPIv3Unauthorized builds a assets service serveOpenAPIv3
+// NewUploadOpenAPIv3Unauthorized builds a assets service uploadOpenAPIv3
 // endpoint unauthorized error.
-func NewServeOpenAPIv3Unauthorized(body *ServeOpenAPIv3UnauthorizedResponseBody) *goa.ServiceError {
+func NewUploadOpenAPIv3Unauthorized(body *UploadOpenAPIv3UnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1808,9 +1995,9 @@ func NewServeOpenAPIv3Unauthorized(body *ServeOpenAPIv3UnauthorizedResponseBody)
 	return v
 }
 
-// NewServeOpenAPIv3Forbidden builds a assets service serveOpenAPIv3 endpoint
+// NewUploadOpenAPIv3Forbidden builds a assets service uploadOpenAPIv3 endpoint
 // forbidden error.
-func NewServeOpenAPIv3Forbidden(body *ServeOpenAPIv3ForbiddenResponseBody) *goa.ServiceError {
+func NewUploadOpenAPIv3Forbidden(body *UploadOpenAPIv3ForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1823,9 +2010,9 @@ func NewServeOpenAPIv3Forbidden(body *ServeOpenAPIv3ForbiddenResponseBody) *goa.
 	return v
 }
 
-// NewServeOpenAPIv3BadRequest builds a assets service serveOpenAPIv3 endpoint
-// bad_request error.
-func NewServeOpenAPIv3BadRequest(body *ServeOpenAPIv3BadRequestResponseBody) *goa.ServiceError {
+// NewUploadOpenAPIv3BadRequest builds a assets service uploadOpenAPIv3
+// endpoint bad_request error.
+func NewUploadOpenAPIv3BadRequest(body *UploadOpenAPIv3BadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1838,9 +2025,170 @@ func NewServeOpenAPIv3BadRequest(body *ServeOpenAPIv3BadRequestResponseBody) *go
 	return v
 }
 
-// NewServeOpenAPIv3NotFound builds a assets service serveOpenAPIv3 endpoint
+// NewUploadOpenAPIv3NotFound builds a assets service uploadOpenAPIv3 endpoint
 // not_found error.
-func NewServeOpenAPIv3NotFound(body *ServeOpenAPIv3NotFoundResponseBody) *goa.ServiceError {
+func NewUploadOpenAPIv3NotFound(body *UploadOpenAPIv3NotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadOpenAPIv3Conflict builds a assets service uploadOpenAPIv3 endpoint
+// conflict error.
+func NewUploadOpenAPIv3Conflict(body *UploadOpenAPIv3ConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadOpenAPIv3UnsupportedMedia builds a assets service uploadOpenAPIv3
+// endpoint unsupported_media error.
+func NewUploadOpenAPIv3UnsupportedMedia(body *UploadOpenAPIv3UnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadOpenAPIv3Invalid builds a assets service uploadOpenAPIv3 endpoint
+// invalid error.
+func NewUploadOpenAPIv3Invalid(body *UploadOpenAPIv3InvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadOpenAPIv3InvariantViolation builds a assets service uploadOpenAPIv3
+// endpoint invariant_violation error.
+func NewUploadOpenAPIv3InvariantViolation(body *UploadOpenAPIv3InvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadOpenAPIv3Unexpected builds a assets service uploadOpenAPIv3
+// endpoint unexpected error.
+func NewUploadOpenAPIv3Unexpected(body *UploadOpenAPIv3UnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadOpenAPIv3GatewayError builds a assets service uploadOpenAPIv3
+// endpoint gateway_error error.
+func NewUploadOpenAPIv3GatewayError(body *UploadOpenAPIv3GatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewServeOpenAPIv3ResultOK builds a "assets" service "serveOpenAPIv3"
+// endpoint result from a HTTP "OK" response.
+func NewServeOpenAPIv3ResultOK(contentType string, contentLength int64, lastModified string) *assets.ServeOpenAPIv3Result {
+	v := &assets.ServeOpenAPIv3Result{}
+	v.ContentType = contentType
+	v.ContentLength = contentLength
+	v.LastModified = lastModified
+
+	return v
+}
+
+// NewServeOpenAPIv3Unauthorized builds a assets service serveOpenAPIv3
+// endpoint unauthorized error.
+func NewServeOpenAPIv3Unauthorized(body *ServeOpenAPIv3UnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewServeOpenAPIv3Forbidden builds a assets service serveOpenAPIv3 endpoint
+// forbidden error.
+func NewServeOpenAPIv3Forbidden(body *ServeOpenAPIv3ForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewServeOpenAPIv3BadRequest builds a assets service serveOpenAPIv3 endpoint
+// bad_request error.
+func NewServeOpenAPIv3BadRequest(body *ServeOpenAPIv3BadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewServeOpenAPIv3NotFound builds a assets service serveOpenAPIv3 endpoint
+// not_found error.
+func NewServeOpenAPIv3NotFound(body *ServeOpenAPIv3NotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -2119,6 +2467,20 @@ func ValidateUploadImageResponseBody(body *UploadImageResponseBody) (err error)
 	return
 }
 
+// ValidateUploadDocumentResponseBody runs the validations defined on
+// UploadDocumentResponseBody
+func ValidateUploadDocumentResponseBody(body *UploadDocumentResponseBody) (err error) {
+	if body.Asset == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset", "body"))
+	}
+	if body.Asset != nil {
+		if err2 := ValidateAssetResponseBody(body.Asset); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
 // ValidateUploadFunctionsResponseBody runs the validations defined on
 // UploadFunctionsResponseBody
 func ValidateUploadFunctionsResponseBody(body *UploadFunctionsResponseBody) (err error) {
@@ -2643,6 +3005,246 @@ func ValidateUploadImageGatewayErrorResponseBody(body *UploadImageGatewayErrorRe
 	return
 }
 
+// ValidateUploadDocumentUnauthorizedResponseBody runs the validations defined
+// on uploadDocument_unauthorized_response_body
+func ValidateUploadDocumentUnauthorizedResponseBody(body *UploadDocumentUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentForbiddenResponseBody runs the validations defined on
+// uploadDocument_forbidden_response_body
+func ValidateUploadDocumentForbiddenResponseBody(body *UploadDocumentForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentBadRequestResponseBody runs the validations defined on
+// uploadDocument_bad_request_response_body
+func ValidateUploadDocumentBadRequestResponseBody(body *UploadDocumentBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentNotFoundResponseBody runs the validations defined on
+// uploadDocument_not_found_response_body
+func ValidateUploadDocumentNotFoundResponseBody(body *UploadDocumentNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentConflictResponseBody runs the validations defined on
+// uploadDocument_conflict_response_body
+func ValidateUploadDocumentConflictResponseBody(body *UploadDocumentConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentUnsupportedMediaResponseBody runs the validations
+// defined on uploadDocument_unsupported_media_response_body
+func ValidateUploadDocumentUnsupportedMediaResponseBody(body *UploadDocumentUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentInvalidResponseBody runs the validations defined on
+// uploadDocument_invalid_response_body
+func ValidateUploadDocumentInvalidResponseBody(body *UploadDocumentInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentInvariantViolationResponseBody runs the validations
+// defined on uploadDocument_invariant_violation_response_body
+func ValidateUploadDocumentInvariantViolationResponseBody(body *UploadDocumentInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentUnexpectedResponseBody runs the validations defined on
+// uploadDocument_unexpected_response_body
+func ValidateUploadDocumentUnexpectedResponseBody(body *UploadDocumentUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadDocumentGatewayErrorResponseBody runs the validations defined
+// on uploadDocument_gateway_error_response_body
+func ValidateUploadDocumentGatewayErrorResponseBody(body *UploadDocumentGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateUploadFunctionsUnauthorizedResponseBody runs the validations defined
 // on uploadFunctions_unauthorized_response_body
 func ValidateUploadFunctionsUnauthorizedResponseBody(body *UploadFunctionsUnauthorizedResponseBody) (err error) {
@@ -17,6 +17,11 @@ func UploadImageAssetsPath() string {
 	return "/rpc/assets.uploadImage"
 }
 
+// UploadDocumentAssetsPath returns the URL path to the assets service uploadDocument HTTP endpoint.
+func UploadDocumentAssetsPath() string {
+	return "/rpc/assets.uploadDocument"
+}
+
 // UploadFunctionsAssetsPath returns the URL path to the assets service uploadFunctions HTTP endpoint.
 func UploadFunctionsAssetsPath() string {
 	return "/rpc/assets.uploadFunctions"
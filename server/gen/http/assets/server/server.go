@@ -24,6 +24,7 @@ type Server struct {
 	Mounts          []*MountPoint
 	ServeImage      http.Handler
 	UploadImage     http.Handler
+	UploadDocument  http.Handler
 	UploadFunctions http.Handler
 	UploadOpenAPIv3 http.Handler
 	ServeOpenAPIv3  http.Handler
@@ -59,6 +60,7 @@ func New(
 		Mounts: []*MountPoint{
 			{"ServeImage", "GET", "/rpc/assets.serveImage"},
 			{"UploadImage", "POST", "/rpc/assets.uploadImage"},
+			{"UploadDocument", "POST", "/rpc/assets.uploadDocument"},
 			{"UploadFunctions", "POST", "/rpc/assets.uploadFunctions"},
 			{"UploadOpenAPIv3", "POST", "/rpc/assets.uploadOpenAPIv3"},
 			{"ServeOpenAPIv3", "GET", "/rpc/assets.serveOpenAPIv3"},
@@ -66,6 +68,7 @@ func New(
 		},
 		ServeImage:      NewServeImageHandler(e.ServeImage, mux, decoder, encoder, errhandler, formatter),
 		UploadImage:     NewUploadImageHandler(e.UploadImage, mux, decoder, encoder, errhandler, formatter),
+		UploadDocument:  NewUploadDocumentHandler(e.UploadDocument, mux, decoder, encoder, errhandler, formatter),
 		UploadFunctions: NewUploadFunctionsHandler(e.UploadFunctions, mux, decoder, encoder, errhandler, formatter),
 		UploadOpenAPIv3: NewUploadOpenAPIv3Handler(e.UploadOpenAPIv3, mux, decoder, encoder, errhandler, formatter),
 		ServeOpenAPIv3:  NewServeOpenAPIv3Handler(e.ServeOpenAPIv3, mux, decoder, encoder, errhandler, formatter),
@@ -80,6 +83,7 @@ func (s *Server) Service() string { return "assets" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.ServeImage = m(s.ServeImage)
 	s.UploadImage = m(s.UploadImage)
+	s.UploadDocument = m(s.UploadDocument)
 	s.UploadFunctions = m(s.UploadFunctions)
 	s.UploadOpenAPIv3 = m(s.UploadOpenAPIv3)
 	s.ServeOpenAPIv3 = m(s.ServeOpenAPIv3)
@@ -93,6 +97,7 @@ func (s *Server) MethodNames() []string { return assets.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountServeImageHandler(mux, h.ServeImage)
 	MountUploadImageHandler(mux, h.UploadImage)
+	MountUploadDocumentHandler(mux, h.UploadDocument)
 	MountUploadFunctionsHandler(mux, h.UploadFunctions)
 	MountUploadOpenAPIv3Handler(mux, h.UploadOpenAPIv3)
 	MountServeOpenAPIv3Handler(mux, h.ServeOpenAPIv3)
@@ -246,6 +251,60 @@ func NewUploadImageHandler(
 	})
 }
 
+// MountUploadDocumentHandler configures the mux to serve the "assets" service
+// "uploadDocument" endpoint.
+func MountUploadDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/assets.uploadDocument", otelhttp.WithRouteTag("/rpc/assets.uploadDocument", f).ServeHTTP)
+}
+
+// NewUploadDocumentHandler creates a HTTP handler which loads the HTTP request
+// and calls the "assets" service "uploadDocument" endpoint.
+func NewUploadDocumentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUploadDocumentRequest(mux, decoder)
+		encodeResponse = EncodeUploadDocumentResponse(encoder)
+		encodeError    = EncodeUploadDocumentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "uploadDocument")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "assets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &assets.UploadDocumentRequestData{Payload: payload, Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountUploadFunctionsHandler configures the mux to serve the "assets" service
 // "uploadFunctions" endpoint.
 func MountUploadFunctionsHandler(mux goahttp.Muxer, h http.Handler) {
@@ -19,6 +19,13 @@ type UploadImageResponseBody struct {
 	Asset *AssetResponseBody `form:"asset" json:"asset" xml:"asset"`
 }
 
+// UploadDocumentResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body.
+type UploadDocumentResponseBody struct {
+	// The asset entry that was created in Gram
+	Asset *AssetResponseBody `form:"asset" json:"asset" xml:"asset"`
+}
+
 // UploadFunctionsResponseBody is the type of the "assets" service
 // "uploadFunctions" endpoint HTTP response body.
 type UploadFunctionsResponseBody struct {
@@ -401,6 +408,188 @@ type UploadImageGatewayErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// UploadDocumentUnauthorizedResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "unauthorized" error.
+type UploadDocumentUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentForbiddenResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "forbidden" error.
+type UploadDocumentForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentBadRequestResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "bad_request" error.
+type UploadDocumentBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentNotFoundResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "not_found" error.
+type UploadDocumentNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentConflictResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "conflict" error.
+type UploadDocumentConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentUnsupportedMediaResponseBody is the type of the "assets"
+// service "uploadDocument" endpoint HTTP response body for the
+// "unsupported_media" error.
+type UploadDocumentUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentInvalidResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "invalid" error.
+type UploadDocumentInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentInvariantViolationResponseBody is the type of the "assets"
+// service "uploadDocument" endpoint HTTP response body for the
+// "invariant_violation" error.
+type UploadDocumentInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentUnexpectedResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "unexpected" error.
+type UploadDocumentUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadDocumentGatewayErrorResponseBody is the type of the "assets" service
+// "uploadDocument" endpoint HTTP response body for the "gateway_error" error.
+type UploadDocumentGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // UploadFunctionsUnauthorizedResponseBody is the type of the "assets" service
 // "uploadFunctions" endpoint HTTP response body for the "unauthorized" error.
 type UploadFunctionsUnauthorizedResponseBody struct {
@@ -1154,6 +1343,16 @@ func NewUploadImageResponseBody(res *assets.UploadImageResult) *UploadImageRespo
 	return body
 }
 
+// NewUploadDocumentResponseBody builds the HTTP response body from the result
+// of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentResponseBody(res *assets.UploadDocumentResult) *UploadDocumentResponseBody {
+	body := &UploadDocumentResponseBody{}
+	if res.Asset != nil {
+		body.Asset = marshalAssetsAssetToAssetResponseBody(res.Asset)
+	}
+	return body
+}
+
 // NewUploadFunctionsResponseBody builds the HTTP response body from the result
 // of the "uploadFunctions" endpoint of the "assets" service.
 func NewUploadFunctionsResponseBody(res *assets.UploadFunctionsResult) *UploadFunctionsResponseBody {
@@ -1469,6 +1668,147 @@ func NewUploadImageGatewayErrorResponseBody(res *goa.ServiceError) *UploadImageG
 	return body
 }
 
+// NewUploadDocumentUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentUnauthorizedResponseBody(res *goa.ServiceError) *UploadDocumentUnauthorizedResponseBody {
+	body := &UploadDocumentUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentForbiddenResponseBody builds the HTTP response body from
+// the result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentForbiddenResponseBody(res *goa.ServiceError) *UploadDocumentForbiddenResponseBody {
+	body := &UploadDocumentForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentBadRequestResponseBody builds the HTTP response body from
+// the result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentBadRequestResponseBody(res *goa.ServiceError) *UploadDocumentBadRequestResponseBody {
+	body := &UploadDocumentBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentNotFoundResponseBody builds the HTTP response body from the
+// result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentNotFoundResponseBody(res *goa.ServiceError) *UploadDocumentNotFoundResponseBody {
+	body := &UploadDocumentNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentConflictResponseBody builds the HTTP response body from the
+// result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentConflictResponseBody(res *goa.ServiceError) *UploadDocumentConflictResponseBody {
+	body := &UploadDocumentConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentUnsupportedMediaResponseBody builds the HTTP response body
+// from the result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentUnsupportedMediaResponseBody(res *goa.ServiceError) *UploadDocumentUnsupportedMediaResponseBody {
+	body := &UploadDocumentUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentInvalidResponseBody builds the HTTP response body from the
+// result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentInvalidResponseBody(res *goa.ServiceError) *UploadDocumentInvalidResponseBody {
+	body := &UploadDocumentInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "uploadDocument" endpoint of the "assets"
+// service.
+func NewUploadDocumentInvariantViolationResponseBody(res *goa.ServiceError) *UploadDocumentInvariantViolationResponseBody {
+	body := &UploadDocumentInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentUnexpectedResponseBody builds the HTTP response body from
+// the result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentUnexpectedResponseBody(res *goa.ServiceError) *UploadDocumentUnexpectedResponseBody {
+	body := &UploadDocumentUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadDocumentGatewayErrorResponseBody builds the HTTP response body from
+// the result of the "uploadDocument" endpoint of the "assets" service.
+func NewUploadDocumentGatewayErrorResponseBody(res *goa.ServiceError) *UploadDocumentGatewayErrorResponseBody {
+	body := &UploadDocumentGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewUploadFunctionsUnauthorizedResponseBody builds the HTTP response body
 // from the result of the "uploadFunctions" endpoint of the "assets" service.
 func NewUploadFunctionsUnauthorizedResponseBody(res *goa.ServiceError) *UploadFunctionsUnauthorizedResponseBody {
@@ -2054,6 +2394,19 @@ func NewUploadImageForm(contentType string, contentLength int64, apikeyToken *st
 	return v
 }
 
+// NewUploadDocumentForm builds a assets service uploadDocument endpoint
+// payload.
+func NewUploadDocumentForm(contentType string, contentLength int64, apikeyToken *string, projectSlugInput *string, sessionToken *string) *assets.UploadDocumentForm {
+	v := &assets.UploadDocumentForm{}
+	v.ContentType = contentType
+	v.ContentLength = contentLength
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+	v.SessionToken = sessionToken
+
+	return v
+}
+
 // NewUploadFunctionsForm builds a assets service uploadFunctions endpoint
 // payload.
 func NewUploadFunctionsForm(contentType string, contentLength int64, apikeyToken *string, projectSlugInput *string, sessionToken *string) *assets.UploadFunctionsForm {
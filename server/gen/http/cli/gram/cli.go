@@ -41,7 +41,7 @@ import (
 func UsageCommands() []string {
 	return []string{
 		"about openapi",
-		"assets (serve-image|upload-image|upload-functions|upload-open-ap-iv3|serve-open-ap-iv3|list-assets)",
+		"assets (serve-image|upload-image|upload-document|upload-functions|upload-open-ap-iv3|serve-open-ap-iv3|list-assets)",
 		"auth (callback|login|switch-scopes|logout|register|info)",
 		"chat (list-chats|load-chat|credit-usage)",
 		"deployments (get-deployment|get-latest-deployment|create-deployment|evolve|redeploy|list-deployments|get-deployment-logs)",
@@ -64,10 +64,10 @@ func UsageCommands() []string {
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
 	return os.Args[0] + ` about openapi` + "\n" +
-		os.Args[0] + ` assets serve-image --id "Id aliquam culpa quis repudiandae." --session-token "Quibusdam voluptatem nulla nesciunt qui sapiente." --apikey-token "Vero modi et."` + "\n" +
-		os.Args[0] + ` auth callback --code "Voluptatem praesentium omnis corporis ex tenetur."` + "\n" +
-		os.Args[0] + ` chat list-chats --session-token "Id voluptatum repellat ut voluptatem suscipit neque." --project-slug-input "Quo minima laboriosam ipsam."` + "\n" +
-		os.Args[0] + ` deployments get-deployment --id "Praesentium rerum." --apikey-token "Sit illum eum odio minima eaque laborum." --session-token "Accusantium aliquam explicabo commodi inventore." --project-slug-input "Voluptatem dignissimos ut vel similique qui hic."` + "\n" +
+		os.Args[0] + ` assets serve-image --id "Soluta et iure quo doloremque voluptatem ab." --session-token "Explicabo eligendi labore." --apikey-token "Aut dignissimos voluptatem."` + "\n" +
+		os.Args[0] + ` auth callback --code "Alias rerum nihil aut ratione officia ipsum."` + "\n" +
+		os.Args[0] + ` chat list-chats --session-token "Dicta exercitationem." --project-slug-input "Sapiente quo dolor vero dolorum."` + "\n" +
+		os.Args[0] + ` deployments get-deployment --id "Qui eligendi unde in quam maiores." --apikey-token "Impedit ipsam dolor consectetur." --session-token "Hic quidem libero voluptas earum." --project-slug-input "Nisi voluptatem saepe eum ea harum ea."` + "\n" +
 		""
 }
 
@@ -100,6 +100,14 @@ func ParseEndpoint(
 		assetsUploadImageSessionTokenFlag     = assetsUploadImageFlags.String("session-token", "", "")
 		assetsUploadImageStreamFlag           = assetsUploadImageFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
 
+		assetsUploadDocumentFlags                = flag.NewFlagSet("upload-document", flag.ExitOnError)
+		assetsUploadDocumentContentTypeFlag      = assetsUploadDocumentFlags.String("content-type", "REQUIRED", "")
+		assetsUploadDocumentContentLengthFlag    = assetsUploadDocumentFlags.String("content-length", "REQUIRED", "")
+		assetsUploadDocumentApikeyTokenFlag      = assetsUploadDocumentFlags.String("apikey-token", "", "")
+		assetsUploadDocumentProjectSlugInputFlag = assetsUploadDocumentFlags.String("project-slug-input", "", "")
+		assetsUploadDocumentSessionTokenFlag     = assetsUploadDocumentFlags.String("session-token", "", "")
+		assetsUploadDocumentStreamFlag           = assetsUploadDocumentFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
 		assetsUploadFunctionsFlags                = flag.NewFlagSet("upload-functions", flag.ExitOnError)
 		assetsUploadFunctionsContentTypeFlag      = assetsUploadFunctionsFlags.String("content-type", "REQUIRED", "")
 		assetsUploadFunctionsContentLengthFlag    = assetsUploadFunctionsFlags.String("content-length", "REQUIRED", "")
@@ -493,6 +501,7 @@ func ParseEndpoint(
 	assetsFlags.Usage = assetsUsage
 	assetsServeImageFlags.Usage = assetsServeImageUsage
 	assetsUploadImageFlags.Usage = assetsUploadImageUsage
+	assetsUploadDocumentFlags.Usage = assetsUploadDocumentUsage
 	assetsUploadFunctionsFlags.Usage = assetsUploadFunctionsUsage
 	assetsUploadOpenAPIv3Flags.Usage = assetsUploadOpenAPIv3Usage
 	assetsServeOpenAPIv3Flags.Usage = assetsServeOpenAPIv3Usage
@@ -676,6 +685,9 @@ func ParseEndpoint(
 			case "upload-image":
 				epf = assetsUploadImageFlags
 
+			case "upload-document":
+				epf = assetsUploadDocumentFlags
+
 			case "upload-functions":
 				epf = assetsUploadFunctionsFlags
 
@@ -987,6 +999,12 @@ func ParseEndpoint(
 				if err == nil {
 					data, err = assetsc.BuildUploadImageStreamPayload(data, *assetsUploadImageStreamFlag)
 				}
+			case "upload-document":
+				endpoint = c.UploadDocument()
+				data, err = assetsc.BuildUploadDocumentPayload(*assetsUploadDocumentContentTypeFlag, *assetsUploadDocumentContentLengthFlag, *assetsUploadDocumentApikeyTokenFlag, *assetsUploadDocumentProjectSlugInputFlag, *assetsUploadDocumentSessionTokenFlag)
+				if err == nil {
+					data, err = assetsc.BuildUploadDocumentStreamPayload(data, *assetsUploadDocumentStreamFlag)
+				}
 			case "upload-functions":
 				endpoint = c.UploadFunctions()
 				data, err = assetsc.BuildUploadFunctionsPayload(*assetsUploadFunctionsContentTypeFlag, *assetsUploadFunctionsContentLengthFlag, *assetsUploadFunctionsApikeyTokenFlag, *assetsUploadFunctionsProjectSlugInputFlag, *assetsUploadFunctionsSessionTokenFlag)
@@ -1306,6 +1324,7 @@ func assetsUsage() {
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    serve-image: Serve an image from Gram.`)
 	fmt.Fprintln(os.Stderr, `    upload-image: Upload an image to Gram.`)
+	fmt.Fprintln(os.Stderr, `    upload-document: Upload a document, such as a markdown runbook, that a toolset can publish as an MCP resource.`)
 	fmt.Fprintln(os.Stderr, `    upload-functions: Upload functions to Gram.`)
 	fmt.Fprintln(os.Stderr, `    upload-open-ap-iv3: Upload an OpenAPI v3 document to Gram.`)
 	fmt.Fprintln(os.Stderr, `    serve-open-ap-iv3: Serve an OpenAPIv3 asset from Gram.`)
@@ -1334,7 +1353,7 @@ func assetsServeImageUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets serve-image --id "Id aliquam culpa quis repudiandae." --session-token "Quibusdam voluptatem nulla nesciunt qui sapiente." --apikey-token "Vero modi et."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets serve-image --id "Soluta et iure quo doloremque voluptatem ab." --session-token "Explicabo eligendi labore." --apikey-token "Aut dignissimos voluptatem."`)
 }
 
 func assetsUploadImageUsage() {
@@ -1363,7 +1382,36 @@ func assetsUploadImageUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets upload-image --content-type "Temporibus voluptas sunt sunt." --content-length 7430994806038517016 --apikey-token "Aut ut placeat dolor maiores accusantium." --project-slug-input "Velit voluptate maxime ducimus expedita." --session-token "Dolorem sit magnam voluptas illum dolor laborum." --stream "goa.png"`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets upload-image --content-type "Voluptatem nostrum commodi accusamus accusamus." --content-length 5899796466319125175 --apikey-token "Harum itaque enim et at." --project-slug-input "Quidem et sed odit voluptas deleniti doloribus." --session-token "Voluptas tenetur aperiam." --stream "goa.png"`)
+}
+
+func assetsUploadDocumentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] assets upload-document", os.Args[0])
+	fmt.Fprint(os.Stderr, " -content-type STRING")
+	fmt.Fprint(os.Stderr, " -content-length INT64")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -stream STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Upload a document, such as a markdown runbook, that a toolset can publish as an MCP resource.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -content-type STRING: `)
+	fmt.Fprintln(os.Stderr, `    -content-length INT64: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -stream STRING: path to file containing the streamed request body`)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets upload-document --content-type "Non et." --content-length 8817640432858946027 --apikey-token "Aut doloremque animi assumenda rem tempore." --project-slug-input "Omnis saepe et quis sunt." --session-token "Harum et nihil rerum reprehenderit optio omnis." --stream "goa.png"`)
 }
 
 func assetsUploadFunctionsUsage() {
@@ -1392,7 +1440,7 @@ func assetsUploadFunctionsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets upload-functions --content-type "Odio aut dignissimos voluptatem est quaerat rerum." --content-length 2932588482119170699 --apikey-token "Veniam sint." --project-slug-input "Recusandae et eum." --session-token "Praesentium voluptate voluptas perferendis enim rerum." --stream "goa.png"`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets upload-functions --content-type "Omnis at et." --content-length 3829889369674949255 --apikey-token "Non molestiae dolores laboriosam sapiente deserunt quis." --project-slug-input "Possimus aspernatur porro et omnis aut est." --session-token "Vitae ducimus consequatur." --stream "goa.png"`)
 }
 
 func assetsUploadOpenAPIv3Usage() {
@@ -1421,7 +1469,7 @@ func assetsUploadOpenAPIv3Usage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets upload-open-ap-iv3 --content-type "At omnis quidem et sed odit." --content-length 3099711254228402050 --apikey-token "Doloribus harum voluptas tenetur aperiam odit accusantium." --project-slug-input "Tenetur sed." --session-token "Odit sint autem animi voluptatum." --stream "goa.png"`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets upload-open-ap-iv3 --content-type "Ducimus vitae recusandae maxime." --content-length 8366217623312519290 --apikey-token "A a quo blanditiis beatae." --project-slug-input "Repellat laudantium natus quae ut placeat aut." --session-token "Adipisci est eius ullam consequatur aspernatur et." --stream "goa.png"`)
 }
 
 func assetsServeOpenAPIv3Usage() {
@@ -1446,7 +1494,7 @@ func assetsServeOpenAPIv3Usage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets serve-open-ap-iv3 --id "Et soluta illum quod." --project-id "Natus eaque." --apikey-token "Veritatis nihil illo repellendus quisquam." --session-token "Sit ut autem sapiente illo."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets serve-open-ap-iv3 --id "Facilis rem dolor consectetur assumenda atque tempora." --project-id "Tempore iste perspiciatis." --apikey-token "Consequatur qui modi quod qui ut quia." --session-token "Explicabo qui."`)
 }
 
 func assetsListAssetsUsage() {
@@ -1469,7 +1517,7 @@ func assetsListAssetsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets list-assets --session-token "Velit architecto odit." --project-slug-input "Exercitationem ut quisquam." --apikey-token "Tempore mollitia ut magni voluptas culpa."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `assets list-assets --session-token "Totam est sit maxime aut consequatur aut." --project-slug-input "Illo eaque exercitationem eaque." --apikey-token "Ut eius inventore nostrum cumque."`)
 }
 
 // authUsage displays the usage of the auth command and its subcommands.
@@ -1503,7 +1551,7 @@ func authCallbackUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth callback --code "Voluptatem praesentium omnis corporis ex tenetur."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth callback --code "Alias rerum nihil aut ratione officia ipsum."`)
 }
 
 func authLoginUsage() {
@@ -1543,7 +1591,7 @@ func authSwitchScopesUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth switch-scopes --organization-id "Omnis aut est." --project-id "Vitae ducimus consequatur." --session-token "Est saepe ut reiciendis ut."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth switch-scopes --organization-id "Minima laboriosam ipsam qui non." --project-id "Quam recusandae ipsum." --session-token "Ut sint nihil rerum repellat qui."`)
 }
 
 func authLogoutUsage() {
@@ -1562,7 +1610,7 @@ func authLogoutUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth logout --session-token "Officia adipisci est eius ullam."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth logout --session-token "Praesentium tempora facere illo laboriosam sunt eum."`)
 }
 
 func authRegisterUsage() {
@@ -1584,8 +1632,8 @@ func authRegisterUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth register --body '{
-      "org_name": "Facilis rem dolor consectetur assumenda atque tempora."
-   }' --session-token "Tempore iste perspiciatis."`)
+      "org_name": "Omnis harum ut velit accusamus architecto."
+   }' --session-token "Dolor dolorum distinctio aut quae eum rerum."`)
 }
 
 func authInfoUsage() {
@@ -1604,7 +1652,7 @@ func authInfoUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth info --session-token "Tempore earum illum incidunt officia."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `auth info --session-token "Velit qui quasi sed."`)
 }
 
 // chatUsage displays the usage of the chat command and its subcommands.
@@ -1637,7 +1685,7 @@ func chatListChatsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `chat list-chats --session-token "Id voluptatum repellat ut voluptatem suscipit neque." --project-slug-input "Quo minima laboriosam ipsam."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `chat list-chats --session-token "Dicta exercitationem." --project-slug-input "Sapiente quo dolor vero dolorum."`)
 }
 
 func chatLoadChatUsage() {
@@ -1660,7 +1708,7 @@ func chatLoadChatUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `chat load-chat --id "Occaecati ratione quia rerum distinctio voluptas." --session-token "Suscipit et qui." --project-slug-input "Error ipsa harum necessitatibus."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `chat load-chat --id "Repellendus deserunt." --session-token "Voluptatem natus dolores." --project-slug-input "Omnis similique velit voluptas perspiciatis."`)
 }
 
 func chatCreditUsageUsage() {
@@ -1681,7 +1729,7 @@ func chatCreditUsageUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `chat credit-usage --session-token "Debitis voluptatum et repellendus." --project-slug-input "Sunt voluptatem."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `chat credit-usage --session-token "Nulla vitae et maiores et atque." --project-slug-input "Ea et autem et."`)
 }
 
 // deploymentsUsage displays the usage of the deployments command and its
@@ -1723,7 +1771,7 @@ func deploymentsGetDeploymentUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments get-deployment --id "Praesentium rerum." --apikey-token "Sit illum eum odio minima eaque laborum." --session-token "Accusantium aliquam explicabo commodi inventore." --project-slug-input "Voluptatem dignissimos ut vel similique qui hic."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments get-deployment --id "Qui eligendi unde in quam maiores." --apikey-token "Impedit ipsam dolor consectetur." --session-token "Hic quidem libero voluptas earum." --project-slug-input "Nisi voluptatem saepe eum ea harum ea."`)
 }
 
 func deploymentsGetLatestDeploymentUsage() {
@@ -1746,7 +1794,7 @@ func deploymentsGetLatestDeploymentUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments get-latest-deployment --apikey-token "Possimus id doloremque optio aliquam." --session-token "Voluptatum autem debitis repudiandae ipsa velit." --project-slug-input "Et architecto nisi et quis."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments get-latest-deployment --apikey-token "Provident et sit deserunt animi culpa quae." --session-token "Dolore qui et aut corporis eligendi porro." --project-slug-input "Ut voluptate qui nihil dolorum fugit animi."`)
 }
 
 func deploymentsCreateDeploymentUsage() {
@@ -1775,37 +1823,47 @@ func deploymentsCreateDeploymentUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments create-deployment --body '{
       "external_id": "bc5f4a555e933e6861d12edba4c2d87ef6caf8e6",
-      "external_url": "Dolorem quibusdam animi.",
+      "external_url": "Vero recusandae dolorem quibusdam corrupti dolores.",
       "github_pr": "1234",
       "github_repo": "speakeasyapi/gram",
       "github_sha": "f33e693e9e12552043bc0ec5c37f1b8a9e076161",
       "openapiv3_assets": [
          {
-            "asset_id": "Molestiae nulla aut.",
-            "name": "Laboriosam iusto ea est et.",
-            "slug": "15w"
+            "asset_id": "Hic molestias excepturi.",
+            "name": "Incidunt sed dolor ut.",
+            "slug": "2sz"
          },
          {
-            "asset_id": "Molestiae nulla aut.",
-            "name": "Laboriosam iusto ea est et.",
-            "slug": "15w"
+            "asset_id": "Hic molestias excepturi.",
+            "name": "Incidunt sed dolor ut.",
+            "slug": "2sz"
+         },
+         {
+            "asset_id": "Hic molestias excepturi.",
+            "name": "Incidunt sed dolor ut.",
+            "slug": "2sz"
+         },
+         {
+            "asset_id": "Hic molestias excepturi.",
+            "name": "Incidunt sed dolor ut.",
+            "slug": "2sz"
          }
       ],
       "packages": [
          {
-            "name": "Qui nisi aut.",
-            "version": "Totam adipisci id ratione ut ex."
+            "name": "Nostrum dolor eum dolores.",
+            "version": "Dolores ducimus cumque."
          },
          {
-            "name": "Qui nisi aut.",
-            "version": "Totam adipisci id ratione ut ex."
+            "name": "Nostrum dolor eum dolores.",
+            "version": "Dolores ducimus cumque."
          },
          {
-            "name": "Qui nisi aut.",
-            "version": "Totam adipisci id ratione ut ex."
+            "name": "Nostrum dolor eum dolores.",
+            "version": "Dolores ducimus cumque."
          }
       ]
-   }' --apikey-token "Fuga et sunt tempora qui et aliquam." --session-token "Ipsam asperiores." --project-slug-input "Impedit inventore voluptatem laboriosam neque." --idempotency-key "01jqq0ajmb4qh9eppz48dejr2m"`)
+   }' --apikey-token "A id in placeat quasi ut." --session-token "Distinctio aliquam laudantium in id." --project-slug-input "Vel praesentium illo." --idempotency-key "01jqq0ajmb4qh9eppz48dejr2m"`)
 }
 
 func deploymentsEvolveUsage() {
@@ -1831,39 +1889,44 @@ func deploymentsEvolveUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments evolve --body '{
-      "deployment_id": "Et tempora.",
+      "deployment_id": "Ea nam sed et mollitia.",
       "exclude_openapiv3_assets": [
-         "Fugiat sed aut dolor ad ut.",
-         "Magnam rerum."
+         "Sunt sit aut esse est modi ipsam.",
+         "Incidunt sunt corrupti est.",
+         "Vel praesentium exercitationem eius perferendis."
       ],
       "exclude_packages": [
-         "Est molestiae.",
-         "Possimus modi ipsa iusto corrupti officia asperiores.",
-         "Beatae vel autem voluptatem aliquid reiciendis."
+         "Totam rerum deserunt nihil.",
+         "Odit impedit iure consequuntur consequatur praesentium sapiente.",
+         "Non veniam ut neque est dolor ut."
       ],
       "upsert_openapiv3_assets": [
          {
-            "asset_id": "Molestiae nulla aut.",
-            "name": "Laboriosam iusto ea est et.",
-            "slug": "15w"
+            "asset_id": "Hic molestias excepturi.",
+            "name": "Incidunt sed dolor ut.",
+            "slug": "2sz"
          },
          {
-            "asset_id": "Molestiae nulla aut.",
-            "name": "Laboriosam iusto ea est et.",
-            "slug": "15w"
+            "asset_id": "Hic molestias excepturi.",
+            "name": "Incidunt sed dolor ut.",
+            "slug": "2sz"
          }
       ],
       "upsert_packages": [
          {
-            "name": "Velit aspernatur occaecati ea illum et eum.",
-            "version": "Blanditiis officia corporis."
+            "name": "Alias nostrum enim id repudiandae.",
+            "version": "Quibusdam quia et et dolor et."
          },
          {
-            "name": "Velit aspernatur occaecati ea illum et eum.",
-            "version": "Blanditiis officia corporis."
+            "name": "Alias nostrum enim id repudiandae.",
+            "version": "Quibusdam quia et et dolor et."
+         },
+         {
+            "name": "Alias nostrum enim id repudiandae.",
+            "version": "Quibusdam quia et et dolor et."
          }
       ]
-   }' --apikey-token "Nemo soluta ipsam provident." --session-token "Vitae in dolorem." --project-slug-input "Tempore quisquam."`)
+   }' --apikey-token "Hic dolorem quia quam temporibus iure non." --session-token "Esse modi reiciendis harum consequatur voluptate." --project-slug-input "Consequuntur non dolor iure dolor iste voluptas."`)
 }
 
 func deploymentsRedeployUsage() {
@@ -1889,8 +1952,8 @@ func deploymentsRedeployUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments redeploy --body '{
-      "deployment_id": "Et ipsa."
-   }' --apikey-token "Est ipsam occaecati accusamus harum qui deleniti." --session-token "Qui cumque eius voluptatem qui dolorem soluta." --project-slug-input "Quo ea."`)
+      "deployment_id": "Cumque alias."
+   }' --apikey-token "Et blanditiis et." --session-token "Et minima libero omnis voluptatem." --project-slug-input "Itaque ad eos."`)
 }
 
 func deploymentsListDeploymentsUsage() {
@@ -1915,7 +1978,7 @@ func deploymentsListDeploymentsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments list-deployments --cursor "Veritatis esse eos fuga natus facilis illo." --apikey-token "Recusandae dolor perferendis dicta voluptas ut." --session-token "Dolor quae." --project-slug-input "Iste est esse voluptas."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments list-deployments --cursor "Asperiores necessitatibus accusamus repudiandae iste non." --apikey-token "Ut incidunt." --session-token "Exercitationem est expedita." --project-slug-input "Odit laudantium eligendi."`)
 }
 
 func deploymentsGetDeploymentLogsUsage() {
@@ -1942,7 +2005,7 @@ func deploymentsGetDeploymentLogsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments get-deployment-logs --deployment-id "Consequatur ea nam." --cursor "Et mollitia illum aperiam." --apikey-token "Alias nostrum enim id repudiandae." --session-token "Quibusdam quia et et dolor et." --project-slug-input "Inventore sunt."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `deployments get-deployment-logs --deployment-id "Sed ad rerum nisi quam deleniti." --cursor "Magni autem." --apikey-token "Dolor minima qui enim aliquam quia." --session-token "Odio ex velit animi." --project-slug-input "Nihil error quia aut et sit possimus."`)
 }
 
 // domainsUsage displays the usage of the domains command and its subcommands.
@@ -1975,7 +2038,7 @@ func domainsGetDomainUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `domains get-domain --session-token "Odit officia velit occaecati autem est itaque." --project-slug-input "Veniam explicabo et est aut cumque alias."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `domains get-domain --session-token "Vitae exercitationem non aut." --project-slug-input "Quis numquam exercitationem earum vel eveniet culpa."`)
 }
 
 func domainsCreateDomainUsage() {
@@ -1999,8 +2062,8 @@ func domainsCreateDomainUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `domains create-domain --body '{
-      "domain": "Quam deleniti occaecati."
-   }' --session-token "Autem non dolor minima." --project-slug-input "Enim aliquam quia debitis odio ex."`)
+      "domain": "Distinctio dolores culpa iusto voluptatem laborum."
+   }' --session-token "Neque exercitationem earum voluptatem cumque." --project-slug-input "Accusamus aliquam laudantium distinctio molestiae."`)
 }
 
 func domainsDeleteDomainUsage() {
@@ -2021,7 +2084,7 @@ func domainsDeleteDomainUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `domains delete-domain --session-token "Aut animi ut nulla." --project-slug-input "Ut aut."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `domains delete-domain --session-token "Qui necessitatibus repudiandae iure sed eos saepe." --project-slug-input "Quae aut voluptatem."`)
 }
 
 // environmentsUsage displays the usage of the environments command and its
@@ -2059,24 +2122,20 @@ func environmentsCreateEnvironmentUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `environments create-environment --body '{
-      "description": "Quidem sint illum ut blanditiis.",
+      "description": "Quaerat adipisci enim sed et.",
       "entries": [
          {
-            "name": "Facilis dolor vitae exercitationem.",
-            "value": "Aut suscipit."
-         },
-         {
-            "name": "Facilis dolor vitae exercitationem.",
-            "value": "Aut suscipit."
+            "name": "Repellat quae ratione.",
+            "value": "Natus nulla ipsa voluptatem."
          },
          {
-            "name": "Facilis dolor vitae exercitationem.",
-            "value": "Aut suscipit."
+            "name": "Repellat quae ratione.",
+            "value": "Natus nulla ipsa voluptatem."
          }
       ],
-      "name": "Enim accusantium nisi est enim.",
-      "organization_id": "Sed doloremque et perspiciatis rerum aliquam id."
-   }' --session-token "Numquam exercitationem earum vel." --project-slug-input "Culpa beatae cupiditate."`)
+      "name": "Maiores quae labore.",
+      "organization_id": "Praesentium quia in."
+   }' --session-token "Quia voluptatem rerum nam a." --project-slug-input "Sint et ducimus et eaque."`)
 }
 
 func environmentsListEnvironmentsUsage() {
@@ -2097,7 +2156,7 @@ func environmentsListEnvironmentsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `environments list-environments --session-token "Aut qui reprehenderit." --project-slug-input "Sed in ea."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `environments list-environments --session-token "Est enim consectetur sed." --project-slug-input "Soluta excepturi non quod qui."`)
 }
 
 func environmentsUpdateEnvironmentUsage() {
@@ -2123,25 +2182,28 @@ func environmentsUpdateEnvironmentUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `environments update-environment --body '{
-      "description": "Accusamus illum aut quo voluptas.",
+      "description": "Nam dolorum et dolorem.",
       "entries_to_remove": [
-         "Commodi sint.",
-         "Et ea qui.",
-         "Praesentium est amet doloremque iste temporibus omnis.",
-         "Voluptas quam aut qui."
+         "Non sed eum eos voluptates magni.",
+         "Rerum id adipisci.",
+         "Dignissimos blanditiis et minus modi exercitationem."
       ],
       "entries_to_update": [
          {
-            "name": "Facilis dolor vitae exercitationem.",
-            "value": "Aut suscipit."
+            "name": "Repellat quae ratione.",
+            "value": "Natus nulla ipsa voluptatem."
+         },
+         {
+            "name": "Repellat quae ratione.",
+            "value": "Natus nulla ipsa voluptatem."
          },
          {
-            "name": "Facilis dolor vitae exercitationem.",
-            "value": "Aut suscipit."
+            "name": "Repellat quae ratione.",
+            "value": "Natus nulla ipsa voluptatem."
          }
       ],
-      "name": "Culpa nobis fuga quibusdam maxime eum natus."
-   }' --slug "qpx" --session-token "Sed voluptatum aspernatur voluptatem alias laudantium ut." --project-slug-input "Est et."`)
+      "name": "Voluptatibus sunt voluptatem ducimus perferendis."
+   }' --slug "ut8" --session-token "Eos tempora repellendus adipisci nobis repellendus consequuntur." --project-slug-input "Dolor reiciendis culpa ipsum."`)
 }
 
 func environmentsDeleteEnvironmentUsage() {
@@ -2164,7 +2226,7 @@ func environmentsDeleteEnvironmentUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `environments delete-environment --slug "evo" --session-token "Ad vitae ut." --project-slug-input "Molestiae repellat aperiam sed dolorem."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `environments delete-environment --slug "aah" --session-token "Eos vero vitae est rerum." --project-slug-input "Explicabo sit."`)
 }
 
 // instancesUsage displays the usage of the instances command and its
@@ -2202,7 +2264,7 @@ func instancesGetInstanceUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `instances get-instance --toolset-slug "03x" --environment-slug "ai5" --session-token "Ipsum qui." --project-slug-input "Quisquam nam dolorum." --apikey-token "Dolorem incidunt voluptatibus sunt."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `instances get-instance --toolset-slug "yk2" --environment-slug "lgz" --session-token "Voluptatem rem eos facilis quisquam quia at." --project-slug-input "Sed aliquam est aut eius ea quia." --apikey-token "Tenetur quasi quo sit et quo inventore."`)
 }
 
 // integrationsUsage displays the usage of the integrations command and its
@@ -2239,7 +2301,7 @@ func integrationsGetUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `integrations get --id "Dignissimos voluptate aut quia nobis." --name "Facilis omnis et distinctio culpa et." --session-token "Tenetur debitis doloribus provident eligendi facere." --project-slug-input "Fuga esse omnis quos atque."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `integrations get --id "Molestias molestias." --name "Et error veritatis." --session-token "Asperiores exercitationem corrupti fuga earum." --project-slug-input "Sint et qui ab dolor et maxime."`)
 }
 
 func integrationsListUsage() {
@@ -2263,10 +2325,10 @@ func integrationsListUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `integrations list --keywords '[
-      "he9",
-      "8ed",
-      "9cz"
-   ]' --session-token "Qui tempore." --project-slug-input "Mollitia pariatur vitae assumenda voluptate."`)
+      "cjx",
+      "m7v",
+      "2d9"
+   ]' --session-token "Commodi doloribus ipsa." --project-slug-input "Error inventore."`)
 }
 
 // keysUsage displays the usage of the keys command and its subcommands.
@@ -2300,13 +2362,11 @@ func keysCreateKeyUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `keys create-key --body '{
-      "name": "Aliquam reiciendis.",
+      "name": "Aut mollitia.",
       "scopes": [
-         "Ut asperiores suscipit illum delectus sit.",
-         "Debitis aliquam ut corporis.",
-         "Repudiandae quidem est quaerat facere eaque doloribus."
+         "Optio voluptas perspiciatis deleniti."
       ]
-   }' --session-token "Nihil delectus impedit enim repellat reprehenderit."`)
+   }' --session-token "Fugit est qui ipsum hic."`)
 }
 
 func keysListKeysUsage() {
@@ -2325,7 +2385,7 @@ func keysListKeysUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `keys list-keys --session-token "Libero numquam animi ut."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `keys list-keys --session-token "Ad culpa fugit."`)
 }
 
 func keysRevokeKeyUsage() {
@@ -2346,7 +2406,7 @@ func keysRevokeKeyUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `keys revoke-key --id "Illum ea." --session-token "Accusamus nihil porro fugiat ut."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `keys revoke-key --id "Officiis eius suscipit magni voluptatem in." --session-token "Illo rerum voluptatum."`)
 }
 
 // packagesUsage displays the usage of the packages command and its subcommands.
@@ -2386,18 +2446,18 @@ func packagesCreatePackageUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `packages create-package --body '{
-      "description": "58f",
-      "image_asset_id": "j63",
+      "description": "xjp",
+      "image_asset_id": "e9r",
       "keywords": [
-         "Aut ab ullam architecto saepe et.",
-         "Amet qui odit consectetur aut dolores enim.",
-         "Dolore blanditiis amet quia et ex."
+         "Libero necessitatibus ex dolorem itaque fugiat nihil.",
+         "Magni earum est dolorem veritatis.",
+         "Et occaecati atque rerum vel dolor corporis."
       ],
-      "name": "c2e",
-      "summary": "dbw",
-      "title": "tlw",
-      "url": "vjo"
-   }' --apikey-token "Exercitationem fuga et est atque." --session-token "Voluptas incidunt quam nam." --project-slug-input "Molestias consequuntur deserunt debitis qui eaque quia."`)
+      "name": "oh9",
+      "summary": "3q0",
+      "title": "jns",
+      "url": "60y"
+   }' --apikey-token "Perferendis ratione fugit pariatur." --session-token "Magni et debitis perferendis blanditiis corporis quae." --project-slug-input "Ut hic labore."`)
 }
 
 func packagesUpdatePackageUsage() {
@@ -2423,18 +2483,18 @@ func packagesUpdatePackageUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `packages update-package --body '{
-      "description": "p06",
-      "id": "9vj",
-      "image_asset_id": "538",
+      "description": "snt",
+      "id": "ck7",
+      "image_asset_id": "j36",
       "keywords": [
-         "Ex dolorem itaque fugiat nihil deserunt magni.",
-         "Est dolorem.",
-         "Dignissimos et occaecati atque rerum."
+         "Sunt sunt aliquam illum.",
+         "Molestiae enim voluptatem eligendi.",
+         "Tempora temporibus ad consequatur aperiam ut."
       ],
-      "summary": "01x",
-      "title": "sg3",
-      "url": "yd5"
-   }' --apikey-token "Nulla ut necessitatibus." --session-token "Ratione fugit." --project-slug-input "Ut magni et debitis perferendis blanditiis."`)
+      "summary": "gul",
+      "title": "c1a",
+      "url": "fgd"
+   }' --apikey-token "Quae culpa." --session-token "Sint necessitatibus sapiente quisquam quia dolor nostrum." --project-slug-input "Et inventore eos aliquid consequatur."`)
 }
 
 func packagesListPackagesUsage() {
@@ -2457,7 +2517,7 @@ func packagesListPackagesUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `packages list-packages --apikey-token "Dolore repellendus." --session-token "Dicta autem aut quo cupiditate sit commodi." --project-slug-input "Velit neque qui ratione."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `packages list-packages --apikey-token "Nisi culpa est." --session-token "Qui placeat aut quo harum." --project-slug-input "Velit aliquid ut sequi et totam."`)
 }
 
 func packagesListVersionsUsage() {
@@ -2482,7 +2542,7 @@ func packagesListVersionsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `packages list-versions --name "Voluptatibus laborum et." --apikey-token "Voluptatem nemo veniam doloribus officia." --session-token "Veritatis facilis asperiores et." --project-slug-input "Illo maxime voluptates."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `packages list-versions --name "Autem non voluptas." --apikey-token "Nesciunt ab esse." --session-token "Ut itaque." --project-slug-input "Quos molestiae accusantium."`)
 }
 
 func packagesPublishUsage() {
@@ -2508,11 +2568,11 @@ func packagesPublishUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `packages publish --body '{
-      "deployment_id": "Modi nihil repudiandae accusantium modi.",
-      "name": "Sit et suscipit ipsum eos.",
-      "version": "Ut eos aliquid eaque eum.",
-      "visibility": "private"
-   }' --apikey-token "Consectetur omnis explicabo sed." --session-token "Asperiores laudantium commodi inventore a nobis impedit." --project-slug-input "Est velit delectus qui et est."`)
+      "deployment_id": "Eligendi voluptate sit qui officiis omnis fuga.",
+      "name": "Totam nemo incidunt accusamus.",
+      "version": "Nam sit rerum consequatur facilis.",
+      "visibility": "public"
+   }' --apikey-token "Facilis voluptas aperiam occaecati." --session-token "Facere aut ea." --project-slug-input "Officia magnam aliquid quas dicta."`)
 }
 
 // projectsUsage displays the usage of the projects command and its subcommands.
@@ -2548,9 +2608,9 @@ func projectsCreateProjectUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `projects create-project --body '{
-      "name": "ck7",
-      "organization_id": "Nulla non qui."
-   }' --apikey-token "Ea tempore." --session-token "Voluptatum aut minima debitis quisquam similique."`)
+      "name": "4mp",
+      "organization_id": "Ipsam enim similique enim et."
+   }' --apikey-token "Error sapiente sequi omnis." --session-token "Aut dolorem id aliquam non sit veniam."`)
 }
 
 func projectsListProjectsUsage() {
@@ -2573,7 +2633,7 @@ func projectsListProjectsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `projects list-projects --organization-id "Eaque debitis eum ad qui." --apikey-token "Rerum sed architecto et temporibus in officia." --session-token "Culpa modi ut voluptatem."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `projects list-projects --organization-id "Perferendis consequatur voluptatem qui accusamus." --apikey-token "Quidem dolores dignissimos aut." --session-token "Culpa illum reiciendis qui error et."`)
 }
 
 func projectsSetLogoUsage() {
@@ -2599,8 +2659,8 @@ func projectsSetLogoUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `projects set-logo --body '{
-      "asset_id": "Fugiat possimus."
-   }' --apikey-token "Amet consectetur nulla autem et." --session-token "Odio numquam et sint maxime ut." --project-slug-input "Officiis iste sapiente omnis."`)
+      "asset_id": "Molestiae et incidunt eaque quam numquam sit."
+   }' --apikey-token "Veniam hic et sed facere." --session-token "Non reiciendis optio dignissimos fugit nihil dignissimos." --project-slug-input "Natus quos officia quisquam."`)
 }
 
 // slackUsage displays the usage of the slack command and its subcommands.
@@ -2635,7 +2695,7 @@ func slackCallbackUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack callback --state "Consequatur facilis aut." --code "Voluptate sit qui."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack callback --state "Numquam saepe ex architecto id." --code "Est sit ut facere quasi magni cum."`)
 }
 
 func slackLoginUsage() {
@@ -2658,7 +2718,7 @@ func slackLoginUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack login --project-slug "Exercitationem velit repellat autem." --return-url "Fugiat ipsam enim." --session-token "Enim et."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack login --project-slug "Repellat quidem sit architecto." --return-url "Et sunt et sint odio a a." --session-token "Fugiat aperiam iure."`)
 }
 
 func slackGetSlackConnectionUsage() {
@@ -2679,7 +2739,7 @@ func slackGetSlackConnectionUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack get-slack-connection --session-token "Aut harum nobis atque voluptas nulla." --project-slug-input "Et et."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack get-slack-connection --session-token "Sit voluptas sunt quasi." --project-slug-input "Ut velit."`)
 }
 
 func slackUpdateSlackConnectionUsage() {
@@ -2703,8 +2763,8 @@ func slackUpdateSlackConnectionUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack update-slack-connection --body '{
-      "default_toolset_slug": "Error et soluta enim."
-   }' --session-token "Nobis facilis atque cumque perferendis accusantium." --project-slug-input "Vel est vel quibusdam excepturi at placeat."`)
+      "default_toolset_slug": "Consequatur qui nam placeat sunt magni maxime."
+   }' --session-token "Sint alias soluta doloremque voluptatum." --project-slug-input "Expedita quae unde ab omnis similique et."`)
 }
 
 func slackDeleteSlackConnectionUsage() {
@@ -2725,7 +2785,7 @@ func slackDeleteSlackConnectionUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack delete-slack-connection --session-token "Quo suscipit animi aut dignissimos sequi." --project-slug-input "Voluptatem a aut placeat occaecati."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `slack delete-slack-connection --session-token "Ex quis ratione." --project-slug-input "Voluptas eligendi suscipit asperiores ea adipisci consequatur."`)
 }
 
 // templatesUsage displays the usage of the templates command and its
@@ -2769,17 +2829,17 @@ func templatesCreateTemplateUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates create-template --body '{
       "arguments": "{\"name\":\"example\",\"email\":\"mail@example.com\"}",
-      "description": "Velit sint.",
+      "description": "Aut accusantium sapiente.",
       "engine": "mustache",
-      "kind": "higher_order_tool",
-      "name": "er5",
-      "prompt": "Quasi ex qui rerum.",
+      "kind": "prompt",
+      "name": "o3u",
+      "prompt": "Alias non.",
       "tools_hint": [
-         "Rem aut et explicabo ut.",
-         "Sequi deserunt reiciendis vero aut.",
-         "Occaecati et qui non qui sunt."
+         "Fuga eos sit aperiam.",
+         "Voluptatem est maiores dolores voluptatem.",
+         "Earum neque doloremque placeat totam et."
       ]
-   }' --apikey-token "Hic molestiae eligendi atque accusantium sed." --session-token "Natus tempora sit enim fugiat." --project-slug-input "Qui minima asperiores occaecati."`)
+   }' --apikey-token "Quia et necessitatibus." --session-token "Non et sunt modi est." --project-slug-input "Amet numquam excepturi."`)
 }
 
 func templatesUpdateTemplateUsage() {
@@ -2806,17 +2866,17 @@ func templatesUpdateTemplateUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates update-template --body '{
       "arguments": "{\"name\":\"example\",\"email\":\"mail@example.com\"}",
-      "description": "Expedita quae unde ab omnis similique et.",
+      "description": "Nulla voluptas ut repellendus iure sed voluptate.",
       "engine": "mustache",
-      "id": "Consequatur qui nam placeat sunt magni maxime.",
+      "id": "Sunt nesciunt impedit atque dolor dignissimos.",
       "kind": "higher_order_tool",
-      "prompt": "Sint alias soluta doloremque voluptatum.",
+      "prompt": "Qui officiis aut tenetur quis pariatur ipsum.",
       "tools_hint": [
-         "Consequuntur eveniet et.",
-         "Sunt repellendus cum dolorum id expedita.",
-         "Sed dolores perferendis ut."
+         "Ducimus reiciendis sed.",
+         "Consequuntur sit.",
+         "Repellat voluptates earum aut."
       ]
-   }' --apikey-token "Quod labore eos odio et." --session-token "Perferendis sint." --project-slug-input "Cumque magnam."`)
+   }' --apikey-token "Est et voluptas eveniet est vero in." --session-token "In sed voluptatem commodi." --project-slug-input "Soluta ipsum."`)
 }
 
 func templatesGetTemplateUsage() {
@@ -2843,7 +2903,7 @@ func templatesGetTemplateUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates get-template --id "Architecto ut commodi natus ipsa delectus repudiandae." --name "Cum debitis quia sit." --apikey-token "Omnis enim officia." --session-token "Quibusdam perspiciatis veniam quasi dolorem molestiae et." --project-slug-input "Enim odio reprehenderit."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates get-template --id "Perspiciatis consequatur aut." --name "Aliquam cum molestias." --apikey-token "Aut mollitia eaque quibusdam." --session-token "Rerum illo." --project-slug-input "Officia possimus beatae."`)
 }
 
 func templatesListTemplatesUsage() {
@@ -2866,7 +2926,7 @@ func templatesListTemplatesUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates list-templates --apikey-token "Quibusdam quae ex labore ut optio." --session-token "Dicta velit laborum hic aut." --project-slug-input "Alias nulla alias similique temporibus delectus."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates list-templates --apikey-token "Eligendi et." --session-token "Animi praesentium." --project-slug-input "Qui praesentium numquam quisquam quisquam et."`)
 }
 
 func templatesDeleteTemplateUsage() {
@@ -2893,7 +2953,7 @@ func templatesDeleteTemplateUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates delete-template --id "Nostrum excepturi libero et aperiam." --name "Aspernatur laboriosam vero accusantium illum ut." --apikey-token "Quis velit quas sit qui sapiente et." --session-token "Sit porro dolor." --project-slug-input "Rerum qui dolores accusantium soluta qui quia."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates delete-template --id "Explicabo repellendus." --name "Quo occaecati reprehenderit quia provident dolorem." --apikey-token "Dolore iste delectus quasi." --session-token "Dolorem pariatur dignissimos nulla in." --project-slug-input "Ea voluptas cum."`)
 }
 
 func templatesRenderTemplateByIDUsage() {
@@ -2922,11 +2982,9 @@ func templatesRenderTemplateByIDUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates render-template-by-id --body '{
       "arguments": {
-         "Non et sunt modi est.": "Amet numquam excepturi.",
-         "Placeat totam et.": "Quia et necessitatibus.",
-         "Ut ipsa accusantium corrupti dolores nemo nesciunt.": "Voluptatem incidunt pariatur omnis illum et officiis."
+         "Culpa distinctio repellat ea dicta quae et.": "Sed est aliquid animi facilis."
       }
-   }' --id "Id qui sed sed qui velit eligendi." --apikey-token "Quis culpa molestiae eos." --session-token "Omnis sunt nesciunt impedit atque dolor dignissimos." --project-slug-input "Qui officiis aut tenetur quis pariatur ipsum."`)
+   }' --id "Sunt possimus blanditiis." --apikey-token "Qui quae maxime ratione dolor dolor." --session-token "Ut sed." --project-slug-input "Ipsum magnam quam."`)
 }
 
 func templatesRenderTemplateUsage() {
@@ -2953,12 +3011,13 @@ func templatesRenderTemplateUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `templates render-template --body '{
       "arguments": {
-         "Sed cumque quo expedita laborum nulla.": "Aut laboriosam tempora et facilis impedit."
+         "Ipsa impedit et nostrum.": "Autem atque voluptas.",
+         "Sed atque earum.": "Placeat vel sunt cum nesciunt tempora."
       },
       "engine": "mustache",
-      "kind": "prompt",
-      "prompt": "Ullam at magnam."
-   }' --apikey-token "Voluptas pariatur error." --session-token "Quia est voluptas." --project-slug-input "Voluptatem molestias dolore ducimus reiciendis sed nostrum."`)
+      "kind": "higher_order_tool",
+      "prompt": "Deserunt fugit."
+   }' --apikey-token "Sit quo numquam ab quam." --session-token "Omnis aspernatur in voluptates quae." --project-slug-input "Voluptate ducimus ad esse exercitationem excepturi distinctio."`)
 }
 
 // toolsUsage displays the usage of the tools command and its subcommands.
@@ -2995,7 +3054,7 @@ func toolsListToolsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `tools list-tools --cursor "Expedita ex omnis accusantium velit." --limit 158702552 --deployment-id "Dolores unde nobis ut at rem facilis." --session-token "Et iste sint unde perspiciatis." --project-slug-input "Aut et aliquam."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `tools list-tools --cursor "Iusto voluptas sunt sit ut." --limit 263857492 --deployment-id "Delectus sed a." --session-token "Ducimus qui dolor et omnis sint." --project-slug-input "Repellendus nam ut tenetur eligendi."`)
 }
 
 // toolsetsUsage displays the usage of the toolsets command and its subcommands.
@@ -3036,15 +3095,16 @@ func toolsetsCreateToolsetUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets create-toolset --body '{
-      "default_environment_slug": "okx",
-      "description": "Velit eligendi et cumque animi.",
+      "default_environment_slug": "4et",
+      "description": "Recusandae minima sed et et aut et.",
       "http_tool_names": [
-         "Qui praesentium numquam quisquam quisquam et.",
-         "Maxime voluptate hic quia eius et vel.",
-         "Quis repudiandae ipsam."
+         "Accusantium labore error eos.",
+         "Omnis velit est minus.",
+         "In atque cupiditate iusto ipsum sit veniam.",
+         "Explicabo a doloribus voluptatem minima."
       ],
-      "name": "Quis nostrum unde reiciendis."
-   }' --session-token "Pariatur velit." --project-slug-input "Odio architecto nihil veritatis libero et."`)
+      "name": "Minus nihil eum."
+   }' --session-token "Odio eligendi nam." --project-slug-input "Ad aut id accusamus eligendi."`)
 }
 
 func toolsetsListToolsetsUsage() {
@@ -3065,7 +3125,7 @@ func toolsetsListToolsetsUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets list-toolsets --session-token "Minima quis." --project-slug-input "Similique ullam sit rerum quas."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets list-toolsets --session-token "Autem exercitationem doloribus cupiditate enim." --project-slug-input "Voluptatem facilis asperiores magnam."`)
 }
 
 func toolsetsUpdateToolsetUsage() {
@@ -3091,25 +3151,61 @@ func toolsetsUpdateToolsetUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets update-toolset --body '{
-      "custom_domain_id": "Illum sunt.",
-      "default_environment_slug": "f9t",
-      "description": "Et recusandae eius.",
+      "client_rules": [
+         {
+            "client_name": "td",
+            "kind": "minimum_version",
+            "min_version": "yp0"
+         },
+         {
+            "client_name": "td",
+            "kind": "minimum_version",
+            "min_version": "yp0"
+         }
+      ],
+      "custom_domain_id": "Qui velit et.",
+      "default_environment_slug": "gy3",
+      "description": "Nesciunt error sunt.",
       "http_tool_names": [
-         "Eos et libero animi.",
-         "Omnis veniam similique.",
-         "Atque autem."
+         "Quaerat voluptatem voluptatum sit.",
+         "Consequuntur est sapiente delectus repellat vitae et.",
+         "Non mollitia et non qui dolorem corporis.",
+         "Omnis nemo corrupti beatae dolore dignissimos."
       ],
-      "mcp_enabled": false,
-      "mcp_is_public": false,
-      "mcp_slug": "3zv",
-      "name": "Dolores pariatur doloribus.",
+      "instructions": "jjf",
+      "mcp_enabled": true,
+      "mcp_is_public": true,
+      "mcp_slug": "88u",
+      "name": "Ut illum iusto consectetur voluptas porro.",
       "prompt_template_names": [
-         "Odit amet beatae impedit id dolores molestiae.",
-         "Rerum voluptatem ad ea.",
-         "Et eius et.",
-         "Nesciunt quam."
-      ]
-   }' --slug "b8s" --session-token "Nihil voluptatem unde culpa dolorem minima sequi." --project-slug-input "Iste excepturi perspiciatis."`)
+         "Consequuntur sint excepturi rerum non labore reprehenderit.",
+         "Vitae earum ipsa et quia commodi.",
+         "Dignissimos et voluptatem dolores porro explicabo."
+      ],
+      "resource_link_threshold": 4587242983470658585,
+      "resources": [
+         {
+            "asset_id": "Eaque nostrum impedit ut et.",
+            "description": "eoz",
+            "http_tool_name": "Accusantium est cum earum eum amet.",
+            "kind": "http_template",
+            "mime_type": "Qui et et aut labore.",
+            "name": "zzk",
+            "uri_template": "Sit et ut animi dolores ullam eum."
+         },
+         {
+            "asset_id": "Eaque nostrum impedit ut et.",
+            "description": "eoz",
+            "http_tool_name": "Accusantium est cum earum eum amet.",
+            "kind": "http_template",
+            "mime_type": "Qui et et aut labore.",
+            "name": "zzk",
+            "uri_template": "Sit et ut animi dolores ullam eum."
+         }
+      ],
+      "response_budget": 1210148688513760152,
+      "tool_selection_mode": "dynamic"
+   }' --slug "skr" --session-token "Rerum et provident." --project-slug-input "Deserunt quo."`)
 }
 
 func toolsetsDeleteToolsetUsage() {
@@ -3132,7 +3228,7 @@ func toolsetsDeleteToolsetUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets delete-toolset --slug "0lc" --session-token "Enim dolorem voluptatem facilis asperiores magnam." --project-slug-input "Facere illum."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets delete-toolset --slug "jf6" --session-token "Laudantium similique perferendis molestias consequatur quam esse." --project-slug-input "Aut dolore."`)
 }
 
 func toolsetsGetToolsetUsage() {
@@ -3155,7 +3251,7 @@ func toolsetsGetToolsetUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets get-toolset --slug "8hd" --session-token "Libero omnis voluptatum nihil vel labore officiis." --project-slug-input "Facilis alias pariatur voluptas dolores suscipit rem."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets get-toolset --slug "zlz" --session-token "Veniam maiores aut veritatis et ut." --project-slug-input "Ea rem deserunt."`)
 }
 
 func toolsetsCheckMCPSlugAvailabilityUsage() {
@@ -3178,7 +3274,7 @@ func toolsetsCheckMCPSlugAvailabilityUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets check-mcp-slug-availability --slug "cep" --session-token "Dolorem corporis asperiores omnis." --project-slug-input "Corrupti beatae dolore dignissimos officiis assumenda."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets check-mcp-slug-availability --slug "akg" --session-token "Perspiciatis maiores nemo." --project-slug-input "At dolores sit voluptas tenetur voluptatem vel."`)
 }
 
 func toolsetsAddExternalOAuthServerUsage() {
@@ -3205,10 +3301,10 @@ func toolsetsAddExternalOAuthServerUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets add-externaloauth-server --body '{
       "external_oauth_server": {
-         "metadata": "Deserunt eaque nostrum impedit ut et ut.",
-         "slug": "q1j"
+         "metadata": "Atque dolorem quia quia officia sed.",
+         "slug": "cbm"
       }
-   }' --slug "hjz" --session-token "Amet quasi sit et ut animi dolores." --project-slug-input "Eum sequi qui sint."`)
+   }' --slug "6wu" --session-token "Debitis et occaecati." --project-slug-input "Officiis quia ratione aut in nihil in."`)
 }
 
 func toolsetsRemoveOAuthServerUsage() {
@@ -3231,7 +3327,7 @@ func toolsetsRemoveOAuthServerUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets removeoauth-server --slug "14o" --session-token "Architecto quaerat eaque et consequatur pariatur aut." --project-slug-input "Atque sed fugiat."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `toolsets removeoauth-server --slug "zgq" --session-token "In voluptate nesciunt ipsum mollitia." --project-slug-input "Voluptas sunt incidunt dolores at ullam."`)
 }
 
 // usageUsage displays the usage of the usage command and its subcommands.
@@ -3265,7 +3361,7 @@ func usageGetPeriodUsageUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `usage get-period-usage --session-token "Tempora harum." --project-slug-input "Aspernatur asperiores enim repellendus."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `usage get-period-usage --session-token "Voluptatem culpa." --project-slug-input "Eius voluptatem aut impedit eligendi placeat voluptas."`)
 }
 
 func usageGetUsageTiersUsage() {
@@ -3303,7 +3399,7 @@ func usageCreateCustomerSessionUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `usage create-customer-session --session-token "Officia sed." --project-slug-input "Illo voluptatem aliquid expedita debitis et occaecati."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `usage create-customer-session --session-token "Omnis voluptate facere animi dolorum." --project-slug-input "Sit quibusdam optio est ut."`)
 }
 
 func usageCreateCheckoutUsage() {
@@ -3324,7 +3420,7 @@ func usageCreateCheckoutUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `usage create-checkout --session-token "Accusantium blanditiis reprehenderit." --project-slug-input "Rerum temporibus et officiis nihil aut voluptate."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `usage create-checkout --session-token "Numquam neque suscipit error voluptatem." --project-slug-input "Sapiente accusamus fuga asperiores."`)
 }
 
 // variationsUsage displays the usage of the variations command and its
@@ -3363,18 +3459,46 @@ func variationsUpsertGlobalUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `variations upsert-global --body '{
-      "confirm": "never",
-      "confirm_prompt": "Consectetur dolorem.",
-      "description": "Aperiam dolores.",
-      "name": "Excepturi voluptatem quis unde.",
-      "src_tool_name": "Tempore at.",
-      "summarizer": "Sequi minus molestiae ad reprehenderit sint.",
-      "summary": "Sint error ea saepe.",
+      "annotations": {
+         "destructive_hint": true,
+         "idempotent_hint": false,
+         "open_world_hint": false,
+         "read_only_hint": false,
+         "title": "14f"
+      },
+      "confirm": "session",
+      "confirm_prompt": "Natus dolores architecto a ea.",
+      "description": "Porro odit quas.",
+      "name": "Doloremque nobis pariatur quaerat nulla vel qui.",
+      "response_budget": 1289182962915140837,
+      "retry_policy": {
+         "exponent": 2.2588540327830695,
+         "initial_interval_ms": 2296614786485474403,
+         "max_attempts": 3,
+         "max_elapsed_time_ms": 6563894258464437115,
+         "max_interval_ms": 2870405887066940252,
+         "methods": [
+            "GET",
+            "HEAD",
+            "PUT"
+         ],
+         "retry_connection_errors": false,
+         "status_codes": [
+            "443",
+            "509"
+         ],
+         "timeout_ms": 1905839684974468112
+      },
+      "src_tool_name": "Soluta nulla illum.",
+      "summarizer": "At dolorum dolorem beatae.",
+      "summary": "Sequi in sit atque.",
       "tags": [
-         "Consequatur quis reprehenderit commodi.",
-         "Sunt quasi ut fugiat aperiam qui maxime."
+         "A qui possimus.",
+         "Sed distinctio nam aliquam alias ullam dolore.",
+         "Assumenda sint eaque qui minus corrupti.",
+         "Blanditiis eveniet."
       ]
-   }' --session-token "Aut earum qui odit illum blanditiis ea." --apikey-token "Voluptatum aliquid similique." --project-slug-input "Voluptas ex aperiam ut."`)
+   }' --session-token "Placeat nulla." --apikey-token "Quaerat amet." --project-slug-input "Labore magnam neque iusto."`)
 }
 
 func variationsDeleteGlobalUsage() {
@@ -3399,7 +3523,7 @@ func variationsDeleteGlobalUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `variations delete-global --variation-id "Ut ea illum qui." --session-token "Eius nobis dicta ratione et." --apikey-token "Nam illum impedit." --project-slug-input "Et magnam consectetur saepe sed libero."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `variations delete-global --variation-id "Dolorum aut beatae." --session-token "Nulla reprehenderit nesciunt ea accusamus possimus." --apikey-token "Suscipit nemo autem." --project-slug-input "Est delectus corporis numquam qui dolores nemo."`)
 }
 
 func variationsListGlobalUsage() {
@@ -3422,5 +3546,5 @@ func variationsListGlobalUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `variations list-global --session-token "Dolores at." --apikey-token "Cumque dolor molestiae aliquid." --project-slug-input "Magni et laborum consequatur omnis."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `variations list-global --session-token "Aut error ducimus." --apikey-token "Blanditiis rerum sint excepturi inventore porro." --project-slug-input "Provident dolorum debitis qui culpa laborum assumenda."`)
 }
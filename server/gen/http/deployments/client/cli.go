@@ -86,7 +86,7 @@ func BuildCreateDeploymentPayload(deploymentsCreateDeploymentBody string, deploy
 	{
 		err = json.Unmarshal([]byte(deploymentsCreateDeploymentBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"external_id\": \"bc5f4a555e933e6861d12edba4c2d87ef6caf8e6\",\n      \"external_url\": \"Dolorem quibusdam animi.\",\n      \"github_pr\": \"1234\",\n      \"github_repo\": \"speakeasyapi/gram\",\n      \"github_sha\": \"f33e693e9e12552043bc0ec5c37f1b8a9e076161\",\n      \"openapiv3_assets\": [\n         {\n            \"asset_id\": \"Molestiae nulla aut.\",\n            \"name\": \"Laboriosam iusto ea est et.\",\n            \"slug\": \"15w\"\n         },\n         {\n            \"asset_id\": \"Molestiae nulla aut.\",\n            \"name\": \"Laboriosam iusto ea est et.\",\n            \"slug\": \"15w\"\n         }\n      ],\n      \"packages\": [\n         {\n            \"name\": \"Qui nisi aut.\",\n            \"version\": \"Totam adipisci id ratione ut ex.\"\n         },\n         {\n            \"name\": \"Qui nisi aut.\",\n            \"version\": \"Totam adipisci id ratione ut ex.\"\n         },\n         {\n            \"name\": \"Qui nisi aut.\",\n            \"version\": \"Totam adipisci id ratione ut ex.\"\n         }\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"external_id\": \"bc5f4a555e933e6861d12edba4c2d87ef6caf8e6\",\n      \"external_url\": \"Vero recusandae dolorem quibusdam corrupti dolores.\",\n      \"github_pr\": \"1234\",\n      \"github_repo\": \"speakeasyapi/gram\",\n      \"github_sha\": \"f33e693e9e12552043bc0ec5c37f1b8a9e076161\",\n      \"openapiv3_assets\": [\n         {\n            \"asset_id\": \"Hic molestias excepturi.\",\n            \"name\": \"Incidunt sed dolor ut.\",\n            \"slug\": \"2sz\"\n         },\n         {\n            \"asset_id\": \"Hic molestias excepturi.\",\n            \"name\": \"Incidunt sed dolor ut.\",\n            \"slug\": \"2sz\"\n         },\n         {\n            \"asset_id\": \"Hic molestias excepturi.\",\n            \"name\": \"Incidunt sed dolor ut.\",\n            \"slug\": \"2sz\"\n         },\n         {\n            \"asset_id\": \"Hic molestias excepturi.\",\n            \"name\": \"Incidunt sed dolor ut.\",\n            \"slug\": \"2sz\"\n         }\n      ],\n      \"packages\": [\n         {\n            \"name\": \"Nostrum dolor eum dolores.\",\n            \"version\": \"Dolores ducimus cumque.\"\n         },\n         {\n            \"name\": \"Nostrum dolor eum dolores.\",\n            \"version\": \"Dolores ducimus cumque.\"\n         },\n         {\n            \"name\": \"Nostrum dolor eum dolores.\",\n            \"version\": \"Dolores ducimus cumque.\"\n         }\n      ]\n   }'")
 		}
 		for _, e := range body.Openapiv3Assets {
 			if e != nil {
@@ -156,7 +156,7 @@ func BuildEvolvePayload(deploymentsEvolveBody string, deploymentsEvolveApikeyTok
 	{
 		err = json.Unmarshal([]byte(deploymentsEvolveBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deployment_id\": \"Et tempora.\",\n      \"exclude_openapiv3_assets\": [\n         \"Fugiat sed aut dolor ad ut.\",\n         \"Magnam rerum.\"\n      ],\n      \"exclude_packages\": [\n         \"Est molestiae.\",\n         \"Possimus modi ipsa iusto corrupti officia asperiores.\",\n         \"Beatae vel autem voluptatem aliquid reiciendis.\"\n      ],\n      \"upsert_openapiv3_assets\": [\n         {\n            \"asset_id\": \"Molestiae nulla aut.\",\n            \"name\": \"Laboriosam iusto ea est et.\",\n            \"slug\": \"15w\"\n         },\n         {\n            \"asset_id\": \"Molestiae nulla aut.\",\n            \"name\": \"Laboriosam iusto ea est et.\",\n            \"slug\": \"15w\"\n         }\n      ],\n      \"upsert_packages\": [\n         {\n            \"name\": \"Velit aspernatur occaecati ea illum et eum.\",\n            \"version\": \"Blanditiis officia corporis.\"\n         },\n         {\n            \"name\": \"Velit aspernatur occaecati ea illum et eum.\",\n            \"version\": \"Blanditiis officia corporis.\"\n         }\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deployment_id\": \"Ea nam sed et mollitia.\",\n      \"exclude_openapiv3_assets\": [\n         \"Sunt sit aut esse est modi ipsam.\",\n         \"Incidunt sunt corrupti est.\",\n         \"Vel praesentium exercitationem eius perferendis.\"\n      ],\n      \"exclude_packages\": [\n         \"Totam rerum deserunt nihil.\",\n         \"Odit impedit iure consequuntur consequatur praesentium sapiente.\",\n         \"Non veniam ut neque est dolor ut.\"\n      ],\n      \"upsert_openapiv3_assets\": [\n         {\n            \"asset_id\": \"Hic molestias excepturi.\",\n            \"name\": \"Incidunt sed dolor ut.\",\n            \"slug\": \"2sz\"\n         },\n         {\n            \"asset_id\": \"Hic molestias excepturi.\",\n            \"name\": \"Incidunt sed dolor ut.\",\n            \"slug\": \"2sz\"\n         }\n      ],\n      \"upsert_packages\": [\n         {\n            \"name\": \"Alias nostrum enim id repudiandae.\",\n            \"version\": \"Quibusdam quia et et dolor et.\"\n         },\n         {\n            \"name\": \"Alias nostrum enim id repudiandae.\",\n            \"version\": \"Quibusdam quia et et dolor et.\"\n         },\n         {\n            \"name\": \"Alias nostrum enim id repudiandae.\",\n            \"version\": \"Quibusdam quia et et dolor et.\"\n         }\n      ]\n   }'")
 		}
 	}
 	var apikeyToken *string
@@ -219,7 +219,7 @@ func BuildRedeployPayload(deploymentsRedeployBody string, deploymentsRedeployApi
 	{
 		err = json.Unmarshal([]byte(deploymentsRedeployBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deployment_id\": \"Et ipsa.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deployment_id\": \"Cumque alias.\"\n   }'")
 		}
 	}
 	var apikeyToken *string
@@ -242,6 +242,13 @@ type HTTPToolDefinitionResponseBody struct {
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
 	// Response filter metadata for the tool
 	ResponseFilter *ResponseFilterResponseBody `form:"response_filter,omitempty" json:"response_filter,omitempty" xml:"response_filter,omitempty"`
+	// Behavioral hints for the tool, derived from its HTTP method unless overridden
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The maximum size in bytes of a response returned to the model before it is
+	// truncated. Falls back to the toolset's budget when unset.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// How calls to the tool's upstream API are timed out and retried
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 	// The ID of the OpenAPI v3 document
 	Openapiv3DocumentID *string `form:"openapiv3_document_id,omitempty" json:"openapiv3_document_id,omitempty" xml:"openapiv3_document_id,omitempty"`
 	// OpenAPI v3 operation
@@ -260,6 +267,9 @@ type HTTPToolDefinitionResponseBody struct {
 	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
 	// JSON schema for the request
 	Schema *string `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// JSON schema for the structured output of the tool, derived from its
+	// successful response
+	OutputSchema *string `form:"output_schema,omitempty" json:"output_schema,omitempty" xml:"output_schema,omitempty"`
 	// The name of the source package
 	PackageName *string `form:"package_name,omitempty" json:"package_name,omitempty" xml:"package_name,omitempty"`
 	// The creation date of the tool.
@@ -282,6 +292,45 @@ type ResponseFilterResponseBody struct {
 	ContentTypes []string `form:"content_types,omitempty" json:"content_types,omitempty" xml:"content_types,omitempty"`
 }
 
+// ToolAnnotationsResponseBody is used to define fields on response body types.
+type ToolAnnotationsResponseBody struct {
+	// A human-readable title for the tool
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// If true, the tool does not modify its environment
+	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
+	// If true, the tool may perform destructive updates
+	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
+	// If true, calling the tool repeatedly with the same arguments has no
+	// additional effect
+	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
+	// If true, the tool interacts with external entities
+	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+}
+
+// ToolRetryPolicyResponseBody is used to define fields on response body types.
+type ToolRetryPolicyResponseBody struct {
+	// The number of attempts made including the first. A value of 1 disables
+	// retries.
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// Response status codes that are retried, such as 429 or 5XX
+	StatusCodes []string `form:"status_codes,omitempty" json:"status_codes,omitempty" xml:"status_codes,omitempty"`
+	// HTTP methods that may be retried
+	Methods []string `form:"methods,omitempty" json:"methods,omitempty" xml:"methods,omitempty"`
+	// Whether requests that fail before a response is received are retried
+	RetryConnectionErrors *bool `form:"retry_connection_errors,omitempty" json:"retry_connection_errors,omitempty" xml:"retry_connection_errors,omitempty"`
+	// The delay before the first retry in milliseconds
+	InitialIntervalMs *int64 `form:"initial_interval_ms,omitempty" json:"initial_interval_ms,omitempty" xml:"initial_interval_ms,omitempty"`
+	// The maximum delay between two attempts in milliseconds
+	MaxIntervalMs *int64 `form:"max_interval_ms,omitempty" json:"max_interval_ms,omitempty" xml:"max_interval_ms,omitempty"`
+	// The factor the delay grows by after each retry
+	Exponent *float64 `form:"exponent,omitempty" json:"exponent,omitempty" xml:"exponent,omitempty"`
+	// The overall deadline for a call across all of its attempts in milliseconds
+	MaxElapsedTimeMs *int64 `form:"max_elapsed_time_ms,omitempty" json:"max_elapsed_time_ms,omitempty" xml:"max_elapsed_time_ms,omitempty"`
+	// The time allowed for a single attempt, including reading the response, in
+	// milliseconds
+	TimeoutMs *int64 `form:"timeout_ms,omitempty" json:"timeout_ms,omitempty" xml:"timeout_ms,omitempty"`
+}
+
 // CanonicalToolAttributesResponseBody is used to define fields on response
 // body types.
 type CanonicalToolAttributesResponseBody struct {
@@ -301,6 +350,12 @@ type CanonicalToolAttributesResponseBody struct {
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
 	// The tags list for this http tool
 	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Behavioral hints set for the tool in its source document
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The response budget set for the tool in its source document
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// The retry policy set for the tool in its source document
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 }
 
 // ToolVariationResponseBody is used to define fields on response body types.
@@ -325,6 +380,13 @@ type ToolVariationResponseBody struct {
 	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 	// The summarizer of the tool variation
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// The behavioral hints of the tool variation
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The response budget of the tool variation in bytes
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// The retry policy of the tool variation. Fields that are set override the
+	// tool's own policy.
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 	// The creation date of the tool variation
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The last update date of the tool variation
@@ -943,6 +1005,21 @@ func ValidateHTTPToolDefinitionResponseBody(body *HTTPToolDefinitionResponseBody
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Annotations != nil {
+		if err2 := ValidateToolAnnotationsResponseBody(body.Annotations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateToolRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
@@ -977,6 +1054,71 @@ func ValidateResponseFilterResponseBody(body *ResponseFilterResponseBody) (err e
 	return
 }
 
+// ValidateToolAnnotationsResponseBody runs the validations defined on
+// ToolAnnotationsResponseBody
+func ValidateToolAnnotationsResponseBody(body *ToolAnnotationsResponseBody) (err error) {
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 100, false))
+		}
+	}
+	return
+}
+
+// ValidateToolRetryPolicyResponseBody runs the validations defined on
+// ToolRetryPolicyResponseBody
+func ValidateToolRetryPolicyResponseBody(body *ToolRetryPolicyResponseBody) (err error) {
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts > 10 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 10, false))
+		}
+	}
+	for _, e := range body.StatusCodes {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.status_codes[*]", e, "^[1-5]([0-9]{2}|XX)$"))
+	}
+	for _, e := range body.Methods {
+		if !(e == "GET" || e == "HEAD" || e == "POST" || e == "PUT" || e == "PATCH" || e == "DELETE" || e == "OPTIONS") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.methods[*]", e, []any{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}))
+		}
+	}
+	if body.InitialIntervalMs != nil {
+		if *body.InitialIntervalMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_interval_ms", *body.InitialIntervalMs, 1, true))
+		}
+	}
+	if body.MaxIntervalMs != nil {
+		if *body.MaxIntervalMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_interval_ms", *body.MaxIntervalMs, 1, true))
+		}
+	}
+	if body.Exponent != nil {
+		if *body.Exponent < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.exponent", *body.Exponent, 1, true))
+		}
+	}
+	if body.Exponent != nil {
+		if *body.Exponent > 10 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.exponent", *body.Exponent, 10, false))
+		}
+	}
+	if body.MaxElapsedTimeMs != nil {
+		if *body.MaxElapsedTimeMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_elapsed_time_ms", *body.MaxElapsedTimeMs, 1, true))
+		}
+	}
+	if body.TimeoutMs != nil {
+		if *body.TimeoutMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_ms", *body.TimeoutMs, 1, true))
+		}
+	}
+	return
+}
+
 // ValidateCanonicalToolAttributesResponseBody runs the validations defined on
 // CanonicalToolAttributesResponseBody
 func ValidateCanonicalToolAttributesResponseBody(body *CanonicalToolAttributesResponseBody) (err error) {
@@ -986,6 +1128,21 @@ func ValidateCanonicalToolAttributesResponseBody(body *CanonicalToolAttributesRe
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
+	if body.Annotations != nil {
+		if err2 := ValidateToolAnnotationsResponseBody(body.Annotations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateToolRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -1007,6 +1164,21 @@ func ValidateToolVariationResponseBody(body *ToolVariationResponseBody) (err err
 	if body.UpdatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
+	if body.Annotations != nil {
+		if err2 := ValidateToolAnnotationsResponseBody(body.Annotations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateToolRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -24,7 +24,7 @@ func BuildCreatePackagePayload(packagesCreatePackageBody string, packagesCreateP
 	{
 		err = json.Unmarshal([]byte(packagesCreatePackageBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"58f\",\n      \"image_asset_id\": \"j63\",\n      \"keywords\": [\n         \"Aut ab ullam architecto saepe et.\",\n         \"Amet qui odit consectetur aut dolores enim.\",\n         \"Dolore blanditiis amet quia et ex.\"\n      ],\n      \"name\": \"c2e\",\n      \"summary\": \"dbw\",\n      \"title\": \"tlw\",\n      \"url\": \"vjo\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"xjp\",\n      \"image_asset_id\": \"e9r\",\n      \"keywords\": [\n         \"Libero necessitatibus ex dolorem itaque fugiat nihil.\",\n         \"Magni earum est dolorem veritatis.\",\n         \"Et occaecati atque rerum vel dolor corporis.\"\n      ],\n      \"name\": \"oh9\",\n      \"summary\": \"3q0\",\n      \"title\": \"jns\",\n      \"url\": \"60y\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", body.Name, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 		if utf8.RuneCountInString(body.Name) > 100 {
@@ -105,7 +105,7 @@ func BuildUpdatePackagePayload(packagesUpdatePackageBody string, packagesUpdateP
 	{
 		err = json.Unmarshal([]byte(packagesUpdatePackageBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"p06\",\n      \"id\": \"9vj\",\n      \"image_asset_id\": \"538\",\n      \"keywords\": [\n         \"Ex dolorem itaque fugiat nihil deserunt magni.\",\n         \"Est dolorem.\",\n         \"Dignissimos et occaecati atque rerum.\"\n      ],\n      \"summary\": \"01x\",\n      \"title\": \"sg3\",\n      \"url\": \"yd5\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"snt\",\n      \"id\": \"ck7\",\n      \"image_asset_id\": \"j36\",\n      \"keywords\": [\n         \"Sunt sunt aliquam illum.\",\n         \"Molestiae enim voluptatem eligendi.\",\n         \"Tempora temporibus ad consequatur aperiam ut.\"\n      ],\n      \"summary\": \"gul\",\n      \"title\": \"c1a\",\n      \"url\": \"fgd\"\n   }'")
 		}
 		if utf8.RuneCountInString(body.ID) > 50 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.id", body.ID, utf8.RuneCountInString(body.ID), 50, false))
@@ -252,7 +252,7 @@ func BuildPublishPayload(packagesPublishBody string, packagesPublishApikeyToken
 	{
 		err = json.Unmarshal([]byte(packagesPublishBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deployment_id\": \"Modi nihil repudiandae accusantium modi.\",\n      \"name\": \"Sit et suscipit ipsum eos.\",\n      \"version\": \"Ut eos aliquid eaque eum.\",\n      \"visibility\": \"private\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deployment_id\": \"Eligendi voluptate sit qui officiis omnis fuga.\",\n      \"name\": \"Totam nemo incidunt accusamus.\",\n      \"version\": \"Nam sit rerum consequatur facilis.\",\n      \"visibility\": \"public\"\n   }'")
 		}
 		if !(body.Visibility == "public" || body.Visibility == "private") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.visibility", body.Visibility, []any{"public", "private"}))
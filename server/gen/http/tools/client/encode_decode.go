@@ -280,6 +280,7 @@ func unmarshalHTTPToolDefinitionResponseBodyToTypesHTTPToolDefinition(v *HTTPToo
 		Confirm:             *v.Confirm,
 		ConfirmPrompt:       v.ConfirmPrompt,
 		Summarizer:          v.Summarizer,
+		ResponseBudget:      v.ResponseBudget,
 		Openapiv3DocumentID: v.Openapiv3DocumentID,
 		Openapiv3Operation:  v.Openapiv3Operation,
 		Security:            v.Security,
@@ -288,6 +289,7 @@ func unmarshalHTTPToolDefinitionResponseBodyToTypesHTTPToolDefinition(v *HTTPToo
 		Path:                *v.Path,
 		SchemaVersion:       v.SchemaVersion,
 		Schema:              *v.Schema,
+		OutputSchema:        v.OutputSchema,
 		PackageName:         v.PackageName,
 		CreatedAt:           *v.CreatedAt,
 		UpdatedAt:           *v.UpdatedAt,
@@ -295,6 +297,12 @@ func unmarshalHTTPToolDefinitionResponseBodyToTypesHTTPToolDefinition(v *HTTPToo
 	if v.ResponseFilter != nil {
 		res.ResponseFilter = unmarshalResponseFilterResponseBodyToTypesResponseFilter(v.ResponseFilter)
 	}
+	if v.Annotations != nil {
+		res.Annotations = unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v.RetryPolicy)
+	}
 	res.Tags = make([]string, len(v.Tags))
 	for i, val := range v.Tags {
 		res.Tags[i] = val
@@ -330,6 +338,56 @@ func unmarshalResponseFilterResponseBodyToTypesResponseFilter(v *ResponseFilterR
 	return res
 }
 
+// unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations builds a value of
+// type *types.ToolAnnotations from a value of type
+// *ToolAnnotationsResponseBody.
+func unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v *ToolAnnotationsResponseBody) *types.ToolAnnotations {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolAnnotations{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy builds a value of
+// type *types.ToolRetryPolicy from a value of type
+// *ToolRetryPolicyResponseBody.
+func unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v *ToolRetryPolicyResponseBody) *types.ToolRetryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolRetryPolicy{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
+
+	return res
+}
+
 // unmarshalCanonicalToolAttributesResponseBodyToTypesCanonicalToolAttributes
 // builds a value of type *types.CanonicalToolAttributes from a value of type
 // *CanonicalToolAttributesResponseBody.
@@ -338,13 +396,14 @@ func unmarshalCanonicalToolAttributesResponseBodyToTypesCanonicalToolAttributes(
 		return nil
 	}
 	res := &types.CanonicalToolAttributes{
-		VariationID:   *v.VariationID,
-		Name:          *v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Summarizer:    v.Summarizer,
+		VariationID:    *v.VariationID,
+		Name:           *v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -352,6 +411,12 @@ func unmarshalCanonicalToolAttributesResponseBodyToTypesCanonicalToolAttributes(
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v.RetryPolicy)
+	}
 
 	return res
 }
@@ -363,17 +428,18 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 		return nil
 	}
 	res := &types.ToolVariation{
-		ID:            *v.ID,
-		GroupID:       *v.GroupID,
-		SrcToolName:   *v.SrcToolName,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Name:          v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Summarizer:    v.Summarizer,
-		CreatedAt:     *v.CreatedAt,
-		UpdatedAt:     *v.UpdatedAt,
+		ID:             *v.ID,
+		GroupID:        *v.GroupID,
+		SrcToolName:    *v.SrcToolName,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Name:           v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
+		CreatedAt:      *v.CreatedAt,
+		UpdatedAt:      *v.UpdatedAt,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -381,6 +447,12 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v.RetryPolicy)
+	}
 
 	return res
 }
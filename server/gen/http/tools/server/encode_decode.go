@@ -266,6 +266,7 @@ func marshalTypesHTTPToolDefinitionToHTTPToolDefinitionResponseBody(v *types.HTT
 		Confirm:             v.Confirm,
 		ConfirmPrompt:       v.ConfirmPrompt,
 		Summarizer:          v.Summarizer,
+		ResponseBudget:      v.ResponseBudget,
 		Openapiv3DocumentID: v.Openapiv3DocumentID,
 		Openapiv3Operation:  v.Openapiv3Operation,
 		Security:            v.Security,
@@ -274,6 +275,7 @@ func marshalTypesHTTPToolDefinitionToHTTPToolDefinitionResponseBody(v *types.HTT
 		Path:                v.Path,
 		SchemaVersion:       v.SchemaVersion,
 		Schema:              v.Schema,
+		OutputSchema:        v.OutputSchema,
 		PackageName:         v.PackageName,
 		CreatedAt:           v.CreatedAt,
 		UpdatedAt:           v.UpdatedAt,
@@ -281,6 +283,12 @@ func marshalTypesHTTPToolDefinitionToHTTPToolDefinitionResponseBody(v *types.HTT
 	if v.ResponseFilter != nil {
 		res.ResponseFilter = marshalTypesResponseFilterToResponseFilterResponseBody(v.ResponseFilter)
 	}
+	if v.Annotations != nil {
+		res.Annotations = marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v.RetryPolicy)
+	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
 		for i, val := range v.Tags {
@@ -328,6 +336,56 @@ func marshalTypesResponseFilterToResponseFilterResponseBody(v *types.ResponseFil
 	return res
 }
 
+// marshalTypesToolAnnotationsToToolAnnotationsResponseBody builds a value of
+// type *ToolAnnotationsResponseBody from a value of type
+// *types.ToolAnnotations.
+func marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v *types.ToolAnnotations) *ToolAnnotationsResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolAnnotationsResponseBody{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody builds a value of
+// type *ToolRetryPolicyResponseBody from a value of type
+// *types.ToolRetryPolicy.
+func marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v *types.ToolRetryPolicy) *ToolRetryPolicyResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolRetryPolicyResponseBody{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
+
+	return res
+}
+
 // marshalTypesCanonicalToolAttributesToCanonicalToolAttributesResponseBody
 // builds a value of type *CanonicalToolAttributesResponseBody from a value of
 // type *types.CanonicalToolAttributes.
@@ -336,13 +394,14 @@ func marshalTypesCanonicalToolAttributesToCanonicalToolAttributesResponseBody(v
 		return nil
 	}
 	res := &CanonicalToolAttributesResponseBody{
-		VariationID:   v.VariationID,
-		Name:          v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Summarizer:    v.Summarizer,
+		VariationID:    v.VariationID,
+		Name:           v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -350,6 +409,12 @@ func marshalTypesCanonicalToolAttributesToCanonicalToolAttributesResponseBody(v
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v.RetryPolicy)
+	}
 
 	return res
 }
@@ -361,17 +426,18 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 		return nil
 	}
 	res := &ToolVariationResponseBody{
-		ID:            v.ID,
-		GroupID:       v.GroupID,
-		SrcToolName:   v.SrcToolName,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Name:          v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Summarizer:    v.Summarizer,
-		CreatedAt:     v.CreatedAt,
-		UpdatedAt:     v.UpdatedAt,
+		ID:             v.ID,
+		GroupID:        v.GroupID,
+		SrcToolName:    v.SrcToolName,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Name:           v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -379,6 +445,12 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v.RetryPolicy)
+	}
 
 	return res
 }
@@ -25,7 +25,7 @@ func BuildCreateToolsetPayload(toolsetsCreateToolsetBody string, toolsetsCreateT
 	{
 		err = json.Unmarshal([]byte(toolsetsCreateToolsetBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"default_environment_slug\": \"okx\",\n      \"description\": \"Velit eligendi et cumque animi.\",\n      \"http_tool_names\": [\n         \"Qui praesentium numquam quisquam quisquam et.\",\n         \"Maxime voluptate hic quia eius et vel.\",\n         \"Quis repudiandae ipsam.\"\n      ],\n      \"name\": \"Quis nostrum unde reiciendis.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"default_environment_slug\": \"4et\",\n      \"description\": \"Recusandae minima sed et et aut et.\",\n      \"http_tool_names\": [\n         \"Accusantium labore error eos.\",\n         \"Omnis velit est minus.\",\n         \"In atque cupiditate iusto ipsum sit veniam.\",\n         \"Explicabo a doloribus voluptatem minima.\"\n      ],\n      \"name\": \"Minus nihil eum.\"\n   }'")
 		}
 		if body.DefaultEnvironmentSlug != nil {
 			err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
@@ -101,7 +101,12 @@ func BuildUpdateToolsetPayload(toolsetsUpdateToolsetBody string, toolsetsUpdateT
 	{
 		err = json.Unmarshal([]byte(toolsetsUpdateToolsetBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"custom_domain_id\": \"Illum sunt.\",\n      \"default_environment_slug\": \"f9t\",\n      \"description\": \"Et recusandae eius.\",\n      \"http_tool_names\": [\n         \"Eos et libero animi.\",\n         \"Omnis veniam similique.\",\n         \"Atque autem.\"\n      ],\n      \"mcp_enabled\": false,\n      \"mcp_is_public\": false,\n      \"mcp_slug\": \"3zv\",\n      \"name\": \"Dolores pariatur doloribus.\",\n      \"prompt_template_names\": [\n         \"Odit amet beatae impedit id dolores molestiae.\",\n         \"Rerum voluptatem ad ea.\",\n         \"Et eius et.\",\n         \"Nesciunt quam.\"\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"client_rules\": [\n         {\n            \"client_name\": \"td\",\n            \"kind\": \"minimum_version\",\n            \"min_version\": \"yp0\"\n         },\n         {\n            \"client_name\": \"td\",\n            \"kind\": \"minimum_version\",\n            \"min_version\": \"yp0\"\n         }\n      ],\n      \"custom_domain_id\": \"Qui velit et.\",\n      \"default_environment_slug\": \"gy3\",\n      \"description\": \"Nesciunt error sunt.\",\n      \"http_tool_names\": [\n         \"Quaerat voluptatem voluptatum sit.\",\n         \"Consequuntur est sapiente delectus repellat vitae et.\",\n         \"Non mollitia et non qui dolorem corporis.\",\n         \"Omnis nemo corrupti beatae dolore dignissimos.\"\n      ],\n      \"instructions\": \"jjf\",\n      \"mcp_enabled\": true,\n      \"mcp_is_public\": true,\n      \"mcp_slug\": \"88u\",\n      \"name\": \"Ut illum iusto consectetur voluptas porro.\",\n      \"prompt_template_names\": [\n         \"Consequuntur sint excepturi rerum non labore reprehenderit.\",\n         \"Vitae earum ipsa et quia commodi.\",\n         \"Dignissimos et voluptatem dolores porro explicabo.\"\n      ],\n      \"resource_link_threshold\": 4587242983470658585,\n      \"resources\": [\n         {\n            \"asset_id\": \"Eaque nostrum impedit ut et.\",\n            \"description\": \"eoz\",\n            \"http_tool_name\": \"Accusantium est cum earum eum amet.\",\n            \"kind\": \"http_template\",\n            \"mime_type\": \"Qui et et aut labore.\",\n            \"name\": \"zzk\",\n            \"uri_template\": \"Sit et ut animi dolores ullam eum.\"\n         },\n         {\n            \"asset_id\": \"Eaque nostrum impedit ut et.\",\n            \"description\": \"eoz\",\n            \"http_tool_name\": \"Accusantium est cum earum eum amet.\",\n            \"kind\": \"http_template\",\n            \"mime_type\": \"Qui et et aut labore.\",\n            \"name\": \"zzk\",\n            \"uri_template\": \"Sit et ut animi dolores ullam eum.\"\n         }\n      ],\n      \"response_budget\": 1210148688513760152,\n      \"tool_selection_mode\": \"dynamic\"\n   }'")
+		}
+		if body.Instructions != nil {
+			if utf8.RuneCountInString(*body.Instructions) > 20000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.instructions", *body.Instructions, utf8.RuneCountInString(*body.Instructions), 20000, false))
+			}
 		}
 		if body.DefaultEnvironmentSlug != nil {
 			err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
@@ -111,6 +116,35 @@ func BuildUpdateToolsetPayload(toolsetsUpdateToolsetBody string, toolsetsUpdateT
 				err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
 			}
 		}
+		for _, e := range body.Resources {
+			if e != nil {
+				if err2 := ValidateToolsetResourceFormRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		for _, e := range body.ClientRules {
+			if e != nil {
+				if err2 := ValidateToolsetClientRuleRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.ToolSelectionMode != nil {
+			if !(*body.ToolSelectionMode == "static" || *body.ToolSelectionMode == "dynamic") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tool_selection_mode", *body.ToolSelectionMode, []any{"static", "dynamic"}))
+			}
+		}
+		if body.ResourceLinkThreshold != nil {
+			if *body.ResourceLinkThreshold < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.resource_link_threshold", *body.ResourceLinkThreshold, 0, true))
+			}
+		}
+		if body.ResponseBudget != nil {
+			if *body.ResponseBudget < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+			}
+		}
 		if body.McpSlug != nil {
 			err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 		}
@@ -147,11 +181,15 @@ func BuildUpdateToolsetPayload(toolsetsUpdateToolsetBody string, toolsetsUpdateT
 		}
 	}
 	v := &toolsets.UpdateToolsetPayload{
-		Name:           body.Name,
-		Description:    body.Description,
-		McpEnabled:     body.McpEnabled,
-		McpIsPublic:    body.McpIsPublic,
-		CustomDomainID: body.CustomDomainID,
+		Name:                  body.Name,
+		Description:           body.Description,
+		Instructions:          body.Instructions,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     body.ToolSelectionMode,
+		ResourceLinkThreshold: body.ResourceLinkThreshold,
+		ResponseBudget:        body.ResponseBudget,
+		McpIsPublic:           body.McpIsPublic,
+		CustomDomainID:        body.CustomDomainID,
 	}
 	if body.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
@@ -173,6 +211,18 @@ func BuildUpdateToolsetPayload(toolsetsUpdateToolsetBody string, toolsetsUpdateT
 			v.PromptTemplateNames[i] = val
 		}
 	}
+	if body.Resources != nil {
+		v.Resources = make([]*types.ToolsetResourceForm, len(body.Resources))
+		for i, val := range body.Resources {
+			v.Resources[i] = marshalToolsetResourceFormRequestBodyToTypesToolsetResourceForm(val)
+		}
+	}
+	if body.ClientRules != nil {
+		v.ClientRules = make([]*types.ToolsetClientRule, len(body.ClientRules))
+		for i, val := range body.ClientRules {
+			v.ClientRules[i] = marshalToolsetClientRuleRequestBodyToTypesToolsetClientRule(val)
+		}
+	}
 	v.Slug = types.Slug(slug)
 	v.SessionToken = sessionToken
 	v.ProjectSlugInput = projectSlugInput
@@ -293,7 +343,7 @@ func BuildAddExternalOAuthServerPayload(toolsetsAddExternalOAuthServerBody strin
 	{
 		err = json.Unmarshal([]byte(toolsetsAddExternalOAuthServerBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"external_oauth_server\": {\n         \"metadata\": \"Deserunt eaque nostrum impedit ut et ut.\",\n         \"slug\": \"q1j\"\n      }\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"external_oauth_server\": {\n         \"metadata\": \"Atque dolorem quia quia officia sed.\",\n         \"slug\": \"cbm\"\n      }\n   }'")
 		}
 		if body.ExternalOauthServer == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("external_oauth_server", "body"))
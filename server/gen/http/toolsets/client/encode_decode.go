@@ -1966,6 +1966,7 @@ func unmarshalHTTPToolDefinitionResponseBodyToTypesHTTPToolDefinition(v *HTTPToo
 		Confirm:             *v.Confirm,
 		ConfirmPrompt:       v.ConfirmPrompt,
 		Summarizer:          v.Summarizer,
+		ResponseBudget:      v.ResponseBudget,
 		Openapiv3DocumentID: v.Openapiv3DocumentID,
 		Openapiv3Operation:  v.Openapiv3Operation,
 		Security:            v.Security,
@@ -1974,6 +1975,7 @@ func unmarshalHTTPToolDefinitionResponseBodyToTypesHTTPToolDefinition(v *HTTPToo
 		Path:                *v.Path,
 		SchemaVersion:       v.SchemaVersion,
 		Schema:              *v.Schema,
+		OutputSchema:        v.OutputSchema,
 		PackageName:         v.PackageName,
 		CreatedAt:           *v.CreatedAt,
 		UpdatedAt:           *v.UpdatedAt,
@@ -1981,6 +1983,12 @@ func unmarshalHTTPToolDefinitionResponseBodyToTypesHTTPToolDefinition(v *HTTPToo
 	if v.ResponseFilter != nil {
 		res.ResponseFilter = unmarshalResponseFilterResponseBodyToTypesResponseFilter(v.ResponseFilter)
 	}
+	if v.Annotations != nil {
+		res.Annotations = unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v.RetryPolicy)
+	}
 	res.Tags = make([]string, len(v.Tags))
 	for i, val := range v.Tags {
 		res.Tags[i] = val
@@ -2016,6 +2024,56 @@ func unmarshalResponseFilterResponseBodyToTypesResponseFilter(v *ResponseFilterR
 	return res
 }
 
+// unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations builds a value of
+// type *types.ToolAnnotations from a value of type
+// *ToolAnnotationsResponseBody.
+func unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v *ToolAnnotationsResponseBody) *types.ToolAnnotations {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolAnnotations{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy builds a value of
+// type *types.ToolRetryPolicy from a value of type
+// *ToolRetryPolicyResponseBody.
+func unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v *ToolRetryPolicyResponseBody) *types.ToolRetryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolRetryPolicy{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
+
+	return res
+}
+
 // unmarshalCanonicalToolAttributesResponseBodyToTypesCanonicalToolAttributes
 // builds a value of type *types.CanonicalToolAttributes from a value of type
 // *CanonicalToolAttributesResponseBody.
@@ -2024,13 +2082,14 @@ func unmarshalCanonicalToolAttributesResponseBodyToTypesCanonicalToolAttributes(
 		return nil
 	}
 	res := &types.CanonicalToolAttributes{
-		VariationID:   *v.VariationID,
-		Name:          *v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Summarizer:    v.Summarizer,
+		VariationID:    *v.VariationID,
+		Name:           *v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -2038,6 +2097,12 @@ func unmarshalCanonicalToolAttributesResponseBodyToTypesCanonicalToolAttributes(
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v.RetryPolicy)
+	}
 
 	return res
 }
@@ -2049,17 +2114,18 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 		return nil
 	}
 	res := &types.ToolVariation{
-		ID:            *v.ID,
-		GroupID:       *v.GroupID,
-		SrcToolName:   *v.SrcToolName,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Name:          v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Summarizer:    v.Summarizer,
-		CreatedAt:     *v.CreatedAt,
-		UpdatedAt:     *v.UpdatedAt,
+		ID:             *v.ID,
+		GroupID:        *v.GroupID,
+		SrcToolName:    *v.SrcToolName,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Name:           v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
+		CreatedAt:      *v.CreatedAt,
+		UpdatedAt:      *v.UpdatedAt,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -2067,6 +2133,12 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v.RetryPolicy)
+	}
 
 	return res
 }
@@ -2095,6 +2167,46 @@ func unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(v *PromptTemplateR
 	return res
 }
 
+// unmarshalToolsetResourceResponseBodyToTypesToolsetResource builds a value of
+// type *types.ToolsetResource from a value of type
+// *ToolsetResourceResponseBody.
+func unmarshalToolsetResourceResponseBodyToTypesToolsetResource(v *ToolsetResourceResponseBody) *types.ToolsetResource {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolsetResource{
+		ID:           *v.ID,
+		Kind:         *v.Kind,
+		Name:         types.Slug(*v.Name),
+		Description:  v.Description,
+		MimeType:     v.MimeType,
+		URI:          v.URI,
+		URITemplate:  v.URITemplate,
+		AssetID:      v.AssetID,
+		HTTPToolName: v.HTTPToolName,
+		CreatedAt:    *v.CreatedAt,
+		UpdatedAt:    *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalToolsetClientRuleResponseBodyToTypesToolsetClientRule builds a
+// value of type *types.ToolsetClientRule from a value of type
+// *ToolsetClientRuleResponseBody.
+func unmarshalToolsetClientRuleResponseBodyToTypesToolsetClientRule(v *ToolsetClientRuleResponseBody) *types.ToolsetClientRule {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolsetClientRule{
+		ClientName: *v.ClientName,
+		Kind:       *v.Kind,
+		MinVersion: v.MinVersion,
+	}
+
+	return res
+}
+
 // unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer builds a
 // value of type *types.ExternalOAuthServer from a value of type
 // *ExternalOAuthServerResponseBody.
@@ -2248,6 +2360,78 @@ func unmarshalPromptTemplateEntryResponseBodyToTypesPromptTemplateEntry(v *Promp
 	return res
 }
 
+// marshalTypesToolsetResourceFormToToolsetResourceFormRequestBody builds a
+// value of type *ToolsetResourceFormRequestBody from a value of type
+// *types.ToolsetResourceForm.
+func marshalTypesToolsetResourceFormToToolsetResourceFormRequestBody(v *types.ToolsetResourceForm) *ToolsetResourceFormRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolsetResourceFormRequestBody{
+		Kind:         v.Kind,
+		Name:         string(v.Name),
+		Description:  v.Description,
+		MimeType:     v.MimeType,
+		AssetID:      v.AssetID,
+		HTTPToolName: v.HTTPToolName,
+		URITemplate:  v.URITemplate,
+	}
+
+	return res
+}
+
+// marshalTypesToolsetClientRuleToToolsetClientRuleRequestBody builds a value
+// of type *ToolsetClientRuleRequestBody from a value of type
+// *types.ToolsetClientRule.
+func marshalTypesToolsetClientRuleToToolsetClientRuleRequestBody(v *types.ToolsetClientRule) *ToolsetClientRuleRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolsetClientRuleRequestBody{
+		ClientName: v.ClientName,
+		Kind:       v.Kind,
+		MinVersion: v.MinVersion,
+	}
+
+	return res
+}
+
+// marshalToolsetResourceFormRequestBodyToTypesToolsetResourceForm builds a
+// value of type *types.ToolsetResourceForm from a value of type
+// *ToolsetResourceFormRequestBody.
+func marshalToolsetResourceFormRequestBodyToTypesToolsetResourceForm(v *ToolsetResourceFormRequestBody) *types.ToolsetResourceForm {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolsetResourceForm{
+		Kind:         v.Kind,
+		Name:         types.Slug(v.Name),
+		Description:  v.Description,
+		MimeType:     v.MimeType,
+		AssetID:      v.AssetID,
+		HTTPToolName: v.HTTPToolName,
+		URITemplate:  v.URITemplate,
+	}
+
+	return res
+}
+
+// marshalToolsetClientRuleRequestBodyToTypesToolsetClientRule builds a value
+// of type *types.ToolsetClientRule from a value of type
+// *ToolsetClientRuleRequestBody.
+func marshalToolsetClientRuleRequestBodyToTypesToolsetClientRule(v *ToolsetClientRuleRequestBody) *types.ToolsetClientRule {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolsetClientRule{
+		ClientName: v.ClientName,
+		Kind:       v.Kind,
+		MinVersion: v.MinVersion,
+	}
+
+	return res
+}
+
 // marshalTypesExternalOAuthServerFormToExternalOAuthServerFormRequestBody
 // builds a value of type *ExternalOAuthServerFormRequestBody from a value of
 // type *types.ExternalOAuthServerForm.
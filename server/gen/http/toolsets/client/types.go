@@ -35,14 +35,31 @@ type UpdateToolsetRequestBody struct {
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// The new description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The new MCP server instructions for the toolset. An empty string removes
+	// them.
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// List of HTTP tool names to include
 	HTTPToolNames []string `form:"http_tool_names,omitempty" json:"http_tool_names,omitempty" xml:"http_tool_names,omitempty"`
 	// List of prompt template names to include
 	PromptTemplateNames []string `form:"prompt_template_names,omitempty" json:"prompt_template_names,omitempty" xml:"prompt_template_names,omitempty"`
+	// The MCP resources to publish. Replaces all existing resources when set.
+	Resources []*ToolsetResourceFormRequestBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect. Replaces all existing rules
+	// when set.
+	ClientRules []*ToolsetClientRuleRequestBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
@@ -75,6 +92,12 @@ type CreateToolsetResponseBody struct {
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -85,12 +108,25 @@ type CreateToolsetResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools,omitempty" json:"http_tools,omitempty" xml:"http_tools,omitempty"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates,omitempty" json:"prompt_templates,omitempty" xml:"prompt_templates,omitempty"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -127,6 +163,12 @@ type UpdateToolsetResponseBody struct {
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -137,12 +179,25 @@ type UpdateToolsetResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools,omitempty" json:"http_tools,omitempty" xml:"http_tools,omitempty"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates,omitempty" json:"prompt_templates,omitempty" xml:"prompt_templates,omitempty"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -172,6 +227,12 @@ type GetToolsetResponseBody struct {
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -182,12 +243,25 @@ type GetToolsetResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools,omitempty" json:"http_tools,omitempty" xml:"http_tools,omitempty"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates,omitempty" json:"prompt_templates,omitempty" xml:"prompt_templates,omitempty"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -217,6 +291,12 @@ type AddExternalOAuthServerResponseBody struct {
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -227,12 +307,25 @@ type AddExternalOAuthServerResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools,omitempty" json:"http_tools,omitempty" xml:"http_tools,omitempty"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates,omitempty" json:"prompt_templates,omitempty" xml:"prompt_templates,omitempty"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -262,6 +355,12 @@ type RemoveOAuthServerResponseBody struct {
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -272,12 +371,25 @@ type RemoveOAuthServerResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools,omitempty" json:"http_tools,omitempty" xml:"http_tools,omitempty"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates,omitempty" json:"prompt_templates,omitempty" xml:"prompt_templates,omitempty"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -1819,6 +1931,13 @@ type HTTPToolDefinitionResponseBody struct {
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
 	// Response filter metadata for the tool
 	ResponseFilter *ResponseFilterResponseBody `form:"response_filter,omitempty" json:"response_filter,omitempty" xml:"response_filter,omitempty"`
+	// Behavioral hints for the tool, derived from its HTTP method unless overridden
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The maximum size in bytes of a response returned to the model before it is
+	// truncated. Falls back to the toolset's budget when unset.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// How calls to the tool's upstream API are timed out and retried
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 	// The ID of the OpenAPI v3 document
 	Openapiv3DocumentID *string `form:"openapiv3_document_id,omitempty" json:"openapiv3_document_id,omitempty" xml:"openapiv3_document_id,omitempty"`
 	// OpenAPI v3 operation
@@ -1837,6 +1956,9 @@ type HTTPToolDefinitionResponseBody struct {
 	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
 	// JSON schema for the request
 	Schema *string `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// JSON schema for the structured output of the tool, derived from its
+	// successful response
+	OutputSchema *string `form:"output_schema,omitempty" json:"output_schema,omitempty" xml:"output_schema,omitempty"`
 	// The name of the source package
 	PackageName *string `form:"package_name,omitempty" json:"package_name,omitempty" xml:"package_name,omitempty"`
 	// The creation date of the tool.
@@ -1859,6 +1981,45 @@ type ResponseFilterResponseBody struct {
 	ContentTypes []string `form:"content_types,omitempty" json:"content_types,omitempty" xml:"content_types,omitempty"`
 }
 
+// ToolAnnotationsResponseBody is used to define fields on response body types.
+type ToolAnnotationsResponseBody struct {
+	// A human-readable title for the tool
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// If true, the tool does not modify its environment
+	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
+	// If true, the tool may perform destructive updates
+	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
+	// If true, calling the tool repeatedly with the same arguments has no
+	// additional effect
+	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
+	// If true, the tool interacts with external entities
+	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+}
+
+// ToolRetryPolicyResponseBody is used to define fields on response body types.
+type ToolRetryPolicyResponseBody struct {
+	// The number of attempts made including the first. A value of 1 disables
+	// retries.
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// Response status codes that are retried, such as 429 or 5XX
+	StatusCodes []string `form:"status_codes,omitempty" json:"status_codes,omitempty" xml:"status_codes,omitempty"`
+	// HTTP methods that may be retried
+	Methods []string `form:"methods,omitempty" json:"methods,omitempty" xml:"methods,omitempty"`
+	// Whether requests that fail before a response is received are retried
+	RetryConnectionErrors *bool `form:"retry_connection_errors,omitempty" json:"retry_connection_errors,omitempty" xml:"retry_connection_errors,omitempty"`
+	// The delay before the first retry in milliseconds
+	InitialIntervalMs *int64 `form:"initial_interval_ms,omitempty" json:"initial_interval_ms,omitempty" xml:"initial_interval_ms,omitempty"`
+	// The maximum delay between two attempts in milliseconds
+	MaxIntervalMs *int64 `form:"max_interval_ms,omitempty" json:"max_interval_ms,omitempty" xml:"max_interval_ms,omitempty"`
+	// The factor the delay grows by after each retry
+	Exponent *float64 `form:"exponent,omitempty" json:"exponent,omitempty" xml:"exponent,omitempty"`
+	// The overall deadline for a call across all of its attempts in milliseconds
+	MaxElapsedTimeMs *int64 `form:"max_elapsed_time_ms,omitempty" json:"max_elapsed_time_ms,omitempty" xml:"max_elapsed_time_ms,omitempty"`
+	// The time allowed for a single attempt, including reading the response, in
+	// milliseconds
+	TimeoutMs *int64 `form:"timeout_ms,omitempty" json:"timeout_ms,omitempty" xml:"timeout_ms,omitempty"`
+}
+
 // CanonicalToolAttributesResponseBody is used to define fields on response
 // body types.
 type CanonicalToolAttributesResponseBody struct {
@@ -1878,6 +2039,12 @@ type CanonicalToolAttributesResponseBody struct {
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
 	// The tags list for this http tool
 	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Behavioral hints set for the tool in its source document
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The response budget set for the tool in its source document
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// The retry policy set for the tool in its source document
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 }
 
 // ToolVariationResponseBody is used to define fields on response body types.
@@ -1902,6 +2069,13 @@ type ToolVariationResponseBody struct {
 	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 	// The summarizer of the tool variation
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// The behavioral hints of the tool variation
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The response budget of the tool variation in bytes
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// The retry policy of the tool variation. Fields that are set override the
+	// tool's own policy.
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 	// The creation date of the tool variation
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The last update date of the tool variation
@@ -1936,6 +2110,47 @@ type PromptTemplateResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// ToolsetResourceResponseBody is used to define fields on response body types.
+type ToolsetResourceResponseBody struct {
+	// The ID of the resource
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The source of the resource
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The name of the resource, unique within the toolset
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the resource
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The MIME type of the resource contents
+	MimeType *string `form:"mime_type,omitempty" json:"mime_type,omitempty" xml:"mime_type,omitempty"`
+	// The URI of a static resource
+	URI *string `form:"uri,omitempty" json:"uri,omitempty" xml:"uri,omitempty"`
+	// The RFC 6570 URI template of a templated resource
+	URITemplate *string `form:"uri_template,omitempty" json:"uri_template,omitempty" xml:"uri_template,omitempty"`
+	// The ID of the asset backing a static resource
+	AssetID *string `form:"asset_id,omitempty" json:"asset_id,omitempty" xml:"asset_id,omitempty"`
+	// The name of the GET tool backing a templated resource
+	HTTPToolName *string `form:"http_tool_name,omitempty" json:"http_tool_name,omitempty" xml:"http_tool_name,omitempty"`
+	// When the resource was created.
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the resource was last updated.
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// ToolsetClientRuleResponseBody is used to define fields on response body
+// types.
+type ToolsetClientRuleResponseBody struct {
+	// The client name to match, as sent in clientInfo.name when the client
+	// initializes. Matching ignores case.
+	ClientName *string `form:"client_name,omitempty" json:"client_name,omitempty" xml:"client_name,omitempty"`
+	// allow adds the client to an allow-list so that only listed clients may
+	// connect, block rejects the client and minimum_version rejects versions of
+	// the client older than min_version.
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The oldest semantic version of the client that may connect. Required for
+	// minimum_version rules.
+	MinVersion *string `form:"min_version,omitempty" json:"min_version,omitempty" xml:"min_version,omitempty"`
+}
+
 // ExternalOAuthServerResponseBody is used to define fields on response body
 // types.
 type ExternalOAuthServerResponseBody struct {
@@ -2050,6 +2265,43 @@ type PromptTemplateEntryResponseBody struct {
 	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
 }
 
+// ToolsetResourceFormRequestBody is used to define fields on request body
+// types.
+type ToolsetResourceFormRequestBody struct {
+	// The source of the resource
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The name of the resource, unique within the toolset
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the resource
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The MIME type of the resource contents. Defaults to the content type of the
+	// asset or the response.
+	MimeType *string `form:"mime_type,omitempty" json:"mime_type,omitempty" xml:"mime_type,omitempty"`
+	// The ID of the asset backing a static resource. Required for asset resources.
+	AssetID *string `form:"asset_id,omitempty" json:"asset_id,omitempty" xml:"asset_id,omitempty"`
+	// The name of a GET tool backing a templated resource. Required for
+	// http_template resources.
+	HTTPToolName *string `form:"http_tool_name,omitempty" json:"http_tool_name,omitempty" xml:"http_tool_name,omitempty"`
+	// The RFC 6570 URI template for a templated resource. Template variables must
+	// match the tool's path or query parameters. Defaults to a template covering
+	// every path and query parameter.
+	URITemplate *string `form:"uri_template,omitempty" json:"uri_template,omitempty" xml:"uri_template,omitempty"`
+}
+
+// ToolsetClientRuleRequestBody is used to define fields on request body types.
+type ToolsetClientRuleRequestBody struct {
+	// The client name to match, as sent in clientInfo.name when the client
+	// initializes. Matching ignores case.
+	ClientName string `form:"client_name" json:"client_name" xml:"client_name"`
+	// allow adds the client to an allow-list so that only listed clients may
+	// connect, block rejects the client and minimum_version rejects versions of
+	// the client older than min_version.
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The oldest semantic version of the client that may connect. Required for
+	// minimum_version rules.
+	MinVersion *string `form:"min_version,omitempty" json:"min_version,omitempty" xml:"min_version,omitempty"`
+}
+
 // ExternalOAuthServerFormRequestBody is used to define fields on request body
 // types.
 type ExternalOAuthServerFormRequestBody struct {
@@ -2083,11 +2335,15 @@ func NewCreateToolsetRequestBody(p *toolsets.CreateToolsetPayload) *CreateToolse
 // the "updateToolset" endpoint of the "toolsets" service.
 func NewUpdateToolsetRequestBody(p *toolsets.UpdateToolsetPayload) *UpdateToolsetRequestBody {
 	body := &UpdateToolsetRequestBody{
-		Name:           p.Name,
-		Description:    p.Description,
-		McpEnabled:     p.McpEnabled,
-		McpIsPublic:    p.McpIsPublic,
-		CustomDomainID: p.CustomDomainID,
+		Name:                  p.Name,
+		Description:           p.Description,
+		Instructions:          p.Instructions,
+		McpEnabled:            p.McpEnabled,
+		ToolSelectionMode:     p.ToolSelectionMode,
+		ResourceLinkThreshold: p.ResourceLinkThreshold,
+		ResponseBudget:        p.ResponseBudget,
+		McpIsPublic:           p.McpIsPublic,
+		CustomDomainID:        p.CustomDomainID,
 	}
 	if p.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := string(*p.DefaultEnvironmentSlug)
@@ -2109,6 +2365,18 @@ func NewUpdateToolsetRequestBody(p *toolsets.UpdateToolsetPayload) *UpdateToolse
 			body.PromptTemplateNames[i] = val
 		}
 	}
+	if p.Resources != nil {
+		body.Resources = make([]*ToolsetResourceFormRequestBody, len(p.Resources))
+		for i, val := range p.Resources {
+			body.Resources[i] = marshalTypesToolsetResourceFormToToolsetResourceFormRequestBody(val)
+		}
+	}
+	if p.ClientRules != nil {
+		body.ClientRules = make([]*ToolsetClientRuleRequestBody, len(p.ClientRules))
+		for i, val := range p.ClientRules {
+			body.ClientRules[i] = marshalTypesToolsetClientRuleToToolsetClientRuleRequestBody(val)
+		}
+	}
 	return body
 }
 
@@ -2126,18 +2394,23 @@ func NewAddExternalOAuthServerRequestBody(p *toolsets.AddExternalOAuthServerPayl
 // endpoint result from a HTTP "OK" response.
 func NewCreateToolsetToolsetOK(body *CreateToolsetResponseBody) *types.Toolset {
 	v := &types.Toolset{
-		ID:             *body.ID,
-		ProjectID:      *body.ProjectID,
-		OrganizationID: *body.OrganizationID,
-		AccountType:    *body.AccountType,
-		Name:           *body.Name,
-		Slug:           types.Slug(*body.Slug),
-		Description:    body.Description,
-		McpIsPublic:    body.McpIsPublic,
-		McpEnabled:     body.McpEnabled,
-		CustomDomainID: body.CustomDomainID,
-		CreatedAt:      *body.CreatedAt,
-		UpdatedAt:      *body.UpdatedAt,
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		Instructions:          body.Instructions,
+		InstructionsVersion:   body.InstructionsVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     body.ToolSelectionMode,
+		ResourceLinkThreshold: body.ResourceLinkThreshold,
+		ResponseBudget:        body.ResponseBudget,
+		CustomDomainID:        body.CustomDomainID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
 	}
 	if body.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
@@ -2167,6 +2440,18 @@ func NewCreateToolsetToolsetOK(body *CreateToolsetResponseBody) *types.Toolset {
 	for i, val := range body.PromptTemplates {
 		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
 	}
+	if body.Resources != nil {
+		v.Resources = make([]*types.ToolsetResource, len(body.Resources))
+		for i, val := range body.Resources {
+			v.Resources[i] = unmarshalToolsetResourceResponseBodyToTypesToolsetResource(val)
+		}
+	}
+	if body.ClientRules != nil {
+		v.ClientRules = make([]*types.ToolsetClientRule, len(body.ClientRules))
+		for i, val := range body.ClientRules {
+			v.ClientRules[i] = unmarshalToolsetClientRuleResponseBodyToTypesToolsetClientRule(val)
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
 	}
@@ -2493,18 +2778,23 @@ func NewListToolsetsGatewayError(body *ListToolsetsGatewayErrorResponseBody) *go
 // endpoint result from a HTTP "OK" response.
 func NewUpdateToolsetToolsetOK(body *UpdateToolsetResponseBody) *types.Toolset {
 	v := &types.Toolset{
-		ID:             *body.ID,
-		ProjectID:      *body.ProjectID,
-		OrganizationID: *body.OrganizationID,
-		AccountType:    *body.AccountType,
-		Name:           *body.Name,
-		Slug:           types.Slug(*body.Slug),
-		Description:    body.Description,
-		McpIsPublic:    body.McpIsPublic,
-		McpEnabled:     body.McpEnabled,
-		CustomDomainID: body.CustomDomainID,
-		CreatedAt:      *body.CreatedAt,
-		UpdatedAt:      *body.UpdatedAt,
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		Instructions:          body.Instructions,
+		InstructionsVersion:   body.InstructionsVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     body.ToolSelectionMode,
+		ResourceLinkThreshold: body.ResourceLinkThreshold,
+		ResponseBudget:        body.ResponseBudget,
+		CustomDomainID:        body.CustomDomainID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
 	}
 	if body.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
@@ -2534,6 +2824,18 @@ func NewUpdateToolsetToolsetOK(body *UpdateToolsetResponseBody) *types.Toolset {
 	for i, val := range body.PromptTemplates {
 		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
 	}
+	if body.Resources != nil {
+		v.Resources = make([]*types.ToolsetResource, len(body.Resources))
+		for i, val := range body.Resources {
+			v.Resources[i] = unmarshalToolsetResourceResponseBodyToTypesToolsetResource(val)
+		}
+	}
+	if body.ClientRules != nil {
+		v.ClientRules = make([]*types.ToolsetClientRule, len(body.ClientRules))
+		for i, val := range body.ClientRules {
+			v.ClientRules[i] = unmarshalToolsetClientRuleResponseBodyToTypesToolsetClientRule(val)
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
 	}
@@ -2848,18 +3150,23 @@ func NewDeleteToolsetGatewayError(body *DeleteToolsetGatewayErrorResponseBody) *
 // result from a HTTP "OK" response.
 func NewGetToolsetToolsetOK(body *GetToolsetResponseBody) *types.Toolset {
 	v := &types.Toolset{
-		ID:             *body.ID,
-		ProjectID:      *body.ProjectID,
-		OrganizationID: *body.OrganizationID,
-		AccountType:    *body.AccountType,
-		Name:           *body.Name,
-		Slug:           types.Slug(*body.Slug),
-		Description:    body.Description,
-		McpIsPublic:    body.McpIsPublic,
-		McpEnabled:     body.McpEnabled,
-		CustomDomainID: body.CustomDomainID,
-		CreatedAt:      *body.CreatedAt,
-		UpdatedAt:      *body.UpdatedAt,
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		Instructions:          body.Instructions,
+		InstructionsVersion:   body.InstructionsVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     body.ToolSelectionMode,
+		ResourceLinkThreshold: body.ResourceLinkThreshold,
+		ResponseBudget:        body.ResponseBudget,
+		CustomDomainID:        body.CustomDomainID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
 	}
 	if body.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
@@ -2889,6 +3196,18 @@ func NewGetToolsetToolsetOK(body *GetToolsetResponseBody) *types.Toolset {
 	for i, val := range body.PromptTemplates {
 		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
 	}
+	if body.Resources != nil {
+		v.Resources = make([]*types.ToolsetResource, len(body.Resources))
+		for i, val := range body.Resources {
+			v.Resources[i] = unmarshalToolsetResourceResponseBodyToTypesToolsetResource(val)
+		}
+	}
+	if body.ClientRules != nil {
+		v.ClientRules = make([]*types.ToolsetClientRule, len(body.ClientRules))
+		for i, val := range body.ClientRules {
+			v.ClientRules[i] = unmarshalToolsetClientRuleResponseBodyToTypesToolsetClientRule(val)
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
 	}
@@ -3203,18 +3522,23 @@ func NewCheckMCPSlugAvailabilityGatewayError(body *CheckMCPSlugAvailabilityGatew
 // "addExternalOAuthServer" endpoint result from a HTTP "OK" response.
 func NewAddExternalOAuthServerToolsetOK(body *AddExternalOAuthServerResponseBody) *types.Toolset {
 	v := &types.Toolset{
-		ID:             *body.ID,
-		ProjectID:      *body.ProjectID,
-		OrganizationID: *body.OrganizationID,
-		AccountType:    *body.AccountType,
-		Name:           *body.Name,
-		Slug:           types.Slug(*body.Slug),
-		Description:    body.Description,
-		McpIsPublic:    body.McpIsPublic,
-		McpEnabled:     body.McpEnabled,
-		CustomDomainID: body.CustomDomainID,
-		CreatedAt:      *body.CreatedAt,
-		UpdatedAt:      *body.UpdatedAt,
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		Instructions:          body.Instructions,
+		InstructionsVersion:   body.InstructionsVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     body.ToolSelectionMode,
+		ResourceLinkThreshold: body.ResourceLinkThreshold,
+		ResponseBudget:        body.ResponseBudget,
+		CustomDomainID:        body.CustomDomainID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
 	}
 	if body.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
@@ -3244,6 +3568,18 @@ func NewAddExternalOAuthServerToolsetOK(body *AddExternalOAuthServerResponseBody
 	for i, val := range body.PromptTemplates {
 		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
 	}
+	if body.Resources != nil {
+		v.Resources = make([]*types.ToolsetResource, len(body.Resources))
+		for i, val := range body.Resources {
+			v.Resources[i] = unmarshalToolsetResourceResponseBodyToTypesToolsetResource(val)
+		}
+	}
+	if body.ClientRules != nil {
+		v.ClientRules = make([]*types.ToolsetClientRule, len(body.ClientRules))
+		for i, val := range body.ClientRules {
+			v.ClientRules[i] = unmarshalToolsetClientRuleResponseBodyToTypesToolsetClientRule(val)
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
 	}
@@ -3408,18 +3744,23 @@ func NewAddExternalOAuthServerGatewayError(body *AddExternalOAuthServerGatewayEr
 // "removeOAuthServer" endpoint result from a HTTP "OK" response.
 func NewRemoveOAuthServerToolsetOK(body *RemoveOAuthServerResponseBody) *types.Toolset {
 	v := &types.Toolset{
-		ID:             *body.ID,
-		ProjectID:      *body.ProjectID,
-		OrganizationID: *body.OrganizationID,
-		AccountType:    *body.AccountType,
-		Name:           *body.Name,
-		Slug:           types.Slug(*body.Slug),
-		Description:    body.Description,
-		McpIsPublic:    body.McpIsPublic,
-		McpEnabled:     body.McpEnabled,
-		CustomDomainID: body.CustomDomainID,
-		CreatedAt:      *body.CreatedAt,
-		UpdatedAt:      *body.UpdatedAt,
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		Instructions:          body.Instructions,
+		InstructionsVersion:   body.InstructionsVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     body.ToolSelectionMode,
+		ResourceLinkThreshold: body.ResourceLinkThreshold,
+		ResponseBudget:        body.ResponseBudget,
+		CustomDomainID:        body.CustomDomainID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
 	}
 	if body.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
@@ -3449,6 +3790,18 @@ func NewRemoveOAuthServerToolsetOK(body *RemoveOAuthServerResponseBody) *types.T
 	for i, val := range body.PromptTemplates {
 		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
 	}
+	if body.Resources != nil {
+		v.Resources = make([]*types.ToolsetResource, len(body.Resources))
+		for i, val := range body.Resources {
+			v.Resources[i] = unmarshalToolsetResourceResponseBodyToTypesToolsetResource(val)
+		}
+	}
+	if body.ClientRules != nil {
+		v.ClientRules = make([]*types.ToolsetClientRule, len(body.ClientRules))
+		for i, val := range body.ClientRules {
+			v.ClientRules[i] = unmarshalToolsetClientRuleResponseBodyToTypesToolsetClientRule(val)
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
 	}
@@ -3686,6 +4039,20 @@ func ValidateCreateToolsetResponseBody(body *CreateToolsetResponseBody) (err err
 			}
 		}
 	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateToolsetResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ClientRules {
+		if e != nil {
+			if err2 := ValidateToolsetClientRuleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	if body.McpSlug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 	}
@@ -3694,6 +4061,21 @@ func ValidateCreateToolsetResponseBody(body *CreateToolsetResponseBody) (err err
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
 		}
 	}
+	if body.ToolSelectionMode != nil {
+		if !(*body.ToolSelectionMode == "static" || *body.ToolSelectionMode == "dynamic") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tool_selection_mode", *body.ToolSelectionMode, []any{"static", "dynamic"}))
+		}
+	}
+	if body.ResourceLinkThreshold != nil {
+		if *body.ResourceLinkThreshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.resource_link_threshold", *body.ResourceLinkThreshold, 0, true))
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -3806,6 +4188,20 @@ func ValidateUpdateToolsetResponseBody(body *UpdateToolsetResponseBody) (err err
 			}
 		}
 	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateToolsetResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ClientRules {
+		if e != nil {
+			if err2 := ValidateToolsetClientRuleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	if body.McpSlug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 	}
@@ -3814,6 +4210,21 @@ func ValidateUpdateToolsetResponseBody(body *UpdateToolsetResponseBody) (err err
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
 		}
 	}
+	if body.ToolSelectionMode != nil {
+		if !(*body.ToolSelectionMode == "static" || *body.ToolSelectionMode == "dynamic") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tool_selection_mode", *body.ToolSelectionMode, []any{"static", "dynamic"}))
+		}
+	}
+	if body.ResourceLinkThreshold != nil {
+		if *body.ResourceLinkThreshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.resource_link_threshold", *body.ResourceLinkThreshold, 0, true))
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -3910,6 +4321,20 @@ func ValidateGetToolsetResponseBody(body *GetToolsetResponseBody) (err error) {
 			}
 		}
 	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateToolsetResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ClientRules {
+		if e != nil {
+			if err2 := ValidateToolsetClientRuleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	if body.McpSlug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 	}
@@ -3918,6 +4343,21 @@ func ValidateGetToolsetResponseBody(body *GetToolsetResponseBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
 		}
 	}
+	if body.ToolSelectionMode != nil {
+		if !(*body.ToolSelectionMode == "static" || *body.ToolSelectionMode == "dynamic") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tool_selection_mode", *body.ToolSelectionMode, []any{"static", "dynamic"}))
+		}
+	}
+	if body.ResourceLinkThreshold != nil {
+		if *body.ResourceLinkThreshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.resource_link_threshold", *body.ResourceLinkThreshold, 0, true))
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -4014,6 +4454,20 @@ func ValidateAddExternalOAuthServerResponseBody(body *AddExternalOAuthServerResp
 			}
 		}
 	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateToolsetResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ClientRules {
+		if e != nil {
+			if err2 := ValidateToolsetClientRuleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	if body.McpSlug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 	}
@@ -4022,6 +4476,21 @@ func ValidateAddExternalOAuthServerResponseBody(body *AddExternalOAuthServerResp
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
 		}
 	}
+	if body.ToolSelectionMode != nil {
+		if !(*body.ToolSelectionMode == "static" || *body.ToolSelectionMode == "dynamic") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tool_selection_mode", *body.ToolSelectionMode, []any{"static", "dynamic"}))
+		}
+	}
+	if body.ResourceLinkThreshold != nil {
+		if *body.ResourceLinkThreshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.resource_link_threshold", *body.ResourceLinkThreshold, 0, true))
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -4118,6 +4587,20 @@ func ValidateRemoveOAuthServerResponseBody(body *RemoveOAuthServerResponseBody)
 			}
 		}
 	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateToolsetResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ClientRules {
+		if e != nil {
+			if err2 := ValidateToolsetClientRuleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	if body.McpSlug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 	}
@@ -4126,6 +4609,21 @@ func ValidateRemoveOAuthServerResponseBody(body *RemoveOAuthServerResponseBody)
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
 		}
 	}
+	if body.ToolSelectionMode != nil {
+		if !(*body.ToolSelectionMode == "static" || *body.ToolSelectionMode == "dynamic") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tool_selection_mode", *body.ToolSelectionMode, []any{"static", "dynamic"}))
+		}
+	}
+	if body.ResourceLinkThreshold != nil {
+		if *body.ResourceLinkThreshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.resource_link_threshold", *body.ResourceLinkThreshold, 0, true))
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
 	if body.ExternalOauthServer != nil {
 		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -6148,6 +6646,21 @@ func ValidateHTTPToolDefinitionResponseBody(body *HTTPToolDefinitionResponseBody
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Annotations != nil {
+		if err2 := ValidateToolAnnotationsResponseBody(body.Annotations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateToolRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
@@ -6182,6 +6695,71 @@ func ValidateResponseFilterResponseBody(body *ResponseFilterResponseBody) (err e
 	return
 }
 
+// ValidateToolAnnotationsResponseBody runs the validations defined on
+// ToolAnnotationsResponseBody
+func ValidateToolAnnotationsResponseBody(body *ToolAnnotationsResponseBody) (err error) {
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 100, false))
+		}
+	}
+	return
+}
+
+// ValidateToolRetryPolicyResponseBody runs the validations defined on
+// ToolRetryPolicyResponseBody
+func ValidateToolRetryPolicyResponseBody(body *ToolRetryPolicyResponseBody) (err error) {
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts > 10 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 10, false))
+		}
+	}
+	for _, e := range body.StatusCodes {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.status_codes[*]", e, "^[1-5]([0-9]{2}|XX)$"))
+	}
+	for _, e := range body.Methods {
+		if !(e == "GET" || e == "HEAD" || e == "POST" || e == "PUT" || e == "PATCH" || e == "DELETE" || e == "OPTIONS") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.methods[*]", e, []any{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}))
+		}
+	}
+	if body.InitialIntervalMs != nil {
+		if *body.InitialIntervalMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_interval_ms", *body.InitialIntervalMs, 1, true))
+		}
+	}
+	if body.MaxIntervalMs != nil {
+		if *body.MaxIntervalMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_interval_ms", *body.MaxIntervalMs, 1, true))
+		}
+	}
+	if body.Exponent != nil {
+		if *body.Exponent < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.exponent", *body.Exponent, 1, true))
+		}
+	}
+	if body.Exponent != nil {
+		if *body.Exponent > 10 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.exponent", *body.Exponent, 10, false))
+		}
+	}
+	if body.MaxElapsedTimeMs != nil {
+		if *body.MaxElapsedTimeMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_elapsed_time_ms", *body.MaxElapsedTimeMs, 1, true))
+		}
+	}
+	if body.TimeoutMs != nil {
+		if *body.TimeoutMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_ms", *body.TimeoutMs, 1, true))
+		}
+	}
+	return
+}
+
 // ValidateCanonicalToolAttributesResponseBody runs the validations defined on
 // CanonicalToolAttributesResponseBody
 func ValidateCanonicalToolAttributesResponseBody(body *CanonicalToolAttributesResponseBody) (err error) {
@@ -6191,6 +6769,21 @@ func ValidateCanonicalToolAttributesResponseBody(body *CanonicalToolAttributesRe
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
+	if body.Annotations != nil {
+		if err2 := ValidateToolAnnotationsResponseBody(body.Annotations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateToolRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -6212,6 +6805,21 @@ func ValidateToolVariationResponseBody(body *ToolVariationResponseBody) (err err
 	if body.UpdatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
+	if body.Annotations != nil {
+		if err2 := ValidateToolAnnotationsResponseBody(body.Annotations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateToolRetryPolicyResponseBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -6278,6 +6886,78 @@ func ValidatePromptTemplateResponseBody(body *PromptTemplateResponseBody) (err e
 	return
 }
 
+// ValidateToolsetResourceResponseBody runs the validations defined on
+// ToolsetResourceResponseBody
+func ValidateToolsetResourceResponseBody(body *ToolsetResourceResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "asset" || *body.Kind == "http_template") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"asset", "http_template"}))
+		}
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 40, false))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateToolsetClientRuleResponseBody runs the validations defined on
+// ToolsetClientRuleResponseBody
+func ValidateToolsetClientRuleResponseBody(body *ToolsetClientRuleResponseBody) (err error) {
+	if body.ClientName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("client_name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.ClientName != nil {
+		if utf8.RuneCountInString(*body.ClientName) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.client_name", *body.ClientName, utf8.RuneCountInString(*body.ClientName), 1, true))
+		}
+	}
+	if body.ClientName != nil {
+		if utf8.RuneCountInString(*body.ClientName) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.client_name", *body.ClientName, utf8.RuneCountInString(*body.ClientName), 100, false))
+		}
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "allow" || *body.Kind == "block" || *body.Kind == "minimum_version") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"allow", "block", "minimum_version"}))
+		}
+	}
+	if body.MinVersion != nil {
+		if utf8.RuneCountInString(*body.MinVersion) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.min_version", *body.MinVersion, utf8.RuneCountInString(*body.MinVersion), 50, false))
+		}
+	}
+	return
+}
+
 // ValidateExternalOAuthServerResponseBody runs the validations defined on
 // ExternalOAuthServerResponseBody
 func ValidateExternalOAuthServerResponseBody(body *ExternalOAuthServerResponseBody) (err error) {
@@ -6519,6 +7199,44 @@ func ValidatePromptTemplateEntryResponseBody(body *PromptTemplateEntryResponseBo
 	return
 }
 
+// ValidateToolsetResourceFormRequestBody runs the validations defined on
+// ToolsetResourceFormRequestBody
+func ValidateToolsetResourceFormRequestBody(body *ToolsetResourceFormRequestBody) (err error) {
+	if !(body.Kind == "asset" || body.Kind == "http_template") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", body.Kind, []any{"asset", "http_template"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidatePattern("body.name", body.Name, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
+	if utf8.RuneCountInString(body.Name) > 40 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 40, false))
+	}
+	if body.Description != nil {
+		if utf8.RuneCountInString(*body.Description) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateToolsetClientRuleRequestBody runs the validations defined on
+// ToolsetClientRuleRequestBody
+func ValidateToolsetClientRuleRequestBody(body *ToolsetClientRuleRequestBody) (err error) {
+	if utf8.RuneCountInString(body.ClientName) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.client_name", body.ClientName, utf8.RuneCountInString(body.ClientName), 1, true))
+	}
+	if utf8.RuneCountInString(body.ClientName) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.client_name", body.ClientName, utf8.RuneCountInString(body.ClientName), 100, false))
+	}
+	if !(body.Kind == "allow" || body.Kind == "block" || body.Kind == "minimum_version") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", body.Kind, []any{"allow", "block", "minimum_version"}))
+	}
+	if body.MinVersion != nil {
+		if utf8.RuneCountInString(*body.MinVersion) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.min_version", *body.MinVersion, utf8.RuneCountInString(*body.MinVersion), 50, false))
+		}
+	}
+	return
+}
+
 // ValidateExternalOAuthServerFormRequestBody runs the validations defined on
 // ExternalOAuthServerFormRequestBody
 func ValidateExternalOAuthServerFormRequestBody(body *ExternalOAuthServerFormRequestBody) (err error) {
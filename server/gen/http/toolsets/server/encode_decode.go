@@ -1855,6 +1855,7 @@ func marshalTypesHTTPToolDefinitionToHTTPToolDefinitionResponseBody(v *types.HTT
 		Confirm:             v.Confirm,
 		ConfirmPrompt:       v.ConfirmPrompt,
 		Summarizer:          v.Summarizer,
+		ResponseBudget:      v.ResponseBudget,
 		Openapiv3DocumentID: v.Openapiv3DocumentID,
 		Openapiv3Operation:  v.Openapiv3Operation,
 		Security:            v.Security,
@@ -1863,6 +1864,7 @@ func marshalTypesHTTPToolDefinitionToHTTPToolDefinitionResponseBody(v *types.HTT
 		Path:                v.Path,
 		SchemaVersion:       v.SchemaVersion,
 		Schema:              v.Schema,
+		OutputSchema:        v.OutputSchema,
 		PackageName:         v.PackageName,
 		CreatedAt:           v.CreatedAt,
 		UpdatedAt:           v.UpdatedAt,
@@ -1870,6 +1872,12 @@ func marshalTypesHTTPToolDefinitionToHTTPToolDefinitionResponseBody(v *types.HTT
 	if v.ResponseFilter != nil {
 		res.ResponseFilter = marshalTypesResponseFilterToResponseFilterResponseBody(v.ResponseFilter)
 	}
+	if v.Annotations != nil {
+		res.Annotations = marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v.RetryPolicy)
+	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
 		for i, val := range v.Tags {
@@ -1917,6 +1925,56 @@ func marshalTypesResponseFilterToResponseFilterResponseBody(v *types.ResponseFil
 	return res
 }
 
+// marshalTypesToolAnnotationsToToolAnnotationsResponseBody builds a value of
+// type *ToolAnnotationsResponseBody from a value of type
+// *types.ToolAnnotations.
+func marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v *types.ToolAnnotations) *ToolAnnotationsResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolAnnotationsResponseBody{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody builds a value of
+// type *ToolRetryPolicyResponseBody from a value of type
+// *types.ToolRetryPolicy.
+func marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v *types.ToolRetryPolicy) *ToolRetryPolicyResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolRetryPolicyResponseBody{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
+
+	return res
+}
+
 // marshalTypesCanonicalToolAttributesToCanonicalToolAttributesResponseBody
 // builds a value of type *CanonicalToolAttributesResponseBody from a value of
 // type *types.CanonicalToolAttributes.
@@ -1925,13 +1983,14 @@ func marshalTypesCanonicalToolAttributesToCanonicalToolAttributesResponseBody(v
 		return nil
 	}
 	res := &CanonicalToolAttributesResponseBody{
-		VariationID:   v.VariationID,
-		Name:          v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Summarizer:    v.Summarizer,
+		VariationID:    v.VariationID,
+		Name:           v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -1939,6 +1998,12 @@ func marshalTypesCanonicalToolAttributesToCanonicalToolAttributesResponseBody(v
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v.RetryPolicy)
+	}
 
 	return res
 }
@@ -1950,17 +2015,18 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 		return nil
 	}
 	res := &ToolVariationResponseBody{
-		ID:            v.ID,
-		GroupID:       v.GroupID,
-		SrcToolName:   v.SrcToolName,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Name:          v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Summarizer:    v.Summarizer,
-		CreatedAt:     v.CreatedAt,
-		UpdatedAt:     v.UpdatedAt,
+		ID:             v.ID,
+		GroupID:        v.GroupID,
+		SrcToolName:    v.SrcToolName,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Name:           v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -1968,6 +2034,12 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v.RetryPolicy)
+	}
 
 	return res
 }
@@ -2000,6 +2072,46 @@ func marshalTypesPromptTemplateToPromptTemplateResponseBody(v *types.PromptTempl
 	return res
 }
 
+// marshalTypesToolsetResourceToToolsetResourceResponseBody builds a value of
+// type *ToolsetResourceResponseBody from a value of type
+// *types.ToolsetResource.
+func marshalTypesToolsetResourceToToolsetResourceResponseBody(v *types.ToolsetResource) *ToolsetResourceResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolsetResourceResponseBody{
+		ID:           v.ID,
+		Kind:         v.Kind,
+		Name:         string(v.Name),
+		Description:  v.Description,
+		MimeType:     v.MimeType,
+		URI:          v.URI,
+		URITemplate:  v.URITemplate,
+		AssetID:      v.AssetID,
+		HTTPToolName: v.HTTPToolName,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+	}
+
+	return res
+}
+
+// marshalTypesToolsetClientRuleToToolsetClientRuleResponseBody builds a value
+// of type *ToolsetClientRuleResponseBody from a value of type
+// *types.ToolsetClientRule.
+func marshalTypesToolsetClientRuleToToolsetClientRuleResponseBody(v *types.ToolsetClientRule) *ToolsetClientRuleResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolsetClientRuleResponseBody{
+		ClientName: v.ClientName,
+		Kind:       v.Kind,
+		MinVersion: v.MinVersion,
+	}
+
+	return res
+}
+
 // marshalTypesExternalOAuthServerToExternalOAuthServerResponseBody builds a
 // value of type *ExternalOAuthServerResponseBody from a value of type
 // *types.ExternalOAuthServer.
@@ -2161,6 +2273,42 @@ func marshalTypesPromptTemplateEntryToPromptTemplateEntryResponseBody(v *types.P
 	return res
 }
 
+// unmarshalToolsetResourceFormRequestBodyToTypesToolsetResourceForm builds a
+// value of type *types.ToolsetResourceForm from a value of type
+// *ToolsetResourceFormRequestBody.
+func unmarshalToolsetResourceFormRequestBodyToTypesToolsetResourceForm(v *ToolsetResourceFormRequestBody) *types.ToolsetResourceForm {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolsetResourceForm{
+		Kind:         *v.Kind,
+		Name:         types.Slug(*v.Name),
+		Description:  v.Description,
+		MimeType:     v.MimeType,
+		AssetID:      v.AssetID,
+		HTTPToolName: v.HTTPToolName,
+		URITemplate:  v.URITemplate,
+	}
+
+	return res
+}
+
+// unmarshalToolsetClientRuleRequestBodyToTypesToolsetClientRule builds a value
+// of type *types.ToolsetClientRule from a value of type
+// *ToolsetClientRuleRequestBody.
+func unmarshalToolsetClientRuleRequestBodyToTypesToolsetClientRule(v *ToolsetClientRuleRequestBody) *types.ToolsetClientRule {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolsetClientRule{
+		ClientName: *v.ClientName,
+		Kind:       *v.Kind,
+		MinVersion: v.MinVersion,
+	}
+
+	return res
+}
+
 // unmarshalExternalOAuthServerFormRequestBodyToTypesExternalOAuthServerForm
 // builds a value of type *types.ExternalOAuthServerForm from a value of type
 // *ExternalOAuthServerFormRequestBody.
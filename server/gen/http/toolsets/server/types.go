@@ -35,14 +35,31 @@ type UpdateToolsetRequestBody struct {
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// The new description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The new MCP server instructions for the toolset. An empty string removes
+	// them.
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// List of HTTP tool names to include
 	HTTPToolNames []string `form:"http_tool_names,omitempty" json:"http_tool_names,omitempty" xml:"http_tool_names,omitempty"`
 	// List of prompt template names to include
 	PromptTemplateNames []string `form:"prompt_template_names,omitempty" json:"prompt_template_names,omitempty" xml:"prompt_template_names,omitempty"`
+	// The MCP resources to publish. Replaces all existing resources when set.
+	Resources []*ToolsetResourceFormRequestBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect. Replaces all existing rules
+	// when set.
+	ClientRules []*ToolsetClientRuleRequestBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
@@ -75,6 +92,12 @@ type CreateToolsetResponseBody struct {
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -85,12 +108,25 @@ type CreateToolsetResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools" json:"http_tools" xml:"http_tools"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates" json:"prompt_templates" xml:"prompt_templates"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -127,6 +163,12 @@ type UpdateToolsetResponseBody struct {
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -137,12 +179,25 @@ type UpdateToolsetResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools" json:"http_tools" xml:"http_tools"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates" json:"prompt_templates" xml:"prompt_templates"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -172,6 +227,12 @@ type GetToolsetResponseBody struct {
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -182,12 +243,25 @@ type GetToolsetResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools" json:"http_tools" xml:"http_tools"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates" json:"prompt_templates" xml:"prompt_templates"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -217,6 +291,12 @@ type AddExternalOAuthServerResponseBody struct {
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -227,12 +307,25 @@ type AddExternalOAuthServerResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools" json:"http_tools" xml:"http_tools"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates" json:"prompt_templates" xml:"prompt_templates"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -262,6 +355,12 @@ type RemoveOAuthServerResponseBody struct {
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 	// Description of the toolset
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string `form:"instructions,omitempty" json:"instructions,omitempty" xml:"instructions,omitempty"`
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32 `form:"instructions_version,omitempty" json:"instructions_version,omitempty" xml:"instructions_version,omitempty"`
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
 	// The security variables that are relevant to the toolset
@@ -272,12 +371,25 @@ type RemoveOAuthServerResponseBody struct {
 	HTTPTools []*HTTPToolDefinitionResponseBody `form:"http_tools" json:"http_tools" xml:"http_tools"`
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates" json:"prompt_templates" xml:"prompt_templates"`
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRuleResponseBody `form:"client_rules,omitempty" json:"client_rules,omitempty" xml:"client_rules,omitempty"`
 	// The slug of the MCP to use for the toolset
 	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64 `form:"resource_link_threshold,omitempty" json:"resource_link_threshold,omitempty" xml:"resource_link_threshold,omitempty"`
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
 	// The external OAuth server details
@@ -1819,6 +1931,13 @@ type HTTPToolDefinitionResponseBody struct {
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
 	// Response filter metadata for the tool
 	ResponseFilter *ResponseFilterResponseBody `form:"response_filter,omitempty" json:"response_filter,omitempty" xml:"response_filter,omitempty"`
+	// Behavioral hints for the tool, derived from its HTTP method unless overridden
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The maximum size in bytes of a response returned to the model before it is
+	// truncated. Falls back to the toolset's budget when unset.
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// How calls to the tool's upstream API are timed out and retried
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 	// The ID of the OpenAPI v3 document
 	Openapiv3DocumentID *string `form:"openapiv3_document_id,omitempty" json:"openapiv3_document_id,omitempty" xml:"openapiv3_document_id,omitempty"`
 	// OpenAPI v3 operation
@@ -1837,6 +1956,9 @@ type HTTPToolDefinitionResponseBody struct {
 	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
 	// JSON schema for the request
 	Schema string `form:"schema" json:"schema" xml:"schema"`
+	// JSON schema for the structured output of the tool, derived from its
+	// successful response
+	OutputSchema *string `form:"output_schema,omitempty" json:"output_schema,omitempty" xml:"output_schema,omitempty"`
 	// The name of the source package
 	PackageName *string `form:"package_name,omitempty" json:"package_name,omitempty" xml:"package_name,omitempty"`
 	// The creation date of the tool.
@@ -1859,6 +1981,45 @@ type ResponseFilterResponseBody struct {
 	ContentTypes []string `form:"content_types" json:"content_types" xml:"content_types"`
 }
 
+// ToolAnnotationsResponseBody is used to define fields on response body types.
+type ToolAnnotationsResponseBody struct {
+	// A human-readable title for the tool
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// If true, the tool does not modify its environment
+	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
+	// If true, the tool may perform destructive updates
+	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
+	// If true, calling the tool repeatedly with the same arguments has no
+	// additional effect
+	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
+	// If true, the tool interacts with external entities
+	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+}
+
+// ToolRetryPolicyResponseBody is used to define fields on response body types.
+type ToolRetryPolicyResponseBody struct {
+	// The number of attempts made including the first. A value of 1 disables
+	// retries.
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// Response status codes that are retried, such as 429 or 5XX
+	StatusCodes []string `form:"status_codes,omitempty" json:"status_codes,omitempty" xml:"status_codes,omitempty"`
+	// HTTP methods that may be retried
+	Methods []string `form:"methods,omitempty" json:"methods,omitempty" xml:"methods,omitempty"`
+	// Whether requests that fail before a response is received are retried
+	RetryConnectionErrors *bool `form:"retry_connection_errors,omitempty" json:"retry_connection_errors,omitempty" xml:"retry_connection_errors,omitempty"`
+	// The delay before the first retry in milliseconds
+	InitialIntervalMs *int64 `form:"initial_interval_ms,omitempty" json:"initial_interval_ms,omitempty" xml:"initial_interval_ms,omitempty"`
+	// The maximum delay between two attempts in milliseconds
+	MaxIntervalMs *int64 `form:"max_interval_ms,omitempty" json:"max_interval_ms,omitempty" xml:"max_interval_ms,omitempty"`
+	// The factor the delay grows by after each retry
+	Exponent *float64 `form:"exponent,omitempty" json:"exponent,omitempty" xml:"exponent,omitempty"`
+	// The overall deadline for a call across all of its attempts in milliseconds
+	MaxElapsedTimeMs *int64 `form:"max_elapsed_time_ms,omitempty" json:"max_elapsed_time_ms,omitempty" xml:"max_elapsed_time_ms,omitempty"`
+	// The time allowed for a single attempt, including reading the response, in
+	// milliseconds
+	TimeoutMs *int64 `form:"timeout_ms,omitempty" json:"timeout_ms,omitempty" xml:"timeout_ms,omitempty"`
+}
+
 // CanonicalToolAttributesResponseBody is used to define fields on response
 // body types.
 type CanonicalToolAttributesResponseBody struct {
@@ -1878,6 +2039,12 @@ type CanonicalToolAttributesResponseBody struct {
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
 	// The tags list for this http tool
 	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Behavioral hints set for the tool in its source document
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The response budget set for the tool in its source document
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// The retry policy set for the tool in its source document
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 }
 
 // ToolVariationResponseBody is used to define fields on response body types.
@@ -1902,6 +2069,13 @@ type ToolVariationResponseBody struct {
 	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 	// The summarizer of the tool variation
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// The behavioral hints of the tool variation
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The response budget of the tool variation in bytes
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// The retry policy of the tool variation. Fields that are set override the
+	// tool's own policy.
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 	// The creation date of the tool variation
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// The last update date of the tool variation
@@ -1936,6 +2110,47 @@ type PromptTemplateResponseBody struct {
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// ToolsetResourceResponseBody is used to define fields on response body types.
+type ToolsetResourceResponseBody struct {
+	// The ID of the resource
+	ID string `form:"id" json:"id" xml:"id"`
+	// The source of the resource
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The name of the resource, unique within the toolset
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the resource
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The MIME type of the resource contents
+	MimeType *string `form:"mime_type,omitempty" json:"mime_type,omitempty" xml:"mime_type,omitempty"`
+	// The URI of a static resource
+	URI *string `form:"uri,omitempty" json:"uri,omitempty" xml:"uri,omitempty"`
+	// The RFC 6570 URI template of a templated resource
+	URITemplate *string `form:"uri_template,omitempty" json:"uri_template,omitempty" xml:"uri_template,omitempty"`
+	// The ID of the asset backing a static resource
+	AssetID *string `form:"asset_id,omitempty" json:"asset_id,omitempty" xml:"asset_id,omitempty"`
+	// The name of the GET tool backing a templated resource
+	HTTPToolName *string `form:"http_tool_name,omitempty" json:"http_tool_name,omitempty" xml:"http_tool_name,omitempty"`
+	// When the resource was created.
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// When the resource was last updated.
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// ToolsetClientRuleResponseBody is used to define fields on response body
+// types.
+type ToolsetClientRuleResponseBody struct {
+	// The client name to match, as sent in clientInfo.name when the client
+	// initializes. Matching ignores case.
+	ClientName string `form:"client_name" json:"client_name" xml:"client_name"`
+	// allow adds the client to an allow-list so that only listed clients may
+	// connect, block rejects the client and minimum_version rejects versions of
+	// the client older than min_version.
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The oldest semantic version of the client that may connect. Required for
+	// minimum_version rules.
+	MinVersion *string `form:"min_version,omitempty" json:"min_version,omitempty" xml:"min_version,omitempty"`
+}
+
 // ExternalOAuthServerResponseBody is used to define fields on response body
 // types.
 type ExternalOAuthServerResponseBody struct {
@@ -2050,6 +2265,43 @@ type PromptTemplateEntryResponseBody struct {
 	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
 }
 
+// ToolsetResourceFormRequestBody is used to define fields on request body
+// types.
+type ToolsetResourceFormRequestBody struct {
+	// The source of the resource
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The name of the resource, unique within the toolset
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the resource
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The MIME type of the resource contents. Defaults to the content type of the
+	// asset or the response.
+	MimeType *string `form:"mime_type,omitempty" json:"mime_type,omitempty" xml:"mime_type,omitempty"`
+	// The ID of the asset backing a static resource. Required for asset resources.
+	AssetID *string `form:"asset_id,omitempty" json:"asset_id,omitempty" xml:"asset_id,omitempty"`
+	// The name of a GET tool backing a templated resource. Required for
+	// http_template resources.
+	HTTPToolName *string `form:"http_tool_name,omitempty" json:"http_tool_name,omitempty" xml:"http_tool_name,omitempty"`
+	// The RFC 6570 URI template for a templated resource. Template variables must
+	// match the tool's path or query parameters. Defaults to a template covering
+	// every path and query parameter.
+	URITemplate *string `form:"uri_template,omitempty" json:"uri_template,omitempty" xml:"uri_template,omitempty"`
+}
+
+// ToolsetClientRuleRequestBody is used to define fields on request body types.
+type ToolsetClientRuleRequestBody struct {
+	// The client name to match, as sent in clientInfo.name when the client
+	// initializes. Matching ignores case.
+	ClientName *string `form:"client_name,omitempty" json:"client_name,omitempty" xml:"client_name,omitempty"`
+	// allow adds the client to an allow-list so that only listed clients may
+	// connect, block rejects the client and minimum_version rejects versions of
+	// the client older than min_version.
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The oldest semantic version of the client that may connect. Required for
+	// minimum_version rules.
+	MinVersion *string `form:"min_version,omitempty" json:"min_version,omitempty" xml:"min_version,omitempty"`
+}
+
 // ExternalOAuthServerFormRequestBody is used to define fields on request body
 // types.
 type ExternalOAuthServerFormRequestBody struct {
@@ -2063,18 +2315,23 @@ type ExternalOAuthServerFormRequestBody struct {
 // of the "createToolset" endpoint of the "toolsets" service.
 func NewCreateToolsetResponseBody(res *types.Toolset) *CreateToolsetResponseBody {
 	body := &CreateToolsetResponseBody{
-		ID:             res.ID,
-		ProjectID:      res.ProjectID,
-		OrganizationID: res.OrganizationID,
-		AccountType:    res.AccountType,
-		Name:           res.Name,
-		Slug:           string(res.Slug),
-		Description:    res.Description,
-		McpIsPublic:    res.McpIsPublic,
-		McpEnabled:     res.McpEnabled,
-		CustomDomainID: res.CustomDomainID,
-		CreatedAt:      res.CreatedAt,
-		UpdatedAt:      res.UpdatedAt,
+		ID:                    res.ID,
+		ProjectID:             res.ProjectID,
+		OrganizationID:        res.OrganizationID,
+		AccountType:           res.AccountType,
+		Name:                  res.Name,
+		Slug:                  string(res.Slug),
+		Description:           res.Description,
+		Instructions:          res.Instructions,
+		InstructionsVersion:   res.InstructionsVersion,
+		McpIsPublic:           res.McpIsPublic,
+		McpEnabled:            res.McpEnabled,
+		ToolSelectionMode:     res.ToolSelectionMode,
+		ResourceLinkThreshold: res.ResourceLinkThreshold,
+		ResponseBudget:        res.ResponseBudget,
+		CustomDomainID:        res.CustomDomainID,
+		CreatedAt:             res.CreatedAt,
+		UpdatedAt:             res.UpdatedAt,
 	}
 	if res.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := string(*res.DefaultEnvironmentSlug)
@@ -2112,6 +2369,18 @@ func NewCreateToolsetResponseBody(res *types.Toolset) *CreateToolsetResponseBody
 	} else {
 		body.PromptTemplates = []*PromptTemplateResponseBody{}
 	}
+	if res.Resources != nil {
+		body.Resources = make([]*ToolsetResourceResponseBody, len(res.Resources))
+		for i, val := range res.Resources {
+			body.Resources[i] = marshalTypesToolsetResourceToToolsetResourceResponseBody(val)
+		}
+	}
+	if res.ClientRules != nil {
+		body.ClientRules = make([]*ToolsetClientRuleResponseBody, len(res.ClientRules))
+		for i, val := range res.ClientRules {
+			body.ClientRules[i] = marshalTypesToolsetClientRuleToToolsetClientRuleResponseBody(val)
+		}
+	}
 	if res.ExternalOauthServer != nil {
 		body.ExternalOauthServer = marshalTypesExternalOAuthServerToExternalOAuthServerResponseBody(res.ExternalOauthServer)
 	}
@@ -2140,18 +2409,23 @@ func NewListToolsetsResponseBody(res *toolsets.ListToolsetsResult) *ListToolsets
 // of the "updateToolset" endpoint of the "toolsets" service.
 func NewUpdateToolsetResponseBody(res *types.Toolset) *UpdateToolsetResponseBody {
 	body := &UpdateToolsetResponseBody{
-		ID:             res.ID,
-		ProjectID:      res.ProjectID,
-		OrganizationID: res.OrganizationID,
-		AccountType:    res.AccountType,
-		Name:           res.Name,
-		Slug:           string(res.Slug),
-		Description:    res.Description,
-		McpIsPublic:    res.McpIsPublic,
-		McpEnabled:     res.McpEnabled,
-		CustomDomainID: res.CustomDomainID,
-		CreatedAt:      res.CreatedAt,
-		UpdatedAt:      res.UpdatedAt,
+		ID:                    res.ID,
+		ProjectID:             res.ProjectID,
+		OrganizationID:        res.OrganizationID,
+		AccountType:           res.AccountType,
+		Name:                  res.Name,
+		Slug:                  string(res.Slug),
+		Description:           res.Description,
+		Instructions:          res.Instructions,
+		InstructionsVersion:   res.InstructionsVersion,
+		McpIsPublic:           res.McpIsPublic,
+		McpEnabled:            res.McpEnabled,
+		ToolSelectionMode:     res.ToolSelectionMode,
+		ResourceLinkThreshold: res.ResourceLinkThreshold,
+		ResponseBudget:        res.ResponseBudget,
+		CustomDomainID:        res.CustomDomainID,
+		CreatedAt:             res.CreatedAt,
+		UpdatedAt:             res.UpdatedAt,
 	}
 	if res.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := string(*res.DefaultEnvironmentSlug)
@@ -2189,6 +2463,18 @@ func NewUpdateToolsetResponseBody(res *types.Toolset) *UpdateToolsetResponseBody
 	} else {
 		body.PromptTemplates = []*PromptTemplateResponseBody{}
 	}
+	if res.Resources != nil {
+		body.Resources = make([]*ToolsetResourceResponseBody, len(res.Resources))
+		for i, val := range res.Resources {
+			body.Resources[i] = marshalTypesToolsetResourceToToolsetResourceResponseBody(val)
+		}
+	}
+	if res.ClientRules != nil {
+		body.ClientRules = make([]*ToolsetClientRuleResponseBody, len(res.ClientRules))
+		for i, val := range res.ClientRules {
+			body.ClientRules[i] = marshalTypesToolsetClientRuleToToolsetClientRuleResponseBody(val)
+		}
+	}
 	if res.ExternalOauthServer != nil {
 		body.ExternalOauthServer = marshalTypesExternalOAuthServerToExternalOAuthServerResponseBody(res.ExternalOauthServer)
 	}
@@ -2202,18 +2488,23 @@ func NewUpdateToolsetResponseBody(res *types.Toolset) *UpdateToolsetResponseBody
 // the "getToolset" endpoint of the "toolsets" service.
 func NewGetToolsetResponseBody(res *types.Toolset) *GetToolsetResponseBody {
 	body := &GetToolsetResponseBody{
-		ID:             res.ID,
-		ProjectID:      res.ProjectID,
-		OrganizationID: res.OrganizationID,
-		AccountType:    res.AccountType,
-		Name:           res.Name,
-		Slug:           string(res.Slug),
-		Description:    res.Description,
-		McpIsPublic:    res.McpIsPublic,
-		McpEnabled:     res.McpEnabled,
-		CustomDomainID: res.CustomDomainID,
-		CreatedAt:      res.CreatedAt,
-		UpdatedAt:      res.UpdatedAt,
+		ID:                    res.ID,
+		ProjectID:             res.ProjectID,
+		OrganizationID:        res.OrganizationID,
+		AccountType:           res.AccountType,
+		Name:                  res.Name,
+		Slug:                  string(res.Slug),
+		Description:           res.Description,
+		Instructions:          res.Instructions,
+		InstructionsVersion:   res.InstructionsVersion,
+		McpIsPublic:           res.McpIsPublic,
+		McpEnabled:            res.McpEnabled,
+		ToolSelectionMode:     res.ToolSelectionMode,
+		ResourceLinkThreshold: res.ResourceLinkThreshold,
+		ResponseBudget:        res.ResponseBudget,
+		CustomDomainID:        res.CustomDomainID,
+		CreatedAt:             res.CreatedAt,
+		UpdatedAt:             res.UpdatedAt,
 	}
 	if res.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := string(*res.DefaultEnvironmentSlug)
@@ -2251,6 +2542,18 @@ func NewGetToolsetResponseBody(res *types.Toolset) *GetToolsetResponseBody {
 	} else {
 		body.PromptTemplates = []*PromptTemplateResponseBody{}
 	}
+	if res.Resources != nil {
+		body.Resources = make([]*ToolsetResourceResponseBody, len(res.Resources))
+		for i, val := range res.Resources {
+			body.Resources[i] = marshalTypesToolsetResourceToToolsetResourceResponseBody(val)
+		}
+	}
+	if res.ClientRules != nil {
+		body.ClientRules = make([]*ToolsetClientRuleResponseBody, len(res.ClientRules))
+		for i, val := range res.ClientRules {
+			body.ClientRules[i] = marshalTypesToolsetClientRuleToToolsetClientRuleResponseBody(val)
+		}
+	}
 	if res.ExternalOauthServer != nil {
 		body.ExternalOauthServer = marshalTypesExternalOAuthServerToExternalOAuthServerResponseBody(res.ExternalOauthServer)
 	}
@@ -2264,18 +2567,23 @@ func NewGetToolsetResponseBody(res *types.Toolset) *GetToolsetResponseBody {
 // result of the "addExternalOAuthServer" endpoint of the "toolsets" service.
 func NewAddExternalOAuthServerResponseBody(res *types.Toolset) *AddExternalOAuthServerResponseBody {
 	body := &AddExternalOAuthServerResponseBody{
-		ID:             res.ID,
-		ProjectID:      res.ProjectID,
-		OrganizationID: res.OrganizationID,
-		AccountType:    res.AccountType,
-		Name:           res.Name,
-		Slug:           string(res.Slug),
-		Description:    res.Description,
-		McpIsPublic:    res.McpIsPublic,
-		McpEnabled:     res.McpEnabled,
-		CustomDomainID: res.CustomDomainID,
-		CreatedAt:      res.CreatedAt,
-		UpdatedAt:      res.UpdatedAt,
+		ID:                    res.ID,
+		ProjectID:             res.ProjectID,
+		OrganizationID:        res.OrganizationID,
+		AccountType:           res.AccountType,
+		Name:                  res.Name,
+		Slug:                  string(res.Slug),
+		Description:           res.Description,
+		Instructions:          res.Instructions,
+		InstructionsVersion:   res.InstructionsVersion,
+		McpIsPublic:           res.McpIsPublic,
+		McpEnabled:            res.McpEnabled,
+		ToolSelectionMode:     res.ToolSelectionMode,
+		ResourceLinkThreshold: res.ResourceLinkThreshold,
+		ResponseBudget:        res.ResponseBudget,
+		CustomDomainID:        res.CustomDomainID,
+		CreatedAt:             res.CreatedAt,
+		UpdatedAt:             res.UpdatedAt,
 	}
 	if res.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := string(*res.DefaultEnvironmentSlug)
@@ -2313,6 +2621,18 @@ func NewAddExternalOAuthServerResponseBody(res *types.Toolset) *AddExternalOAuth
 	} else {
 		body.PromptTemplates = []*PromptTemplateResponseBody{}
 	}
+	if res.Resources != nil {
+		body.Resources = make([]*ToolsetResourceResponseBody, len(res.Resources))
+		for i, val := range res.Resources {
+			body.Resources[i] = marshalTypesToolsetResourceToToolsetResourceResponseBody(val)
+		}
+	}
+	if res.ClientRules != nil {
+		body.ClientRules = make([]*ToolsetClientRuleResponseBody, len(res.ClientRules))
+		for i, val := range res.ClientRules {
+			body.ClientRules[i] = marshalTypesToolsetClientRuleToToolsetClientRuleResponseBody(val)
+		}
+	}
 	if res.ExternalOauthServer != nil {
 		body.ExternalOauthServer = marshalTypesExternalOAuthServerToExternalOAuthServerResponseBody(res.ExternalOauthServer)
 	}
@@ -2326,18 +2646,23 @@ func NewAddExternalOAuthServerResponseBody(res *types.Toolset) *AddExternalOAuth
 // result of the "removeOAuthServer" endpoint of the "toolsets" service.
 func NewRemoveOAuthServerResponseBody(res *types.Toolset) *RemoveOAuthServerResponseBody {
 	body := &RemoveOAuthServerResponseBody{
-		ID:             res.ID,
-		ProjectID:      res.ProjectID,
-		OrganizationID: res.OrganizationID,
-		AccountType:    res.AccountType,
-		Name:           res.Name,
-		Slug:           string(res.Slug),
-		Description:    res.Description,
-		McpIsPublic:    res.McpIsPublic,
-		McpEnabled:     res.McpEnabled,
-		CustomDomainID: res.CustomDomainID,
-		CreatedAt:      res.CreatedAt,
-		UpdatedAt:      res.UpdatedAt,
+		ID:                    res.ID,
+		ProjectID:             res.ProjectID,
+		OrganizationID:        res.OrganizationID,
+		AccountType:           res.AccountType,
+		Name:                  res.Name,
+		Slug:                  string(res.Slug),
+		Description:           res.Description,
+		Instructions:          res.Instructions,
+		InstructionsVersion:   res.InstructionsVersion,
+		McpIsPublic:           res.McpIsPublic,
+		McpEnabled:            res.McpEnabled,
+		ToolSelectionMode:     res.ToolSelectionMode,
+		ResourceLinkThreshold: res.ResourceLinkThreshold,
+		ResponseBudget:        res.ResponseBudget,
+		CustomDomainID:        res.CustomDomainID,
+		CreatedAt:             res.CreatedAt,
+		UpdatedAt:             res.UpdatedAt,
 	}
 	if res.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := string(*res.DefaultEnvironmentSlug)
@@ -2375,6 +2700,18 @@ func NewRemoveOAuthServerResponseBody(res *types.Toolset) *RemoveOAuthServerResp
 	} else {
 		body.PromptTemplates = []*PromptTemplateResponseBody{}
 	}
+	if res.Resources != nil {
+		body.Resources = make([]*ToolsetResourceResponseBody, len(res.Resources))
+		for i, val := range res.Resources {
+			body.Resources[i] = marshalTypesToolsetResourceToToolsetResourceResponseBody(val)
+		}
+	}
+	if res.ClientRules != nil {
+		body.ClientRules = make([]*ToolsetClientRuleResponseBody, len(res.ClientRules))
+		for i, val := range res.ClientRules {
+			body.ClientRules[i] = marshalTypesToolsetClientRuleToToolsetClientRuleResponseBody(val)
+		}
+	}
 	if res.ExternalOauthServer != nil {
 		body.ExternalOauthServer = marshalTypesExternalOAuthServerToExternalOAuthServerResponseBody(res.ExternalOauthServer)
 	}
@@ -3567,11 +3904,15 @@ func NewListToolsetsPayload(sessionToken *string, projectSlugInput *string) *too
 // payload.
 func NewUpdateToolsetPayload(body *UpdateToolsetRequestBody, slug string, sessionToken *string, projectSlugInput *string) *toolsets.UpdateToolsetPayload {
 	v := &toolsets.UpdateToolsetPayload{
-		Name:           body.Name,
-		Description:    body.Description,
-		McpEnabled:     body.McpEnabled,
-		McpIsPublic:    body.McpIsPublic,
-		CustomDomainID: body.CustomDomainID,
+		Name:                  body.Name,
+		Description:           body.Description,
+		Instructions:          body.Instructions,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     body.ToolSelectionMode,
+		ResourceLinkThreshold: body.ResourceLinkThreshold,
+		ResponseBudget:        body.ResponseBudget,
+		McpIsPublic:           body.McpIsPublic,
+		CustomDomainID:        body.CustomDomainID,
 	}
 	if body.DefaultEnvironmentSlug != nil {
 		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
@@ -3593,6 +3934,18 @@ func NewUpdateToolsetPayload(body *UpdateToolsetRequestBody, slug string, sessio
 			v.PromptTemplateNames[i] = val
 		}
 	}
+	if body.Resources != nil {
+		v.Resources = make([]*types.ToolsetResourceForm, len(body.Resources))
+		for i, val := range body.Resources {
+			v.Resources[i] = unmarshalToolsetResourceFormRequestBodyToTypesToolsetResourceForm(val)
+		}
+	}
+	if body.ClientRules != nil {
+		v.ClientRules = make([]*types.ToolsetClientRule, len(body.ClientRules))
+		for i, val := range body.ClientRules {
+			v.ClientRules[i] = unmarshalToolsetClientRuleRequestBodyToTypesToolsetClientRule(val)
+		}
+	}
 	v.Slug = types.Slug(slug)
 	v.SessionToken = sessionToken
 	v.ProjectSlugInput = projectSlugInput
@@ -3675,6 +4028,11 @@ func ValidateCreateToolsetRequestBody(body *CreateToolsetRequestBody) (err error
 // ValidateUpdateToolsetRequestBody runs the validations defined on
 // UpdateToolsetRequestBody
 func ValidateUpdateToolsetRequestBody(body *UpdateToolsetRequestBody) (err error) {
+	if body.Instructions != nil {
+		if utf8.RuneCountInString(*body.Instructions) > 20000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.instructions", *body.Instructions, utf8.RuneCountInString(*body.Instructions), 20000, false))
+		}
+	}
 	if body.DefaultEnvironmentSlug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 	}
@@ -3683,6 +4041,35 @@ func ValidateUpdateToolsetRequestBody(body *UpdateToolsetRequestBody) (err error
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
 		}
 	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateToolsetResourceFormRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ClientRules {
+		if e != nil {
+			if err2 := ValidateToolsetClientRuleRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.ToolSelectionMode != nil {
+		if !(*body.ToolSelectionMode == "static" || *body.ToolSelectionMode == "dynamic") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tool_selection_mode", *body.ToolSelectionMode, []any{"static", "dynamic"}))
+		}
+	}
+	if body.ResourceLinkThreshold != nil {
+		if *body.ResourceLinkThreshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.resource_link_threshold", *body.ResourceLinkThreshold, 0, true))
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
 	if body.McpSlug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
 	}
@@ -3708,6 +4095,68 @@ func ValidateAddExternalOAuthServerRequestBody(body *AddExternalOAuthServerReque
 	return
 }
 
+// ValidateToolsetResourceFormRequestBody runs the validations defined on
+// ToolsetResourceFormRequestBody
+func ValidateToolsetResourceFormRequestBody(body *ToolsetResourceFormRequestBody) (err error) {
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "asset" || *body.Kind == "http_template") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"asset", "http_template"}))
+		}
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "^[a-z0-9]+(?:[a-z0-9_-]*[a-z0-9])?$"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 40, false))
+		}
+	}
+	if body.Description != nil {
+		if utf8.RuneCountInString(*body.Description) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateToolsetClientRuleRequestBody runs the validations defined on
+// ToolsetClientRuleRequestBody
+func ValidateToolsetClientRuleRequestBody(body *ToolsetClientRuleRequestBody) (err error) {
+	if body.ClientName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("client_name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.ClientName != nil {
+		if utf8.RuneCountInString(*body.ClientName) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.client_name", *body.ClientName, utf8.RuneCountInString(*body.ClientName), 1, true))
+		}
+	}
+	if body.ClientName != nil {
+		if utf8.RuneCountInString(*body.ClientName) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.client_name", *body.ClientName, utf8.RuneCountInString(*body.ClientName), 100, false))
+		}
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "allow" || *body.Kind == "block" || *body.Kind == "minimum_version") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"allow", "block", "minimum_version"}))
+		}
+	}
+	if body.MinVersion != nil {
+		if utf8.RuneCountInString(*body.MinVersion) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.min_version", *body.MinVersion, utf8.RuneCountInString(*body.MinVersion), 50, false))
+		}
+	}
+	return
+}
+
 // ValidateExternalOAuthServerFormRequestBody runs the validations defined on
 // ExternalOAuthServerFormRequestBody
 func ValidateExternalOAuthServerFormRequestBody(body *ExternalOAuthServerFormRequestBody) (err error) {
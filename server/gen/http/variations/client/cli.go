@@ -23,13 +23,28 @@ func BuildUpsertGlobalPayload(variationsUpsertGlobalBody string, variationsUpser
 	{
 		err = json.Unmarshal([]byte(variationsUpsertGlobalBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"confirm\": \"never\",\n      \"confirm_prompt\": \"Consectetur dolorem.\",\n      \"description\": \"Aperiam dolores.\",\n      \"name\": \"Excepturi voluptatem quis unde.\",\n      \"src_tool_name\": \"Tempore at.\",\n      \"summarizer\": \"Sequi minus molestiae ad reprehenderit sint.\",\n      \"summary\": \"Sint error ea saepe.\",\n      \"tags\": [\n         \"Consequatur quis reprehenderit commodi.\",\n         \"Sunt quasi ut fugiat aperiam qui maxime.\"\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"annotations\": {\n         \"destructive_hint\": true,\n         \"idempotent_hint\": false,\n         \"open_world_hint\": false,\n         \"read_only_hint\": false,\n         \"title\": \"14f\"\n      },\n      \"confirm\": \"session\",\n      \"confirm_prompt\": \"Natus dolores architecto a ea.\",\n      \"description\": \"Porro odit quas.\",\n      \"name\": \"Doloremque nobis pariatur quaerat nulla vel qui.\",\n      \"response_budget\": 1289182962915140837,\n      \"retry_policy\": {\n         \"exponent\": 2.2588540327830695,\n         \"initial_interval_ms\": 2296614786485474403,\n         \"max_attempts\": 3,\n         \"max_elapsed_time_ms\": 6563894258464437115,\n         \"max_interval_ms\": 2870405887066940252,\n         \"methods\": [\n            \"GET\",\n            \"HEAD\",\n            \"PUT\"\n         ],\n         \"retry_connection_errors\": false,\n         \"status_codes\": [\n            \"443\",\n            \"509\"\n         ],\n         \"timeout_ms\": 1905839684974468112\n      },\n      \"src_tool_name\": \"Soluta nulla illum.\",\n      \"summarizer\": \"At dolorum dolorem beatae.\",\n      \"summary\": \"Sequi in sit atque.\",\n      \"tags\": [\n         \"A qui possimus.\",\n         \"Sed distinctio nam aliquam alias ullam dolore.\",\n         \"Assumenda sint eaque qui minus corrupti.\",\n         \"Blanditiis eveniet.\"\n      ]\n   }'")
 		}
 		if body.Confirm != nil {
 			if !(*body.Confirm == "always" || *body.Confirm == "never" || *body.Confirm == "session") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.confirm", *body.Confirm, []any{"always", "never", "session"}))
 			}
 		}
+		if body.Annotations != nil {
+			if err2 := ValidateToolAnnotationsRequestBody(body.Annotations); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.ResponseBudget != nil {
+			if *body.ResponseBudget < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+			}
+		}
+		if body.RetryPolicy != nil {
+			if err2 := ValidateToolRetryPolicyRequestBody(body.RetryPolicy); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -53,13 +68,14 @@ func BuildUpsertGlobalPayload(variationsUpsertGlobalBody string, variationsUpser
 		}
 	}
 	v := &variations.UpsertGlobalPayload{
-		SrcToolName:   body.SrcToolName,
-		Confirm:       body.Confirm,
-		ConfirmPrompt: body.ConfirmPrompt,
-		Name:          body.Name,
-		Summary:       body.Summary,
-		Description:   body.Description,
-		Summarizer:    body.Summarizer,
+		SrcToolName:    body.SrcToolName,
+		Confirm:        body.Confirm,
+		ConfirmPrompt:  body.ConfirmPrompt,
+		Name:           body.Name,
+		Summary:        body.Summary,
+		Description:    body.Description,
+		Summarizer:     body.Summarizer,
+		ResponseBudget: body.ResponseBudget,
 	}
 	if body.Tags != nil {
 		v.Tags = make([]string, len(body.Tags))
@@ -67,6 +83,12 @@ func BuildUpsertGlobalPayload(variationsUpsertGlobalBody string, variationsUpser
 			v.Tags[i] = val
 		}
 	}
+	if body.Annotations != nil {
+		v.Annotations = marshalToolAnnotationsRequestBodyToTypesToolAnnotations(body.Annotations)
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = marshalToolRetryPolicyRequestBodyToTypesToolRetryPolicy(body.RetryPolicy)
+	}
 	v.SessionToken = sessionToken
 	v.ApikeyToken = apikeyToken
 	v.ProjectSlugInput = projectSlugInput
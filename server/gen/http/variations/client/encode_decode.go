@@ -740,21 +740,118 @@ func DecodeListGlobalResponse(decoder func(*http.Response) goahttp.Decoder, rest
 	}
 }
 
+// marshalTypesToolAnnotationsToToolAnnotationsRequestBody builds a value of
+// type *ToolAnnotationsRequestBody from a value of type *types.ToolAnnotations.
+func marshalTypesToolAnnotationsToToolAnnotationsRequestBody(v *types.ToolAnnotations) *ToolAnnotationsRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolAnnotationsRequestBody{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// marshalTypesToolRetryPolicyToToolRetryPolicyRequestBody builds a value of
+// type *ToolRetryPolicyRequestBody from a value of type *types.ToolRetryPolicy.
+func marshalTypesToolRetryPolicyToToolRetryPolicyRequestBody(v *types.ToolRetryPolicy) *ToolRetryPolicyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolRetryPolicyRequestBody{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalToolAnnotationsRequestBodyToTypesToolAnnotations builds a value of
+// type *types.ToolAnnotations from a value of type *ToolAnnotationsRequestBody.
+func marshalToolAnnotationsRequestBodyToTypesToolAnnotations(v *ToolAnnotationsRequestBody) *types.ToolAnnotations {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolAnnotations{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// marshalToolRetryPolicyRequestBodyToTypesToolRetryPolicy builds a value of
+// type *types.ToolRetryPolicy from a value of type *ToolRetryPolicyRequestBody.
+func marshalToolRetryPolicyRequestBodyToTypesToolRetryPolicy(v *ToolRetryPolicyRequestBody) *types.ToolRetryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolRetryPolicy{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
+
+	return res
+}
+
 // unmarshalToolVariationResponseBodyToTypesToolVariation builds a value of
 // type *types.ToolVariation from a value of type *ToolVariationResponseBody.
 func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResponseBody) *types.ToolVariation {
 	res := &types.ToolVariation{
-		ID:            *v.ID,
-		GroupID:       *v.GroupID,
-		SrcToolName:   *v.SrcToolName,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Name:          v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Summarizer:    v.Summarizer,
-		CreatedAt:     *v.CreatedAt,
-		UpdatedAt:     *v.UpdatedAt,
+		ID:             *v.ID,
+		GroupID:        *v.GroupID,
+		SrcToolName:    *v.SrcToolName,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Name:           v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
+		CreatedAt:      *v.CreatedAt,
+		UpdatedAt:      *v.UpdatedAt,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -762,6 +859,62 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v.RetryPolicy)
+	}
+
+	return res
+}
+
+// unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations builds a value of
+// type *types.ToolAnnotations from a value of type
+// *ToolAnnotationsResponseBody.
+func unmarshalToolAnnotationsResponseBodyToTypesToolAnnotations(v *ToolAnnotationsResponseBody) *types.ToolAnnotations {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolAnnotations{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy builds a value of
+// type *types.ToolRetryPolicy from a value of type
+// *ToolRetryPolicyResponseBody.
+func unmarshalToolRetryPolicyResponseBodyToTypesToolRetryPolicy(v *ToolRetryPolicyResponseBody) *types.ToolRetryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolRetryPolicy{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
 
 	return res
 }
@@ -697,21 +697,70 @@ func EncodeListGlobalError(encoder func(context.Context, http.ResponseWriter) go
 	}
 }
 
+// unmarshalToolAnnotationsRequestBodyToTypesToolAnnotations builds a value of
+// type *types.ToolAnnotations from a value of type *ToolAnnotationsRequestBody.
+func unmarshalToolAnnotationsRequestBodyToTypesToolAnnotations(v *ToolAnnotationsRequestBody) *types.ToolAnnotations {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolAnnotations{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// unmarshalToolRetryPolicyRequestBodyToTypesToolRetryPolicy builds a value of
+// type *types.ToolRetryPolicy from a value of type *ToolRetryPolicyRequestBody.
+func unmarshalToolRetryPolicyRequestBodyToTypesToolRetryPolicy(v *ToolRetryPolicyRequestBody) *types.ToolRetryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolRetryPolicy{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
+
+	return res
+}
+
 // marshalTypesToolVariationToToolVariationResponseBody builds a value of type
 // *ToolVariationResponseBody from a value of type *types.ToolVariation.
 func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation) *ToolVariationResponseBody {
 	res := &ToolVariationResponseBody{
-		ID:            v.ID,
-		GroupID:       v.GroupID,
-		SrcToolName:   v.SrcToolName,
-		Confirm:       v.Confirm,
-		ConfirmPrompt: v.ConfirmPrompt,
-		Name:          v.Name,
-		Summary:       v.Summary,
-		Description:   v.Description,
-		Summarizer:    v.Summarizer,
-		CreatedAt:     v.CreatedAt,
-		UpdatedAt:     v.UpdatedAt,
+		ID:             v.ID,
+		GroupID:        v.GroupID,
+		SrcToolName:    v.SrcToolName,
+		Confirm:        v.Confirm,
+		ConfirmPrompt:  v.ConfirmPrompt,
+		Name:           v.Name,
+		Summary:        v.Summary,
+		Description:    v.Description,
+		Summarizer:     v.Summarizer,
+		ResponseBudget: v.ResponseBudget,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
 	}
 	if v.Tags != nil {
 		res.Tags = make([]string, len(v.Tags))
@@ -719,6 +768,62 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 			res.Tags[i] = val
 		}
 	}
+	if v.Annotations != nil {
+		res.Annotations = marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v.Annotations)
+	}
+	if v.RetryPolicy != nil {
+		res.RetryPolicy = marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v.RetryPolicy)
+	}
+
+	return res
+}
+
+// marshalTypesToolAnnotationsToToolAnnotationsResponseBody builds a value of
+// type *ToolAnnotationsResponseBody from a value of type
+// *types.ToolAnnotations.
+func marshalTypesToolAnnotationsToToolAnnotationsResponseBody(v *types.ToolAnnotations) *ToolAnnotationsResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolAnnotationsResponseBody{
+		Title:           v.Title,
+		ReadOnlyHint:    v.ReadOnlyHint,
+		DestructiveHint: v.DestructiveHint,
+		IdempotentHint:  v.IdempotentHint,
+		OpenWorldHint:   v.OpenWorldHint,
+	}
+
+	return res
+}
+
+// marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody builds a value of
+// type *ToolRetryPolicyResponseBody from a value of type
+// *types.ToolRetryPolicy.
+func marshalTypesToolRetryPolicyToToolRetryPolicyResponseBody(v *types.ToolRetryPolicy) *ToolRetryPolicyResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolRetryPolicyResponseBody{
+		MaxAttempts:           v.MaxAttempts,
+		RetryConnectionErrors: v.RetryConnectionErrors,
+		InitialIntervalMs:     v.InitialIntervalMs,
+		MaxIntervalMs:         v.MaxIntervalMs,
+		Exponent:              v.Exponent,
+		MaxElapsedTimeMs:      v.MaxElapsedTimeMs,
+		TimeoutMs:             v.TimeoutMs,
+	}
+	if v.StatusCodes != nil {
+		res.StatusCodes = make([]string, len(v.StatusCodes))
+		for i, val := range v.StatusCodes {
+			res.StatusCodes[i] = val
+		}
+	}
+	if v.Methods != nil {
+		res.Methods = make([]string, len(v.Methods))
+		for i, val := range v.Methods {
+			res.Methods[i] = val
+		}
+	}
 
 	return res
 }
@@ -8,6 +8,8 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	variations "github.com/speakeasy-api/gram/server/gen/variations"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -31,6 +33,13 @@ type UpsertGlobalRequestBody struct {
 	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 	// The summarizer of the tool variation
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// The behavioral hints of the tool variation
+	Annotations *ToolAnnotationsRequestBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The response budget of the tool variation in bytes
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// The retry policy of the tool variation. Fields that are set override the
+	// tool's own policy.
+	RetryPolicy *ToolRetryPolicyRequestBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 }
 
 // UpsertGlobalResponseBody is the type of the "variations" service
@@ -620,12 +629,97 @@ type ToolVariationResponseBody struct {
 	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 	// The summarizer of the tool variation
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// The behavioral hints of the tool variation
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The response budget of the tool variation in bytes
+	ResponseBudget *int64 `form:"response_budget,omitempty" json:"response_budget,omitempty" xml:"response_budget,omitempty"`
+	// The retry policy of the tool variation. Fields that are set override the
+	// tool's own policy.
+	RetryPolicy *ToolRetryPolicyResponseBody `form:"retry_policy,omitempty" json:"retry_policy,omitempty" xml:"retry_policy,omitempty"`
 	// The creation date of the tool variation
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// The last update date of the tool variation
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// ToolAnnotationsResponseBody is used to define fields on response body types.
+type ToolAnnotationsResponseBody struct {
+	// A human-readable title for the tool
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// If true, the tool does not modify its environment
+	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
+	// If true, the tool may perform destructive updates
+	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
+	// If true, calling the tool repeatedly with the same arguments has no
+	// additional effect
+	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
+	// If true, the tool interacts with external entities
+	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+}
+
+// ToolRetryPolicyResponseBody is used to define fields on response body types.
+type ToolRetryPolicyResponseBody struct {
+	// The number of attempts made including the first. A value of 1 disables
+	// retries.
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// Response status codes that are retried, such as 429 or 5XX
+	StatusCodes []string `form:"status_codes,omitempty" json:"status_codes,omitempty" xml:"status_codes,omitempty"`
+	// HTTP methods that may be retried
+	Methods []string `form:"methods,omitempty" json:"methods,omitempty" xml:"methods,omitempty"`
+	// Whether requests that fail before a response is received are retried
+	RetryConnectionErrors *bool `form:"retry_connection_errors,omitempty" json:"retry_connection_errors,omitempty" xml:"retry_connection_errors,omitempty"`
+	// The delay before the first retry in milliseconds
+	InitialIntervalMs *int64 `form:"initial_interval_ms,omitempty" json:"initial_interval_ms,omitempty" xml:"initial_interval_ms,omitempty"`
+	// The maximum delay between two attempts in milliseconds
+	MaxIntervalMs *int64 `form:"max_interval_ms,omitempty" json:"max_interval_ms,omitempty" xml:"max_interval_ms,omitempty"`
+	// The factor the delay grows by after each retry
+	Exponent *float64 `form:"exponent,omitempty" json:"exponent,omitempty" xml:"exponent,omitempty"`
+	// The overall deadline for a call across all of its attempts in milliseconds
+	MaxElapsedTimeMs *int64 `form:"max_elapsed_time_ms,omitempty" json:"max_elapsed_time_ms,omitempty" xml:"max_elapsed_time_ms,omitempty"`
+	// The time allowed for a single attempt, including reading the response, in
+	// milliseconds
+	TimeoutMs *int64 `form:"timeout_ms,omitempty" json:"timeout_ms,omitempty" xml:"timeout_ms,omitempty"`
+}
+
+// ToolAnnotationsRequestBody is used to define fields on request body types.
+type ToolAnnotationsRequestBody struct {
+	// A human-readable title for the tool
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// If true, the tool does not modify its environment
+	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
+	// If true, the tool may perform destructive updates
+	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
+	// If true, calling the tool repeatedly with the same arguments has no
+	// additional effect
+	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
+	// If true, the tool interacts with external entities
+	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+}
+
+// ToolRetryPolicyRequestBody is used to define fields on request body types.
+type ToolRetryPolicyRequestBody struct {
+	// The number of attempts made including the first. A value of 1 disables
+	// retries.
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// Response status codes that are retried, such as 429 or 5XX
+	StatusCodes []string `form:"status_codes,omitempty" json:"status_codes,omitempty" xml:"status_codes,omitempty"`
+	// HTTP methods that may be retried
+	Methods []string `form:"methods,omitempty" json:"methods,omitempty" xml:"methods,omitempty"`
+	// Whether requests that fail before a response is received are retried
+	RetryConnectionErrors *bool `form:"retry_connection_errors,omitempty" json:"retry_connection_errors,omitempty" xml:"retry_connection_errors,omitempty"`
+	// The delay before the first retry in milliseconds
+	InitialIntervalMs *int64 `form:"initial_interval_ms,omitempty" json:"initial_interval_ms,omitempty" xml:"initial_interval_ms,omitempty"`
+	// The maximum delay between two attempts in milliseconds
+	MaxIntervalMs *int64 `form:"max_interval_ms,omitempty" json:"max_interval_ms,omitempty" xml:"max_interval_ms,omitempty"`
+	// The factor the delay grows by after each retry
+	Exponent *float64 `form:"exponent,omitempty" json:"exponent,omitempty" xml:"exponent,omitempty"`
+	// The overall deadline for a call across all of its attempts in milliseconds
+	MaxElapsedTimeMs *int64 `form:"max_elapsed_time_ms,omitempty" json:"max_elapsed_time_ms,omitempty" xml:"max_elapsed_time_ms,omitempty"`
+	// The time allowed for a single attempt, including reading the response, in
+	// milliseconds
+	TimeoutMs *int64 `form:"timeout_ms,omitempty" json:"timeout_ms,omitempty" xml:"timeout_ms,omitempty"`
+}
+
 // NewUpsertGlobalResponseBody builds the HTTP response body from the result of
 // the "upsertGlobal" endpoint of the "variations" service.
 func NewUpsertGlobalResponseBody(res *variations.UpsertGlobalToolVariationResult) *UpsertGlobalResponseBody {
@@ -1084,13 +1178,14 @@ func NewListGlobalGatewayErrorResponseBody(res *goa.ServiceError) *ListGlobalGat
 // payload.
 func NewUpsertGlobalPayload(body *UpsertGlobalRequestBody, sessionToken *string, apikeyToken *string, projectSlugInput *string) *variations.UpsertGlobalPayload {
 	v := &variations.UpsertGlobalPayload{
-		SrcToolName:   *body.SrcToolName,
-		Confirm:       body.Confirm,
-		ConfirmPrompt: body.ConfirmPrompt,
-		Name:          body.Name,
-		Summary:       body.Summary,
-		Description:   body.Description,
-		Summarizer:    body.Summarizer,
+		SrcToolName:    *body.SrcToolName,
+		Confirm:        body.Confirm,
+		ConfirmPrompt:  body.ConfirmPrompt,
+		Name:           body.Name,
+		Summary:        body.Summary,
+		Description:    body.Description,
+		Summarizer:     body.Summarizer,
+		ResponseBudget: body.ResponseBudget,
 	}
 	if body.Tags != nil {
 		v.Tags = make([]string, len(body.Tags))
@@ -1098,6 +1193,12 @@ func NewUpsertGlobalPayload(body *UpsertGlobalRequestBody, sessionToken *string,
 			v.Tags[i] = val
 		}
 	}
+	if body.Annotations != nil {
+		v.Annotations = unmarshalToolAnnotationsRequestBodyToTypesToolAnnotations(body.Annotations)
+	}
+	if body.RetryPolicy != nil {
+		v.RetryPolicy = unmarshalToolRetryPolicyRequestBodyToTypesToolRetryPolicy(body.RetryPolicy)
+	}
 	v.SessionToken = sessionToken
 	v.ApikeyToken = apikeyToken
 	v.ProjectSlugInput = projectSlugInput
@@ -1138,5 +1239,85 @@ func ValidateUpsertGlobalRequestBody(body *UpsertGlobalRequestBody) (err error)
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.confirm", *body.Confirm, []any{"always", "never", "session"}))
 		}
 	}
+	if body.Annotations != nil {
+		if err2 := ValidateToolAnnotationsRequestBody(body.Annotations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ResponseBudget != nil {
+		if *body.ResponseBudget < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.response_budget", *body.ResponseBudget, 1, true))
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err2 := ValidateToolRetryPolicyRequestBody(body.RetryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateToolAnnotationsRequestBody runs the validations defined on
+// ToolAnnotationsRequestBody
+func ValidateToolAnnotationsRequestBody(body *ToolAnnotationsRequestBody) (err error) {
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 100, false))
+		}
+	}
+	return
+}
+
+// ValidateToolRetryPolicyRequestBody runs the validations defined on
+// ToolRetryPolicyRequestBody
+func ValidateToolRetryPolicyRequestBody(body *ToolRetryPolicyRequestBody) (err error) {
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts > 10 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 10, false))
+		}
+	}
+	for _, e := range body.StatusCodes {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.status_codes[*]", e, "^[1-5]([0-9]{2}|XX)$"))
+	}
+	for _, e := range body.Methods {
+		if !(e == "GET" || e == "HEAD" || e == "POST" || e == "PUT" || e == "PATCH" || e == "DELETE" || e == "OPTIONS") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.methods[*]", e, []any{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}))
+		}
+	}
+	if body.InitialIntervalMs != nil {
+		if *body.InitialIntervalMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_interval_ms", *body.InitialIntervalMs, 1, true))
+		}
+	}
+	if body.MaxIntervalMs != nil {
+		if *body.MaxIntervalMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_interval_ms", *body.MaxIntervalMs, 1, true))
+		}
+	}
+	if body.Exponent != nil {
+		if *body.Exponent < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.exponent", *body.Exponent, 1, true))
+		}
+	}
+	if body.Exponent != nil {
+		if *body.Exponent > 10 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.exponent", *body.Exponent, 10, false))
+		}
+	}
+	if body.MaxElapsedTimeMs != nil {
+		if *body.MaxElapsedTimeMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_elapsed_time_ms", *body.MaxElapsedTimeMs, 1, true))
+		}
+	}
+	if body.TimeoutMs != nil {
+		if *body.TimeoutMs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_ms", *body.TimeoutMs, 1, true))
+		}
+	}
 	return
 }
@@ -143,14 +143,31 @@ type UpdateToolsetPayload struct {
 	Name *string
 	// The new description of the toolset
 	Description *string
+	// The new MCP server instructions for the toolset. An empty string removes
+	// them.
+	Instructions *string
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *types.Slug
 	// List of HTTP tool names to include
 	HTTPToolNames []string
 	// List of prompt template names to include
 	PromptTemplateNames []string
+	// The MCP resources to publish. Replaces all existing resources when set.
+	Resources []*types.ToolsetResourceForm
+	// Rules that decide which MCP clients may connect. Replaces all existing rules
+	// when set.
+	ClientRules []*types.ToolsetClientRule
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64
 	// The slug of the MCP to use for the toolset
 	McpSlug *types.Slug
 	// Whether the toolset is public in MCP
@@ -25,4 +25,10 @@ type CanonicalToolAttributes struct {
 	Summarizer *string
 	// The tags list for this http tool
 	Tags []string
+	// Behavioral hints set for the tool in its source document
+	Annotations *ToolAnnotations
+	// The response budget set for the tool in its source document
+	ResponseBudget *int64
+	// The retry policy set for the tool in its source document
+	RetryPolicy *ToolRetryPolicy
 }
@@ -31,6 +31,13 @@ type HTTPToolDefinition struct {
 	Summarizer *string
 	// Response filter metadata for the tool
 	ResponseFilter *ResponseFilter
+	// Behavioral hints for the tool, derived from its HTTP method unless overridden
+	Annotations *ToolAnnotations
+	// The maximum size in bytes of a response returned to the model before it is
+	// truncated. Falls back to the toolset's budget when unset.
+	ResponseBudget *int64
+	// How calls to the tool's upstream API are timed out and retried
+	RetryPolicy *ToolRetryPolicy
 	// The ID of the OpenAPI v3 document
 	Openapiv3DocumentID *string
 	// OpenAPI v3 operation
@@ -49,6 +56,9 @@ type HTTPToolDefinition struct {
 	SchemaVersion *string
 	// JSON schema for the request
 	Schema string
+	// JSON schema for the structured output of the tool, derived from its
+	// successful response
+	OutputSchema *string
 	// The name of the source package
 	PackageName *string
 	// The creation date of the tool.
@@ -28,6 +28,13 @@ type ToolVariation struct {
 	Tags []string
 	// The summarizer of the tool variation
 	Summarizer *string
+	// The behavioral hints of the tool variation
+	Annotations *ToolAnnotations
+	// The response budget of the tool variation in bytes
+	ResponseBudget *int64
+	// The retry policy of the tool variation. Fields that are set override the
+	// tool's own policy.
+	RetryPolicy *ToolRetryPolicy
 	// The creation date of the tool variation
 	CreatedAt string
 	// The last update date of the tool variation
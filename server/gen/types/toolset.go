@@ -23,6 +23,12 @@ type Toolset struct {
 	Slug Slug
 	// Description of the toolset
 	Description *string
+	// Long-form guidance returned to MCP clients when they connect, such as the
+	// intended order of tool calls and a glossary of domain terms
+	Instructions *string
+	// The revision number of the current instructions. Every edit creates a new
+	// revision.
+	InstructionsVersion *int32
 	// The slug of the environment to use as the default for the toolset
 	DefaultEnvironmentSlug *Slug
 	// The security variables that are relevant to the toolset
@@ -33,12 +39,25 @@ type Toolset struct {
 	HTTPTools []*HTTPToolDefinition
 	// The prompt templates in this toolset
 	PromptTemplates []*PromptTemplate
+	// The MCP resources published by this toolset
+	Resources []*ToolsetResource
+	// Rules that decide which MCP clients may connect to the toolset
+	ClientRules []*ToolsetClientRule
 	// The slug of the MCP to use for the toolset
 	McpSlug *Slug
 	// Whether the toolset is public in MCP
 	McpIsPublic *bool
 	// Whether the toolset is enabled for MCP
 	McpEnabled *bool
+	// How MCP clients discover tools. static lists every tool while dynamic lists
+	// meta-tools to search for, describe and execute tools on demand.
+	ToolSelectionMode *string
+	// Size in bytes above which binary and document tool responses are returned as
+	// a short-lived download link instead of being embedded in the result.
+	ResourceLinkThreshold *int64
+	// Size in bytes above which text tool responses are truncated before they are
+	// returned to the model. Tools may set their own budget.
+	ResponseBudget *int64
 	// The ID of the custom domain to use for the toolset
 	CustomDomainID *string
 	// The external OAuth server details
@@ -0,0 +1,21 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// User types
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package types
+
+type ToolsetClientRule struct {
+	// The client name to match, as sent in clientInfo.name when the client
+	// initializes. Matching ignores case.
+	ClientName string
+	// allow adds the client to an allow-list so that only listed clients may
+	// connect, block rejects the client and minimum_version rejects versions of
+	// the client older than min_version.
+	Kind string
+	// The oldest semantic version of the client that may connect. Required for
+	// minimum_version rules.
+	MinVersion *string
+}
@@ -100,6 +100,13 @@ type UpsertGlobalPayload struct {
 	Tags []string
 	// The summarizer of the tool variation
 	Summarizer *string
+	// The behavioral hints of the tool variation
+	Annotations *types.ToolAnnotations
+	// The response budget of the tool variation in bytes
+	ResponseBudget *int64
+	// The retry policy of the tool variation. Fields that are set override the
+	// tool's own policy.
+	RetryPolicy *types.ToolRetryPolicy
 }
 
 // UpsertGlobalToolVariationResult is the result type of the variations service
@@ -44,6 +44,7 @@ const (
 	MaxFileSizeFunctions = 15 * mib
 	MaxFileSizeOpenAPI   = 10 * mib
 	MaxFileSizeImage     = 4 * mib
+	MaxFileSizeDocument  = 10 * mib
 )
 
 type Service struct {
@@ -247,6 +248,99 @@ func (s *Service) UploadImage(ctx context.Context, payload *gen.UploadImageForm,
 	}, nil
 }
 
+func (s *Service) UploadDocument(ctx context.Context, payload *gen.UploadDocumentForm, reader io.ReadCloser) (*gen.UploadDocumentResult, error) {
+	defer o11y.LogDefer(ctx, s.logger, func() error {
+		return reader.Close()
+	})
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	result, err := s.downloadPendingAsset(ctx, reader, &downloadPendingAssetParams{
+		maxLength:     MaxFileSizeDocument,
+		contentLength: payload.ContentLength,
+		contentType:   payload.ContentType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer o11y.LogDefer(ctx, s.logger, func() error {
+		return result.cleanup()
+	})
+
+	existing, err := s.findExistingAsset(ctx, &findAssetParams{
+		projectID: *authCtx.ProjectID,
+		hash:      result.hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &gen.UploadDocumentResult{Asset: existing}, nil
+	}
+
+	contentType, _, err := mime.ParseMediaType(payload.ContentType)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, fmt.Errorf("parse content type: %w", err), "error parsing content type")
+	}
+
+	mimeType, ext, err := sniffMimeType(sniffMimeTypeParams{
+		contentLength: payload.ContentLength,
+		inputMimeType: contentType,
+		allowedTypes: []string{
+			"text/markdown",
+			"text/plain",
+			"text/csv",
+			"text/html",
+			"application/json",
+			"application/yaml",
+			"application/pdf",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filename := fmt.Sprintf("document-%s%s", result.hash, ext)
+	uri, err := s.uploadAsset(ctx, &uploadAssetParams{
+		projectID:     *authCtx.ProjectID,
+		filename:      filename,
+		contentType:   mimeType,
+		contentLength: payload.ContentLength,
+		file:          result.file,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	asset, err := s.repo.CreateAsset(ctx, repo.CreateAssetParams{
+		Name:          filename,
+		Url:           uri.String(),
+		ProjectID:     *authCtx.ProjectID,
+		Sha256:        result.hash,
+		Kind:          "document",
+		ContentType:   contentType,
+		ContentLength: payload.ContentLength,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, fmt.Errorf("create asset in database: %w", err), "error saving document info")
+	}
+
+	return &gen.UploadDocumentResult{
+		Asset: &gen.Asset{
+			ID:            asset.ID.String(),
+			Kind:          asset.Kind,
+			Sha256:        asset.Sha256,
+			ContentType:   asset.ContentType,
+			ContentLength: asset.ContentLength,
+			CreatedAt:     asset.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt:     asset.UpdatedAt.Time.Format(time.RFC3339),
+		},
+	}, nil
+}
+
 func (s *Service) UploadFunctions(ctx context.Context, payload *gen.UploadFunctionsForm, reader io.ReadCloser) (*gen.UploadFunctionsResult, error) {
 	defer o11y.LogDefer(ctx, s.logger, func() error {
 		return reader.Close()
@@ -602,6 +696,10 @@ func sniffMimeType(params sniffMimeTypeParams) (mtype string, ext string, err er
 		exts = []string{".json"}
 	case "application/zip", "application/x-zip-compressed", "application/x-zip":
 		exts = []string{".zip"}
+	case "text/markdown":
+		exts = []string{".md"}
+	case "text/plain":
+		exts = []string{".txt"}
 	default:
 		exts, err = mime.ExtensionsByType(params.inputMimeType)
 		if err != nil {
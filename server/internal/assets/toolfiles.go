@@ -0,0 +1,61 @@
+package assets
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/assets/repo"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+)
+
+// ToolFiles loads project assets so that tool calls can send them as files in
+// multipart request bodies.
+type ToolFiles struct {
+	repo    *repo.Queries
+	storage BlobStore
+}
+
+var _ gateway.AssetLoader = (*ToolFiles)(nil)
+
+func NewToolFiles(db repo.DBTX, storage BlobStore) *ToolFiles {
+	return &ToolFiles{
+		repo:    repo.New(db),
+		storage: storage,
+	}
+}
+
+func (t *ToolFiles) OpenAsset(ctx context.Context, projectID uuid.UUID, assetID uuid.UUID) (*gateway.AssetFile, error) {
+	row, err := t.repo.GetProjectAsset(ctx, repo.GetProjectAssetParams{
+		ProjectID: projectID,
+		ID:        assetID,
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, gateway.ErrAssetNotFound
+	case err != nil:
+		return nil, fmt.Errorf("get project asset: %w", err)
+	case row.Deleted:
+		return nil, gateway.ErrAssetNotFound
+	}
+
+	assetURL, err := url.Parse(row.Url)
+	if err != nil {
+		return nil, fmt.Errorf("parse asset url: %w", err)
+	}
+
+	body, err := t.storage.Read(ctx, assetURL)
+	if err != nil {
+		return nil, fmt.Errorf("read asset: %w", err)
+	}
+
+	return &gateway.AssetFile{
+		Name:        row.Name,
+		ContentType: row.ContentType,
+		Body:        body,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package assets_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/gen/assets"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func TestService_UploadDocument_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestAssetsService(t)
+
+	documentContent := "# Runbook\n\nRestart the service."
+	sha := sha256.Sum256([]byte(documentContent))
+	expectedSha256 := hex.EncodeToString(sha[:])
+	contentType := "text/markdown"
+	contentLength := int64(len(documentContent))
+
+	result, err := ti.service.UploadDocument(ctx, &assets.UploadDocumentForm{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		ContentType:      contentType,
+		ContentLength:    contentLength,
+	}, io.NopCloser(strings.NewReader(documentContent)))
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotNil(t, result.Asset)
+
+	require.NotEqual(t, uuid.Nil.String(), result.Asset.ID)
+	require.Equal(t, "document", result.Asset.Kind)
+	require.Equal(t, contentType, result.Asset.ContentType)
+	require.Equal(t, contentLength, result.Asset.ContentLength)
+	require.Equal(t, expectedSha256, result.Asset.Sha256)
+}
+
+func TestService_UploadDocument_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	_, ti := newTestAssetsService(t)
+
+	// Create context without auth
+	ctx := t.Context()
+
+	_, err := ti.service.UploadDocument(ctx, &assets.UploadDocumentForm{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		ContentType:      "text/markdown",
+		ContentLength:    100,
+	}, io.NopCloser(strings.NewReader("test")))
+
+	var oopsErr *oops.ShareableError
+	require.ErrorAs(t, err, &oopsErr)
+	require.Equal(t, oops.CodeUnauthorized, oopsErr.Code)
+}
+
+func TestService_UploadDocument_UnsupportedContentType(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestAssetsService(t)
+
+	content := "fake zip content"
+	_, err := ti.service.UploadDocument(ctx, &assets.UploadDocumentForm{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		ContentType:      "application/zip",
+		ContentLength:    int64(len(content)),
+	}, io.NopCloser(strings.NewReader(content)))
+
+	var oopsErr *oops.ShareableError
+	require.ErrorAs(t, err, &oopsErr)
+	require.Equal(t, oops.CodeUnsupportedMedia, oopsErr.Code)
+}
@@ -77,10 +77,15 @@ const (
 	HTTPEncodingStyleKey           = attribute.Key("gram.http.encoding.style")
 	HTTPParamNameKey               = attribute.Key("gram.http.param.name")
 	HTTPParamValueKey              = attribute.Key("gram.http.param.value")
+	HTTPConnReusedKey              = attribute.Key("gram.http.conn.reused")
+	HTTPForwardProxyKey            = attribute.Key("gram.http.forward_proxy")
+	HTTPResponseCacheResultKey     = attribute.Key("gram.http.response.cache_result")
 	HTTPResponseExternalKey        = attribute.Key("gram.http.response.external")
 	HTTPResponseFilteredKey        = attribute.Key("gram.http.response.filtered")
 	HTTPStatusCodePatternKey       = attribute.Key("gram.http.status_code_pattern")
 	IngressNameKey                 = attribute.Key("gram.ingress.name")
+	McpClientNameKey               = attribute.Key("gram.mcp.client.name")
+	McpClientVersionKey            = attribute.Key("gram.mcp.client.version")
 	McpMethodKey                   = attribute.Key("gram.mcp.method")
 	McpURLKey                      = attribute.Key("gram.mcp.url")
 	MetricNameKey                  = attribute.Key("gram.metric.name")
@@ -314,9 +319,22 @@ func SlogHTTPEncodingStyle(v string) slog.Attr      { return slog.String(string(
 func HTTPResponseExternal(v bool) attribute.KeyValue { return HTTPResponseExternalKey.Bool(v) }
 func SlogHTTPResponseExternal(v bool) slog.Attr      { return slog.Bool(string(HTTPResponseExternalKey), v) }
 
+func HTTPConnReused(v bool) attribute.KeyValue { return HTTPConnReusedKey.Bool(v) }
+func SlogHTTPConnReused(v bool) slog.Attr      { return slog.Bool(string(HTTPConnReusedKey), v) }
+
+func HTTPForwardProxy(v bool) attribute.KeyValue { return HTTPForwardProxyKey.Bool(v) }
+func SlogHTTPForwardProxy(v bool) slog.Attr      { return slog.Bool(string(HTTPForwardProxyKey), v) }
+
 func HTTPResponseFiltered(v bool) attribute.KeyValue { return HTTPResponseFilteredKey.Bool(v) }
 func SlogHTTPResponseFiltered(v bool) slog.Attr      { return slog.Bool(string(HTTPResponseFilteredKey), v) }
 
+func HTTPResponseCacheResult(v string) attribute.KeyValue {
+	return HTTPResponseCacheResultKey.String(v)
+}
+func SlogHTTPResponseCacheResult(v string) slog.Attr {
+	return slog.String(string(HTTPResponseCacheResultKey), v)
+}
+
 func HTTPStatusCodePattern(v string) attribute.KeyValue { return HTTPStatusCodePatternKey.String(v) }
 func SlogHTTPStatusCodePattern(v string) slog.Attr {
 	return slog.String(string(HTTPStatusCodePatternKey), v)
@@ -453,6 +471,12 @@ func SlogMcpURL(v string) slog.Attr      { return slog.String(string(McpURLKey),
 func McpMethod(v string) attribute.KeyValue { return McpMethodKey.String(v) }
 func SlogMcpMethod(v string) slog.Attr      { return slog.String(string(McpMethodKey), v) }
 
+func McpClientName(v string) attribute.KeyValue { return McpClientNameKey.String(v) }
+func SlogMcpClientName(v string) slog.Attr      { return slog.String(string(McpClientNameKey), v) }
+
+func McpClientVersion(v string) attribute.KeyValue { return McpClientVersionKey.String(v) }
+func SlogMcpClientVersion(v string) slog.Attr      { return slog.String(string(McpClientVersionKey), v) }
+
 func ToolCallDuration(v time.Duration) attribute.KeyValue {
 	return ToolCallDurationKey.Float64(v.Seconds())
 }
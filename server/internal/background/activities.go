@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel/metric"
 
 	"github.com/speakeasy-api/gram/server/internal/assets"
@@ -14,6 +15,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/chat"
 	"github.com/speakeasy-api/gram/server/internal/feature"
 	"github.com/speakeasy-api/gram/server/internal/k8s"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/openrouter"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/posthog"
 	slack_client "github.com/speakeasy-api/gram/server/internal/thirdparty/slack/client"
@@ -23,6 +25,7 @@ import (
 type Activities struct {
 	processDeployment             *activities.ProcessDeployment
 	transitionDeployment          *activities.TransitionDeployment
+	notifyListChanged             *activities.NotifyListChanged
 	getSlackProjectContext        *activities.GetSlackProjectContext
 	postSlackMessage              *activities.PostSlackMessage
 	slackChatCompletion           *activities.SlackChatCompletion
@@ -50,10 +53,12 @@ func NewActivities(
 	billingTracker billing.Tracker,
 	billingRepo billing.Repository,
 	posthogClient *posthog.Posthog,
+	redisClient *redis.Client,
 ) *Activities {
 	return &Activities{
 		processDeployment:             activities.NewProcessDeployment(logger, meterProvider, db, features, assetStorage),
 		transitionDeployment:          activities.NewTransitionDeployment(logger, db),
+		notifyListChanged:             activities.NewNotifyListChanged(logger, relay.NewBroker(logger, redisClient)),
 		getSlackProjectContext:        activities.NewSlackProjectContextActivity(logger, db, slackClient),
 		postSlackMessage:              activities.NewPostSlackMessageActivity(logger, slackClient),
 		slackChatCompletion:           activities.NewSlackChatCompletionActivity(logger, slackClient, chatClient),
@@ -76,6 +81,10 @@ func (a *Activities) ProcessDeployment(ctx context.Context, projectID uuid.UUID,
 	return a.processDeployment.Do(ctx, projectID, deploymentID)
 }
 
+func (a *Activities) NotifyListChanged(ctx context.Context, projectID uuid.UUID, deploymentID uuid.UUID) error {
+	return a.notifyListChanged.Do(ctx, projectID, deploymentID)
+}
+
 func (a *Activities) GetSlackProjectContext(ctx context.Context, event types.SlackEvent) (*activities.SlackProjectContextResponse, error) {
 	return a.getSlackProjectContext.Do(ctx, event)
 }
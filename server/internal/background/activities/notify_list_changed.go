@@ -0,0 +1,42 @@
+package activities
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
+)
+
+type NotifyListChanged struct {
+	logger *slog.Logger
+	broker *relay.Broker
+}
+
+func NewNotifyListChanged(logger *slog.Logger, broker *relay.Broker) *NotifyListChanged {
+	return &NotifyListChanged{
+		logger: logger,
+		broker: broker,
+	}
+}
+
+// Do tells connected MCP clients that the tools of every toolset in a
+// project, and the resources templated on them, may have changed after a
+// deployment completed. Failures are only logged because clients can still
+// discover the changes by listing tools and reading resources.
+func (n *NotifyListChanged) Do(ctx context.Context, projectID uuid.UUID, deploymentID uuid.UUID) error {
+	err := n.broker.PublishListChanged(ctx, projectID.String(), "", relay.KindToolsListChanged, relay.KindResourcesUpdated)
+	if err != nil {
+		n.logger.WarnContext(
+			ctx,
+			"failed to announce deployment to mcp clients",
+			attr.SlogError(err),
+			attr.SlogProjectID(projectID.String()),
+			attr.SlogDeploymentID(deploymentID.String()),
+		)
+	}
+
+	return nil
+}
@@ -123,6 +123,23 @@ func ProcessDeploymentWorkflow(ctx workflow.Context, params ProcessDeploymentWor
 		)
 	}
 
+	if finalStatus == "completed" {
+		err = workflow.ExecuteActivity(
+			ctx,
+			a.NotifyListChanged,
+			params.ProjectID,
+			params.DeploymentID,
+		).Get(ctx, nil)
+		if err != nil {
+			logger.Warn(
+				"failed to notify mcp clients of deployment",
+				"error", err.Error(),
+				string(attr.ProjectIDKey), params.ProjectID,
+				string(attr.DeploymentIDKey), params.DeploymentID,
+			)
+		}
+	}
+
 	return &ProcessDeploymentWorkflowResult{
 		ProjectID:    params.ProjectID,
 		DeploymentID: params.DeploymentID,
@@ -118,10 +118,12 @@ func NewTemporalWorker(
 		opts.BillingTracker,
 		opts.BillingRepository,
 		opts.PosthogClient,
+		opts.RedisClient,
 	)
 
 	temporalWorker.RegisterActivity(activities.ProcessDeployment)
 	temporalWorker.RegisterActivity(activities.TransitionDeployment)
+	temporalWorker.RegisterActivity(activities.NotifyListChanged)
 	temporalWorker.RegisterActivity(activities.GetSlackProjectContext)
 	temporalWorker.RegisterActivity(activities.PostSlackMessage)
 	temporalWorker.RegisterActivity(activities.SlackChatCompletion)
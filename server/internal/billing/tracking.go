@@ -23,6 +23,10 @@ type ToolCallUsageEvent struct {
 	ToolsetSlug      *string
 	ChatID           *string
 	MCPURL           *string
+	// MCPClientName and MCPClientVersion identify the MCP client that made
+	// the call, as declared when it initialized its session.
+	MCPClientName    *string
+	MCPClientVersion *string
 	Type             ToolCallType
 }
 
@@ -0,0 +1,189 @@
+// Package bm25 implements an in-memory full-text index ranked with Okapi
+// BM25. It is meant for small corpora, such as the tools of a toolset, that
+// can be indexed on demand without an external search service.
+package bm25
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// k1 controls how quickly repeated terms stop adding to a score.
+	k1 = 1.2
+	// b controls how much long documents are penalized.
+	b = 0.75
+)
+
+// stopWords are left out of the index because they match nearly everything.
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "by": {},
+	"for": {}, "from": {}, "in": {}, "is": {}, "it": {}, "of": {}, "on": {}, "or": {},
+	"that": {}, "the": {}, "this": {}, "to": {}, "with": {},
+}
+
+// Field is a piece of text in a document. Terms in a field with a higher
+// weight count as if they appeared that many times.
+type Field struct {
+	Text   string
+	Weight int
+}
+
+// Document is a unit of text that can be found by a search.
+type Document struct {
+	ID     string
+	Fields []Field
+}
+
+// Result is a document that matched a search.
+type Result struct {
+	ID    string
+	Score float64
+}
+
+type indexedDocument struct {
+	id     string
+	terms  map[string]float64
+	length float64
+}
+
+// Index ranks documents against free-text queries.
+type Index struct {
+	docs      []indexedDocument
+	docFreq   map[string]int
+	avgLength float64
+}
+
+// New indexes a set of documents.
+func New(docs []Document) *Index {
+	idx := &Index{
+		docs:      make([]indexedDocument, 0, len(docs)),
+		docFreq:   make(map[string]int),
+		avgLength: 0,
+	}
+
+	var total float64
+	for _, doc := range docs {
+		terms := make(map[string]float64)
+		var length float64
+		for _, field := range doc.Fields {
+			weight := float64(max(field.Weight, 1))
+			for _, term := range Tokenize(field.Text) {
+				terms[term] += weight
+				length += weight
+			}
+		}
+
+		for term := range terms {
+			idx.docFreq[term]++
+		}
+
+		idx.docs = append(idx.docs, indexedDocument{id: doc.ID, terms: terms, length: length})
+		total += length
+	}
+
+	if len(idx.docs) > 0 {
+		idx.avgLength = total / float64(len(idx.docs))
+	}
+
+	return idx
+}
+
+// Search returns up to limit documents that match the query, best match
+// first. Documents with equal scores keep the order they were indexed in.
+func (idx *Index) Search(query string, limit int) []Result {
+	terms := Tokenize(query)
+	if len(terms) == 0 || limit <= 0 {
+		return []Result{}
+	}
+
+	n := float64(len(idx.docs))
+	results := make([]Result, 0)
+	for _, doc := range idx.docs {
+		var score float64
+		for _, term := range terms {
+			tf, ok := doc.terms[term]
+			if !ok {
+				continue
+			}
+
+			df := float64(idx.docFreq[term])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			norm := 1 - b + b*doc.length/idx.avgLength
+			score += idf * tf * (k1 + 1) / (tf + k1*norm)
+		}
+
+		if score > 0 {
+			results = append(results, Result{ID: doc.id, Score: score})
+		}
+	}
+
+	slices.SortStableFunc(results, func(x, y Result) int {
+		switch {
+		case x.Score > y.Score:
+			return -1
+		case x.Score < y.Score:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return results[:min(limit, len(results))]
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Tokenize splits text into lowercase terms. Words are split at
+// punctuation, underscores and camelCase boundaries so that identifiers such
+// as listPets and list_pets produce the same terms, and a trailing plural "s"
+// is dropped.
+func Tokenize(text string) []string {
+	var terms []string
+	var word []rune
+
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+
+		term := strings.ToLower(string(word))
+		word = word[:0]
+
+		if len(term) > 3 && strings.HasSuffix(term, "s") && !hasAnySuffix(term, "ss", "us", "is") {
+			term = term[:len(term)-1]
+		}
+		if _, stop := stopWords[term]; stop {
+			return
+		}
+
+		terms = append(terms, term)
+	}
+
+	runes := []rune(text)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && len(word) > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))):
+			flush()
+			word = append(word, r)
+		default:
+			word = append(word, r)
+		}
+	}
+	flush()
+
+	return terms
+}
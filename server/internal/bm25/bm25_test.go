@@ -0,0 +1,63 @@
+package bm25
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "camel case", text: "listPets", want: []string{"list", "pet"}},
+		{name: "snake case", text: "list_pets", want: []string{"list", "pet"}},
+		{name: "acronym", text: "getHTTPStatus", want: []string{"get", "http", "status"}},
+		{name: "stop words", text: "Get the owner of a pet", want: []string{"get", "owner", "pet"}},
+		{name: "punctuation", text: "pets/{id}: delete", want: []string{"pet", "id", "delete"}},
+		{name: "empty", text: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, Tokenize(tt.text))
+		})
+	}
+}
+
+func TestSearch_RanksMatchingDocuments(t *testing.T) {
+	t.Parallel()
+
+	idx := New([]Document{
+		{ID: "list_pets", Fields: []Field{{Text: "list_pets", Weight: 3}, {Text: "List all pets in the store", Weight: 1}}},
+		{ID: "create_order", Fields: []Field{{Text: "create_order", Weight: 3}, {Text: "Place an order for a pet", Weight: 1}}},
+		{ID: "get_inventory", Fields: []Field{{Text: "get_inventory", Weight: 3}, {Text: "Returns pet inventories by status", Weight: 1}}},
+	})
+
+	results := idx.Search("list pets", 10)
+	require.NotEmpty(t, results)
+	require.Equal(t, "list_pets", results[0].ID)
+
+	results = idx.Search("order", 10)
+	require.Len(t, results, 1)
+	require.Equal(t, "create_order", results[0].ID)
+}
+
+func TestSearch_Limit(t *testing.T) {
+	t.Parallel()
+
+	idx := New([]Document{
+		{ID: "a", Fields: []Field{{Text: "pet", Weight: 1}}},
+		{ID: "b", Fields: []Field{{Text: "pet", Weight: 1}}},
+		{ID: "c", Fields: []Field{{Text: "pet", Weight: 1}}},
+	})
+
+	require.Len(t, idx.Search("pet", 2), 2)
+	require.Empty(t, idx.Search("pet", 0))
+	require.Empty(t, idx.Search("", 10))
+	require.Empty(t, idx.Search("unknown", 10))
+}
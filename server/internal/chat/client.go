@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/speakeasy-api/gram/server/internal/assets"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/cache"
 	"github.com/speakeasy-api/gram/server/internal/environments"
@@ -50,6 +51,7 @@ func NewChatClient(logger *slog.Logger,
 	env *environments.EnvironmentEntries,
 	cacheImpl cache.Cache,
 	guardianPolicy *guardian.Policy,
+	assetStorage assets.BlobStore,
 ) *ChatClient {
 	return &ChatClient{
 		logger:     logger,
@@ -65,6 +67,7 @@ func NewChatClient(logger *slog.Logger,
 			gateway.ToolCallSourceDirect,
 			cacheImpl,
 			guardianPolicy,
+			assets.NewToolFiles(db, assetStorage),
 		),
 	}
 }
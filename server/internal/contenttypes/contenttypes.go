@@ -5,6 +5,7 @@ import "regexp"
 var (
 	jsonRE = regexp.MustCompile(`(?i)\bjson\b`)
 	yamlRE = regexp.MustCompile(`(?i)\byaml\b`)
+	xmlRE  = regexp.MustCompile(`(?i)\bxml\b`)
 )
 
 func IsJSON(contentType string) bool {
@@ -14,3 +15,7 @@ func IsJSON(contentType string) bool {
 func IsYAML(contentType string) bool {
 	return yamlRE.MatchString(contentType)
 }
+
+func IsXML(contentType string) bool {
+	return xmlRE.MatchString(contentType)
+}
@@ -146,3 +146,61 @@ func TestIsYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestIsXML(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		contentType string
+		expected    bool
+	}{
+		{
+			name:        "application/xml",
+			contentType: "application/xml",
+			expected:    true,
+		},
+		{
+			name:        "text/xml",
+			contentType: "text/xml",
+			expected:    true,
+		},
+		{
+			name:        "application/soap+xml",
+			contentType: "application/soap+xml",
+			expected:    true,
+		},
+		{
+			name:        "application/xml with charset",
+			contentType: "application/xml; charset=utf-8",
+			expected:    true,
+		},
+		{
+			name:        "application/json",
+			contentType: "application/json",
+			expected:    false,
+		},
+		{
+			name:        "application/xhtml",
+			contentType: "application/xhtml",
+			expected:    false,
+		},
+		{
+			name:        "empty string",
+			contentType: "",
+			expected:    false,
+		},
+		{
+			name:        "case insensitive - uppercase should pass",
+			contentType: "APPLICATION/XML",
+			expected:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := IsXML(tt.contentType)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -130,6 +130,16 @@ func PtrToPGTextEmpty(t *string) pgtype.Text {
 	return pgtype.Text{String: *t, Valid: *t != ""}
 }
 
+// PtrToPGBool converts a bool pointer to a pgtype.Bool with Valid set to true
+// only if the pointer is not nil.
+func PtrToPGBool(b *bool) pgtype.Bool {
+	if b == nil {
+		return pgtype.Bool{Valid: false, Bool: false}
+	}
+
+	return pgtype.Bool{Bool: *b, Valid: true}
+}
+
 // FromPGBool converts a pgtype.Bool to a bool or subtype of bool. If Bool is
 // not valid, it returns nil.
 func FromPGBool[T ~bool](t pgtype.Bool) *T {
@@ -141,6 +151,27 @@ func FromPGBool[T ~bool](t pgtype.Bool) *T {
 	return &val
 }
 
+// PtrToPGInt8 converts an int64 pointer to a pgtype.Int8 with Valid set to
+// true only if the pointer is not nil.
+func PtrToPGInt8(i *int64) pgtype.Int8 {
+	if i == nil {
+		return pgtype.Int8{Valid: false, Int64: 0}
+	}
+
+	return pgtype.Int8{Int64: *i, Valid: true}
+}
+
+// FromPGInt8 converts a pgtype.Int8 to an *int64. If the Int8 is not valid, it
+// returns nil.
+func FromPGInt8(i pgtype.Int8) *int64 {
+	if !i.Valid {
+		return nil
+	}
+
+	val := i.Int64
+	return &val
+}
+
 // FromBytes converts a byte slice to a string pointer. If the byte slice is
 // empty, it returns nil.
 func FromBytes(b []byte) *string {
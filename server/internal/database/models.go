@@ -198,6 +198,11 @@ type HttpToolDefinition struct {
 	Confirm             pgtype.Text
 	ConfirmPrompt       pgtype.Text
 	Summarizer          pgtype.Text
+	Title               pgtype.Text
+	ReadOnlyHint        pgtype.Bool
+	DestructiveHint     pgtype.Bool
+	IdempotentHint      pgtype.Bool
+	OpenWorldHint       pgtype.Bool
 	Name                string
 	UntruncatedName     pgtype.Text
 	Summary             string
@@ -219,7 +224,13 @@ type HttpToolDefinition struct {
 	QuerySettings       []byte
 	PathSettings        []byte
 	RequestContentType  pgtype.Text
+	BodySettings        []byte
 	ResponseFilter      *models.ResponseFilter
+	OutputSchema        []byte
+	XmlResponseSchema   []byte
+	ResponseBudget      pgtype.Int8
+	RetryPolicy         *models.RetryPolicy
+	CachePolicy         *models.CachePolicy
 	CreatedAt           pgtype.Timestamptz
 	UpdatedAt           pgtype.Timestamptz
 	DeletedAt           pgtype.Timestamptz
@@ -376,20 +387,27 @@ type SlackAppConnection struct {
 }
 
 type ToolVariation struct {
-	ID            uuid.UUID
-	GroupID       uuid.UUID
-	SrcToolName   string
-	Confirm       pgtype.Text
-	ConfirmPrompt pgtype.Text
-	Name          pgtype.Text
-	Summary       pgtype.Text
-	Description   pgtype.Text
-	Tags          []string
-	Summarizer    pgtype.Text
-	CreatedAt     pgtype.Timestamptz
-	UpdatedAt     pgtype.Timestamptz
-	DeletedAt     pgtype.Timestamptz
-	Deleted       bool
+	ID              uuid.UUID
+	GroupID         uuid.UUID
+	SrcToolName     string
+	Confirm         pgtype.Text
+	ConfirmPrompt   pgtype.Text
+	Name            pgtype.Text
+	Summary         pgtype.Text
+	Description     pgtype.Text
+	Tags            []string
+	Summarizer      pgtype.Text
+	Title           pgtype.Text
+	ReadOnlyHint    pgtype.Bool
+	DestructiveHint pgtype.Bool
+	IdempotentHint  pgtype.Bool
+	OpenWorldHint   pgtype.Bool
+	ResponseBudget  pgtype.Int8
+	RetryPolicy     *models.RetryPolicy
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	DeletedAt       pgtype.Timestamptz
+	Deleted         bool
 }
 
 type ToolVariationsGroup struct {
@@ -415,6 +433,9 @@ type Toolset struct {
 	McpSlug                pgtype.Text
 	McpIsPublic            bool
 	McpEnabled             bool
+	ToolSelectionMode      string
+	ResourceLinkThreshold  pgtype.Int8
+	ResponseBudget         pgtype.Int8
 	CustomDomainID         uuid.NullUUID
 	ExternalOauthServerID  uuid.NullUUID
 	OauthProxyServerID     uuid.NullUUID
@@ -424,6 +445,25 @@ type Toolset struct {
 	Deleted                bool
 }
 
+type ToolsetClientRule struct {
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+	ToolsetID  uuid.UUID
+	ClientName string
+	Kind       string
+	MinVersion pgtype.Text
+	CreatedAt  pgtype.Timestamptz
+}
+
+type ToolsetInstruction struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	ToolsetID uuid.UUID
+	Version   int32
+	Content   pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
 type ToolsetPrompt struct {
 	ID               uuid.UUID
 	ProjectID        uuid.UUID
@@ -433,6 +473,21 @@ type ToolsetPrompt struct {
 	PromptName       string
 }
 
+type ToolsetResource struct {
+	ID           uuid.UUID
+	ProjectID    uuid.UUID
+	ToolsetID    uuid.UUID
+	Kind         string
+	Name         string
+	Description  pgtype.Text
+	MimeType     pgtype.Text
+	AssetID      uuid.NullUUID
+	HttpToolName pgtype.Text
+	UriTemplate  pgtype.Text
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
 type User struct {
 	ID          string
 	Email       string
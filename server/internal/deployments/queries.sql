@@ -322,6 +322,11 @@ INSERT INTO http_tool_definitions (
   , tags
   , confirm
   , confirm_prompt
+  , title
+  , read_only_hint
+  , destructive_hint
+  , idempotent_hint
+  , open_world_hint
   , x_gram
   , original_name
   , original_summary
@@ -337,7 +342,13 @@ INSERT INTO http_tool_definitions (
   , server_env_var
   , default_server_url
   , request_content_type
+  , body_settings
   , response_filter
+  , output_schema
+  , xml_response_schema
+  , response_budget
+  , retry_policy
+  , cache_policy
 ) VALUES (
     @project_id
   , @deployment_id
@@ -350,6 +361,11 @@ INSERT INTO http_tool_definitions (
   , @tags
   , @confirm
   , @confirm_prompt
+  , @title
+  , @read_only_hint
+  , @destructive_hint
+  , @idempotent_hint
+  , @open_world_hint
   , @x_gram
   , @original_name
   , @original_summary
@@ -365,7 +381,13 @@ INSERT INTO http_tool_definitions (
   , @server_env_var
   , @default_server_url
   , @request_content_type
+  , @body_settings
   , @response_filter
+  , @output_schema
+  , @xml_response_schema
+  , @response_budget
+  , @retry_policy
+  , @cache_policy
 )
 RETURNING *;
 
@@ -304,6 +304,11 @@ INSERT INTO http_tool_definitions (
   , tags
   , confirm
   , confirm_prompt
+  , title
+  , read_only_hint
+  , destructive_hint
+  , idempotent_hint
+  , open_world_hint
   , x_gram
   , original_name
   , original_summary
@@ -319,7 +324,13 @@ INSERT INTO http_tool_definitions (
   , server_env_var
   , default_server_url
   , request_content_type
+  , body_settings
   , response_filter
+  , output_schema
+  , xml_response_schema
+  , response_budget
+  , retry_policy
+  , cache_policy
 ) VALUES (
     $1
   , $2
@@ -348,8 +359,19 @@ INSERT INTO http_tool_definitions (
   , $25
   , $26
   , $27
+  , $28
+  , $29
+  , $30
+  , $31
+  , $32
+  , $33
+  , $34
+  , $35
+  , $36
+  , $37
+  , $38
 )
-RETURNING id, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, response_filter, created_at, updated_at, deleted_at, deleted
+RETURNING id, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, body_settings, response_filter, output_schema, xml_response_schema, response_budget, retry_policy, cache_policy, created_at, updated_at, deleted_at, deleted
 `
 
 type CreateOpenAPIv3ToolDefinitionParams struct {
@@ -364,6 +386,11 @@ type CreateOpenAPIv3ToolDefinitionParams struct {
 	Tags                []string
 	Confirm             pgtype.Text
 	ConfirmPrompt       pgtype.Text
+	Title               pgtype.Text
+	ReadOnlyHint        pgtype.Bool
+	DestructiveHint     pgtype.Bool
+	IdempotentHint      pgtype.Bool
+	OpenWorldHint       pgtype.Bool
 	XGram               pgtype.Bool
 	OriginalName        pgtype.Text
 	OriginalSummary     pgtype.Text
@@ -379,7 +406,13 @@ type CreateOpenAPIv3ToolDefinitionParams struct {
 	ServerEnvVar        string
 	DefaultServerUrl    pgtype.Text
 	RequestContentType  pgtype.Text
+	BodySettings        []byte
 	ResponseFilter      *models.ResponseFilter
+	OutputSchema        []byte
+	XmlResponseSchema   []byte
+	ResponseBudget      pgtype.Int8
+	RetryPolicy         *models.RetryPolicy
+	CachePolicy         *models.CachePolicy
 }
 
 func (q *Queries) CreateOpenAPIv3ToolDefinition(ctx context.Context, arg CreateOpenAPIv3ToolDefinitionParams) (HttpToolDefinition, error) {
@@ -395,6 +428,11 @@ func (q *Queries) CreateOpenAPIv3ToolDefinition(ctx context.Context, arg CreateO
 		arg.Tags,
 		arg.Confirm,
 		arg.ConfirmPrompt,
+		arg.Title,
+		arg.ReadOnlyHint,
+		arg.DestructiveHint,
+		arg.IdempotentHint,
+		arg.OpenWorldHint,
 		arg.XGram,
 		arg.OriginalName,
 		arg.OriginalSummary,
@@ -410,7 +448,13 @@ func (q *Queries) CreateOpenAPIv3ToolDefinition(ctx context.Context, arg CreateO
 		arg.ServerEnvVar,
 		arg.DefaultServerUrl,
 		arg.RequestContentType,
+		arg.BodySettings,
 		arg.ResponseFilter,
+		arg.OutputSchema,
+		arg.XmlResponseSchema,
+		arg.ResponseBudget,
+		arg.RetryPolicy,
+		arg.CachePolicy,
 	)
 	var i HttpToolDefinition
 	err := row.Scan(
@@ -421,6 +465,11 @@ func (q *Queries) CreateOpenAPIv3ToolDefinition(ctx context.Context, arg CreateO
 		&i.Confirm,
 		&i.ConfirmPrompt,
 		&i.Summarizer,
+		&i.Title,
+		&i.ReadOnlyHint,
+		&i.DestructiveHint,
+		&i.IdempotentHint,
+		&i.OpenWorldHint,
 		&i.Name,
 		&i.UntruncatedName,
 		&i.Summary,
@@ -442,7 +491,13 @@ func (q *Queries) CreateOpenAPIv3ToolDefinition(ctx context.Context, arg CreateO
 		&i.QuerySettings,
 		&i.PathSettings,
 		&i.RequestContentType,
+		&i.BodySettings,
 		&i.ResponseFilter,
+		&i.OutputSchema,
+		&i.XmlResponseSchema,
+		&i.ResponseBudget,
+		&i.RetryPolicy,
+		&i.CachePolicy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
@@ -0,0 +1,203 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Diagnostic is a log record produced while proxying a tool call that has
+// been scrubbed of sensitive values so that it can be shown to the caller.
+type Diagnostic struct {
+	Level   slog.Level
+	Message string
+	Data    map[string]any
+}
+
+// DiagnosticsFunc receives the diagnostics emitted during a tool call.
+type DiagnosticsFunc func(ctx context.Context, d Diagnostic)
+
+type diagnosticsKey struct{}
+
+type diagnosticsListener struct {
+	level slog.Level
+	emit  DiagnosticsFunc
+}
+
+// WithDiagnostics returns a context that causes ToolProxy.Do to forward its
+// log records at or above level to emit, in addition to the server logs.
+func WithDiagnostics(ctx context.Context, level slog.Level, emit DiagnosticsFunc) context.Context {
+	return context.WithValue(ctx, diagnosticsKey{}, &diagnosticsListener{level: level, emit: emit})
+}
+
+func diagnosticsFromContext(ctx context.Context) *diagnosticsListener {
+	listener, _ := ctx.Value(diagnosticsKey{}).(*diagnosticsListener)
+	return listener
+}
+
+const redacted = "[REDACTED]"
+
+var (
+	urlUserinfoPattern = regexp.MustCompile(`://[^/@\s]+@`)
+	sensitiveKeyParts  = []string{"token", "secret", "password", "authorization", "cookie", "api_key", "apikey", "credential"}
+)
+
+// diagnosticsScrubber removes credentials from diagnostics. Values of the
+// environment variables backing a tool's security schemes are redacted
+// wherever they appear, as is the userinfo component of any URL.
+type diagnosticsScrubber struct {
+	tool *HTTPTool
+	env  *caseInsensitiveEnv
+}
+
+func (s *diagnosticsScrubber) scrub(value string) string {
+	value = urlUserinfoPattern.ReplaceAllString(value, "://"+redacted+"@")
+
+	for _, security := range s.tool.Security {
+		for _, name := range security.EnvVariables {
+			// Very short values would redact unrelated text.
+			if secret := s.env.Get(name); len(secret) >= 4 {
+				value = strings.ReplaceAll(value, secret, redacted)
+			}
+		}
+	}
+
+	return value
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	return slices.ContainsFunc(sensitiveKeyParts, func(part string) bool {
+		return strings.Contains(key, part)
+	})
+}
+
+// diagnosticsHandler tees log records to the server's handler and to a
+// diagnostics listener.
+type diagnosticsHandler struct {
+	inner    slog.Handler
+	listener *diagnosticsListener
+	scrubber *diagnosticsScrubber
+	attrs    []slog.Attr
+	groups   []string
+}
+
+var _ slog.Handler = (*diagnosticsHandler)(nil)
+
+func newDiagnosticsHandler(inner slog.Handler, listener *diagnosticsListener, scrubber *diagnosticsScrubber) *diagnosticsHandler {
+	return &diagnosticsHandler{
+		inner:    inner,
+		listener: listener,
+		scrubber: scrubber,
+		attrs:    nil,
+		groups:   nil,
+	}
+}
+
+func (h *diagnosticsHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.listener.level || h.inner.Enabled(ctx, level)
+}
+
+func (h *diagnosticsHandler) Handle(ctx context.Context, r slog.Record) error {
+	var err error
+	if h.inner.Enabled(ctx, r.Level) {
+		err = h.inner.Handle(ctx, r)
+	}
+
+	if r.Level < h.listener.level {
+		return err
+	}
+
+	data := make(map[string]any, len(h.attrs)+r.NumAttrs())
+	for _, a := range h.attrs {
+		h.addAttr(data, a)
+	}
+	prefix := strings.Join(h.groups, ".")
+	r.Attrs(func(a slog.Attr) bool {
+		if prefix != "" {
+			a.Key = prefix + "." + a.Key
+		}
+		h.addAttr(data, a)
+		return true
+	})
+
+	h.listener.emit(ctx, Diagnostic{
+		Level:   r.Level,
+		Message: h.scrubber.scrub(r.Message),
+		Data:    data,
+	})
+
+	return err
+}
+
+func (h *diagnosticsHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	prefix := strings.Join(h.groups, ".")
+	next := slices.Clone(h.attrs)
+	for _, a := range attrs {
+		if prefix != "" {
+			a.Key = prefix + "." + a.Key
+		}
+		next = append(next, a)
+	}
+
+	return &diagnosticsHandler{
+		inner:    h.inner.WithAttrs(attrs),
+		listener: h.listener,
+		scrubber: h.scrubber,
+		attrs:    next,
+		groups:   h.groups,
+	}
+}
+
+func (h *diagnosticsHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	return &diagnosticsHandler{
+		inner:    h.inner.WithGroup(name),
+		listener: h.listener,
+		scrubber: h.scrubber,
+		attrs:    h.attrs,
+		groups:   append(slices.Clone(h.groups), name),
+	}
+}
+
+func (h *diagnosticsHandler) addAttr(data map[string]any, a slog.Attr) {
+	if a.Key == "" || isSensitiveKey(a.Key) {
+		return
+	}
+
+	value := a.Value.Resolve()
+	switch value.Kind() {
+	case slog.KindGroup:
+		group := make(map[string]any)
+		for _, ga := range value.Group() {
+			h.addAttr(group, ga)
+		}
+		if len(group) > 0 {
+			data[a.Key] = group
+		}
+	case slog.KindString:
+		data[a.Key] = h.scrubber.scrub(value.String())
+	case slog.KindBool:
+		data[a.Key] = value.Bool()
+	case slog.KindInt64:
+		data[a.Key] = value.Int64()
+	case slog.KindUint64:
+		data[a.Key] = value.Uint64()
+	case slog.KindFloat64:
+		data[a.Key] = value.Float64()
+	case slog.KindDuration, slog.KindTime, slog.KindLogValuer:
+		data[a.Key] = value.String()
+	case slog.KindAny:
+		if err, ok := value.Any().(error); ok {
+			data[a.Key] = h.scrubber.scrub(err.Error())
+		} else {
+			data[a.Key] = h.scrubber.scrub(fmt.Sprint(value.Any()))
+		}
+	}
+}
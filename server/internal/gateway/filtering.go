@@ -68,7 +68,12 @@ func handleResponseFiltering(ctx context.Context, logger *slog.Logger, tool *HTT
 	}
 
 	var respData any
-	if err := yaml.Unmarshal(data, &respData); err != nil {
+	if contenttypes.IsXML(mediaType) {
+		respData, err = decodeXMLDocument(data, tool.XMLResponseSchema)
+	} else {
+		err = yaml.Unmarshal(data, &respData)
+	}
+	if err != nil {
 		filterSpan.SetStatus(codes.Error, err.Error())
 		logger.ErrorContext(ctx, "failed to unmarshal response body", attr.SlogError(err))
 		return &responseFilteringResult{
@@ -110,7 +115,9 @@ func handleResponseFiltering(ctx context.Context, logger *slog.Logger, tool *HTT
 		results = append(results, v)
 	}
 
-	if contenttypes.IsJSON(mediaType) {
+	// XML responses are filtered as their JSON representation and the results
+	// are returned as JSON.
+	if contenttypes.IsJSON(mediaType) || contenttypes.IsXML(mediaType) {
 		if err := json.NewEncoder(buf).Encode(results); err != nil {
 			filterSpan.SetStatus(codes.Error, err.Error())
 			logger.ErrorContext(ctx, "failed to encode response filter results", attr.SlogError(err))
@@ -122,6 +129,10 @@ func handleResponseFiltering(ctx context.Context, logger *slog.Logger, tool *HTT
 		}
 	}
 
+	if contenttypes.IsXML(mediaType) {
+		contentType = "application/json"
+	}
+
 	return &responseFilteringResult{
 		resp:        buf,
 		statusCode:  statusCode,
@@ -36,6 +36,10 @@ func TestHandleResponseFiltering_NoFilter(t *testing.T) {
 		PathParams:         map[string]*HTTPParameter{},
 		RequestContentType: NullString{Value: "", Valid: false},
 		ResponseFilter:     nil,
+		RetryPolicy:        nil,
+		BodyEncoding:       nil,
+		XMLResponseSchema:  nil,
+		CachePolicy:        nil,
 	}
 
 	resp := &http.Response{
@@ -87,6 +91,10 @@ func TestHandleResponseFiltering_NoResponseFilter(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/json"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	resp := &http.Response{
@@ -138,6 +146,10 @@ func TestHandleResponseFiltering_ContentTypeMismatch(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/json"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	responseFilter := &ResponseFilterRequest{
@@ -194,6 +206,10 @@ func TestHandleResponseFiltering_StatusCodeMismatch(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/json"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	responseFilter := &ResponseFilterRequest{
@@ -250,6 +266,10 @@ func TestHandleResponseFiltering_InvalidJQFilter(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/json"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	responseFilter := &ResponseFilterRequest{
@@ -306,6 +326,10 @@ func TestHandleResponseFiltering_SuccessfulJSONFilter(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/json"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	responseFilter := &ResponseFilterRequest{
@@ -374,6 +398,10 @@ func TestHandleResponseFiltering_SuccessfulYAMLFilter(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/yaml"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	responseFilter := &ResponseFilterRequest{
@@ -445,6 +473,10 @@ func TestHandleResponseFiltering_ComplexJQFilter(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/json"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	responseFilter := &ResponseFilterRequest{
@@ -534,6 +566,10 @@ func TestHandleResponseFiltering_FilterError(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/json"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	responseFilter := &ResponseFilterRequest{
@@ -602,6 +638,10 @@ func TestHandleResponseFiltering_ReadBodyError(t *testing.T) {
 			StatusCodes:  []string{"200"},
 			ContentTypes: []string{"application/json"},
 		},
+		RetryPolicy:       nil,
+		BodyEncoding:      nil,
+		XMLResponseSchema: nil,
+		CachePolicy:       nil,
 	}
 
 	responseFilter := &ResponseFilterRequest{
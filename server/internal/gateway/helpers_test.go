@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+// newTestProxy returns a proxy for direct tool calls that may reach any
+// address, including the local test servers.
+func newTestProxy(t *testing.T, responseCache cache.Cache, assets AssetLoader) *ToolProxy {
+	t.Helper()
+
+	policy, err := guardian.NewUnsafePolicy([]string{})
+	require.NoError(t, err)
+
+	return NewToolProxy(
+		testenv.NewLogger(t),
+		testenv.NewTracerProvider(t),
+		testenv.NewMeterProvider(t),
+		ToolCallSourceDirect,
+		responseCache,
+		policy,
+		assets,
+	)
+}
+
+// newTestTool returns a GET tool for the root path of serverURL without
+// parameters, security or policies. Options adjust it for a test.
+func newTestTool(serverURL string, opts ...func(*HTTPTool)) *HTTPTool {
+	tool := &HTTPTool{
+		ID:                 uuid.New().String(),
+		ProjectID:          uuid.New().String(),
+		DeploymentID:       uuid.New().String(),
+		OrganizationID:     uuid.New().String(),
+		Name:               "test_tool",
+		ServerEnvVar:       "TEST_SERVER_URL",
+		DefaultServerUrl:   NullString{Value: serverURL, Valid: true},
+		Security:           []*HTTPToolSecurity{},
+		SecurityScopes:     map[string][]string{},
+		Method:             http.MethodGet,
+		Path:               "/",
+		Schema:             []byte{},
+		HeaderParams:       map[string]*HTTPParameter{},
+		QueryParams:        map[string]*HTTPParameter{},
+		PathParams:         map[string]*HTTPParameter{},
+		RequestContentType: NullString{Value: "", Valid: false},
+		BodyEncoding:       nil,
+		ResponseFilter:     nil,
+		RetryPolicy:        nil,
+		XMLResponseSchema:  nil,
+		CachePolicy:        nil,
+	}
+
+	for _, opt := range opts {
+		opt(tool)
+	}
+
+	return tool
+}
+
+// callTool calls tool through proxy with an empty call body that options can
+// fill in.
+func callTool(t *testing.T, proxy *ToolProxy, tool *HTTPTool, opts ...func(*ToolCallBody)) (*httptest.ResponseRecorder, error) {
+	t.Helper()
+
+	return callToolContext(t.Context(), t, proxy, tool, opts...)
+}
+
+// callToolContext is callTool with a context that can carry call options such
+// as WithAssetFiles.
+func callToolContext(ctx context.Context, t *testing.T, proxy *ToolProxy, tool *HTTPTool, opts ...func(*ToolCallBody)) (*httptest.ResponseRecorder, error) {
+	t.Helper()
+
+	call := ToolCallBody{
+		PathParameters:       nil,
+		QueryParameters:      nil,
+		Headers:              nil,
+		Body:                 nil,
+		ResponseFilter:       nil,
+		EnvironmentVariables: nil,
+		GramRequestSummary:   "",
+	}
+	for _, opt := range opts {
+		opt(&call)
+	}
+
+	payload, err := json.Marshal(call)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	err = proxy.Do(ctx, recorder, bytes.NewReader(payload), map[string]string{}, tool)
+
+	return recorder, err
+}
+
+func withBody(body string) func(*ToolCallBody) {
+	return func(call *ToolCallBody) {
+		call.Body = json.RawMessage(body)
+	}
+}
@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/baggage"
@@ -14,6 +15,16 @@ import (
 
 type metrics struct {
 	toolCallsCounter metric.Int64Counter
+
+	// Upstream connection pool metrics. Together they show how often tool
+	// calls reuse a warm connection rather than paying for a new handshake.
+	upstreamConnsOpen     metric.Int64UpDownCounter
+	upstreamConnsAcquired metric.Int64Counter
+	upstreamConnIdleTime  metric.Float64Histogram
+
+	// responseCacheLookups counts tool calls that were eligible for the
+	// upstream response cache, labelled by whether they were served from it.
+	responseCacheLookups metric.Int64Counter
 }
 
 func newMetrics(meter metric.Meter, logger *slog.Logger) *metrics {
@@ -26,8 +37,49 @@ func newMetrics(meter metric.Meter, logger *slog.Logger) *metrics {
 		logger.ErrorContext(context.Background(), "failed to create tool calls counter", attr.SlogError(err))
 	}
 
+	upstreamConnsOpen, err := meter.Int64UpDownCounter(
+		"tool_proxy.upstream.connections.open",
+		metric.WithDescription("Number of open connections to upstream APIs"),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create open upstream connections counter", attr.SlogError(err))
+	}
+
+	upstreamConnsAcquired, err := meter.Int64Counter(
+		"tool_proxy.upstream.connections.acquired",
+		metric.WithDescription("Number of connections taken from the upstream connection pool, labelled by whether an existing connection was reused"),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create acquired upstream connections counter", attr.SlogError(err))
+	}
+
+	upstreamConnIdleTime, err := meter.Float64Histogram(
+		"tool_proxy.upstream.connections.idle_time",
+		metric.WithDescription("How long a reused upstream connection sat idle in the pool"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 90),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create upstream connection idle time histogram", attr.SlogError(err))
+	}
+
+	responseCacheLookups, err := meter.Int64Counter(
+		"tool_proxy.response_cache.lookups",
+		metric.WithDescription("Number of tool calls looked up in the upstream response cache, labelled by whether they were a hit, a miss or revalidated with the upstream"),
+		metric.WithUnit("{lookup}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create response cache lookups counter", attr.SlogError(err))
+	}
+
 	return &metrics{
-		toolCallsCounter: toolCallsCounter,
+		toolCallsCounter:      toolCallsCounter,
+		upstreamConnsOpen:     upstreamConnsOpen,
+		upstreamConnsAcquired: upstreamConnsAcquired,
+		upstreamConnIdleTime:  upstreamConnIdleTime,
+		responseCacheLookups:  responseCacheLookups,
 	}
 }
 
@@ -50,3 +102,45 @@ func (m *metrics) RecordHTTPToolCall(ctx context.Context, orgID string, toolName
 
 	m.toolCallsCounter.Add(ctx, 1, metric.WithAttributes(kv...))
 }
+
+func (m *metrics) RecordUpstreamConnOpened(ctx context.Context, proxied bool) {
+	if m.upstreamConnsOpen == nil {
+		return
+	}
+
+	m.upstreamConnsOpen.Add(ctx, 1, metric.WithAttributes(attr.HTTPForwardProxy(proxied)))
+}
+
+func (m *metrics) RecordUpstreamConnClosed(ctx context.Context, proxied bool) {
+	if m.upstreamConnsOpen == nil {
+		return
+	}
+
+	m.upstreamConnsOpen.Add(ctx, -1, metric.WithAttributes(attr.HTTPForwardProxy(proxied)))
+}
+
+func (m *metrics) RecordUpstreamConnAcquired(ctx context.Context, proxied bool, reused bool, idleTime time.Duration) {
+	kv := []attribute.KeyValue{
+		attr.HTTPForwardProxy(proxied),
+		attr.HTTPConnReused(reused),
+	}
+
+	if m.upstreamConnsAcquired != nil {
+		m.upstreamConnsAcquired.Add(ctx, 1, metric.WithAttributes(kv...))
+	}
+
+	if reused && m.upstreamConnIdleTime != nil {
+		m.upstreamConnIdleTime.Record(ctx, idleTime.Seconds(), metric.WithAttributes(attr.HTTPForwardProxy(proxied)))
+	}
+}
+
+func (m *metrics) RecordResponseCacheLookup(ctx context.Context, toolName string, result string) {
+	if m == nil || m.responseCacheLookups == nil {
+		return
+	}
+
+	m.responseCacheLookups.Add(ctx, 1, metric.WithAttributes(
+		attr.ToolName(toolName),
+		attr.HTTPResponseCacheResult(result),
+	))
+}
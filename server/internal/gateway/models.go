@@ -38,10 +38,16 @@ type HTTPTool struct {
 	QueryParams        map[string]*HTTPParameter `json:"query_params" yaml:"query_params"`
 	HeaderParams       map[string]*HTTPParameter `json:"header_params" yaml:"header_params"`
 	RequestContentType NullString                `json:"request_content_type" yaml:"request_content_type"`
+	BodyEncoding       map[string]*HTTPEncoding  `json:"body_encoding" yaml:"body_encoding"`
 	Security           []*HTTPToolSecurity       `json:"security" yaml:"security"`
 	SecurityScopes     map[string][]string       `json:"security_scopes" yaml:"security_scopes"`
 
 	ResponseFilter *ResponseFilter `json:"response_filter" yaml:"response_filter"`
+	RetryPolicy    *RetryPolicy    `json:"retry_policy" yaml:"retry_policy"`
+	CachePolicy    *CachePolicy    `json:"cache_policy" yaml:"cache_policy"`
+	// XMLResponseSchema is the JSON schema of the tool's XML response. It
+	// guides the conversion of XML responses to JSON.
+	XMLResponseSchema []byte `json:"xml_response_schema" yaml:"xml_response_schema"`
 }
 
 // HTTPParameter holds the settings for encoding a parameter into an HTTP
@@ -59,6 +65,18 @@ type HTTPParameter struct {
 	AllowEmptyValue bool `json:"allow_empty_value" yaml:"allow_empty_value"`
 }
 
+// HTTPEncoding holds the settings for encoding a property of a multipart
+// request body.
+type HTTPEncoding struct {
+	// ContentType is the content type of the property's part. It may be a
+	// comma-separated list or contain wildcards, in which case the content
+	// type of the value is used.
+	ContentType string `json:"content_type" yaml:"content_type"`
+	// File indicates that the property carries file contents which are given
+	// as base64 data, a URL or a Gram asset reference.
+	File bool `json:"file" yaml:"file"`
+}
+
 // HTTPToolSecurity describes the security requirements for a given HTTP endpoint.
 type HTTPToolSecurity struct {
 	ID           string     `json:"id" yaml:"id"`
@@ -0,0 +1,422 @@
+package gateway
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+const (
+	// maxMultipartFileSize bounds the size of a single file sent in a
+	// multipart request body.
+	maxMultipartFileSize = 50 << 20
+	// multipartFileFetchTimeout bounds downloading a file given by URL.
+	multipartFileFetchTimeout = 30 * time.Second
+)
+
+// ErrAssetNotFound is returned by an AssetLoader when the requested asset
+// does not exist in the project.
+var ErrAssetNotFound = errors.New("asset not found")
+
+// AssetLoader opens files that were uploaded to a Gram project so that they
+// can be sent to upstream APIs in multipart request bodies.
+type AssetLoader interface {
+	OpenAsset(ctx context.Context, projectID uuid.UUID, assetID uuid.UUID) (*AssetFile, error)
+}
+
+type assetFilesKey struct{}
+
+// WithAssetFiles returns a context that allows ToolProxy.Do to send files
+// uploaded to the tool's project, referenced by asset_id, in multipart request
+// bodies. Callers must only set it for requests that are trusted with the
+// project's assets and whose upstream server cannot be chosen by the caller.
+func WithAssetFiles(ctx context.Context) context.Context {
+	return context.WithValue(ctx, assetFilesKey{}, true)
+}
+
+func assetFilesAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(assetFilesKey{}).(bool)
+	return allowed
+}
+
+// AssetFile is an open file loaded by an AssetLoader. Callers must close
+// Body.
+type AssetFile struct {
+	Name        string
+	ContentType string
+	Body        io.ReadCloser
+}
+
+// fileInput is the value a tool call provides for a file field in a multipart
+// request body. Exactly one of Data, URL and AssetID must be set.
+type fileInput struct {
+	Data        string `json:"data"`
+	URL         string `json:"url"`
+	AssetID     string `json:"asset_id"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+}
+
+// loadedFile holds the contents of a file field once its source has been
+// read.
+type loadedFile struct {
+	name        string
+	contentType string
+	data        []byte
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// encodeMultipartBody converts the JSON body of a tool call into a
+// multipart/form-data request body. Each property of the body becomes a part,
+// or one part per item when the property is an array. Properties marked as
+// files in the tool's body encoding are loaded from base64 data, a URL or a
+// Gram asset. It returns the encoded body and the content type, including the
+// boundary, to send it with.
+func (itp *ToolProxy) encodeMultipartBody(ctx context.Context, logger *slog.Logger, tool *HTTPTool, body json.RawMessage) (*bytes.Buffer, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	var fields map[string]any
+	if len(body) > 0 && string(body) != "null" {
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		if err := dec.Decode(&fields); err != nil {
+			return nil, "", oops.E(oops.CodeBadRequest, err, "failed to parse multipart form body").Log(ctx, logger)
+		}
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		value := fields[name]
+		encoding := tool.BodyEncoding[name]
+
+		values := []any{value}
+		if arr, ok := value.([]any); ok {
+			values = arr
+		}
+
+		for _, v := range values {
+			if v == nil {
+				continue
+			}
+
+			if encoding != nil && encoding.File {
+				if err := itp.writeFilePart(ctx, logger, writer, tool, name, encoding, v); err != nil {
+					return nil, "", err
+				}
+				continue
+			}
+
+			if err := writeValuePart(writer, name, encoding, v); err != nil {
+				return nil, "", oops.E(oops.CodeUnexpected, err, "failed to encode multipart form body").Log(ctx, logger)
+			}
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", oops.E(oops.CodeUnexpected, err, "failed to finalize multipart form body").Log(ctx, logger)
+	}
+
+	return &buf, writer.FormDataContentType(), nil
+}
+
+func writeValuePart(writer *multipart.Writer, name string, encoding *HTTPEncoding, value any) error {
+	var data []byte
+	contentType := ""
+
+	switch v := value.(type) {
+	case string:
+		data = []byte(v)
+	case json.Number:
+		data = []byte(v.String())
+	case bool:
+		data = []byte(fmt.Sprintf("%t", v))
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("encode multipart field %q: %w", name, err)
+		}
+		data = encoded
+		contentType = "application/json"
+	}
+
+	if encoding != nil {
+		if ct := concreteContentType(encoding.ContentType); ct != "" {
+			contentType = ct
+		}
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, quoteEscaper.Replace(name)))
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("create multipart field %q: %w", name, err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return fmt.Errorf("write multipart field %q: %w", name, err)
+	}
+
+	return nil
+}
+
+func (itp *ToolProxy) writeFilePart(ctx context.Context, logger *slog.Logger, writer *multipart.Writer, tool *HTTPTool, name string, encoding *HTTPEncoding, value any) error {
+	input, err := parseFileInput(value)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid file for field %q: %s", name, err.Error()).Log(ctx, logger)
+	}
+
+	var file *loadedFile
+	switch {
+	case input.Data != "":
+		file, err = decodeFileData(input.Data)
+		if err != nil {
+			return oops.E(oops.CodeBadRequest, err, "invalid file data for field %q: %s", name, err.Error()).Log(ctx, logger)
+		}
+	case input.URL != "":
+		file, err = itp.fetchFile(ctx, input.URL)
+		if err != nil {
+			return oops.E(oops.CodeBadRequest, err, "failed to download file for field %q: %s", name, err.Error()).Log(ctx, logger)
+		}
+	default:
+		file, err = itp.loadAssetFile(ctx, logger, tool, input.AssetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	filename := cmp.Or(input.Filename, file.name, name)
+	contentType := cmp.Or(input.ContentType, concreteContentType(encoding.ContentType), file.contentType, http.DetectContentType(file.data))
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(name), quoteEscaper.Replace(filename)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to create multipart file part").Log(ctx, logger)
+	}
+	if _, err := part.Write(file.data); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to write multipart file part").Log(ctx, logger)
+	}
+
+	return nil
+}
+
+// parseFileInput reads the value of a file field. Plain strings are accepted
+// as shorthand for a URL, a data URL or base64 data since models commonly
+// produce them even when the schema asks for an object.
+func parseFileInput(value any) (*fileInput, error) {
+	input := &fileInput{Data: "", URL: "", AssetID: "", Filename: "", ContentType: ""}
+
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://") {
+			input.URL = v
+		} else {
+			input.Data = v
+		}
+		return input, nil
+	case map[string]any:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("encode file input: %w", err)
+		}
+		if err := json.Unmarshal(encoded, input); err != nil {
+			return nil, fmt.Errorf("expected an object with data, url or asset_id: %w", err)
+		}
+	default:
+		return nil, errors.New("expected an object with data, url or asset_id")
+	}
+
+	sources := 0
+	for _, s := range []string{input.Data, input.URL, input.AssetID} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, errors.New("provide exactly one of data, url or asset_id")
+	}
+
+	return input, nil
+}
+
+// decodeFileData decodes base64 file contents or a data URL.
+func decodeFileData(data string) (*loadedFile, error) {
+	file := &loadedFile{name: "", contentType: "", data: nil}
+
+	if rest, ok := strings.CutPrefix(data, "data:"); ok {
+		meta, payload, found := strings.Cut(rest, ",")
+		if !found {
+			return nil, errors.New("malformed data URL")
+		}
+
+		mediaType, isBase64 := strings.CutSuffix(meta, ";base64")
+		if mediaType != "" {
+			file.contentType = mediaType
+		}
+
+		if !isBase64 {
+			unescaped, err := url.PathUnescape(payload)
+			if err != nil {
+				return nil, fmt.Errorf("decode data URL: %w", err)
+			}
+			file.data = []byte(unescaped)
+			return file, checkFileSize(len(file.data))
+		}
+		data = payload
+	}
+
+	data = strings.TrimSpace(data)
+	if err := checkFileSize(base64.RawStdEncoding.DecodedLen(len(strings.TrimRight(data, "=")))); err != nil {
+		return nil, err
+	}
+
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		decoded, err := enc.DecodeString(data)
+		if err == nil {
+			file.data = decoded
+			return file, checkFileSize(len(decoded))
+		}
+	}
+
+	return nil, errors.New("data is not valid base64")
+}
+
+// fetchFile downloads a file given by URL. The request is subject to the same
+// guardian policy as upstream requests.
+func (itp *ToolProxy) fetchFile(ctx context.Context, rawURL string) (*loadedFile, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.New("invalid url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported url scheme %q", u.Scheme)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, multipartFileFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+
+	client, _, err := itp.transports.client(req, itp.policy)
+	if err != nil {
+		return nil, fmt.Errorf("prepare connection: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.New("request failed")
+	}
+	defer o11y.NoLogDefer(func() error { return resp.Body.Close() })
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxMultipartFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if err := checkFileSize(len(data)); err != nil {
+		return nil, err
+	}
+
+	contentType := ""
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		contentType = mediaType
+	}
+
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		name = ""
+	}
+
+	return &loadedFile{name: name, contentType: contentType, data: data}, nil
+}
+
+func (itp *ToolProxy) loadAssetFile(ctx context.Context, logger *slog.Logger, tool *HTTPTool, rawAssetID string) (*loadedFile, error) {
+	if itp.assets == nil || !assetFilesAllowed(ctx) {
+		return nil, oops.E(oops.CodeBadRequest, nil, "files from Gram assets cannot be sent by this tool call").Log(ctx, logger)
+	}
+
+	assetID, err := uuid.Parse(rawAssetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid asset id: %s", rawAssetID).Log(ctx, logger)
+	}
+
+	projectID, err := uuid.Parse(tool.ProjectID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "invalid tool project id").Log(ctx, logger)
+	}
+
+	asset, err := itp.assets.OpenAsset(ctx, projectID, assetID)
+	switch {
+	case errors.Is(err, ErrAssetNotFound):
+		return nil, oops.E(oops.CodeBadRequest, err, "asset not found: %s", assetID).Log(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to open asset").Log(ctx, logger, attr.SlogAssetID(assetID.String()))
+	}
+	defer o11y.LogDefer(ctx, logger, func() error { return asset.Body.Close() })
+
+	data, err := io.ReadAll(io.LimitReader(asset.Body, maxMultipartFileSize+1))
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to read asset").Log(ctx, logger, attr.SlogAssetID(assetID.String()))
+	}
+	if err := checkFileSize(len(data)); err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "asset %s is too large: %s", assetID, err.Error()).Log(ctx, logger)
+	}
+
+	return &loadedFile{name: asset.Name, contentType: asset.ContentType, data: data}, nil
+}
+
+func checkFileSize(size int) error {
+	if size > maxMultipartFileSize {
+		return fmt.Errorf("files must not be larger than %d MiB", maxMultipartFileSize>>20)
+	}
+	return nil
+}
+
+// concreteContentType returns the content type set in an encoding object if
+// it names a single, concrete media type and an empty string otherwise.
+func concreteContentType(contentType string) string {
+	contentType = strings.TrimSpace(contentType)
+	if contentType == "" || strings.ContainsAny(contentType, ",*") {
+		return ""
+	}
+	return contentType
+}
@@ -0,0 +1,196 @@
+package gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAssetLoader struct {
+	assets map[uuid.UUID]string
+}
+
+func (f *fakeAssetLoader) OpenAsset(_ context.Context, _ uuid.UUID, assetID uuid.UUID) (*AssetFile, error) {
+	contents, ok := f.assets[assetID]
+	if !ok {
+		return nil, ErrAssetNotFound
+	}
+
+	return &AssetFile{
+		Name:        "notes.txt",
+		ContentType: "text/plain",
+		Body:        io.NopCloser(strings.NewReader(contents)),
+	}, nil
+}
+
+type receivedPart struct {
+	filename    string
+	contentType string
+	data        string
+}
+
+// multipartServer records the parts of the multipart requests it receives.
+func multipartServer(t *testing.T) (*httptest.Server, map[string][]receivedPart) {
+	t.Helper()
+
+	parts := make(map[string][]receivedPart)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, err := r.MultipartReader()
+		if err != nil {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			data, _ := io.ReadAll(part)
+			parts[part.FormName()] = append(parts[part.FormName()], receivedPart{
+				filename:    part.FileName(),
+				contentType: part.Header.Get("Content-Type"),
+				data:        string(data),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, parts
+}
+
+func callMultipartTool(ctx context.Context, t *testing.T, serverURL string, assets AssetLoader, body string) (*httptest.ResponseRecorder, error) {
+	t.Helper()
+
+	tool := newTestTool(serverURL, func(tool *HTTPTool) {
+		tool.Name = "upload_document"
+		tool.Method = http.MethodPost
+		tool.Path = "/documents"
+		tool.RequestContentType = NullString{Value: "multipart/form-data", Valid: true}
+		tool.BodyEncoding = map[string]*HTTPEncoding{
+			"metadata":    {ContentType: "application/json", File: false},
+			"cover":       {ContentType: "image/png", File: true},
+			"attachments": {ContentType: "", File: true},
+		}
+	})
+
+	return callToolContext(ctx, t, newTestProxy(t, nil, assets), tool, withBody(body))
+}
+
+func TestToolProxy_Do_MultipartFormData(t *testing.T) {
+	t.Parallel()
+
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte("%PDF-1.7"))
+	}))
+	t.Cleanup(files.Close)
+
+	server, parts := multipartServer(t)
+
+	assetID := uuid.New()
+	loader := &fakeAssetLoader{assets: map[uuid.UUID]string{assetID: "meeting notes"}}
+
+	body, err := json.Marshal(map[string]any{
+		"title":    "Quarterly report",
+		"pages":    12,
+		"tags":     []string{"finance", "q3"},
+		"metadata": map[string]any{"author": "ops"},
+		"cover": map[string]any{
+			"data":     base64.StdEncoding.EncodeToString([]byte("cover image")),
+			"filename": "cover.png",
+		},
+		"attachments": []any{
+			map[string]any{"url": files.URL + "/files/report.pdf"},
+			map[string]any{"asset_id": assetID.String()},
+		},
+	})
+	require.NoError(t, err)
+
+	recorder, err := callMultipartTool(WithAssetFiles(t.Context()), t, server.URL, loader, string(body))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	require.Equal(t, []receivedPart{{filename: "", contentType: "", data: "Quarterly report"}}, parts["title"])
+	require.Equal(t, []receivedPart{{filename: "", contentType: "", data: "12"}}, parts["pages"])
+	require.Equal(t, []receivedPart{
+		{filename: "", contentType: "", data: "finance"},
+		{filename: "", contentType: "", data: "q3"},
+	}, parts["tags"])
+	require.Equal(t, []receivedPart{{filename: "", contentType: "application/json", data: `{"author":"ops"}`}}, parts["metadata"])
+	require.Equal(t, []receivedPart{{filename: "cover.png", contentType: "image/png", data: "cover image"}}, parts["cover"])
+	require.Equal(t, []receivedPart{
+		{filename: "report.pdf", contentType: "application/pdf", data: "%PDF-1.7"},
+		{filename: "notes.txt", contentType: "text/plain", data: "meeting notes"},
+	}, parts["attachments"])
+}
+
+func TestToolProxy_Do_MultipartFileShorthand(t *testing.T) {
+	t.Parallel()
+
+	server, parts := multipartServer(t)
+
+	recorder, err := callMultipartTool(t.Context(), t, server.URL, nil, `{"attachments":["data:text/csv;base64,YSxiCjEsMg=="]}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	require.Equal(t, []receivedPart{{filename: "attachments", contentType: "text/csv", data: "a,b\n1,2"}}, parts["attachments"])
+}
+
+func TestToolProxy_Do_MultipartRejectsInvalidFiles(t *testing.T) {
+	t.Parallel()
+
+	server, _ := multipartServer(t)
+
+	tests := map[string]string{
+		"several sources":  `{"cover":{"data":"aGVsbG8=","url":"https://example.com/cover.png"}}`,
+		"no source":        `{"cover":{"filename":"cover.png"}}`,
+		"invalid base64":   `{"cover":{"data":"not base64!"}}`,
+		"assets disabled":  `{"cover":{"asset_id":"` + uuid.NewString() + `"}}`,
+		"unsupported type": `{"cover":42}`,
+	}
+
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := callMultipartTool(t.Context(), t, server.URL, nil, body)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestToolProxy_Do_MultipartAssetsNeedPermission(t *testing.T) {
+	t.Parallel()
+
+	server, parts := multipartServer(t)
+
+	assetID := uuid.New()
+	loader := &fakeAssetLoader{assets: map[uuid.UUID]string{assetID: "meeting notes"}}
+	body := `{"attachments":[{"asset_id":"` + assetID.String() + `"}]}`
+
+	_, err := callMultipartTool(t.Context(), t, server.URL, loader, body)
+	require.ErrorContains(t, err, "files from Gram assets cannot be sent by this tool call")
+	require.Empty(t, parts["attachments"])
+
+	recorder, err := callMultipartTool(WithAssetFiles(t.Context()), t, server.URL, loader, body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, []receivedPart{{filename: "notes.txt", contentType: "text/plain", data: "meeting notes"}}, parts["attachments"])
+}
@@ -0,0 +1,90 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// progressReportInterval is the number of response bytes read between
+// progress updates.
+const progressReportInterval = 256 * 1024
+
+// ProgressFunc receives human readable updates as a tool call talks to the
+// upstream server, such as retries and response bytes received.
+type ProgressFunc func(ctx context.Context, message string)
+
+type progressKey struct{}
+
+// WithProgress returns a context that causes ToolProxy.Do to report its
+// progress to fn.
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+func progressFromContext(ctx context.Context) ProgressFunc {
+	fn, _ := ctx.Value(progressKey{}).(ProgressFunc)
+	return fn
+}
+
+func reportProgress(ctx context.Context, format string, args ...any) {
+	if fn := progressFromContext(ctx); fn != nil {
+		fn(ctx, fmt.Sprintf(format, args...))
+	}
+}
+
+// progressReader reports how much of an upstream response body has been
+// read.
+type progressReader struct {
+	io.ReadCloser
+	ctx      context.Context
+	total    int64
+	read     int64
+	reported int64
+}
+
+func newProgressReader(ctx context.Context, body io.ReadCloser, total int64) io.ReadCloser {
+	if progressFromContext(ctx) == nil {
+		return body
+	}
+
+	return &progressReader{
+		ReadCloser: body,
+		ctx:        ctx,
+		total:      total,
+		read:       0,
+		reported:   0,
+	}
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.read += int64(n)
+
+	if r.read-r.reported >= progressReportInterval || (err != nil && r.read > r.reported) {
+		r.reported = r.read
+		if r.total > 0 {
+			reportProgress(r.ctx, "Received %s of %s from upstream", formatBytes(r.read), formatBytes(r.total))
+		} else {
+			reportProgress(r.ctx, "Received %s from upstream", formatBytes(r.read))
+		}
+	}
+
+	//nolint:wrapcheck // Read errors such as io.EOF must be returned as is
+	return n, err
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
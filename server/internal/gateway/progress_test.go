@@ -0,0 +1,37 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressReader_ReportsBytesReceived(t *testing.T) {
+	t.Parallel()
+
+	var messages []string
+	ctx := WithProgress(t.Context(), func(_ context.Context, message string) {
+		messages = append(messages, message)
+	})
+
+	total := int64(progressReportInterval + 1024)
+	body := newProgressReader(ctx, io.NopCloser(bytes.NewReader(make([]byte, total))), total)
+
+	n, err := io.Copy(io.Discard, body)
+	require.NoError(t, err)
+	require.Equal(t, total, n)
+	require.Equal(t, []string{
+		"Received 256.0 KiB of 257.0 KiB from upstream",
+		"Received 257.0 KiB of 257.0 KiB from upstream",
+	}, messages)
+}
+
+func TestProgressReader_NoListener(t *testing.T) {
+	t.Parallel()
+
+	body := io.NopCloser(bytes.NewReader([]byte("hello")))
+	require.Equal(t, body, newProgressReader(t.Context(), body, 5))
+}
@@ -8,15 +8,13 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"mime"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
-	"runtime"
 	"slices"
-	"strconv"
 	"strings"
-	"time"
 
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
@@ -24,6 +22,7 @@ import (
 
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/contenttypes"
 	"github.com/speakeasy-api/gram/server/internal/guardian"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oops"
@@ -100,12 +99,15 @@ type InstanceToolProxyConfig struct {
 }
 
 type ToolProxy struct {
-	source  ToolCallSource
-	logger  *slog.Logger
-	tracer  trace.Tracer
-	metrics *metrics
-	cache   cache.Cache
-	policy  *guardian.Policy
+	source     ToolCallSource
+	logger     *slog.Logger
+	tracer     trace.Tracer
+	metrics    *metrics
+	cache      cache.Cache
+	policy     *guardian.Policy
+	assets     AssetLoader
+	transports *transportPool
+	responses  *responseCache
 }
 
 func NewToolProxy(
@@ -115,17 +117,23 @@ func NewToolProxy(
 	source ToolCallSource,
 	cache cache.Cache,
 	policy *guardian.Policy,
+	assets AssetLoader,
 ) *ToolProxy {
 	tracer := tracerProivder.Tracer("github.com/speakeasy-api/gram/server/internal/gateway")
 	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/gateway")
 
+	metrics := newMetrics(meter, logger)
+
 	return &ToolProxy{
-		source:  source,
-		logger:  logger,
-		tracer:  tracer,
-		metrics: newMetrics(meter, logger),
-		cache:   cache,
-		policy:  policy,
+		source:     source,
+		logger:     logger,
+		tracer:     tracer,
+		metrics:    metrics,
+		cache:      cache,
+		policy:     policy,
+		assets:     assets,
+		transports: newTransportPool(metrics),
+		responses:  newResponseCache(cache, metrics),
 	}
 }
 
@@ -146,7 +154,13 @@ func (itp *ToolProxy) Do(
 	))
 	defer span.End()
 
-	logger := itp.logger.With(
+	ciEnv := newCaseInsensitiveEnv(envVars)
+
+	logger := itp.logger
+	if listener := diagnosticsFromContext(ctx); listener != nil {
+		logger = slog.New(newDiagnosticsHandler(logger.Handler(), listener, &diagnosticsScrubber{tool: tool, env: ciEnv}))
+	}
+	logger = logger.With(
 		attr.SlogProjectID(tool.ProjectID),
 		attr.SlogDeploymentID(tool.DeploymentID),
 		attr.SlogToolID(tool.ID),
@@ -169,8 +183,6 @@ func (itp *ToolProxy) Do(
 		span.SetAttributes(attr.HTTPResponseStatusCode(responseStatusCode))
 	}()
 
-	ciEnv := newCaseInsensitiveEnv(envVars)
-
 	bodyBytes, err := io.ReadAll(requestBody)
 	if err != nil {
 		return oops.E(oops.CodeBadRequest, err, "failed to read request body").Log(ctx, logger)
@@ -242,12 +254,14 @@ func (itp *ToolProxy) Do(
 
 	// Get the server URL from the tool definition
 	var serverURL string
+	serverURLSource := "tool definition"
 	if tool.DefaultServerUrl.Valid {
 		serverURL = tool.DefaultServerUrl.Value
 	}
 
 	if envServerURL := processServerEnvVars(ctx, logger, tool, ciEnv); envServerURL != "" {
 		serverURL = envServerURL
+		serverURLSource = tool.ServerEnvVar
 	}
 
 	if serverURL == "" {
@@ -267,7 +281,8 @@ func (itp *ToolProxy) Do(
 	}
 
 	var req *http.Request
-	if strings.HasPrefix(tool.RequestContentType.Value, "application/x-www-form-urlencoded") {
+	switch {
+	case strings.HasPrefix(tool.RequestContentType.Value, "application/x-www-form-urlencoded"):
 		encoded := ""
 		if len(toolCallBody.Body) > 0 {
 			// Assume toolCallBody.Body is a JSON object (map[string]interface{})
@@ -291,7 +306,37 @@ func (itp *ToolProxy) Do(
 			return oops.E(oops.CodeUnexpected, err, "failed to build url-encoded request").Log(ctx, logger)
 		}
 		req.Header.Set("Content-Type", tool.RequestContentType.Value)
-	} else {
+	case strings.HasPrefix(tool.RequestContentType.Value, "multipart/form-data"):
+		body, contentType, encodeErr := itp.encodeMultipartBody(ctx, logger, tool, toolCallBody.Body)
+		if encodeErr != nil {
+			return encodeErr
+		}
+		req, err = http.NewRequestWithContext(
+			ctx,
+			tool.Method,
+			fullURL,
+			bytes.NewReader(body.Bytes()),
+		)
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "failed to build multipart request").Log(ctx, logger)
+		}
+		req.Header.Set("Content-Type", contentType)
+	case contenttypes.IsXML(tool.RequestContentType.Value):
+		body, encodeErr := encodeXMLBody(tool.Schema, toolCallBody.Body)
+		if encodeErr != nil {
+			return oops.E(oops.CodeBadRequest, encodeErr, "failed to encode xml body").Log(ctx, logger)
+		}
+		req, err = http.NewRequestWithContext(
+			ctx,
+			tool.Method,
+			fullURL,
+			bytes.NewReader(body),
+		)
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "failed to build xml request").Log(ctx, logger)
+		}
+		req.Header.Set("Content-Type", tool.RequestContentType.Value)
+	default:
 		req, err = http.NewRequestWithContext(
 			ctx,
 			tool.Method,
@@ -368,62 +413,12 @@ func (itp *ToolProxy) Do(
 
 	req.Header.Set("X-Gram-Proxy", "1")
 
-	return reverseProxyRequest(ctx, logger, itp.tracer, tool, toolCallBody.ResponseFilter, w, req, itp.policy, &responseStatusCode)
-}
-
-type retryConfig struct {
-	initialInterval time.Duration
-	maxInterval     time.Duration
-	maxAttempts     int
-	backoffFactor   float64
-	statusCodes     []int    // HTTP status codes to retry on
-	methods         []string // HTTP methods to retry on
-}
-
-func retryWithBackoff(
-	ctx context.Context,
-	retryBackoff retryConfig,
-	doRequest func() (*http.Response, error),
-) (*http.Response, error) {
-	var resp *http.Response
-	var err error
-	delayInterval := retryBackoff.initialInterval
-	for attempt := 0; attempt < retryBackoff.maxAttempts; attempt++ {
-		if attempt > 0 {
-			select {
-			case <-time.After(delayInterval):
-			case <-ctx.Done():
-				return nil, fmt.Errorf("retry context done: %w", ctx.Err())
-			}
-
-			delayInterval = min(time.Duration(float64(delayInterval)*retryBackoff.backoffFactor), retryBackoff.maxInterval)
-		}
-		resp, err = doRequest()
-		// retry by default on gateway errors
-		if err != nil {
-			continue
-		}
-
-		if !slices.Contains(retryBackoff.methods, resp.Request.Method) || !slices.Contains(retryBackoff.statusCodes, resp.StatusCode) {
-			return resp, err
-		}
-
-		if retryAfter := resp.Header.Get("retry-after"); retryAfter != "" {
-			if parsedNumber, err := strconv.ParseInt(retryAfter, 10, 64); err == nil && parsedNumber > 0 {
-				retryAfterDuration := time.Duration(parsedNumber) * time.Second
-				delayInterval = min(retryAfterDuration, retryBackoff.maxInterval)
-				continue
-			}
+	logger.DebugContext(ctx, fmt.Sprintf("sending %s request to %s (server url from %s)", req.Method, req.URL.Host, serverURLSource),
+		attr.SlogHTTPRequestMethod(req.Method),
+		attr.SlogURLDomain(req.URL.Host),
+	)
 
-			if parsedDate, err := time.Parse(time.RFC1123, retryAfter); err == nil {
-				retryAfterDuration := time.Until(parsedDate)
-				if retryAfterDuration > 0 {
-					delayInterval = min(retryAfterDuration, retryBackoff.maxInterval)
-				}
-			}
-		}
-	}
-	return resp, err
+	return reverseProxyRequest(ctx, logger, itp.tracer, tool, toolCallBody.ResponseFilter, w, req, itp.transports, itp.responses, itp.policy, &responseStatusCode)
 }
 
 func reverseProxyRequest(ctx context.Context,
@@ -433,31 +428,43 @@ func reverseProxyRequest(ctx context.Context,
 	responseFilter *ResponseFilterRequest,
 	w http.ResponseWriter,
 	req *http.Request,
+	transports *transportPool,
+	responses *responseCache,
 	policy *guardian.Policy,
 	responseStatusCodeCapture *int,
 ) error {
 	ctx, span := tracer.Start(ctx, fmt.Sprintf("tool_proxy.%s", tool.Name))
 	defer span.End()
 
-	transport := &http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
-		DialContext:           policy.Dialer().DialContext,
-		MaxIdleConns:          100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
-		ForceAttemptHTTP2:     true,
-		MaxIdleConnsPerHost:   runtime.GOMAXPROCS(0) + 1,
+	client, transportKey, err := transports.client(req, policy)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return oops.E(oops.CodeGatewayError, err, "failed to prepare upstream connection").Log(ctx, logger)
 	}
-
-	client := &http.Client{
-		Timeout:   60 * time.Second,
-		Transport: transport,
+	retries := retryConfigFor(tool.RetryPolicy)
+	if retries.maxElapsedTime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, retries.maxElapsedTime)
+		defer cancel()
 	}
+	tracedCtx := transports.traceConnections(ctx, transportKey)
+
+	// Each attempt gets its own timeout. The attempts' contexts stay alive
+	// until the response has been relayed because cancelling them would cut
+	// off the response body.
+	var cancelAttempts []context.CancelFunc
+	defer func() {
+		for _, cancel := range cancelAttempts {
+			cancel()
+		}
+	}()
 
 	executeRequest := func() (*http.Response, error) {
+		attemptCtx, cancel := context.WithTimeout(tracedCtx, retries.attemptTimeout)
+		cancelAttempts = append(cancelAttempts, cancel)
+
 		// Clone the request for each retry attempt
-		retryReq := req.Clone(ctx)
+		retryReq := req.Clone(attemptCtx)
 
 		// Set fresh body on the cloned request
 		if req.Body != nil && req.GetBody != nil {
@@ -470,32 +477,24 @@ func reverseProxyRequest(ctx context.Context,
 
 		return client.Do(retryReq)
 	}
-	resp, err := retryWithBackoff(ctx, retryConfig{
-		initialInterval: 500 * time.Millisecond,
-		maxInterval:     5 * time.Second,
-		maxAttempts:     3,
-		backoffFactor:   2,
-		statusCodes: []int{ // reasonable status code presets
-			408, // Request Timeout
-			429, // Rate Limit Exceeded
-			500, // Internal Server Error
-			502, // Bad Gateway
-			503, // Service Unavailable
-			504, // Gateway Timeout
-			509, // Bandwidth Limit Exceeded
-			521, // Web Server Is Down (Cloudflare)
-			522, // Connection Timed Out (Cloudflare)
-			523, // Origin Is Unreachable (Cloudflare)
-			524, // A Timeout Occurred (Cloudflare)
-		},
-		methods: []string{
-			http.MethodGet,
-		},
-	}, executeRequest)
+	resp, cacheResult, err := responses.do(ctx, logger, tool, req, func() (*http.Response, error) {
+		return retryWithBackoff(ctx, logger, retries, executeRequest)
+	})
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
+		if tool.Retryable() {
+			err = oops.Temp(err)
+		}
 		return oops.E(oops.CodeGatewayError, err, "failed to execute request").Log(ctx, logger)
 	}
+	if cacheResult != "" {
+		span.SetAttributes(attr.HTTPResponseCacheResult(cacheResult))
+		w.Header().Set(HeaderResponseCache, cacheResult)
+	}
+	isEventStream := strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream")
+	if !isEventStream {
+		resp.Body = newProgressReader(ctx, resp.Body, resp.ContentLength)
+	}
 	defer o11y.LogDefer(ctx, logger, func() error {
 		return resp.Body.Close()
 	})
@@ -526,7 +525,7 @@ func reverseProxyRequest(ctx context.Context,
 	w.Header().Set(HeaderProxiedResponse, "1")
 
 	finalStatusCode := resp.StatusCode
-	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+	if isEventStream {
 		w.WriteHeader(finalStatusCode)
 
 		// Streaming mode: flush after each chunk
@@ -534,6 +533,7 @@ func reverseProxyRequest(ctx context.Context,
 
 		buf := make([]byte, 32*1024)
 		flusher, canFlush := w.(http.Flusher)
+		var streamed int64
 
 		for {
 			n, err := resp.Body.Read(buf)
@@ -545,6 +545,8 @@ func reverseProxyRequest(ctx context.Context,
 				if canFlush {
 					flusher.Flush()
 				}
+				streamed += int64(n)
+				reportProgress(ctx, "Received %s of event stream data from upstream", formatBytes(streamed))
 			}
 			if err != nil {
 				if err != io.EOF {
@@ -564,6 +566,14 @@ func reverseProxyRequest(ctx context.Context,
 			span.SetAttributes(attr.HTTPResponseFiltered(true))
 			finalStatusCode = result.statusCode
 			body = result.resp
+			w.Header().Del("Content-Length")
+		} else {
+			var converted bool
+			body, converted = convertXMLResponse(ctx, logger, tool, resp)
+			if converted {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Del("Content-Length")
+			}
 		}
 
 		w.WriteHeader(finalStatusCode)
@@ -580,13 +590,43 @@ func reverseProxyRequest(ctx context.Context,
 	return nil
 }
 
+// convertXMLResponse converts an XML response body to JSON and reports whether
+// it did. The original body is returned when the response is not XML or
+// cannot be converted.
+func convertXMLResponse(ctx context.Context, logger *slog.Logger, tool *HTTPTool, resp *http.Response) (io.Reader, bool) {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || !contenttypes.IsXML(mediaType) {
+		return resp.Body, false
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to read xml response body", attr.SlogError(err))
+		return bytes.NewReader(data), false
+	}
+
+	value, err := decodeXMLDocument(data, tool.XMLResponseSchema)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to convert xml response to json", attr.SlogError(err))
+		return bytes.NewReader(data), false
+	}
+
+	converted, err := json.Marshal(value)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to encode converted xml response", attr.SlogError(err))
+		return bytes.NewReader(data), false
+	}
+
+	return bytes.NewReader(converted), true
+}
+
 func processServerEnvVars(ctx context.Context, logger *slog.Logger, tool *HTTPTool, envVars *caseInsensitiveEnv) string {
 	if tool.ServerEnvVar != "" {
 		envVar := envVars.Get(tool.ServerEnvVar)
 		if envVar != "" {
 			return envVar
 		} else {
-			logger.WarnContext(ctx, "provided variables for server not found", attr.SlogEnvVarName(tool.ServerEnvVar))
+			logger.WarnContext(ctx, fmt.Sprintf("server url variable %s is not set, falling back to the tool's default server url", tool.ServerEnvVar), attr.SlogEnvVarName(tool.ServerEnvVar))
 		}
 	}
 	return ""
@@ -190,6 +190,10 @@ func TestToolProxy_Do_PathParams(t *testing.T) {
 				PathParams:         map[string]*HTTPParameter{},
 				RequestContentType: NullString{Value: "application/json", Valid: true},
 				ResponseFilter:     nil,
+				RetryPolicy:        nil,
+				BodyEncoding:       nil,
+				XMLResponseSchema:  nil,
+				CachePolicy:        nil,
 			}
 
 			// Add path parameter configuration for the parameter in the test
@@ -224,6 +228,7 @@ func TestToolProxy_Do_PathParams(t *testing.T) {
 				ToolCallSourceDirect,
 				nil, // no cache needed for this test
 				policy,
+				nil,
 			)
 
 			// Create response recorder
@@ -544,6 +549,10 @@ func TestToolProxy_Do_QueryParams(t *testing.T) {
 				PathParams:         map[string]*HTTPParameter{},
 				RequestContentType: NullString{Value: "application/json", Valid: true},
 				ResponseFilter:     nil,
+				RetryPolicy:        nil,
+				BodyEncoding:       nil,
+				XMLResponseSchema:  nil,
+				CachePolicy:        nil,
 			}
 
 			// Create request body with query parameters
@@ -568,6 +577,7 @@ func TestToolProxy_Do_QueryParams(t *testing.T) {
 				ToolCallSourceDirect,
 				nil, // no cache needed for this test
 				policy,
+				nil,
 			)
 
 			// Create response recorder
@@ -757,6 +767,10 @@ func TestToolProxy_Do_Body(t *testing.T) {
 				PathParams:         map[string]*HTTPParameter{},
 				RequestContentType: NullString{Value: tt.contentType, Valid: true},
 				ResponseFilter:     nil,
+				RetryPolicy:        nil,
+				BodyEncoding:       nil,
+				XMLResponseSchema:  nil,
+				CachePolicy:        nil,
 			}
 
 			// Marshal the test request body
@@ -785,6 +799,7 @@ func TestToolProxy_Do_Body(t *testing.T) {
 				ToolCallSourceDirect,
 				nil, // no cache needed for this test
 				policy,
+				nil,
 			)
 
 			// Create response recorder
@@ -0,0 +1,365 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+)
+
+const (
+	// HeaderResponseCache reports whether a response was served from the
+	// upstream response cache.
+	HeaderResponseCache = "X-Gram-Proxy-ResponseCache"
+
+	// maxCachedResponseSize caps the size of response bodies that are stored
+	// in the response cache. Larger responses are passed through.
+	maxCachedResponseSize = 4 << 20
+	// staleResponseRetention is how long responses that carry validators are
+	// kept past their freshness so that they can be revalidated with the
+	// upstream rather than fetched again.
+	staleResponseRetention = time.Hour
+)
+
+// Outcomes of looking up a request in the response cache.
+const (
+	responseCacheHit         = "hit"
+	responseCacheMiss        = "miss"
+	responseCacheRevalidated = "revalidated"
+)
+
+// CachePolicy opts a tool into caching upstream responses to GET and HEAD
+// requests.
+type CachePolicy struct {
+	// TTL, when positive, is how long responses are served from the cache. It
+	// takes precedence over the upstream's caching headers. When zero, the
+	// upstream's Cache-Control and Expires headers decide.
+	TTL time.Duration `json:"ttl" yaml:"ttl"`
+}
+
+// cachedResponse is an upstream response held in the response cache.
+type cachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	// Vary holds the values of the request headers named by the response's
+	// Vary header. The entry only answers requests with the same values.
+	Vary      map[string]string `json:"vary"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
+
+// responseCache serves repeated tool calls from responses previously returned
+// by the upstream. It is only used for tools whose cache policy opts in.
+type responseCache struct {
+	cache   cache.Cache
+	metrics *metrics
+}
+
+func newResponseCache(c cache.Cache, metrics *metrics) *responseCache {
+	return &responseCache{
+		cache:   c,
+		metrics: metrics,
+	}
+}
+
+// do answers req from the cache when it holds a fresh response, revalidates a
+// stale response with the upstream when it can, and otherwise calls execute and
+// stores the response for later calls. The returned outcome is empty when the
+// request bypassed the cache.
+func (c *responseCache) do(ctx context.Context, logger *slog.Logger, tool *HTTPTool, req *http.Request, execute func() (*http.Response, error)) (*http.Response, string, error) {
+	if c == nil || c.cache == nil || !isCacheableRequest(tool, req) {
+		resp, err := execute()
+		return resp, "", err
+	}
+
+	key, err := responseCacheKey(tool, req)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to compute response cache key", attr.SlogError(err))
+		resp, err := execute()
+		return resp, "", err
+	}
+
+	var entry *cachedResponse
+	var stored cachedResponse
+	if err := c.cache.Get(ctx, key, &stored); err == nil && stored.matches(req) {
+		entry = &stored
+	}
+
+	if entry != nil && time.Now().Before(entry.ExpiresAt) {
+		c.metrics.RecordResponseCacheLookup(ctx, tool.Name, responseCacheHit)
+		return entry.response(req), responseCacheHit, nil
+	}
+
+	if entry != nil {
+		if etag := entry.Header.Get("ETag"); etag != "" && req.Header.Get("If-None-Match") == "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if modified := entry.Header.Get("Last-Modified"); modified != "" && req.Header.Get("If-Modified-Since") == "" {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := execute()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if entry != nil && resp.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		if err := resp.Body.Close(); err != nil {
+			logger.WarnContext(ctx, "failed to close not modified response body", attr.SlogError(err))
+		}
+
+		// Headers sent with a 304 response update those of the stored
+		// response, which may extend its freshness.
+		for name, values := range resp.Header {
+			if name != "Content-Length" {
+				entry.Header[name] = values
+			}
+		}
+		c.store(ctx, logger, tool, key, entry)
+
+		c.metrics.RecordResponseCacheLookup(ctx, tool.Name, responseCacheRevalidated)
+		return entry.response(req), responseCacheRevalidated, nil
+	}
+
+	c.metrics.RecordResponseCacheLookup(ctx, tool.Name, responseCacheMiss)
+	return c.storeResponse(ctx, logger, tool, key, req, resp), responseCacheMiss, nil
+}
+
+// storeResponse stores resp in the cache when it may be reused and returns a
+// response with an equivalent body for the caller to relay.
+func (c *responseCache) storeResponse(ctx context.Context, logger *slog.Logger, tool *HTTPTool, key string, req *http.Request, resp *http.Response) *http.Response {
+	if resp.StatusCode != http.StatusOK || strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		return resp
+	}
+
+	vary := make(map[string]string)
+	for _, value := range resp.Header.Values("Vary") {
+		for name := range strings.SplitSeq(value, ",") {
+			name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+			switch name {
+			case "":
+			case "*":
+				return resp
+			default:
+				vary[name] = req.Header.Get(name)
+			}
+		}
+	}
+
+	entry := &cachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       nil,
+		Vary:       vary,
+		ExpiresAt:  time.Time{},
+	}
+	// Cookies are never replayed to other calls.
+	entry.Header.Del("Set-Cookie")
+
+	if _, ok := entry.freshness(tool.CachePolicy); !ok {
+		return resp
+	}
+
+	body := resp.Body
+	data, err := io.ReadAll(io.LimitReader(body, maxCachedResponseSize+1))
+	if err != nil || len(data) > maxCachedResponseSize {
+		// Hand back what was read followed by the rest of the body so that
+		// the caller sees the response, or the read error, as the upstream
+		// sent it.
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), body), body}
+		return resp
+	}
+	if err := body.Close(); err != nil {
+		logger.WarnContext(ctx, "failed to close upstream response body", attr.SlogError(err))
+	}
+
+	entry.Body = data
+	c.store(ctx, logger, tool, key, entry)
+
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	resp.ContentLength = int64(len(data))
+	return resp
+}
+
+func (c *responseCache) store(ctx context.Context, logger *slog.Logger, tool *HTTPTool, key string, entry *cachedResponse) {
+	freshness, ok := entry.freshness(tool.CachePolicy)
+	if !ok {
+		if err := c.cache.Delete(ctx, key); err != nil {
+			logger.WarnContext(ctx, "failed to delete cached response", attr.SlogCacheKey(key), attr.SlogError(err))
+		}
+		return
+	}
+
+	entry.ExpiresAt = time.Now().Add(freshness)
+
+	ttl := freshness
+	if entry.Header.Get("ETag") != "" || entry.Header.Get("Last-Modified") != "" {
+		ttl += staleResponseRetention
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	if err := c.cache.Set(ctx, key, entry, ttl); err != nil {
+		logger.WarnContext(ctx, "failed to cache upstream response", attr.SlogCacheKey(key), attr.SlogError(err))
+	}
+}
+
+// freshness returns how long the response may be served without contacting
+// the upstream. It reports false when the response must not be stored at all,
+// either because the upstream forbids it or because it could be neither
+// reused nor revalidated.
+func (e *cachedResponse) freshness(policy *CachePolicy) (time.Duration, bool) {
+	if policy != nil && policy.TTL > 0 {
+		return policy.TTL, true
+	}
+
+	validated := e.Header.Get("ETag") != "" || e.Header.Get("Last-Modified") != ""
+
+	directives := parseCacheControl(e.Header.Values("Cache-Control"))
+	if _, ok := directives["no-store"]; ok {
+		return 0, false
+	}
+	if _, ok := directives["no-cache"]; ok {
+		return 0, validated
+	}
+
+	// Entries are scoped to the credentials of the request, so max-age
+	// applies even to private responses and s-maxage is ignored.
+	if maxAge, ok := directives["max-age"]; ok {
+		seconds, err := strconv.ParseInt(maxAge, 10, 64)
+		if err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		return 0, validated
+	}
+
+	if expires := e.Header.Get("Expires"); expires != "" {
+		expiresAt, err := http.ParseTime(expires)
+		if err != nil {
+			return 0, validated
+		}
+
+		date := time.Now()
+		if sent, err := http.ParseTime(e.Header.Get("Date")); err == nil {
+			date = sent
+		}
+		if freshness := expiresAt.Sub(date); freshness > 0 {
+			return freshness, true
+		}
+	}
+
+	return 0, validated
+}
+
+func (e *cachedResponse) matches(req *http.Request) bool {
+	for name, value := range e.Vary {
+		if req.Header.Get(name) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *cachedResponse) response(req *http.Request) *http.Response {
+	header := e.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	var body []byte
+	if req.Method != http.MethodHead {
+		body = e.Body
+	}
+
+	return &http.Response{
+		Status:           fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:       e.StatusCode,
+		Proto:            "HTTP/1.1",
+		ProtoMajor:       1,
+		ProtoMinor:       1,
+		Header:           header,
+		Body:             io.NopCloser(bytes.NewReader(body)),
+		ContentLength:    int64(len(body)),
+		TransferEncoding: nil,
+		Close:            false,
+		Uncompressed:     false,
+		Trailer:          nil,
+		Request:          req,
+		TLS:              nil,
+	}
+}
+
+func isCacheableRequest(tool *HTTPTool, req *http.Request) bool {
+	return tool.CachePolicy != nil &&
+		(req.Method == http.MethodGet || req.Method == http.MethodHead) &&
+		(req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+}
+
+// responseCacheKey identifies a request to a tool's upstream. Besides the tool
+// and the resolved URL, it covers every request header and the body, which
+// some APIs accept even on GET requests. Credentials are applied to the
+// request's headers, cookies or query string before it is sent, so callers
+// with different credentials never share entries.
+func responseCacheKey(tool *HTTPTool, req *http.Request) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", req.Method, req.URL.String())
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", fmt.Errorf("read request body: %w", err)
+		}
+		_, err = io.Copy(h, body)
+		_ = body.Close()
+		if err != nil {
+			return "", fmt.Errorf("read request body: %w", err)
+		}
+		fmt.Fprint(h, "\n")
+	}
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			fmt.Fprintf(h, "%s: %s\n", name, value)
+		}
+	}
+
+	return fmt.Sprintf("gateway:response:%s:%s", tool.ID, hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// parseCacheControl returns the directives of Cache-Control headers keyed by
+// their lowercased names.
+func parseCacheControl(values []string) map[string]string {
+	directives := make(map[string]string)
+	for _, value := range values {
+		for directive := range strings.SplitSeq(value, ",") {
+			name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			if name == "" {
+				continue
+			}
+			directives[strings.ToLower(name)] = strings.Trim(arg, `"`)
+		}
+	}
+	return directives
+}
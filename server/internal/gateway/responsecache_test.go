@@ -0,0 +1,223 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// memoryCache is a cache.Cache that keeps JSON encoded values in memory.
+type memoryCache struct {
+	mu    sync.Mutex
+	items map[string][]byte
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{mu: sync.Mutex{}, items: make(map[string][]byte)}
+}
+
+func (m *memoryCache) Get(_ context.Context, key string, value any) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.items[key]
+	if !ok {
+		return errors.New("cache miss")
+	}
+	return json.Unmarshal(data, value)
+}
+
+func (m *memoryCache) Set(_ context.Context, key string, value any, _ time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items[key] = data
+	return nil
+}
+
+func (m *memoryCache) Update(ctx context.Context, key string, value any) error {
+	return m.Set(ctx, key, value, 0)
+}
+
+func (m *memoryCache) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.items, key)
+	return nil
+}
+
+func newCachedTool(serverURL string, method string, cachePolicy *CachePolicy) *HTTPTool {
+	return newTestTool(serverURL, func(tool *HTTPTool) {
+		tool.Name = "get_weather"
+		tool.Method = method
+		tool.Path = "/weather"
+		tool.CachePolicy = cachePolicy
+	})
+}
+
+func callCachedTool(t *testing.T, proxy *ToolProxy, tool *HTTPTool, headers map[string]any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	recorder, err := callTool(t, proxy, tool, func(call *ToolCallBody) {
+		call.QueryParameters = map[string]any{"city": "Paris"}
+		call.Headers = headers
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	return recorder
+}
+
+func TestToolProxy_ResponseCache_Fresh(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "private, max-age=60")
+		_, _ = w.Write([]byte(`{"temp":21}`))
+	}))
+	t.Cleanup(server.Close)
+
+	proxy := newTestProxy(t, newMemoryCache(), nil)
+	tool := newCachedTool(server.URL, http.MethodGet, &CachePolicy{TTL: 0})
+
+	first := callCachedTool(t, proxy, tool, nil)
+	require.Equal(t, responseCacheMiss, first.Header().Get(HeaderResponseCache))
+	require.JSONEq(t, `{"temp":21}`, first.Body.String())
+
+	second := callCachedTool(t, proxy, tool, nil)
+	require.Equal(t, responseCacheHit, second.Header().Get(HeaderResponseCache))
+	require.JSONEq(t, `{"temp":21}`, second.Body.String())
+	require.Equal(t, "application/json", second.Header().Get("Content-Type"))
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestToolProxy_ResponseCache_Revalidates(t *testing.T) {
+	t.Parallel()
+
+	var calls, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"temp":21}`))
+	}))
+	t.Cleanup(server.Close)
+
+	proxy := newTestProxy(t, newMemoryCache(), nil)
+	tool := newCachedTool(server.URL, http.MethodGet, &CachePolicy{TTL: 0})
+
+	callCachedTool(t, proxy, tool, nil)
+	second := callCachedTool(t, proxy, tool, nil)
+	require.Equal(t, responseCacheRevalidated, second.Header().Get(HeaderResponseCache))
+	require.JSONEq(t, `{"temp":21}`, second.Body.String())
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, int32(1), notModified.Load())
+}
+
+func TestToolProxy_ResponseCache_ScopedToCredentials(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"user":"` + r.Header.Get("X-Api-Key") + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	proxy := newTestProxy(t, newMemoryCache(), nil)
+	tool := newCachedTool(server.URL, http.MethodGet, &CachePolicy{TTL: time.Minute})
+
+	alice := callCachedTool(t, proxy, tool, map[string]any{"X-Api-Key": "alice"})
+	bob := callCachedTool(t, proxy, tool, map[string]any{"X-Api-Key": "bob"})
+	aliceAgain := callCachedTool(t, proxy, tool, map[string]any{"X-Api-Key": "alice"})
+
+	require.JSONEq(t, `{"user":"alice"}`, alice.Body.String())
+	require.JSONEq(t, `{"user":"bob"}`, bob.Body.String())
+	require.JSONEq(t, `{"user":"alice"}`, aliceAgain.Body.String())
+	require.Equal(t, responseCacheHit, aliceAgain.Header().Get(HeaderResponseCache))
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestToolProxy_ResponseCache_Bypassed(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method      string
+		cachePolicy *CachePolicy
+		header      string
+	}{
+		"not opted in":    {method: http.MethodGet, cachePolicy: nil, header: "max-age=60"},
+		"unsafe method":   {method: http.MethodPost, cachePolicy: &CachePolicy{TTL: time.Minute}, header: "max-age=60"},
+		"no-store":        {method: http.MethodGet, cachePolicy: &CachePolicy{TTL: 0}, header: "no-store"},
+		"no freshness":    {method: http.MethodGet, cachePolicy: &CachePolicy{TTL: 0}, header: ""},
+		"already expired": {method: http.MethodGet, cachePolicy: &CachePolicy{TTL: 0}, header: "max-age=0"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				if tt.header != "" {
+					w.Header().Set("Cache-Control", tt.header)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"temp":21}`))
+			}))
+			t.Cleanup(server.Close)
+
+			proxy := newTestProxy(t, newMemoryCache(), nil)
+			tool := newCachedTool(server.URL, tt.method, tt.cachePolicy)
+
+			callCachedTool(t, proxy, tool, nil)
+			second := callCachedTool(t, proxy, tool, nil)
+			require.NotEqual(t, responseCacheHit, second.Header().Get(HeaderResponseCache))
+			require.Equal(t, int32(2), calls.Load())
+		})
+	}
+}
+
+func TestToolProxy_ResponseCache_TTLOverridesUpstream(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"temp":21}`))
+	}))
+	t.Cleanup(server.Close)
+
+	proxy := newTestProxy(t, newMemoryCache(), nil)
+	tool := newCachedTool(server.URL, http.MethodGet, &CachePolicy{TTL: time.Minute})
+
+	callCachedTool(t, proxy, tool, nil)
+	second := callCachedTool(t, proxy, tool, nil)
+	require.Equal(t, responseCacheHit, second.Header().Get(HeaderResponseCache))
+	require.Equal(t, int32(1), calls.Load())
+}
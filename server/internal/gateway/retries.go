@@ -0,0 +1,220 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+)
+
+// RetryPolicy controls how the proxy times out and retries requests to a
+// tool's upstream API. Zero values fall back to the gateway's defaults.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts made including the first. A
+	// value of one disables retries.
+	MaxAttempts int `json:"max_attempts" yaml:"max_attempts"`
+	// StatusCodes lists the response status codes that are retried. Entries
+	// may be exact codes such as "429" or ranges such as "5XX".
+	StatusCodes []string `json:"status_codes" yaml:"status_codes"`
+	// Methods lists the HTTP methods whose responses may be retried.
+	Methods []string `json:"methods" yaml:"methods"`
+	// RetryConnectionErrors controls whether requests that fail before a
+	// response is received are retried. Nil retries them.
+	RetryConnectionErrors *bool `json:"retry_connection_errors" yaml:"retry_connection_errors"`
+	// InitialInterval is the delay before the first retry.
+	InitialInterval time.Duration `json:"initial_interval" yaml:"initial_interval"`
+	// MaxInterval caps the delay between two attempts.
+	MaxInterval time.Duration `json:"max_interval" yaml:"max_interval"`
+	// Exponent is the factor the delay grows by after each retry.
+	Exponent float64 `json:"exponent" yaml:"exponent"`
+	// MaxElapsedTime is the overall deadline for a call across all of its
+	// attempts. Zero leaves calls bound only by their attempts.
+	MaxElapsedTime time.Duration `json:"max_elapsed_time" yaml:"max_elapsed_time"`
+	// Timeout bounds a single attempt, including reading the response.
+	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+}
+
+type retryConfig struct {
+	initialInterval  time.Duration
+	maxInterval      time.Duration
+	maxAttempts      int
+	backoffFactor    float64
+	statusCodes      []string // HTTP status codes or ranges such as 5XX to retry on
+	methods          []string // HTTP methods to retry on
+	connectionErrors bool     // whether to retry requests that got no response
+	attemptTimeout   time.Duration
+	maxElapsedTime   time.Duration
+}
+
+var defaultRetryConfig = retryConfig{
+	initialInterval: 500 * time.Millisecond,
+	maxInterval:     5 * time.Second,
+	maxAttempts:     3,
+	backoffFactor:   2,
+	statusCodes: []string{ // reasonable status code presets
+		"408", // Request Timeout
+		"429", // Rate Limit Exceeded
+		"500", // Internal Server Error
+		"502", // Bad Gateway
+		"503", // Service Unavailable
+		"504", // Gateway Timeout
+		"509", // Bandwidth Limit Exceeded
+		"521", // Web Server Is Down (Cloudflare)
+		"522", // Connection Timed Out (Cloudflare)
+		"523", // Origin Is Unreachable (Cloudflare)
+		"524", // A Timeout Occurred (Cloudflare)
+	},
+	methods: []string{
+		http.MethodGet,
+	},
+	connectionErrors: true,
+	attemptTimeout:   upstreamTimeout,
+	maxElapsedTime:   0,
+}
+
+// retryConfigFor resolves a tool's retry policy against the defaults.
+func retryConfigFor(policy *RetryPolicy) retryConfig {
+	cfg := defaultRetryConfig
+	if policy == nil {
+		return cfg
+	}
+
+	if policy.MaxAttempts > 0 {
+		cfg.maxAttempts = policy.MaxAttempts
+	}
+	if len(policy.StatusCodes) > 0 {
+		cfg.statusCodes = policy.StatusCodes
+	}
+	if len(policy.Methods) > 0 {
+		cfg.methods = policy.Methods
+	}
+	if policy.RetryConnectionErrors != nil {
+		cfg.connectionErrors = *policy.RetryConnectionErrors
+	}
+	if policy.InitialInterval > 0 {
+		cfg.initialInterval = policy.InitialInterval
+	}
+	if policy.MaxInterval > 0 {
+		cfg.maxInterval = policy.MaxInterval
+	}
+	cfg.maxInterval = max(cfg.maxInterval, cfg.initialInterval)
+	if policy.Exponent >= 1 {
+		cfg.backoffFactor = policy.Exponent
+	}
+	if policy.MaxElapsedTime > 0 {
+		cfg.maxElapsedTime = policy.MaxElapsedTime
+	}
+	if policy.Timeout > 0 {
+		cfg.attemptTimeout = policy.Timeout
+	}
+
+	return cfg
+}
+
+// Retryable reports whether calls to the tool may be sent again after they
+// failed. Only calls whose method the tool's retry policy retries are safe to
+// repeat.
+func (t *HTTPTool) Retryable() bool {
+	return slices.Contains(retryConfigFor(t.RetryPolicy).methods, t.Method)
+}
+
+// retriesStatus reports whether the config retries responses with the given
+// status code.
+func (c retryConfig) retriesStatus(statusCode int) bool {
+	code := strconv.Itoa(statusCode)
+	return slices.ContainsFunc(c.statusCodes, func(pattern string) bool {
+		if strings.HasSuffix(pattern, "XX") {
+			return len(pattern) == 3 && code[0] == pattern[0]
+		}
+		return pattern == code
+	})
+}
+
+func retryWithBackoff(
+	ctx context.Context,
+	logger *slog.Logger,
+	retryBackoff retryConfig,
+	doRequest func() (*http.Response, error),
+) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+	delayInterval := retryBackoff.initialInterval
+	for attempt := 0; attempt < retryBackoff.maxAttempts; attempt++ {
+		if attempt > 0 {
+			// Give up early rather than sleep past the overall deadline so
+			// that the last response is returned instead of a timeout.
+			if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delayInterval).After(deadline) {
+				return resp, err
+			}
+
+			select {
+			case <-time.After(delayInterval):
+			case <-ctx.Done():
+				if resp != nil {
+					return resp, nil
+				}
+				return nil, fmt.Errorf("retry context done: %w", ctx.Err())
+			}
+
+			delayInterval = min(time.Duration(float64(delayInterval)*retryBackoff.backoffFactor), retryBackoff.maxInterval)
+			discardResponse(resp)
+		}
+		resp, err = doRequest()
+		retriesLeft := attempt+1 < retryBackoff.maxAttempts
+		if err != nil {
+			if !retryBackoff.connectionErrors || ctx.Err() != nil {
+				return nil, err
+			}
+			if retriesLeft {
+				logger.WarnContext(ctx, fmt.Sprintf("retrying request after error: %s", err.Error()), attr.SlogError(err))
+				reportProgress(ctx, "Retrying request (attempt %d of %d) after a connection error", attempt+2, retryBackoff.maxAttempts)
+			}
+			continue
+		}
+
+		if !slices.Contains(retryBackoff.methods, resp.Request.Method) || !retryBackoff.retriesStatus(resp.StatusCode) {
+			return resp, err
+		}
+
+		if !retriesLeft {
+			break
+		}
+
+		logger.WarnContext(ctx, fmt.Sprintf("retrying request after upstream responded with status %d", resp.StatusCode), attr.SlogHTTPResponseStatusCode(resp.StatusCode))
+		reportProgress(ctx, "Retrying request (attempt %d of %d) after upstream responded with status %d", attempt+2, retryBackoff.maxAttempts, resp.StatusCode)
+
+		if retryAfter := resp.Header.Get("retry-after"); retryAfter != "" {
+			if parsedNumber, err := strconv.ParseInt(retryAfter, 10, 64); err == nil && parsedNumber > 0 {
+				retryAfterDuration := time.Duration(parsedNumber) * time.Second
+				delayInterval = min(retryAfterDuration, retryBackoff.maxInterval)
+				continue
+			}
+
+			if parsedDate, err := time.Parse(time.RFC1123, retryAfter); err == nil {
+				retryAfterDuration := time.Until(parsedDate)
+				if retryAfterDuration > 0 {
+					delayInterval = min(retryAfterDuration, retryBackoff.maxInterval)
+				}
+			}
+		}
+	}
+	return resp, err
+}
+
+// discardResponse drains and closes a response that is being retried so that
+// its connection can return to the pool.
+func discardResponse(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,260 @@
+package gateway
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryTestTool(serverURL string, method string, policy *RetryPolicy) *HTTPTool {
+	return newTestTool(serverURL, func(tool *HTTPTool) {
+		tool.Name = "search_reports"
+		tool.Method = method
+		tool.Path = "/reports"
+		tool.RequestContentType = NullString{Value: "application/json", Valid: true}
+		tool.RetryPolicy = policy
+	})
+}
+
+func callRetryTestTool(t *testing.T, tool *HTTPTool) (*httptest.ResponseRecorder, error) {
+	t.Helper()
+
+	return callTool(t, newTestProxy(t, nil, nil), tool, withBody(`{"query":"revenue"}`))
+}
+
+// flakyServer responds with 503 to the first failures requests and with 200
+// afterwards.
+func flakyServer(t *testing.T, failures int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestToolProxy_Do_DoesNotRetryPostByDefault(t *testing.T) {
+	t.Parallel()
+
+	server, calls := flakyServer(t, 1)
+
+	recorder, err := callRetryTestTool(t, newRetryTestTool(server.URL, http.MethodPost, nil))
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestToolProxy_Do_RetriesPostWhenPolicyAllows(t *testing.T) {
+	t.Parallel()
+
+	server, calls := flakyServer(t, 2)
+
+	recorder, err := callRetryTestTool(t, newRetryTestTool(server.URL, http.MethodPost, &RetryPolicy{
+		MaxAttempts:           3,
+		StatusCodes:           []string{"5XX"},
+		Methods:               []string{http.MethodPost},
+		RetryConnectionErrors: nil,
+		InitialInterval:       time.Millisecond,
+		MaxInterval:           time.Millisecond,
+		Exponent:              0,
+		MaxElapsedTime:        0,
+		Timeout:               0,
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestToolProxy_Do_StopsRetryingAtMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	server, calls := flakyServer(t, 10)
+
+	recorder, err := callRetryTestTool(t, newRetryTestTool(server.URL, http.MethodGet, &RetryPolicy{
+		MaxAttempts:           5,
+		StatusCodes:           []string{"503"},
+		Methods:               nil,
+		RetryConnectionErrors: nil,
+		InitialInterval:       time.Millisecond,
+		MaxInterval:           time.Millisecond,
+		Exponent:              0,
+		MaxElapsedTime:        0,
+		Timeout:               0,
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	require.Equal(t, int32(5), calls.Load())
+}
+
+func TestToolProxy_Do_RespectsOverallDeadline(t *testing.T) {
+	t.Parallel()
+
+	server, calls := flakyServer(t, 10)
+
+	start := time.Now()
+	recorder, err := callRetryTestTool(t, newRetryTestTool(server.URL, http.MethodGet, &RetryPolicy{
+		MaxAttempts:           5,
+		StatusCodes:           nil,
+		Methods:               nil,
+		RetryConnectionErrors: nil,
+		InitialInterval:       time.Second,
+		MaxInterval:           time.Second,
+		Exponent:              0,
+		MaxElapsedTime:        200 * time.Millisecond,
+		Timeout:               0,
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code, "the last response should be returned once the next retry would miss the deadline")
+	require.Equal(t, int32(1), calls.Load())
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestToolProxy_Do_AppliesAttemptTimeout(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		if calls.Add(1) == 1 {
+			select {
+			case <-time.After(2 * time.Second):
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	recorder, err := callRetryTestTool(t, newRetryTestTool(server.URL, http.MethodGet, &RetryPolicy{
+		MaxAttempts:           2,
+		StatusCodes:           nil,
+		Methods:               nil,
+		RetryConnectionErrors: nil,
+		InitialInterval:       time.Millisecond,
+		MaxInterval:           time.Millisecond,
+		Exponent:              0,
+		MaxElapsedTime:        0,
+		Timeout:               100 * time.Millisecond,
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, recorder.Code, "a timed out attempt should be retried")
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestToolProxy_Do_SkipsConnectionErrorRetriesWhenDisabled(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		calls.Add(1)
+		select {
+		case <-time.After(2 * time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := callRetryTestTool(t, newRetryTestTool(server.URL, http.MethodGet, &RetryPolicy{
+		MaxAttempts:           3,
+		StatusCodes:           nil,
+		Methods:               nil,
+		RetryConnectionErrors: new(bool),
+		InitialInterval:       time.Millisecond,
+		MaxInterval:           time.Millisecond,
+		Exponent:              0,
+		MaxElapsedTime:        0,
+		Timeout:               50 * time.Millisecond,
+	}))
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryConfig_RetriesStatus(t *testing.T) {
+	t.Parallel()
+
+	cfg := retryConfigFor(&RetryPolicy{
+		MaxAttempts:           0,
+		StatusCodes:           []string{"429", "5XX"},
+		Methods:               nil,
+		RetryConnectionErrors: nil,
+		InitialInterval:       0,
+		MaxInterval:           0,
+		Exponent:              0,
+		MaxElapsedTime:        0,
+		Timeout:               0,
+	})
+
+	require.True(t, cfg.retriesStatus(http.StatusTooManyRequests))
+	require.True(t, cfg.retriesStatus(http.StatusInternalServerError))
+	require.True(t, cfg.retriesStatus(http.StatusGatewayTimeout))
+	require.False(t, cfg.retriesStatus(http.StatusRequestTimeout))
+	require.False(t, cfg.retriesStatus(http.StatusOK))
+}
+
+func TestRetryConfigFor_Defaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := retryConfigFor(nil)
+	require.Equal(t, 3, cfg.maxAttempts)
+	require.Equal(t, []string{http.MethodGet}, cfg.methods)
+	require.Equal(t, upstreamTimeout, cfg.attemptTimeout)
+	require.True(t, cfg.connectionErrors)
+	require.Zero(t, cfg.maxElapsedTime)
+
+	cfg = retryConfigFor(&RetryPolicy{
+		MaxAttempts:           0,
+		StatusCodes:           nil,
+		Methods:               nil,
+		RetryConnectionErrors: nil,
+		InitialInterval:       10 * time.Second,
+		MaxInterval:           0,
+		Exponent:              0,
+		MaxElapsedTime:        0,
+		Timeout:               5 * time.Minute,
+	})
+	require.Equal(t, 3, cfg.maxAttempts)
+	require.Equal(t, 5*time.Minute, cfg.attemptTimeout)
+	require.Equal(t, 10*time.Second, cfg.maxInterval, "the max interval should never be below the initial interval")
+}
+
+func TestHTTPTool_Retryable(t *testing.T) {
+	t.Parallel()
+
+	postPolicy := &RetryPolicy{
+		MaxAttempts:           0,
+		StatusCodes:           nil,
+		Methods:               []string{http.MethodGet, http.MethodPost},
+		RetryConnectionErrors: nil,
+		InitialInterval:       0,
+		MaxInterval:           0,
+		Exponent:              0,
+		MaxElapsedTime:        0,
+		Timeout:               0,
+	}
+
+	require.True(t, newRetryTestTool("", http.MethodGet, nil).Retryable())
+	require.False(t, newRetryTestTool("", http.MethodPost, nil).Retryable(), "non-idempotent calls are not retryable by default")
+	require.True(t, newRetryTestTool("", http.MethodPost, postPolicy).Retryable())
+	require.False(t, newRetryTestTool("", http.MethodDelete, postPolicy).Retryable())
+}
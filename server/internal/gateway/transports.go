@@ -0,0 +1,138 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+)
+
+const (
+	// upstreamTimeout bounds a single attempt at an upstream request,
+	// including reading the response body, unless the tool's retry policy
+	// sets its own timeout.
+	upstreamTimeout = 60 * time.Second
+
+	upstreamMaxIdleConns        = 512
+	upstreamMaxIdleConnsPerHost = 64
+	upstreamIdleConnTimeout     = 90 * time.Second
+)
+
+// transportKey identifies the upstream requests that may share connections.
+// Requests only share a transport when they are dialled under the same
+// guardian policy and through the same forward proxy, so that a connection is
+// never reused in a context where it would not have been allowed.
+type transportKey struct {
+	policy *guardian.Policy
+	// proxy is the forward proxy selected for the upstream from the
+	// environment. It is empty when the upstream is dialled directly.
+	proxy string
+}
+
+// transportPool holds the long-lived transports used to reach upstream APIs.
+// Reusing them keeps connections warm between tool calls so that chatty
+// agents do not pay for DNS, TCP and TLS handshakes on every call.
+type transportPool struct {
+	metrics *metrics
+
+	mu      sync.Mutex
+	clients map[transportKey]*http.Client
+}
+
+func newTransportPool(metrics *metrics) *transportPool {
+	return &transportPool{
+		metrics: metrics,
+		mu:      sync.Mutex{},
+		clients: make(map[transportKey]*http.Client),
+	}
+}
+
+// client returns the shared client for requests to req's upstream under the
+// given policy.
+func (p *transportPool) client(req *http.Request, policy *guardian.Policy) (*http.Client, transportKey, error) {
+	proxyURL, err := http.ProxyFromEnvironment(req)
+	if err != nil {
+		return nil, transportKey{policy: nil, proxy: ""}, fmt.Errorf("resolve forward proxy: %w", err)
+	}
+
+	key := transportKey{policy: policy, proxy: ""}
+	if proxyURL != nil {
+		key.proxy = proxyURL.String()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, ok := p.clients[key]; ok {
+		return client, key, nil
+	}
+
+	// Timeouts are applied per attempt through the request context so that
+	// tools can wait longer than the default on slow upstreams.
+	client := &http.Client{
+		Transport: p.newTransport(key, proxyURL),
+	}
+	p.clients[key] = client
+
+	return client, key, nil
+}
+
+func (p *transportPool) newTransport(key transportKey, proxyURL *url.URL) *http.Transport {
+	dialer := key.policy.Dialer()
+	proxied := proxyURL != nil
+
+	return &http.Transport{
+		Proxy: func(*http.Request) (*url.URL, error) { return proxyURL, nil },
+		DialContext: func(ctx context.Context, network string, address string) (net.Conn, error) {
+			conn, err := dialer.DialContext(ctx, network, address)
+			if err != nil {
+				return nil, err
+			}
+
+			p.metrics.RecordUpstreamConnOpened(ctx, proxied)
+			return &countedConn{
+				Conn:    conn,
+				once:    sync.Once{},
+				onClose: func() { p.metrics.RecordUpstreamConnClosed(context.Background(), proxied) },
+			}, nil
+		},
+		MaxIdleConns:          upstreamMaxIdleConns,
+		MaxIdleConnsPerHost:   upstreamMaxIdleConnsPerHost,
+		IdleConnTimeout:       upstreamIdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+	}
+}
+
+// traceConnections records whether requests made with the returned context
+// reused a pooled connection.
+func (p *transportPool) traceConnections(ctx context.Context, key transportKey) context.Context {
+	proxied := key.proxy != ""
+
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			p.metrics.RecordUpstreamConnAcquired(ctx, proxied, info.Reused, info.IdleTime)
+		},
+	})
+}
+
+// countedConn reports when a pooled connection is closed so that the number
+// of open upstream connections can be tracked.
+type countedConn struct {
+	net.Conn
+	once    sync.Once
+	onClose func()
+}
+
+func (c *countedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.onClose)
+	return err
+}
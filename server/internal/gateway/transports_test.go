@@ -0,0 +1,107 @@
+package gateway
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func newTestTransportPool(t *testing.T) *transportPool {
+	t.Helper()
+
+	logger := testenv.NewLogger(t)
+	meter := testenv.NewMeterProvider(t).Meter("github.com/speakeasy-api/gram/server/internal/gateway")
+
+	return newTransportPool(newMetrics(meter, logger))
+}
+
+func TestTransportPool_SharesClientsPerPolicy(t *testing.T) {
+	t.Parallel()
+
+	pool := newTestTransportPool(t)
+	unsafe, err := guardian.NewUnsafePolicy([]string{})
+	require.NoError(t, err)
+	safe := guardian.NewDefaultPolicy()
+
+	first, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://api.example.com/pets", nil)
+	require.NoError(t, err)
+	second, err := http.NewRequestWithContext(t.Context(), http.MethodPost, "https://other.example.com/orders", nil)
+	require.NoError(t, err)
+
+	a, _, err := pool.client(first, unsafe)
+	require.NoError(t, err)
+	b, _, err := pool.client(second, unsafe)
+	require.NoError(t, err)
+	c, _, err := pool.client(first, safe)
+	require.NoError(t, err)
+
+	require.Same(t, a, b, "requests under the same policy should share a client")
+	require.NotSame(t, a, c, "requests under different policies should not share a client")
+}
+
+func TestTransportPool_ReusesConnections(t *testing.T) {
+	t.Parallel()
+
+	var newConns atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			newConns.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+
+	pool := newTestTransportPool(t)
+	policy, err := guardian.NewUnsafePolicy([]string{})
+	require.NoError(t, err)
+
+	for range 3 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		client, key, err := pool.client(req, policy)
+		require.NoError(t, err)
+
+		resp, err := client.Do(req.WithContext(pool.traceConnections(t.Context(), key)))
+		require.NoError(t, err)
+		_, err = io.Copy(io.Discard, resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	require.Equal(t, int32(1), newConns.Load(), "sequential calls should reuse one connection")
+}
+
+func TestTransportPool_AppliesPolicy(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	pool := newTestTransportPool(t)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	client, _, err := pool.client(req, guardian.NewDefaultPolicy())
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	if resp != nil {
+		require.NoError(t, resp.Body.Close())
+	}
+	require.ErrorIs(t, err, guardian.ErrBlockedIP)
+}
@@ -0,0 +1,709 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// defaultXMLRootName names the root element of XML request bodies whose
+// schema does not name it.
+const defaultXMLRootName = "root"
+
+// maxXMLSchemaRefDepth bounds how many references are followed when resolving
+// a schema, which guards against reference cycles.
+const maxXMLSchemaRefDepth = 32
+
+// xmlObject is OpenAPI's xml object which describes how a schema is
+// represented in an XML document.
+type xmlObject struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Prefix    string `json:"prefix"`
+	Attribute bool   `json:"attribute"`
+	Wrapped   bool   `json:"wrapped"`
+}
+
+// xmlSchema is the subset of a JSON schema needed to convert between JSON
+// values and XML documents.
+type xmlSchema struct {
+	Ref                  string                `json:"$ref"`
+	Type                 xmlSchemaType         `json:"type"`
+	Properties           xmlSchemaProperties   `json:"properties"`
+	AdditionalProperties json.RawMessage       `json:"additionalProperties"`
+	Items                *xmlSchema            `json:"items"`
+	AllOf                []*xmlSchema          `json:"allOf"`
+	XML                  *xmlObject            `json:"xml"`
+	Defs                 map[string]*xmlSchema `json:"$defs"`
+}
+
+// xmlSchemaType is the type of a schema. Of the types in a list, the first
+// one that is not null is used.
+type xmlSchemaType string
+
+func (t *xmlSchemaType) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = xmlSchemaType(single)
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("parse schema type: %w", err)
+	}
+	for _, typ := range list {
+		if typ != "null" {
+			*t = xmlSchemaType(typ)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+type xmlSchemaProperty struct {
+	name   string
+	schema *xmlSchema
+}
+
+// xmlSchemaProperties holds the properties of an object schema in the order
+// they were defined, which is the order of the elements in a document.
+type xmlSchemaProperties []xmlSchemaProperty
+
+func (p *xmlSchemaProperties) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("parse schema properties: %w", err)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("parse schema properties: %w", err)
+		}
+		name, ok := tok.(string)
+		if !ok {
+			return errors.New("parse schema properties: expected a property name")
+		}
+
+		var schema xmlSchema
+		if err := dec.Decode(&schema); err != nil {
+			return fmt.Errorf("parse schema for property %q: %w", name, err)
+		}
+		*p = append(*p, xmlSchemaProperty{name: name, schema: &schema})
+	}
+
+	return nil
+}
+
+// xmlSchemaResolver resolves references and combines allOf schemas against
+// the definitions of the document a schema came from.
+type xmlSchemaResolver struct {
+	defs map[string]*xmlSchema
+}
+
+func (r *xmlSchemaResolver) resolve(schema *xmlSchema) *xmlSchema {
+	return r.resolveDepth(schema, 0)
+}
+
+func (r *xmlSchemaResolver) resolveDepth(schema *xmlSchema, depth int) *xmlSchema {
+	if schema == nil || depth > maxXMLSchemaRefDepth {
+		return nil
+	}
+
+	if schema.Ref != "" {
+		target := r.resolveDepth(r.defs[strings.TrimPrefix(schema.Ref, "#/$defs/")], depth+1)
+		if target == nil || schema.XML == nil {
+			return target
+		}
+
+		// An xml object next to a reference overrides the one of the
+		// referenced schema.
+		resolved := *target
+		resolved.XML = schema.XML
+		return &resolved
+	}
+
+	if len(schema.AllOf) == 0 {
+		return schema
+	}
+
+	combined := *schema
+	combined.AllOf = nil
+	combined.Properties = slices.Clone(schema.Properties)
+	for _, part := range schema.AllOf {
+		part = r.resolveDepth(part, depth+1)
+		if part == nil {
+			continue
+		}
+		if combined.Type == "" {
+			combined.Type = part.Type
+		}
+		if combined.XML == nil {
+			combined.XML = part.XML
+		}
+		if combined.Items == nil {
+			combined.Items = part.Items
+		}
+		combined.Properties = append(combined.Properties, part.Properties...)
+	}
+
+	return &combined
+}
+
+func (s *xmlSchema) isObject() bool {
+	return s != nil && (s.Type == "object" || (s.Type == "" && len(s.Properties) > 0))
+}
+
+func (s *xmlSchema) isArray() bool {
+	return s != nil && (s.Type == "array" || (s.Type == "" && s.Items != nil))
+}
+
+func (s *xmlSchema) xml() xmlObject {
+	if s == nil || s.XML == nil {
+		return xmlObject{Name: "", Namespace: "", Prefix: "", Attribute: false, Wrapped: false}
+	}
+	return *s.XML
+}
+
+// allowsAdditionalProperties reports whether properties that the schema does
+// not define should be kept when converting a document.
+func (s *xmlSchema) allowsAdditionalProperties() bool {
+	return s == nil || string(bytes.TrimSpace(s.AdditionalProperties)) != "false"
+}
+
+func parseXMLSchema(data []byte) (*xmlSchema, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var schema xmlSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+
+	return &schema, nil
+}
+
+// encodeXMLBody serializes the JSON body of a tool call as an XML document.
+// The body's schema is taken from the tool schema and its xml objects decide
+// element names, namespaces, attributes and whether arrays are wrapped.
+func encodeXMLBody(toolSchema []byte, body json.RawMessage) ([]byte, error) {
+	if len(bytes.TrimSpace(body)) == 0 || string(bytes.TrimSpace(body)) == "null" {
+		return nil, nil
+	}
+
+	var value any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("parse request body: %w", err)
+	}
+
+	root, err := parseXMLSchema(toolSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &xmlSchemaResolver{defs: nil}
+	var bodySchema *xmlSchema
+	if root != nil {
+		resolver.defs = root.Defs
+		for _, prop := range root.Properties {
+			if prop.name == "body" {
+				bodySchema = resolver.resolve(prop.schema)
+				break
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+	w := &xmlWriter{enc: enc, resolver: resolver}
+	name := bodySchema.xml().Name
+	if name == "" {
+		name = defaultXMLRootName
+	}
+	if err := w.element(name, bodySchema, value); err != nil {
+		return nil, err
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, fmt.Errorf("write xml document: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+type xmlWriter struct {
+	enc      *xml.Encoder
+	resolver *xmlSchemaResolver
+}
+
+// element writes value as an element with the given name. schema must be
+// resolved.
+func (w *xmlWriter) element(name string, schema *xmlSchema, value any) error {
+	if value == nil {
+		return nil
+	}
+
+	obj := schema.xml()
+	start := xml.StartElement{Name: xml.Name{Space: "", Local: qualifiedXMLName(obj.Prefix, name)}, Attr: nil}
+	if obj.Namespace != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "", Local: qualifiedXMLName("xmlns", obj.Prefix)}, Value: obj.Namespace})
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		return w.object(start, schema, v)
+	case []any:
+		// Arrays outside of an object, such as a root array, are always
+		// wrapped in the element.
+		var items *xmlSchema
+		if schema != nil {
+			items = w.resolver.resolve(schema.Items)
+		}
+		itemName := items.xml().Name
+		if itemName == "" {
+			itemName = name
+		}
+
+		if err := w.enc.EncodeToken(start); err != nil {
+			return fmt.Errorf("write element %q: %w", name, err)
+		}
+		for _, item := range v {
+			if err := w.element(itemName, items, item); err != nil {
+				return err
+			}
+		}
+		return w.end(start)
+	default:
+		if err := w.enc.EncodeToken(start); err != nil {
+			return fmt.Errorf("write element %q: %w", name, err)
+		}
+		if err := w.enc.EncodeToken(xml.CharData(xmlScalarText(v))); err != nil {
+			return fmt.Errorf("write element %q: %w", name, err)
+		}
+		return w.end(start)
+	}
+}
+
+func (w *xmlWriter) object(start xml.StartElement, schema *xmlSchema, value map[string]any) error {
+	type child struct {
+		name   string
+		schema *xmlSchema
+		value  any
+	}
+
+	var children []child
+	seen := make(map[string]bool, len(value))
+	if schema != nil {
+		for _, prop := range schema.Properties {
+			v, ok := value[prop.name]
+			if !ok || seen[prop.name] {
+				continue
+			}
+			seen[prop.name] = true
+
+			propSchema := w.resolver.resolve(prop.schema)
+			obj := propSchema.xml()
+			name := obj.Name
+			if name == "" {
+				name = prop.name
+			}
+
+			if obj.Attribute {
+				if v == nil {
+					continue
+				}
+				start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "", Local: qualifiedXMLName(obj.Prefix, name)}, Value: xmlScalarText(v)})
+				continue
+			}
+
+			children = append(children, child{name: prop.name, schema: propSchema, value: v})
+		}
+	}
+
+	// Properties the schema does not describe are written as plain elements
+	// after the described ones.
+	extra := make([]string, 0, len(value))
+	for name := range value {
+		if !seen[name] {
+			extra = append(extra, name)
+		}
+	}
+	slices.Sort(extra)
+	for _, name := range extra {
+		children = append(children, child{name: name, schema: nil, value: value[name]})
+	}
+
+	if err := w.enc.EncodeToken(start); err != nil {
+		return fmt.Errorf("write element %q: %w", start.Name.Local, err)
+	}
+	for _, c := range children {
+		if err := w.property(c.name, c.schema, c.value); err != nil {
+			return err
+		}
+	}
+	return w.end(start)
+}
+
+// property writes the value of an object's property. Arrays are written as
+// one element per item, inside a wrapping element when the array's xml
+// object asks for it.
+func (w *xmlWriter) property(propName string, schema *xmlSchema, value any) error {
+	obj := schema.xml()
+	name := obj.Name
+	if name == "" {
+		name = propName
+	}
+
+	arr, ok := value.([]any)
+	if !ok {
+		return w.element(name, schema, value)
+	}
+
+	var items *xmlSchema
+	if schema != nil {
+		items = w.resolver.resolve(schema.Items)
+	}
+	itemName := items.xml().Name
+	if itemName == "" {
+		itemName = propName
+	}
+
+	if !obj.Wrapped {
+		for _, item := range arr {
+			if err := w.element(itemName, items, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	start := xml.StartElement{Name: xml.Name{Space: "", Local: qualifiedXMLName(obj.Prefix, name)}, Attr: nil}
+	if obj.Namespace != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "", Local: qualifiedXMLName("xmlns", obj.Prefix)}, Value: obj.Namespace})
+	}
+	if err := w.enc.EncodeToken(start); err != nil {
+		return fmt.Errorf("write element %q: %w", name, err)
+	}
+	for _, item := range arr {
+		if err := w.element(itemName, items, item); err != nil {
+			return err
+		}
+	}
+	return w.end(start)
+}
+
+func (w *xmlWriter) end(start xml.StartElement) error {
+	if err := w.enc.EncodeToken(start.End()); err != nil {
+		return fmt.Errorf("write element %q: %w", start.Name.Local, err)
+	}
+	return nil
+}
+
+func qualifiedXMLName(prefix string, name string) string {
+	switch {
+	case prefix == "":
+		return name
+	case name == "":
+		return prefix
+	default:
+		return prefix + ":" + name
+	}
+}
+
+func xmlScalarText(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(bs)
+	}
+}
+
+// xmlNode is an element of a parsed XML document.
+type xmlNode struct {
+	name     string
+	attrs    []xml.Attr
+	children []*xmlNode
+	text     strings.Builder
+}
+
+func parseXMLDocument(data []byte) (*xmlNode, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	// Documents that declare other encodings are read as is rather than
+	// rejected. Nearly all of them only use ASCII.
+	dec.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+
+	var root *xmlNode
+	var stack []*xmlNode
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse xml: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: t.Name.Local, attrs: t.Attr, children: nil, text: strings.Builder{}}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			} else if root == nil {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("parse xml: document has no root element")
+	}
+
+	return root, nil
+}
+
+// decodeXMLDocument converts an XML document into a JSON value. When schema
+// is set, it is used to decide element names, which elements form arrays and
+// how text is typed. Elements and attributes it does not describe, or all of
+// them without a schema, are converted generically: attributes become
+// properties prefixed with @, repeated elements become arrays and the text of
+// elements that also have attributes or children is kept under #text.
+func decodeXMLDocument(data []byte, schema []byte) (any, error) {
+	root, err := parseXMLDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := parseXMLSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &xmlSchemaResolver{defs: nil}
+	if parsed != nil {
+		resolver.defs = parsed.Defs
+	}
+
+	r := &xmlReader{resolver: resolver}
+	return r.node(root, resolver.resolve(parsed)), nil
+}
+
+type xmlReader struct {
+	resolver *xmlSchemaResolver
+}
+
+// node converts an element using its resolved schema.
+func (r *xmlReader) node(node *xmlNode, schema *xmlSchema) any {
+	switch {
+	case schema.isObject():
+		return r.object(node, schema)
+	case schema.isArray():
+		items := r.resolver.resolve(schema.Items)
+		out := make([]any, 0, len(node.children))
+		for _, child := range node.children {
+			out = append(out, r.node(child, items))
+		}
+		return out
+	case schema != nil && schema.Type != "":
+		return coerceXMLText(strings.TrimSpace(node.text.String()), schema.Type)
+	default:
+		return genericXMLValue(node)
+	}
+}
+
+func (r *xmlReader) object(node *xmlNode, schema *xmlSchema) map[string]any {
+	out := make(map[string]any, len(schema.Properties))
+	usedAttrs := make(map[string]bool)
+	usedChildren := make(map[*xmlNode]bool)
+
+	for _, prop := range schema.Properties {
+		propSchema := r.resolver.resolve(prop.schema)
+		obj := propSchema.xml()
+		name := obj.Name
+		if name == "" {
+			name = prop.name
+		}
+
+		switch {
+		case obj.Attribute:
+			for _, attr := range node.attrs {
+				if attr.Name.Local == name && !isNamespaceAttr(attr) {
+					usedAttrs[attr.Name.Local] = true
+					out[prop.name] = coerceXMLText(attr.Value, propSchema.Type)
+					break
+				}
+			}
+		case propSchema.isArray():
+			items := r.resolver.resolve(propSchema.Items)
+			itemName := items.xml().Name
+			if itemName == "" {
+				itemName = prop.name
+			}
+
+			parent := node
+			if obj.Wrapped {
+				parent = childXMLNode(node, name)
+				if parent == nil {
+					continue
+				}
+				usedChildren[parent] = true
+			}
+
+			values := []any{}
+			for _, child := range parent.children {
+				if child.name == itemName || (obj.Wrapped && !hasXMLChild(parent, itemName)) {
+					usedChildren[child] = true
+					values = append(values, r.node(child, items))
+				}
+			}
+			if obj.Wrapped || len(values) > 0 {
+				out[prop.name] = values
+			}
+		default:
+			if child := childXMLNode(node, name); child != nil {
+				usedChildren[child] = true
+				out[prop.name] = r.node(child, propSchema)
+			}
+		}
+	}
+
+	if !schema.allowsAdditionalProperties() {
+		return out
+	}
+
+	for _, attr := range node.attrs {
+		if !usedAttrs[attr.Name.Local] && !isNamespaceAttr(attr) {
+			setIfAbsent(out, "@"+attr.Name.Local, attr.Value)
+		}
+	}
+	for key, value := range genericXMLChildren(node.children, usedChildren) {
+		setIfAbsent(out, key, value)
+	}
+
+	return out
+}
+
+func genericXMLValue(node *xmlNode) any {
+	text := strings.TrimSpace(node.text.String())
+
+	attrs := slices.DeleteFunc(slices.Clone(node.attrs), isNamespaceAttr)
+	if len(attrs) == 0 && len(node.children) == 0 {
+		return text
+	}
+
+	out := make(map[string]any, len(attrs)+len(node.children)+1)
+	for _, attr := range attrs {
+		out["@"+attr.Name.Local] = attr.Value
+	}
+	for key, value := range genericXMLChildren(node.children, nil) {
+		setIfAbsent(out, key, value)
+	}
+	if text != "" {
+		out["#text"] = text
+	}
+
+	return out
+}
+
+// genericXMLChildren groups elements by name. Names that appear more than
+// once hold an array of the elements' values.
+func genericXMLChildren(children []*xmlNode, skip map[*xmlNode]bool) map[string]any {
+	out := make(map[string]any)
+	counts := make(map[string]int)
+	for _, child := range children {
+		if !skip[child] {
+			counts[child.name]++
+		}
+	}
+
+	for _, child := range children {
+		if skip[child] {
+			continue
+		}
+
+		value := genericXMLValue(child)
+		if counts[child.name] == 1 {
+			out[child.name] = value
+			continue
+		}
+		existing, _ := out[child.name].([]any)
+		out[child.name] = append(existing, value)
+	}
+
+	return out
+}
+
+func coerceXMLText(text string, typ xmlSchemaType) any {
+	trimmed := strings.TrimSpace(text)
+
+	switch typ {
+	case "integer":
+		if v, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+			return int(v)
+		}
+	case "number":
+		if v, err := strconv.ParseFloat(trimmed, 64); err == nil {
+			return v
+		}
+	case "boolean":
+		switch trimmed {
+		case "true", "1":
+			return true
+		case "false", "0":
+			return false
+		}
+	}
+
+	return text
+}
+
+func childXMLNode(node *xmlNode, name string) *xmlNode {
+	for _, child := range node.children {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+func hasXMLChild(node *xmlNode, name string) bool {
+	return childXMLNode(node, name) != nil
+}
+
+func isNamespaceAttr(attr xml.Attr) bool {
+	return attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns")
+}
+
+func setIfAbsent(out map[string]any, key string, value any) {
+	if _, ok := out[key]; !ok {
+		out[key] = value
+	}
+}
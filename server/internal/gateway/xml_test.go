@@ -0,0 +1,198 @@
+package gateway
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var petToolSchema = []byte(`{
+	"type": "object",
+	"properties": {
+		"body": {"$ref": "#/$defs/Pet", "xml": {"name": "pet"}}
+	},
+	"$defs": {
+		"Pet": {
+			"type": "object",
+			"properties": {
+				"id": {"type": "integer", "xml": {"attribute": true}},
+				"name": {"type": "string"},
+				"photoUrls": {
+					"type": "array",
+					"xml": {"name": "photos", "wrapped": true},
+					"items": {"type": "string", "xml": {"name": "photo"}}
+				},
+				"tags": {"type": "array", "items": {"type": "string", "xml": {"name": "tag"}}},
+				"owner": {
+					"type": "object",
+					"xml": {"prefix": "o", "namespace": "https://example.com/owners"},
+					"properties": {"name": {"type": "string"}}
+				}
+			}
+		}
+	}
+}`)
+
+var petResponseSchema = []byte(`{
+	"type": "object",
+	"properties": {
+		"id": {"type": "integer", "xml": {"attribute": true}},
+		"name": {"type": "string"},
+		"available": {"type": "boolean"},
+		"weight": {"type": "number"},
+		"photoUrls": {
+			"type": "array",
+			"xml": {"name": "photos", "wrapped": true},
+			"items": {"type": "string", "xml": {"name": "photo"}}
+		},
+		"tags": {"type": "array", "items": {"type": "string", "xml": {"name": "tag"}}}
+	}
+}`)
+
+func TestEncodeXMLBody(t *testing.T) {
+	t.Parallel()
+
+	body, err := encodeXMLBody(petToolSchema, json.RawMessage(`{
+		"tags": ["good", "fluffy"],
+		"id": 7,
+		"name": "Rex & co",
+		"photoUrls": ["a.png", "b.png"],
+		"owner": {"name": "Sam"},
+		"nickname": "R"
+	}`))
+	require.NoError(t, err)
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+		`<pet id="7">` +
+		`<name>Rex &amp; co</name>` +
+		`<photos><photo>a.png</photo><photo>b.png</photo></photos>` +
+		`<tag>good</tag><tag>fluffy</tag>` +
+		`<o:owner xmlns:o="https://example.com/owners"><name>Sam</name></o:owner>` +
+		`<nickname>R</nickname>` +
+		`</pet>`
+	require.Equal(t, expected, string(body))
+
+	// The prefixed element must resolve to its namespace when parsed.
+	var parsed struct {
+		Owner struct {
+			Name string `xml:"name"`
+		} `xml:"https://example.com/owners owner"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &parsed))
+	require.Equal(t, "Sam", parsed.Owner.Name)
+}
+
+func TestEncodeXMLBody_WithoutSchema(t *testing.T) {
+	t.Parallel()
+
+	body, err := encodeXMLBody(nil, json.RawMessage(`{"b": 1, "a": [true, false]}`))
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+`<root><a>true</a><a>false</a><b>1</b></root>`, string(body))
+
+	body, err = encodeXMLBody(nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, body)
+}
+
+func TestDecodeXMLDocument(t *testing.T) {
+	t.Parallel()
+
+	doc := []byte(`<?xml version="1.0"?>
+<pet id="7" xmlns="https://example.com/pets">
+	<name>Rex</name>
+	<available>true</available>
+	<weight>12.5</weight>
+	<photos><photo>a.png</photo><photo>b.png</photo></photos>
+	<tag>good</tag>
+	<tag>fluffy</tag>
+	<vet clinic="north"><name>Dr. Lee</name></vet>
+</pet>`)
+
+	value, err := decodeXMLDocument(doc, petResponseSchema)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"id":        7,
+		"name":      "Rex",
+		"available": true,
+		"weight":    12.5,
+		"photoUrls": []any{"a.png", "b.png"},
+		"tags":      []any{"good", "fluffy"},
+		"vet":       map[string]any{"@clinic": "north", "name": "Dr. Lee"},
+	}, value)
+}
+
+func TestDecodeXMLDocument_WithoutSchema(t *testing.T) {
+	t.Parallel()
+
+	value, err := decodeXMLDocument([]byte(`<list count="2"><item>a</item><item>b</item><note lang="en">hi</note></list>`), nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"@count": "2",
+		"item":   []any{"a", "b"},
+		"note":   map[string]any{"@lang": "en", "#text": "hi"},
+	}, value)
+
+	_, err = decodeXMLDocument([]byte(`not xml`), nil)
+	require.Error(t, err)
+}
+
+func callXMLTool(t *testing.T, filter *ResponseFilterRequest) (*httptest.ResponseRecorder, string) {
+	t.Helper()
+
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		received = string(data)
+
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<pet id="7"><name>Rex</name><photos><photo>a.png</photo></photos></pet>`))
+	}))
+	t.Cleanup(server.Close)
+
+	tool := newTestTool(server.URL, func(tool *HTTPTool) {
+		tool.Name = "add_pet"
+		tool.Method = http.MethodPost
+		tool.Path = "/pets"
+		tool.Schema = petToolSchema
+		tool.RequestContentType = NullString{Value: "application/xml", Valid: true}
+		tool.ResponseFilter = &ResponseFilter{
+			Type:         FilterTypeJQ,
+			Schema:       petResponseSchema,
+			StatusCodes:  []string{"200"},
+			ContentTypes: []string{"application/xml"},
+		}
+		tool.XMLResponseSchema = petResponseSchema
+	})
+
+	recorder, err := callTool(t, newTestProxy(t, nil, nil), tool, withBody(`{"id": 7, "name": "Rex"}`), func(call *ToolCallBody) {
+		call.ResponseFilter = filter
+	})
+	require.NoError(t, err)
+
+	return recorder, received
+}
+
+func TestToolProxy_Do_XML(t *testing.T) {
+	t.Parallel()
+
+	recorder, received := callXMLTool(t, nil)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+`<pet id="7"><name>Rex</name></pet>`, received)
+	require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	require.JSONEq(t, `{"id": 7, "name": "Rex", "photoUrls": ["a.png"]}`, recorder.Body.String())
+}
+
+func TestToolProxy_Do_XMLResponseFilter(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := callXMLTool(t, &ResponseFilterRequest{Type: "jq", Filter: ".photoUrls[0]"})
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "1", recorder.Header().Get(HeaderFilteredResponse))
+	require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	require.JSONEq(t, `["a.png"]`, recorder.Body.String())
+}
@@ -20,6 +20,7 @@ import (
 	srv "github.com/speakeasy-api/gram/server/gen/http/instances/server"
 	gen "github.com/speakeasy-api/gram/server/gen/instances"
 	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/assets"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/auth"
 	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
@@ -68,6 +69,7 @@ func NewService(
 	guardianPolicy *guardian.Policy,
 	posthog *posthog.Posthog,
 	billing billing.Tracker,
+	assetStorage assets.BlobStore,
 ) *Service {
 	envRepo := environments_repo.New(db)
 	tracer := traceProvider.Tracer("github.com/speakeasy-api/gram/server/internal/instances")
@@ -90,6 +92,7 @@ func NewService(
 			gateway.ToolCallSourceDirect,
 			cacheImpl,
 			guardianPolicy,
+			assets.NewToolFiles(db, assetStorage),
 		),
 	}
 }
@@ -299,7 +302,9 @@ func (s *Service) ExecuteInstanceTool(w http.ResponseWriter, r *http.Request) er
 	// Use a response interceptor that completely captures the response
 	interceptor := newResponseInterceptor(w)
 
-	err = s.toolProxy.Do(ctx, interceptor, requestBody, envVars, executionInfo.Tool)
+	// Playground callers are project members and the server URL only comes
+	// from a stored environment, so they can attach project assets.
+	err = s.toolProxy.Do(gateway.WithAssetFiles(ctx), interceptor, requestBody, envVars, executionInfo.Tool)
 	if err != nil {
 		return fmt.Errorf("failed to proxy tool call: %w", err)
 	}
@@ -337,6 +342,8 @@ func (s *Service) ExecuteInstanceTool(w http.ResponseWriter, r *http.Request) er
 		ToolsetSlug:      &toolsetSlug,
 		ChatID:           &chatID,
 		MCPURL:           nil, // Not applicable for direct tool calls
+		MCPClientName:    nil,
+		MCPClientVersion: nil,
 	})
 
 	return nil
@@ -0,0 +1,106 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/sourcegraph/conc/pool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+// DefaultBatchConcurrency is the number of messages in a JSON-RPC batch that
+// are handled at the same time when no limit is configured.
+const DefaultBatchConcurrency = 8
+
+// DefaultMaxBatchSize is the maximum number of messages accepted in a
+// JSON-RPC batch when no limit is configured.
+const DefaultMaxBatchSize = 50
+
+// messageHandler handles a single JSON-RPC message and returns its response,
+// if any.
+type messageHandler func(ctx context.Context, req *rawRequest) (json.RawMessage, error)
+
+// handleBatch handles the messages of a JSON-RPC batch with up to concurrency
+// handlers at a time and returns their responses in the order the requests
+// were sent. Messages that do not produce a response, such as notifications,
+// are left out, even when handling them failed. No body is returned if none
+// of the messages produced a response.
+//
+// initialize and logging/setLevel messages are handled before every other
+// message, in the order they appear, even when they come later in the batch.
+// Clients can observe this: a tools/call sent ahead of a logging/setLevel in
+// the same batch already logs at the new level.
+func handleBatch(ctx context.Context, logger *slog.Logger, concurrency int, batch batchedRawRequest, handle messageHandler) (json.RawMessage, error) {
+	results := make([]json.RawMessage, len(batch))
+	errs := make([]error, len(batch))
+
+	// Messages that change session state run first and in order so that the
+	// rest of the batch observes their effects, as documented above.
+	workers := pool.New().WithMaxGoroutines(concurrency)
+	for i, req := range batch {
+		if changesSessionState(req) {
+			results[i], errs[i] = handle(ctx, req)
+		}
+	}
+	for i, req := range batch {
+		if changesSessionState(req) {
+			continue
+		}
+
+		workers.Go(func() {
+			results[i], errs[i] = handle(ctx, req)
+		})
+	}
+	workers.Wait()
+
+	responses := make([]json.RawMessage, 0, len(batch))
+	for i, req := range batch {
+		result, err := results[i], errs[i]
+		if err != nil && !req.expectsResponse() {
+			// JSON-RPC forbids responding to notifications, so the failure
+			// can only be logged.
+			logger.WarnContext(ctx, "failed to handle json-rpc notification", attr.SlogMcpMethod(req.Method), attr.SlogError(err))
+			continue
+		}
+		if err != nil {
+			bs, merr := json.Marshal(NewErrorFromCause(ctx, req, err))
+			if merr != nil {
+				return nil, oops.E(oops.CodeUnexpected, merr, "failed to serialize error response").Log(ctx, logger)
+			}
+
+			result = bs
+		}
+
+		if result != nil {
+			responses = append(responses, result)
+		}
+	}
+
+	switch {
+	case len(responses) == 0:
+		return nil, nil
+	case len(batch) == 1:
+		return responses[0], nil
+	}
+
+	m, err := json.Marshal(responses)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize results").Log(ctx, logger)
+	}
+
+	return m, nil
+}
+
+// changesSessionState reports whether a message updates the session that
+// other messages in the same batch read.
+func changesSessionState(req *rawRequest) bool {
+	switch req.Method {
+	case "initialize", "logging/setLevel":
+		return true
+	default:
+		return false
+	}
+}
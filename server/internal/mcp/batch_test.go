@@ -0,0 +1,185 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func decodeTestBatch(t *testing.T, raw string) batchedRawRequest {
+	t.Helper()
+
+	var batch batchedRawRequest
+	require.NoError(t, json.Unmarshal([]byte(raw), &batch))
+
+	return batch
+}
+
+func TestHandleBatch_SessionStateChangesRunFirst(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		batch string
+		first []string
+	}{
+		"initialize": {
+			batch: `[{"jsonrpc":"2.0","id":1,"method":"tools/list"},{"jsonrpc":"2.0","id":2,"method":"ping"},{"jsonrpc":"2.0","id":3,"method":"initialize"}]`,
+			first: []string{"initialize"},
+		},
+		"set level": {
+			batch: `[{"jsonrpc":"2.0","id":1,"method":"tools/call"},{"jsonrpc":"2.0","id":2,"method":"logging/setLevel"}]`,
+			first: []string{"logging/setLevel"},
+		},
+		"both in the order sent": {
+			batch: `[{"jsonrpc":"2.0","id":1,"method":"ping"},{"jsonrpc":"2.0","id":2,"method":"logging/setLevel"},{"jsonrpc":"2.0","id":3,"method":"prompts/list"},{"jsonrpc":"2.0","id":4,"method":"initialize"}]`,
+			first: []string{"logging/setLevel", "initialize"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			batch := decodeTestBatch(t, tt.batch)
+
+			var mu sync.Mutex
+			var handled []string
+			body, err := handleBatch(t.Context(), testenv.NewLogger(t), 4, batch, func(_ context.Context, req *rawRequest) (json.RawMessage, error) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				handled = append(handled, req.Method)
+				return json.RawMessage(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":{}}`, req.ID.Value())), nil
+			})
+			require.NoError(t, err)
+
+			require.Len(t, handled, len(batch))
+			require.Equal(t, tt.first, handled[:len(tt.first)])
+
+			// Responses keep the order of the requests regardless of when
+			// they were handled.
+			var responses []struct {
+				ID int `json:"id"`
+			}
+			require.NoError(t, json.Unmarshal(body, &responses))
+			require.Len(t, responses, len(batch))
+			for i, res := range responses {
+				require.Equal(t, i+1, res.ID)
+			}
+		})
+	}
+}
+
+func TestHandleBatch_Responses(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		batch    string
+		expected string
+	}{
+		"notifications only": {
+			batch:    `[{"jsonrpc":"2.0","method":"notifications/initialized"},{"jsonrpc":"2.0","method":"notifications/cancelled"}]`,
+			expected: "",
+		},
+		"single message is not wrapped": {
+			batch:    `{"jsonrpc":"2.0","id":1,"method":"ping"}`,
+			expected: `{"jsonrpc":"2.0","id":1,"result":{}}`,
+		},
+		"notifications are left out": {
+			batch:    `[{"jsonrpc":"2.0","method":"notifications/initialized"},{"jsonrpc":"2.0","id":1,"method":"ping"}]`,
+			expected: `[{"jsonrpc":"2.0","id":1,"result":{}}]`,
+		},
+		"failed notifications are not answered": {
+			batch:    `[{"jsonrpc":"2.0","method":"unknown"},{"jsonrpc":"2.0","id":1,"method":"ping"}]`,
+			expected: `[{"jsonrpc":"2.0","id":1,"result":{}}]`,
+		},
+		"single failed notification is not answered": {
+			batch:    `{"jsonrpc":"2.0","method":"unknown"}`,
+			expected: "",
+		},
+		"errors become error responses": {
+			batch:    `[{"jsonrpc":"2.0","id":1,"method":"ping"},{"jsonrpc":"2.0","id":2,"method":"unknown"}]`,
+			expected: `[{"jsonrpc":"2.0","id":1,"result":{}},{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"unknown: method not found","data":null}}]`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := handleBatch(t.Context(), testenv.NewLogger(t), 2, decodeTestBatch(t, tt.batch), func(_ context.Context, req *rawRequest) (json.RawMessage, error) {
+				switch {
+				case strings.HasPrefix(req.Method, "notifications/"):
+					return nil, nil
+				case req.Method == "ping":
+					return json.RawMessage(fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":{}}`, req.ID.Value())), nil
+				default:
+					return nil, &rpcError{ID: req.ID, Code: methodNotFound, Message: req.Method + ": method not found", Data: nil}
+				}
+			})
+			require.NoError(t, err)
+
+			if tt.expected == "" {
+				require.Nil(t, body)
+				return
+			}
+			require.JSONEq(t, tt.expected, string(body))
+		})
+	}
+}
+
+func TestServeMessages_BatchSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	notifications := func(n int) string {
+		msgs := make([]string, n)
+		for i := range msgs {
+			msgs[i] = `{"jsonrpc":"2.0","method":"notifications/initialized"}`
+		}
+		return "[" + strings.Join(msgs, ",") + "]"
+	}
+
+	tests := map[string]struct {
+		limit    int
+		size     int
+		rejected bool
+	}{
+		"under the limit": {limit: 3, size: 2, rejected: false},
+		"at the limit":    {limit: 3, size: 3, rejected: false},
+		"over the limit":  {limit: 3, size: 4, rejected: true},
+		"no limit":        {limit: 0, size: 100, rejected: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newTestService(t)
+			s.maxBatchSize = tt.limit
+
+			r := httptest.NewRequest(http.MethodPost, "/mcp/petstore", strings.NewReader(notifications(tt.size)))
+			r.Header.Set(headerProtocolVersion, string(protocolVersion20250326))
+			w := httptest.NewRecorder()
+
+			inputs := newTestInputs()
+			require.NoError(t, s.serveMessages(t.Context(), w, r, inputs))
+
+			if !tt.rejected {
+				require.Equal(t, http.StatusAccepted, w.Code)
+				return
+			}
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), fmt.Sprintf("json-rpc batch of %d messages exceeds the limit of %d", tt.size, tt.limit))
+		})
+	}
+}
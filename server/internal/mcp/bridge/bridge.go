@@ -0,0 +1,622 @@
+// Package bridge connects MCP clients that only speak the stdio transport to
+// MCP servers hosted by Gram over the Streamable HTTP transport.
+package bridge
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+)
+
+const (
+	headerSessionID       = "Mcp-Session-Id"
+	headerProtocolVersion = "Mcp-Protocol-Version"
+	headerLastEventID     = "Last-Event-ID"
+
+	defaultMaxRetries = 5
+	minRetryDelay     = 500 * time.Millisecond
+	maxRetryDelay     = 30 * time.Second
+	terminateTimeout  = 5 * time.Second
+)
+
+var (
+	// errSessionExpired is returned when the server no longer recognizes the
+	// session and the client must initialize a new one.
+	errSessionExpired = errors.New("mcp session expired")
+	// errStreamUnsupported is returned when the server does not offer a
+	// standalone event stream for server-initiated messages.
+	errStreamUnsupported = errors.New("server does not support event streams")
+)
+
+// Authenticator supplies credentials for requests to the MCP server.
+type Authenticator interface {
+	// Authorization returns the value of the Authorization header. An empty
+	// value sends the request without credentials.
+	Authorization(ctx context.Context) (string, error)
+	// Refresh is called when the server rejects the current credentials with
+	// the value of its WWW-Authenticate header. It reports whether new
+	// credentials were obtained and the request should be retried.
+	Refresh(ctx context.Context, challenge string) (bool, error)
+}
+
+// Options configures a Bridge.
+type Options struct {
+	// URL is the Streamable HTTP endpoint of the MCP server.
+	URL string
+	// Headers are sent with every request, such as the MCP-* headers that
+	// supply environment variables to the server's tools.
+	Headers http.Header
+	// Auth supplies credentials. It may be nil for servers that do not
+	// require authentication.
+	Auth Authenticator
+	// HTTPClient sends requests. http.DefaultClient is used if nil.
+	HTTPClient *http.Client
+	// MaxRetries is the number of times a failed request is retried before
+	// an error is returned to the client. Defaults to 5.
+	MaxRetries int
+}
+
+// Bridge forwards newline-delimited JSON-RPC messages read from a local
+// client to an MCP server and writes the server's messages back.
+type Bridge struct {
+	logger *slog.Logger
+	opts   Options
+	client *http.Client
+	out    *messageWriter
+
+	mu              sync.Mutex
+	sessionID       string
+	protocolVersion string
+	// initialize and initialized are the client's handshake messages. They
+	// are replayed to start a new session when the server expires the
+	// current one.
+	initialize  json.RawMessage
+	initialized json.RawMessage
+
+	reinitialize sync.Mutex
+	listenOnce   sync.Once
+}
+
+func New(logger *slog.Logger, opts Options) *Bridge {
+	client := opts.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if opts.MaxRetries <= 0 {
+		opts.MaxRetries = defaultMaxRetries
+	}
+	if opts.Headers == nil {
+		opts.Headers = http.Header{}
+	}
+
+	return &Bridge{
+		logger:          logger.With(attr.SlogComponent("mcp_bridge")),
+		opts:            opts,
+		client:          client,
+		out:             nil,
+		mu:              sync.Mutex{},
+		sessionID:       "",
+		protocolVersion: "",
+		initialize:      nil,
+		initialized:     nil,
+		reinitialize:    sync.Mutex{},
+		listenOnce:      sync.Once{},
+	}
+}
+
+// envelope holds the fields of a JSON-RPC message that the bridge inspects.
+type envelope struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+}
+
+func (e envelope) isRequest() bool {
+	return len(e.ID) > 0 && e.Method != ""
+}
+
+// idempotentMethods are requests that can be sent again without side effects
+// when the outcome of an earlier attempt is unknown.
+var idempotentMethods = map[string]bool{
+	"initialize":               true,
+	"ping":                     true,
+	"tools/list":               true,
+	"prompts/list":             true,
+	"prompts/get":              true,
+	"resources/list":           true,
+	"resources/templates/list": true,
+	"resources/read":           true,
+	"completion/complete":      true,
+	"logging/setLevel":         true,
+}
+
+// idempotent reports whether the message can be sent again after a failure
+// that the server may have seen. Notifications and responses to server
+// requests are safe to repeat.
+func (e envelope) idempotent() bool {
+	if e.Method == "" || strings.HasPrefix(e.Method, "notifications/") {
+		return true
+	}
+
+	return idempotentMethods[e.Method]
+}
+
+// Run bridges messages until in is exhausted or ctx is done. The session is
+// terminated on the server before Run returns.
+func (b *Bridge) Run(ctx context.Context, in io.Reader, out io.Writer) error {
+	b.out = &messageWriter{mu: sync.Mutex{}, w: out}
+
+	listenCtx, stopListening := context.WithCancel(ctx)
+	defer stopListening()
+
+	var requests, listener sync.WaitGroup
+	defer listener.Wait()
+
+	reader := bufio.NewReader(in)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if msg := bytes.TrimSpace(line); len(msg) > 0 {
+			var env envelope
+			if err := json.Unmarshal(msg, &env); err != nil {
+				b.logger.WarnContext(ctx, "discarding malformed message from client", attr.SlogError(err))
+				continue
+			}
+
+			switch env.Method {
+			case "initialize", "notifications/initialized":
+				// The handshake must complete before anything else is sent
+				// so that later messages carry the new session id.
+				b.forward(ctx, msg, env)
+				if env.Method == "notifications/initialized" {
+					b.listenOnce.Do(func() {
+						listener.Add(1)
+						go func() {
+							defer listener.Done()
+							b.listen(listenCtx)
+						}()
+					})
+				}
+			default:
+				requests.Add(1)
+				go func() {
+					defer requests.Done()
+					b.forward(ctx, msg, env)
+				}()
+			}
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			requests.Wait()
+			b.terminate(ctx)
+			return fmt.Errorf("read client message: %w", readErr)
+		}
+	}
+
+	requests.Wait()
+	stopListening()
+	b.terminate(ctx)
+
+	return nil
+}
+
+// forward sends a client message to the server and relays the response.
+// Requests that cannot be delivered are answered with a JSON-RPC error so
+// that the client does not wait forever.
+func (b *Bridge) forward(ctx context.Context, msg json.RawMessage, env envelope) {
+	switch env.Method {
+	case "initialize":
+		b.mu.Lock()
+		b.initialize = msg
+		b.sessionID = ""
+		b.mu.Unlock()
+	case "notifications/initialized":
+		b.mu.Lock()
+		b.initialized = msg
+		b.mu.Unlock()
+	}
+
+	deliver := b.out.write
+	if env.Method == "initialize" {
+		deliver = func(data []byte) {
+			b.recordProtocolVersion(data)
+			b.out.write(data)
+		}
+	}
+
+	err := b.send(ctx, msg, env.idempotent(), deliver)
+	if err == nil {
+		return
+	}
+
+	b.logger.ErrorContext(ctx, "failed to forward message to mcp server", attr.SlogError(err))
+	if env.isRequest() {
+		b.out.writeError(env.ID, err)
+	}
+}
+
+// send posts a message with retries. Expired sessions are replaced and
+// rejected credentials are refreshed before trying again. Other failures are
+// only retried for idempotent messages, or when the message never reached the
+// server, so that tool calls are not run twice.
+func (b *Bridge) send(ctx context.Context, msg json.RawMessage, idempotent bool, deliver func([]byte)) error {
+	var lastErr error
+	for attempt := 0; attempt <= b.opts.MaxRetries; attempt++ {
+		sessionID := b.currentSessionID()
+		err := b.post(ctx, msg, sessionID, deliver)
+
+		var authErr *authError
+		var statusErr *statusError
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, errSessionExpired):
+			if rerr := b.restartSession(ctx, sessionID); rerr != nil {
+				return rerr
+			}
+		case errors.As(err, &authErr):
+			if ok, rerr := b.refreshAuth(ctx, authErr.challenge); rerr != nil || !ok {
+				return errors.Join(err, rerr)
+			}
+		case errors.As(err, &statusErr) && (!idempotent || !statusErr.retryable()):
+			return err
+		case ctx.Err() != nil:
+			return fmt.Errorf("forward message: %w", ctx.Err())
+		case !idempotent && !neverSent(err):
+			return err
+		default:
+			b.logger.WarnContext(ctx, "failed to reach mcp server, retrying", attr.SlogError(err))
+			if serr := sleep(ctx, retryDelay(attempt+1)); serr != nil {
+				return serr
+			}
+		}
+
+		lastErr = err
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %w", b.opts.MaxRetries+1, lastErr)
+}
+
+// post sends one message and delivers every message the server returns in
+// its response, whether as a single JSON body or an event stream.
+func (b *Bridge) post(ctx context.Context, msg json.RawMessage, sessionID string, deliver func([]byte)) error {
+	req, err := b.newRequest(ctx, http.MethodPost, sessionID, bytes.NewReader(msg))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+
+	res, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return res.Body.Close() })
+
+	if err := b.checkResponse(res, sessionID); err != nil {
+		// JSON-RPC errors are relayed to the client as they are.
+		var statusErr *statusError
+		if errors.As(err, &statusErr) && isMessage([]byte(statusErr.body)) {
+			deliver([]byte(statusErr.body))
+			return nil
+		}
+		return err
+	}
+
+	if id := res.Header.Get(headerSessionID); id != "" {
+		b.mu.Lock()
+		b.sessionID = id
+		b.mu.Unlock()
+	}
+
+	switch {
+	case res.StatusCode == http.StatusAccepted || res.StatusCode == http.StatusNoContent:
+		return nil
+	case isEventStream(res.Header):
+		return readEvents(res.Body, func(ev event) { deliver(ev.data) })
+	default:
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf("read response: %w", err)
+		}
+		if len(bytes.TrimSpace(body)) > 0 {
+			deliver(body)
+		}
+		return nil
+	}
+}
+
+// listen holds open the server's event stream so that notifications and
+// requests that are not tied to a client request reach the client. It
+// reconnects until ctx is done.
+func (b *Bridge) listen(ctx context.Context) {
+	lastEventID := ""
+	for attempt := 0; ctx.Err() == nil; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, retryDelay(attempt)); err != nil {
+				return
+			}
+		}
+
+		sessionID := b.currentSessionID()
+		received, err := b.openStream(ctx, sessionID, &lastEventID)
+		if received {
+			attempt = 0
+		}
+
+		var authErr *authError
+		switch {
+		case ctx.Err() != nil:
+			return
+		case errors.Is(err, errStreamUnsupported):
+			b.logger.InfoContext(ctx, "mcp server does not offer an event stream")
+			return
+		case errors.Is(err, errSessionExpired):
+			lastEventID = ""
+			if rerr := b.restartSession(ctx, sessionID); rerr != nil {
+				b.logger.ErrorContext(ctx, "failed to restart mcp session", attr.SlogError(rerr))
+			}
+		case errors.As(err, &authErr):
+			if ok, rerr := b.refreshAuth(ctx, authErr.challenge); rerr != nil || !ok {
+				b.logger.ErrorContext(ctx, "mcp server rejected credentials for event stream", attr.SlogError(errors.Join(err, rerr)))
+				return
+			}
+		case err != nil:
+			b.logger.WarnContext(ctx, "mcp event stream disconnected, reconnecting", attr.SlogError(err))
+		}
+	}
+}
+
+func (b *Bridge) openStream(ctx context.Context, sessionID string, lastEventID *string) (bool, error) {
+	req, err := b.newRequest(ctx, http.MethodGet, sessionID, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if *lastEventID != "" {
+		req.Header.Set(headerLastEventID, *lastEventID)
+	}
+
+	res, err := b.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("open event stream: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return res.Body.Close() })
+
+	if res.StatusCode == http.StatusMethodNotAllowed {
+		return false, errStreamUnsupported
+	}
+	if err := b.checkResponse(res, sessionID); err != nil {
+		return false, err
+	}
+
+	received := false
+	err = readEvents(res.Body, func(ev event) {
+		received = true
+		if ev.id != "" {
+			*lastEventID = ev.id
+		}
+		b.out.write(ev.data)
+	})
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return received, err
+}
+
+// terminate ends the session on the server. Failures are ignored because the
+// server expires idle sessions on its own.
+func (b *Bridge) terminate(ctx context.Context) {
+	sessionID := b.currentSessionID()
+	if sessionID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), terminateTimeout)
+	defer cancel()
+
+	req, err := b.newRequest(ctx, http.MethodDelete, sessionID, nil)
+	if err != nil {
+		return
+	}
+
+	res, err := b.client.Do(req)
+	if err != nil {
+		b.logger.DebugContext(ctx, "failed to terminate mcp session", attr.SlogError(err))
+		return
+	}
+	o11y.NoLogDefer(func() error { return res.Body.Close() })
+}
+
+// restartSession replays the client's handshake after the server expired the
+// session. Concurrent callers that saw the same expired session wait for a
+// single replacement.
+func (b *Bridge) restartSession(ctx context.Context, expired string) error {
+	b.reinitialize.Lock()
+	defer b.reinitialize.Unlock()
+
+	b.mu.Lock()
+	initialize, initialized := b.initialize, b.initialized
+	if b.sessionID != expired {
+		b.mu.Unlock()
+		return nil
+	}
+	b.sessionID = ""
+	b.mu.Unlock()
+
+	if initialize == nil {
+		return errors.New("mcp session expired before the client initialized it")
+	}
+
+	b.logger.InfoContext(ctx, "mcp session expired, starting a new one")
+
+	discard := func([]byte) {}
+	if err := b.post(ctx, initialize, "", b.recordProtocolVersion); err != nil {
+		return fmt.Errorf("reinitialize session: %w", err)
+	}
+	if initialized != nil {
+		if err := b.post(ctx, initialized, b.currentSessionID(), discard); err != nil {
+			return fmt.Errorf("reinitialize session: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (b *Bridge) refreshAuth(ctx context.Context, challenge string) (bool, error) {
+	if b.opts.Auth == nil {
+		return false, nil
+	}
+
+	ok, err := b.opts.Auth.Refresh(ctx, challenge)
+	if err != nil {
+		return false, fmt.Errorf("refresh credentials: %w", err)
+	}
+
+	return ok, nil
+}
+
+func (b *Bridge) newRequest(ctx context.Context, method string, sessionID string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, b.opts.URL, body)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	for key, values := range b.opts.Headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if b.opts.Auth != nil {
+		authz, err := b.opts.Auth.Authorization(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get credentials: %w", err)
+		}
+		if authz != "" {
+			req.Header.Set("Authorization", authz)
+		}
+	}
+
+	b.mu.Lock()
+	version := b.protocolVersion
+	b.mu.Unlock()
+
+	if sessionID != "" {
+		req.Header.Set(headerSessionID, sessionID)
+	}
+	if version != "" {
+		req.Header.Set(headerProtocolVersion, version)
+	}
+
+	return req, nil
+}
+
+// checkResponse converts unsuccessful responses into errors that send and
+// listen know how to recover from.
+func (b *Bridge) checkResponse(res *http.Response, sessionID string) error {
+	switch {
+	case res.StatusCode < http.StatusBadRequest:
+		return nil
+	case res.StatusCode == http.StatusNotFound && sessionID != "":
+		return errSessionExpired
+	case res.StatusCode == http.StatusUnauthorized:
+		return &authError{challenge: res.Header.Get("WWW-Authenticate")}
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+	return &statusError{status: res.StatusCode, body: strings.TrimSpace(string(body))}
+}
+
+func (b *Bridge) currentSessionID() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.sessionID
+}
+
+// recordProtocolVersion remembers the revision the server negotiated in its
+// initialize response so that it can be sent on later requests.
+func (b *Bridge) recordProtocolVersion(data []byte) {
+	var res struct {
+		Result struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil || res.Result.ProtocolVersion == "" {
+		return
+	}
+
+	b.mu.Lock()
+	b.protocolVersion = res.Result.ProtocolVersion
+	b.mu.Unlock()
+}
+
+type authError struct {
+	challenge string
+}
+
+func (e *authError) Error() string {
+	return "mcp server rejected credentials"
+}
+
+type statusError struct {
+	status int
+	body   string
+}
+
+func (e *statusError) Error() string {
+	if e.body == "" {
+		return fmt.Sprintf("mcp server responded with status %d", e.status)
+	}
+
+	return fmt.Sprintf("mcp server responded with status %d: %s", e.status, e.body)
+}
+
+func (e *statusError) retryable() bool {
+	return e.status == http.StatusTooManyRequests || e.status >= http.StatusInternalServerError
+}
+
+// neverSent reports whether a request failed before a connection to the
+// server was established, which proves that the server did not receive it.
+func neverSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func retryDelay(attempt int) time.Duration {
+	delay := minRetryDelay << min(attempt-1, 10)
+	return min(delay, maxRetryDelay)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait to retry: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
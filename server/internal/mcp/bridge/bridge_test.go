@@ -0,0 +1,316 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// fakeServer is an MCP server that answers POSTs with handle. Event streams
+// are not offered and session termination always succeeds.
+func fakeServer(t *testing.T, handle func(w http.ResponseWriter, r *http.Request, msg rpcMessage)) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			var msg rpcMessage
+			if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			handle(w, r, msg)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func writeResult(w http.ResponseWriter, msg rpcMessage, result string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(msg.ID) + `,"result":` + result + `}`))
+}
+
+// runBridge sends the given messages through a bridge and returns what it
+// wrote back to the client.
+func runBridge(t *testing.T, opts Options, messages ...string) []rpcMessage {
+	t.Helper()
+
+	var out bytes.Buffer
+	b := New(testenv.NewLogger(t), opts)
+	require.NoError(t, b.Run(t.Context(), strings.NewReader(strings.Join(messages, "\n")+"\n"), &out))
+
+	var received []rpcMessage
+	for line := range strings.SplitSeq(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var msg rpcMessage
+		require.NoError(t, json.Unmarshal([]byte(line), &msg))
+		received = append(received, msg)
+	}
+
+	return received
+}
+
+func TestBridge_Send_RetriesIdempotentRequests(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := fakeServer(t, func(w http.ResponseWriter, r *http.Request, msg rpcMessage) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeResult(w, msg, `{"tools":[]}`)
+	})
+
+	received := runBridge(t, Options{URL: server.URL, Headers: nil, Auth: nil, HTTPClient: nil, MaxRetries: 2},
+		`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`,
+	)
+
+	require.Len(t, received, 1)
+	require.Nil(t, received[0].Error)
+	require.JSONEq(t, `{"tools":[]}`, string(received[0].Result))
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestBridge_Send_DoesNotRetryToolCalls(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(w http.ResponseWriter){
+		"server error": func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusBadGateway)
+		},
+		"rate limited": func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		},
+		"connection dropped": func(w http.ResponseWriter) {
+			conn, _, err := http.NewResponseController(w).Hijack()
+			if err == nil {
+				_ = conn.Close()
+			}
+		},
+	}
+
+	for name, fail := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := fakeServer(t, func(w http.ResponseWriter, r *http.Request, msg rpcMessage) {
+				calls.Add(1)
+				fail(w)
+			})
+
+			received := runBridge(t, Options{URL: server.URL, Headers: nil, Auth: nil, HTTPClient: nil, MaxRetries: 2},
+				`{"jsonrpc":"2.0","id":7,"method":"tools/call","params":{"name":"create_order"}}`,
+			)
+
+			require.Len(t, received, 1)
+			require.JSONEq(t, `7`, string(received[0].ID))
+			require.NotNil(t, received[0].Error)
+			require.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+// failFirstDial fails the first request with a dial error before it reaches
+// the server.
+type failFirstDial struct {
+	failed atomic.Bool
+}
+
+func (f *failFirstDial) RoundTrip(req *http.Request) (*http.Response, error) {
+	if f.failed.CompareAndSwap(false, true) {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Source: nil, Addr: nil, Err: errors.New("connection refused")}
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestBridge_Send_RetriesToolCallsThatNeverReachedServer(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := fakeServer(t, func(w http.ResponseWriter, r *http.Request, msg rpcMessage) {
+		calls.Add(1)
+		writeResult(w, msg, `{"content":[]}`)
+	})
+
+	client := &http.Client{Transport: &failFirstDial{failed: atomic.Bool{}}}
+	received := runBridge(t, Options{URL: server.URL, Headers: nil, Auth: nil, HTTPClient: client, MaxRetries: 2},
+		`{"jsonrpc":"2.0","id":7,"method":"tools/call","params":{"name":"create_order"}}`,
+	)
+
+	require.Len(t, received, 1)
+	require.Nil(t, received[0].Error)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestBridge_Send_ReinitializesExpiredSession(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var initializes int
+	current := ""
+	var initialized []string
+	server := fakeServer(t, func(w http.ResponseWriter, r *http.Request, msg rpcMessage) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		sessionID := r.Header.Get(headerSessionID)
+		switch msg.Method {
+		case "initialize":
+			initializes++
+			current = []string{"", "first", "second"}[initializes]
+			w.Header().Set(headerSessionID, current)
+			writeResult(w, msg, `{"protocolVersion":"2025-06-18"}`)
+		case "notifications/initialized":
+			initialized = append(initialized, sessionID)
+			w.WriteHeader(http.StatusAccepted)
+		case "tools/list":
+			// The first session expires as soon as the client uses it.
+			if sessionID != "second" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Header.Get(headerProtocolVersion) != "2025-06-18" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			writeResult(w, msg, `{"tools":[]}`)
+		}
+	})
+
+	received := runBridge(t, Options{URL: server.URL, Headers: nil, Auth: nil, HTTPClient: nil, MaxRetries: 2},
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+	)
+
+	// The replayed handshake is not shown to the client.
+	require.Len(t, received, 2)
+	require.JSONEq(t, `1`, string(received[0].ID))
+	require.JSONEq(t, `2`, string(received[1].ID))
+	require.JSONEq(t, `{"tools":[]}`, string(received[1].Result))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 2, initializes)
+	require.Equal(t, []string{"first", "second"}, initialized)
+}
+
+// rotatingAuth hands out a new token every time the server rejects the
+// current one.
+type rotatingAuth struct {
+	mu         sync.Mutex
+	token      string
+	challenges []string
+}
+
+func (a *rotatingAuth) Authorization(context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return "Bearer " + a.token, nil
+}
+
+func (a *rotatingAuth) Refresh(_ context.Context, challenge string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.challenges = append(a.challenges, challenge)
+	a.token = "fresh"
+	return true, nil
+}
+
+func TestBridge_Send_RefreshesRejectedCredentials(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := fakeServer(t, func(w http.ResponseWriter, r *http.Request, msg rpcMessage) {
+		calls.Add(1)
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.Header().Set("WWW-Authenticate", `Bearer resource_metadata="https://example.com/meta"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeResult(w, msg, `{"content":[]}`)
+	})
+
+	auth := &rotatingAuth{mu: sync.Mutex{}, token: "stale", challenges: nil}
+	received := runBridge(t, Options{URL: server.URL, Headers: nil, Auth: auth, HTTPClient: nil, MaxRetries: 2},
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"create_order"}}`,
+	)
+
+	require.Len(t, received, 1)
+	require.Nil(t, received[0].Error)
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, []string{`Bearer resource_metadata="https://example.com/meta"`}, auth.challenges)
+}
+
+func TestBridge_Send_GivesUpWhenCredentialsAreNotRefreshed(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := fakeServer(t, func(w http.ResponseWriter, r *http.Request, msg rpcMessage) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	received := runBridge(t, Options{URL: server.URL, Headers: nil, Auth: APIKey("gram_key"), HTTPClient: nil, MaxRetries: 2},
+		`{"jsonrpc":"2.0","id":4,"method":"tools/list"}`,
+	)
+
+	require.Len(t, received, 1)
+	require.NotNil(t, received[0].Error)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestBridge_Send_RelaysJSONRPCErrors(t *testing.T) {
+	t.Parallel()
+
+	server := fakeServer(t, func(w http.ResponseWriter, r *http.Request, msg rpcMessage) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, `{"jsonrpc":"2.0","id":`+string(msg.ID)+`,"error":{"code":-32602,"message":"bad params"}}`)
+	})
+
+	received := runBridge(t, Options{URL: server.URL, Headers: nil, Auth: nil, HTTPClient: nil, MaxRetries: 2},
+		`{"jsonrpc":"2.0","id":5,"method":"prompts/get","params":{}}`,
+	)
+
+	require.Len(t, received, 1)
+	require.NotNil(t, received[0].Error)
+	require.Equal(t, -32602, received[0].Error.Code)
+	require.Equal(t, "bad params", received[0].Error.Message)
+}
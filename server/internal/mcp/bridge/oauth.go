@@ -0,0 +1,498 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+)
+
+const (
+	// LoginBrowser signs in with the authorization code flow, receiving the
+	// code on a loopback redirect.
+	LoginBrowser = "browser"
+	// LoginDevice signs in with the device authorization flow, which suits
+	// machines without a local browser.
+	LoginDevice = "device"
+
+	loginTimeout = 5 * time.Minute
+	// loginReuseWindow stops concurrent requests that were all rejected
+	// with the same stale token from each starting a login.
+	loginReuseWindow = 30 * time.Second
+	deviceCodeGrant  = "urn:ietf:params:oauth:grant-type:device_code"
+)
+
+// APIKey authenticates with a Gram API key.
+type APIKey string
+
+var _ Authenticator = APIKey("")
+
+func (k APIKey) Authorization(context.Context) (string, error) {
+	key := string(k)
+	if strings.HasPrefix(strings.ToLower(key), "bearer ") {
+		return key, nil
+	}
+
+	return "Bearer " + key, nil
+}
+
+func (k APIKey) Refresh(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+// OAuthOptions configures OAuth.
+type OAuthOptions struct {
+	// MCPURL is the MCP server that tokens are requested for.
+	MCPURL string
+	// Method is LoginBrowser or LoginDevice.
+	Method string
+	// ClientName is the name registered with the authorization server.
+	ClientName string
+	// Store keeps tokens between runs. Tokens are kept in memory only if
+	// nil.
+	Store *TokenStore
+	// Prompt receives instructions for the user, such as the URL to open.
+	// It must not be the stream used for JSON-RPC messages.
+	Prompt io.Writer
+}
+
+// OAuth signs the user in to the MCP server's authorization server when the
+// server asks for credentials and refreshes the resulting tokens.
+type OAuth struct {
+	opts OAuthOptions
+
+	mu        sync.Mutex
+	config    *oauth2.Config
+	source    oauth2.TokenSource
+	token     *oauth2.Token
+	lastLogin time.Time
+}
+
+var _ Authenticator = (*OAuth)(nil)
+
+func NewOAuth(opts OAuthOptions) *OAuth {
+	if opts.Method == "" {
+		opts.Method = LoginBrowser
+	}
+
+	o := &OAuth{
+		opts:      opts,
+		mu:        sync.Mutex{},
+		config:    nil,
+		source:    nil,
+		token:     nil,
+		lastLogin: time.Time{},
+	}
+
+	if cred, ok := opts.Store.load(opts.MCPURL); ok {
+		o.use(cred.config(), cred.Token)
+	}
+
+	return o
+}
+
+func (o *OAuth) Authorization(ctx context.Context) (string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.source == nil {
+		return "", nil
+	}
+
+	token, err := o.source.Token()
+	if err != nil {
+		// The refresh token is no longer valid. Sending no credentials makes
+		// the server ask for them and triggers a new login.
+		o.source = nil
+		return "", nil
+	}
+
+	if o.token == nil || token.AccessToken != o.token.AccessToken {
+		o.token = token
+		o.save()
+	}
+
+	return token.Type() + " " + token.AccessToken, nil
+}
+
+func (o *OAuth) Refresh(ctx context.Context, challenge string) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if time.Since(o.lastLogin) < loginReuseWindow {
+		return true, nil
+	}
+
+	metadata, err := discoverAuthorizationServer(ctx, o.opts.MCPURL, challenge)
+	if err != nil {
+		return false, err
+	}
+
+	var config *oauth2.Config
+	var token *oauth2.Token
+	switch o.opts.Method {
+	case LoginDevice:
+		config, token, err = o.loginWithDevice(ctx, metadata)
+	default:
+		config, token, err = o.loginWithBrowser(ctx, metadata)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	o.use(config, token)
+	o.lastLogin = time.Now()
+	o.save()
+
+	return true, nil
+}
+
+func (o *OAuth) use(config *oauth2.Config, token *oauth2.Token) {
+	o.config = config
+	o.token = token
+	o.source = config.TokenSource(context.Background(), token)
+}
+
+func (o *OAuth) save() {
+	if o.config == nil || o.token == nil {
+		return
+	}
+
+	o.opts.Store.save(o.opts.MCPURL, newStoredCredential(o.config, o.token))
+}
+
+func (o *OAuth) loginWithBrowser(ctx context.Context, metadata *authorizationServerMetadata) (*oauth2.Config, *oauth2.Token, error) {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, nil, fmt.Errorf("listen for login redirect: %w", err)
+	}
+	redirectURL := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+
+	client, err := registerClient(ctx, metadata, o.clientRegistration([]string{redirectURL}, []string{"authorization_code", "refresh_token"}))
+	if err != nil {
+		_ = listener.Close()
+		return nil, nil, err
+	}
+
+	config := metadata.config(client)
+	config.RedirectURL = redirectURL
+
+	state := randomString()
+	verifier := oauth2.GenerateVerifier()
+
+	type callback struct {
+		code string
+		err  error
+	}
+	results := make(chan callback, 1)
+	report := func(result callback) {
+		select {
+		case results <- result:
+		default:
+		}
+	}
+
+	srv := &http.Server{
+		ReadHeaderTimeout: 10 * time.Second,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/callback" {
+				http.NotFound(w, r)
+				return
+			}
+
+			query := r.URL.Query()
+			switch {
+			case query.Get("state") != state:
+				http.Error(w, "Login failed: state mismatch.", http.StatusBadRequest)
+				report(callback{code: "", err: errors.New("login redirect state mismatch")})
+			case query.Get("error") != "":
+				http.Error(w, "Login failed: "+query.Get("error"), http.StatusBadRequest)
+				report(callback{code: "", err: fmt.Errorf("login failed: %s %s", query.Get("error"), query.Get("error_description"))})
+			default:
+				_, _ = io.WriteString(w, "Signed in to Gram. You can close this window.")
+				report(callback{code: query.Get("code"), err: nil})
+			}
+		}),
+	}
+	go func() { _ = srv.Serve(listener) }()
+	defer o11y.NoLogDefer(func() error { return srv.Close() })
+
+	authURL := config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier), oauth2.SetAuthURLParam("resource", o.opts.MCPURL))
+	fmt.Fprintf(o.opts.Prompt, "Open the following URL to sign in to %s:\n\n  %s\n\n", o.opts.MCPURL, authURL)
+	openBrowser(ctx, authURL)
+
+	ctx, cancel := context.WithTimeout(ctx, loginTimeout)
+	defer cancel()
+
+	var result callback
+	select {
+	case <-ctx.Done():
+		return nil, nil, fmt.Errorf("wait for login: %w", ctx.Err())
+	case result = <-results:
+	}
+	if result.err != nil {
+		return nil, nil, result.err
+	}
+
+	token, err := config.Exchange(ctx, result.code, oauth2.VerifierOption(verifier), oauth2.SetAuthURLParam("resource", o.opts.MCPURL))
+	if err != nil {
+		return nil, nil, fmt.Errorf("exchange authorization code: %w", err)
+	}
+
+	return config, token, nil
+}
+
+func (o *OAuth) loginWithDevice(ctx context.Context, metadata *authorizationServerMetadata) (*oauth2.Config, *oauth2.Token, error) {
+	if metadata.DeviceAuthorizationEndpoint == "" {
+		return nil, nil, errors.New("the authorization server does not support device login, use browser login instead")
+	}
+
+	client, err := registerClient(ctx, metadata, o.clientRegistration([]string{}, []string{deviceCodeGrant, "refresh_token"}))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := metadata.config(client)
+
+	device, err := config.DeviceAuth(ctx, oauth2.SetAuthURLParam("resource", o.opts.MCPURL))
+	if err != nil {
+		return nil, nil, fmt.Errorf("start device login: %w", err)
+	}
+
+	fmt.Fprintf(o.opts.Prompt, "To sign in to %s, open %s and enter the code %s\n\n", o.opts.MCPURL, device.VerificationURI, device.UserCode)
+	if device.VerificationURIComplete != "" {
+		openBrowser(ctx, device.VerificationURIComplete)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, loginTimeout)
+	defer cancel()
+
+	token, err := config.DeviceAccessToken(ctx, device)
+	if err != nil {
+		return nil, nil, fmt.Errorf("complete device login: %w", err)
+	}
+
+	return config, token, nil
+}
+
+func (o *OAuth) clientRegistration(redirectURIs []string, grantTypes []string) clientRegistration {
+	return clientRegistration{
+		ClientName:              o.opts.ClientName,
+		RedirectURIs:            redirectURIs,
+		GrantTypes:              grantTypes,
+		ResponseTypes:           []string{"code"},
+		TokenEndpointAuthMethod: "none",
+		ApplicationType:         "native",
+	}
+}
+
+type protectedResourceMetadata struct {
+	AuthorizationServers []string `json:"authorization_servers"`
+}
+
+type authorizationServerMetadata struct {
+	AuthorizationEndpoint       string `json:"authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	RegistrationEndpoint        string `json:"registration_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+func (m *authorizationServerMetadata) config(client *registeredClient) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     client.ClientID,
+		ClientSecret: client.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       m.AuthorizationEndpoint,
+			TokenURL:      m.TokenEndpoint,
+			DeviceAuthURL: m.DeviceAuthorizationEndpoint,
+			AuthStyle:     oauth2.AuthStyleInParams,
+		},
+		RedirectURL: "",
+		Scopes:      nil,
+	}
+}
+
+// discoverAuthorizationServer finds the authorization server for an MCP
+// server, starting from the resource metadata URL in its WWW-Authenticate
+// challenge as described by the MCP authorization spec.
+func discoverAuthorizationServer(ctx context.Context, mcpURL string, challenge string) (*authorizationServerMetadata, error) {
+	resourceMetadataURL := challengeParam(challenge, "resource_metadata")
+	if resourceMetadataURL == "" {
+		u, err := wellKnownURL(mcpURL, "oauth-protected-resource")
+		if err != nil {
+			return nil, err
+		}
+		resourceMetadataURL = u
+	}
+
+	var resource protectedResourceMetadata
+	if err := getJSON(ctx, resourceMetadataURL, &resource); err != nil {
+		return nil, fmt.Errorf("get protected resource metadata: %w", err)
+	}
+	if len(resource.AuthorizationServers) == 0 {
+		return nil, errors.New("mcp server does not name an authorization server")
+	}
+
+	metadataURL, err := wellKnownURL(resource.AuthorizationServers[0], "oauth-authorization-server")
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata authorizationServerMetadata
+	if err := getJSON(ctx, metadataURL, &metadata); err != nil {
+		return nil, fmt.Errorf("get authorization server metadata: %w", err)
+	}
+	if metadata.TokenEndpoint == "" {
+		return nil, errors.New("authorization server metadata has no token endpoint")
+	}
+
+	return &metadata, nil
+}
+
+type clientRegistration struct {
+	ClientName              string   `json:"client_name"`
+	RedirectURIs            []string `json:"redirect_uris"`
+	GrantTypes              []string `json:"grant_types"`
+	ResponseTypes           []string `json:"response_types"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method"`
+	ApplicationType         string   `json:"application_type"`
+}
+
+type registeredClient struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// registerClient registers the bridge with the authorization server using
+// dynamic client registration.
+func registerClient(ctx context.Context, metadata *authorizationServerMetadata, registration clientRegistration) (*registeredClient, error) {
+	if metadata.RegistrationEndpoint == "" {
+		return nil, errors.New("the authorization server does not support dynamic client registration")
+	}
+
+	body, err := json.Marshal(registration)
+	if err != nil {
+		return nil, fmt.Errorf("marshal client registration: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.RegistrationEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create client registration request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("register client: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return res.Body.Close() })
+
+	if res.StatusCode >= http.StatusBadRequest {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return nil, fmt.Errorf("register client: status %d: %s", res.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var client registeredClient
+	if err := json.NewDecoder(res.Body).Decode(&client); err != nil {
+		return nil, fmt.Errorf("decode client registration: %w", err)
+	}
+	if client.ClientID == "" {
+		return nil, errors.New("client registration did not return a client id")
+	}
+
+	return &client, nil
+}
+
+func getJSON(ctx context.Context, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return res.Body.Close() })
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", res.StatusCode, rawURL)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	return nil
+}
+
+// wellKnownURL inserts a well-known path segment between the host and path
+// of an issuer or resource URL as described by RFC 8414 and RFC 9728.
+func wellKnownURL(rawURL string, name string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("parse url: %w", err)
+	}
+
+	u.Path = "/.well-known/" + name + strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u.String(), nil
+}
+
+// challengeParam extracts a parameter from a WWW-Authenticate challenge.
+func challengeParam(challenge string, name string) string {
+	for part := range strings.SplitSeq(challenge, ",") {
+		part = strings.TrimSpace(part)
+		part = strings.TrimPrefix(part, "Bearer ")
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok && strings.EqualFold(key, name) {
+			return strings.Trim(value, `"`)
+		}
+	}
+
+	return ""
+}
+
+func randomString() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// openBrowser makes a best effort to open a URL in the user's browser. The
+// URL is always printed as well in case this fails.
+func openBrowser(ctx context.Context, target string) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.CommandContext(ctx, "open", target)
+	case "windows":
+		cmd = exec.CommandContext(ctx, "rundll32", "url.dll,FileProtocolHandler", target)
+	default:
+		cmd = exec.CommandContext(ctx, "xdg-open", target)
+	}
+
+	if err := cmd.Start(); err == nil {
+		go func() { _ = cmd.Wait() }()
+	}
+}
@@ -0,0 +1,181 @@
+package bridge
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestAPIKey(t *testing.T) {
+	t.Parallel()
+
+	authz, err := APIKey("gram_live_123").Authorization(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer gram_live_123", authz)
+
+	authz, err = APIKey("bearer gram_live_123").Authorization(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "bearer gram_live_123", authz)
+
+	refreshed, err := APIKey("gram_live_123").Refresh(t.Context(), "")
+	require.NoError(t, err)
+	require.False(t, refreshed)
+}
+
+func TestChallengeParam(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		challenge string
+		name      string
+		expected  string
+	}{
+		"quoted":         {challenge: `Bearer resource_metadata="https://a.example/meta"`, name: "resource_metadata", expected: "https://a.example/meta"},
+		"among others":   {challenge: `Bearer realm="gram", error="invalid_token", resource_metadata="https://a.example/meta"`, name: "resource_metadata", expected: "https://a.example/meta"},
+		"case folded":    {challenge: `Bearer Realm=gram`, name: "realm", expected: "gram"},
+		"missing":        {challenge: `Bearer realm="gram"`, name: "resource_metadata", expected: ""},
+		"empty":          {challenge: "", name: "realm", expected: ""},
+		"bare parameter": {challenge: `Bearer error=invalid_token`, name: "error", expected: "invalid_token"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, challengeParam(tt.challenge, tt.name))
+		})
+	}
+}
+
+func TestWellKnownURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		url      string
+		expected string
+	}{
+		"host only":      {url: "https://auth.example.com", expected: "https://auth.example.com/.well-known/oauth-authorization-server"},
+		"with path":      {url: "https://app.example.com/oauth/tenant/", expected: "https://app.example.com/.well-known/oauth-authorization-server/oauth/tenant"},
+		"query stripped": {url: "https://auth.example.com/issuer?x=1#frag", expected: "https://auth.example.com/.well-known/oauth-authorization-server/issuer"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := wellKnownURL(tt.url, "oauth-authorization-server")
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, u)
+		})
+	}
+}
+
+func TestDiscoverAuthorizationServer(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	mux.HandleFunc("/.well-known/oauth-protected-resource/mcp/petstore", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"authorization_servers": []string{server.URL + "/oauth/petstore"}})
+	})
+	mux.HandleFunc("/custom-metadata", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"authorization_servers": []string{server.URL + "/oauth/custom"}})
+	})
+	for _, issuer := range []string{"petstore", "custom"} {
+		mux.HandleFunc("/.well-known/oauth-authorization-server/oauth/"+issuer, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, map[string]any{
+				"authorization_endpoint": server.URL + "/oauth/" + issuer + "/authorize",
+				"token_endpoint":         server.URL + "/oauth/" + issuer + "/token",
+			})
+		})
+	}
+
+	// Without a challenge the resource metadata is found at its well-known
+	// location.
+	metadata, err := discoverAuthorizationServer(t.Context(), server.URL+"/mcp/petstore", "")
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/oauth/petstore/token", metadata.TokenEndpoint)
+
+	// The challenge can point elsewhere.
+	metadata, err = discoverAuthorizationServer(t.Context(), server.URL+"/mcp/petstore", `Bearer resource_metadata="`+server.URL+`/custom-metadata"`)
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/oauth/custom/token", metadata.TokenEndpoint)
+
+	_, err = discoverAuthorizationServer(t.Context(), server.URL+"/mcp/unknown", "")
+	require.Error(t, err)
+}
+
+func TestOAuth_RefreshesStoredToken(t *testing.T) {
+	t.Parallel()
+
+	var refreshes atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "refresh_token" || r.PostForm.Get("refresh_token") != "refresh-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		refreshes.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-2","token_type":"Bearer","refresh_token":"refresh-2","expires_in":3600}`))
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	const mcpURL = "https://app.getgram.ai/mcp/petstore"
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	NewTokenStore(path).save(mcpURL, storedCredential{
+		ClientID:      "client",
+		ClientSecret:  "",
+		AuthURL:       tokenServer.URL + "/authorize",
+		TokenURL:      tokenServer.URL + "/token",
+		DeviceAuthURL: "",
+		Token: &oauth2.Token{
+			AccessToken:  "access-1",
+			TokenType:    "Bearer",
+			RefreshToken: "refresh-1",
+			Expiry:       time.Now().Add(-time.Minute),
+			ExpiresIn:    0,
+		},
+	})
+
+	auth := NewOAuth(OAuthOptions{MCPURL: mcpURL, Method: LoginDevice, ClientName: "test", Store: NewTokenStore(path), Prompt: nil})
+
+	authz, err := auth.Authorization(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer access-2", authz)
+
+	// The still valid token is reused without another refresh.
+	authz, err = auth.Authorization(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer access-2", authz)
+	require.Equal(t, int32(1), refreshes.Load())
+
+	// The refreshed token is persisted for the next run.
+	cred, ok := NewTokenStore(path).load(mcpURL)
+	require.True(t, ok)
+	require.Equal(t, "access-2", cred.Token.AccessToken)
+	require.Equal(t, "refresh-2", cred.Token.RefreshToken)
+}
+
+func TestOAuth_WithoutCredentials(t *testing.T) {
+	t.Parallel()
+
+	auth := NewOAuth(OAuthOptions{MCPURL: "https://app.getgram.ai/mcp/petstore", Method: "", ClientName: "test", Store: nil, Prompt: nil})
+
+	// Sending no credentials makes the server ask for them.
+	authz, err := auth.Authorization(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, authz)
+}
@@ -0,0 +1,134 @@
+package bridge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenStore keeps OAuth credentials on disk, keyed by MCP server URL, so
+// that users do not have to sign in every time their client starts the
+// bridge.
+type TokenStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewTokenStore stores credentials in the file at path.
+func NewTokenStore(path string) *TokenStore {
+	return &TokenStore{mu: sync.Mutex{}, path: path}
+}
+
+// DefaultTokenStorePath returns the location of the credentials file in the
+// user's configuration directory.
+func DefaultTokenStorePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find user config dir: %w", err)
+	}
+
+	return filepath.Join(dir, "gram", "mcp-credentials.json"), nil
+}
+
+type storedCredential struct {
+	ClientID      string        `json:"client_id"`
+	ClientSecret  string        `json:"client_secret,omitempty"`
+	AuthURL       string        `json:"auth_url"`
+	TokenURL      string        `json:"token_url"`
+	DeviceAuthURL string        `json:"device_auth_url,omitempty"`
+	Token         *oauth2.Token `json:"token"`
+}
+
+func newStoredCredential(config *oauth2.Config, token *oauth2.Token) storedCredential {
+	return storedCredential{
+		ClientID:      config.ClientID,
+		ClientSecret:  config.ClientSecret,
+		AuthURL:       config.Endpoint.AuthURL,
+		TokenURL:      config.Endpoint.TokenURL,
+		DeviceAuthURL: config.Endpoint.DeviceAuthURL,
+		Token:         token,
+	}
+}
+
+func (c storedCredential) config() *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       c.AuthURL,
+			TokenURL:      c.TokenURL,
+			DeviceAuthURL: c.DeviceAuthURL,
+			AuthStyle:     oauth2.AuthStyleInParams,
+		},
+		RedirectURL: "",
+		Scopes:      nil,
+	}
+}
+
+func (s *TokenStore) load(mcpURL string) (storedCredential, bool) {
+	if s == nil {
+		return storedCredential{}, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	creds, err := s.read()
+	if err != nil {
+		return storedCredential{}, false
+	}
+
+	cred, ok := creds[mcpURL]
+	return cred, ok && cred.Token != nil
+}
+
+// save records credentials for a server. Failing to persist them only means
+// that the user signs in again next time, so errors are ignored.
+func (s *TokenStore) save(mcpURL string, cred storedCredential) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	creds, err := s.read()
+	if err != nil {
+		creds = map[string]storedCredential{}
+	}
+	creds[mcpURL] = cred
+
+	bs, err := json.MarshalIndent(creds, "", "  ")
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return
+	}
+
+	_ = os.WriteFile(s.path, bs, 0o600)
+}
+
+func (s *TokenStore) read() (map[string]storedCredential, error) {
+	bs, err := os.ReadFile(s.path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return map[string]storedCredential{}, nil
+	case err != nil:
+		return nil, fmt.Errorf("read credentials: %w", err)
+	}
+
+	creds := map[string]storedCredential{}
+	if err := json.Unmarshal(bs, &creds); err != nil {
+		return nil, fmt.Errorf("parse credentials: %w", err)
+	}
+
+	return creds, nil
+}
@@ -0,0 +1,82 @@
+package bridge
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func newTestCredential(accessToken string) storedCredential {
+	return storedCredential{
+		ClientID:      "client",
+		ClientSecret:  "",
+		AuthURL:       "https://auth.example.com/authorize",
+		TokenURL:      "https://auth.example.com/token",
+		DeviceAuthURL: "",
+		Token: &oauth2.Token{
+			AccessToken:  accessToken,
+			TokenType:    "Bearer",
+			RefreshToken: "refresh",
+			Expiry:       time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+			ExpiresIn:    0,
+		},
+	}
+}
+
+func TestTokenStore_SaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "credentials.json")
+
+	store := NewTokenStore(path)
+	store.save("https://app.getgram.ai/mcp/a", newTestCredential("a"))
+	store.save("https://app.getgram.ai/mcp/b", newTestCredential("b"))
+
+	// A new store reads what an earlier run saved.
+	reopened := NewTokenStore(path)
+	cred, ok := reopened.load("https://app.getgram.ai/mcp/a")
+	require.True(t, ok)
+	require.Equal(t, "a", cred.Token.AccessToken)
+	require.Equal(t, "https://auth.example.com/token", cred.config().Endpoint.TokenURL)
+
+	cred, ok = reopened.load("https://app.getgram.ai/mcp/b")
+	require.True(t, ok)
+	require.Equal(t, "b", cred.Token.AccessToken)
+
+	_, ok = reopened.load("https://app.getgram.ai/mcp/c")
+	require.False(t, ok)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestTokenStore_CorruptFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0o600))
+
+	store := NewTokenStore(path)
+	_, ok := store.load("https://app.getgram.ai/mcp/a")
+	require.False(t, ok)
+
+	// Saving replaces the unreadable file.
+	store.save("https://app.getgram.ai/mcp/a", newTestCredential("a"))
+	cred, ok := store.load("https://app.getgram.ai/mcp/a")
+	require.True(t, ok)
+	require.Equal(t, "a", cred.Token.AccessToken)
+}
+
+func TestTokenStore_Nil(t *testing.T) {
+	t.Parallel()
+
+	var store *TokenStore
+	store.save("https://app.getgram.ai/mcp/a", newTestCredential("a"))
+	_, ok := store.load("https://app.getgram.ai/mcp/a")
+	require.False(t, ok)
+}
@@ -0,0 +1,115 @@
+package bridge
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// messageWriter writes newline-delimited JSON-RPC messages to the client.
+type messageWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// write sends a message to the client. Messages are compacted because the
+// stdio transport does not allow embedded newlines.
+func (m *messageWriter) write(data []byte) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return
+	}
+	buf.WriteByte('\n')
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, _ = m.w.Write(buf.Bytes())
+}
+
+// writeError answers a request that could not be delivered to the server.
+func (m *messageWriter) writeError(id json.RawMessage, cause error) {
+	bs, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"error": map[string]any{
+			"code":    -32603,
+			"message": cause.Error(),
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	m.write(bs)
+}
+
+// isMessage reports whether data is a JSON-RPC message.
+func isMessage(data []byte) bool {
+	var msg struct {
+		JSONRPC string `json:"jsonrpc"`
+	}
+
+	return json.Unmarshal(data, &msg) == nil && msg.JSONRPC == "2.0"
+}
+
+// event is a server-sent event carrying a JSON-RPC message.
+type event struct {
+	id   string
+	data []byte
+}
+
+func isEventStream(headers http.Header) bool {
+	mediatype, _, err := mime.ParseMediaType(headers.Get("Content-Type"))
+	return err == nil && mediatype == "text/event-stream"
+}
+
+// readEvents parses a server-sent event stream until it ends, calling handle
+// for every event that has data.
+func readEvents(r io.Reader, handle func(event)) error {
+	reader := bufio.NewReader(r)
+
+	var id string
+	var data bytes.Buffer
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("read event stream: %w", err)
+		}
+		eof := errors.Is(err, io.EOF)
+
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "":
+			if data.Len() > 0 {
+				handle(event{id: id, data: bytes.Clone(data.Bytes())})
+			}
+			data.Reset()
+		case strings.HasPrefix(line, ":"):
+			// Comments keep the connection alive and carry no message.
+		default:
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "id":
+				id = value
+			case "data":
+				if data.Len() > 0 {
+					data.WriteByte('\n')
+				}
+				data.WriteString(value)
+			}
+		}
+
+		if eof {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package bridge
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEvents(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		stream   string
+		expected []event
+	}{
+		"single event": {
+			stream:   "event: message\ndata: {\"a\":1}\n\n",
+			expected: []event{{id: "", data: []byte(`{"a":1}`)}},
+		},
+		"ids carry over": {
+			stream: "id: 1\ndata: one\n\ndata: two\n\nid: 3\ndata: three\n\n",
+			expected: []event{
+				{id: "1", data: []byte("one")},
+				{id: "1", data: []byte("two")},
+				{id: "3", data: []byte("three")},
+			},
+		},
+		"multi-line data and CRLF": {
+			stream:   "data: {\r\ndata:   \"a\": 1\r\ndata: }\r\n\r\n",
+			expected: []event{{id: "", data: []byte("{\n  \"a\": 1\n}")}},
+		},
+		"comments and empty events are skipped": {
+			stream:   ": keepalive\n\nevent: message\n\ndata: x\n\n",
+			expected: []event{{id: "", data: []byte("x")}},
+		},
+		"trailing event without separator": {
+			stream:   "data: x\n\ndata: y",
+			expected: []event{{id: "", data: []byte("x")}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var events []event
+			require.NoError(t, readEvents(strings.NewReader(tt.stream), func(ev event) {
+				events = append(events, ev)
+			}))
+			require.Equal(t, tt.expected, events)
+		})
+	}
+}
+
+func TestReadEvents_ReadError(t *testing.T) {
+	t.Parallel()
+
+	err := readEvents(iotest.ErrReader(errors.New("connection reset")), func(event) {})
+	require.ErrorContains(t, err, "connection reset")
+}
+
+func TestMessageWriter(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	w := &messageWriter{mu: sync.Mutex{}, w: &out}
+
+	w.write([]byte("{\n  \"jsonrpc\": \"2.0\",\n  \"method\": \"ping\"\n}"))
+	w.write([]byte("not json"))
+	w.writeError([]byte(`"req-1"`), errors.New("server unavailable"))
+
+	require.Equal(t,
+		`{"jsonrpc":"2.0","method":"ping"}`+"\n"+
+			`{"error":{"code":-32603,"message":"server unavailable"},"id":"req-1","jsonrpc":"2.0"}`+"\n",
+		out.String(),
+	)
+}
+
+func TestIsMessage(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isMessage([]byte(`{"jsonrpc":"2.0","id":1,"error":{}}`)))
+	require.False(t, isMessage([]byte(`{"error":"not found"}`)))
+	require.False(t, isMessage([]byte(`<html></html>`)))
+}
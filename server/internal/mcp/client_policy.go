@@ -0,0 +1,111 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+// client returns the identity the client declared when it initialized its
+// session. It is empty for requests that arrive before a session exists.
+func (p *mcpInputs) client() clientInfo {
+	if p.session == nil {
+		return clientInfo{Name: "", Version: ""}
+	}
+
+	return p.session.ClientInfo
+}
+
+// withClient tags the logger and the current span with the identity of the
+// MCP client so that requests can be traced back to the agent that made them.
+func withClient(ctx context.Context, logger *slog.Logger, client clientInfo) *slog.Logger {
+	if client.Name == "" {
+		return logger
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(
+		attr.McpClientName(client.Name),
+		attr.McpClientVersion(client.Version),
+	)
+
+	return logger.With(
+		attr.SlogMcpClientName(client.Name),
+		attr.SlogMcpClientVersion(client.Version),
+	)
+}
+
+// checkClientRules rejects requests from clients that a toolset's rules do
+// not admit. Clients identify themselves when they initialize a session so
+// requests made without one are rejected by any toolset with rules, otherwise
+// leaving out the session would get around them.
+func checkClientRules(ctx context.Context, logger *slog.Logger, rules []*types.ToolsetClientRule, payload *mcpInputs) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	if payload.session == nil {
+		return oops.E(oops.CodeForbidden, nil, "this MCP server only accepts clients that identify themselves, initialize a session first").Log(ctx, logger)
+	}
+
+	if refusal := clientRefusal(rules, payload.client()); refusal != "" {
+		return oops.E(oops.CodeForbidden, nil, "%s", refusal).Log(ctx, logger)
+	}
+
+	return nil
+}
+
+// clientRefusal checks an MCP client against a toolset's client rules and
+// returns why the client may not use the toolset, or an empty string when it
+// may. Block rules always apply. Once a toolset has any allow rule only the
+// allowed clients may connect. Minimum version rules reject older releases
+// of a client and clients whose version cannot be parsed.
+func clientRefusal(rules []*types.ToolsetClientRule, client clientInfo) string {
+	name := strings.TrimSpace(client.Name)
+	displayName := conv.Default(name, "an unnamed client")
+
+	allowList := false
+	allowed := false
+	for _, rule := range rules {
+		matches := name != "" && strings.EqualFold(rule.ClientName, name)
+
+		switch rule.Kind {
+		case mv.ClientRuleAllow:
+			allowList = true
+			allowed = allowed || matches
+		case mv.ClientRuleBlock:
+			if matches {
+				return fmt.Sprintf("%s is blocked from this MCP server", displayName)
+			}
+		case mv.ClientRuleMinimumVersion:
+			if !matches || rule.MinVersion == nil {
+				continue
+			}
+
+			minimum, err := semver.NewVersion(*rule.MinVersion)
+			if err != nil {
+				continue
+			}
+
+			current, err := semver.NewVersion(client.Version)
+			if err != nil || current.LessThan(minimum) {
+				return fmt.Sprintf("%s %s is not supported by this MCP server, version %s or later is required", displayName, client.Version, minimum)
+			}
+		}
+	}
+
+	if allowList && !allowed {
+		return fmt.Sprintf("%s is not allowed to connect to this MCP server", displayName)
+	}
+
+	return ""
+}
@@ -0,0 +1,154 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func clientRule(kind string, name string, minVersion *string) *types.ToolsetClientRule {
+	return &types.ToolsetClientRule{ClientName: name, Kind: kind, MinVersion: minVersion}
+}
+
+func TestClientRefusal(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		rules   []*types.ToolsetClientRule
+		client  clientInfo
+		refusal string
+	}{
+		"no rules": {
+			rules:   nil,
+			client:  clientInfo{Name: "cursor", Version: "1.0.0"},
+			refusal: "",
+		},
+		"allowed": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleAllow, "Claude-Desktop", nil)},
+			client:  clientInfo{Name: "claude-desktop", Version: "0.9.0"},
+			refusal: "",
+		},
+		"not on the allow list": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleAllow, "claude-desktop", nil)},
+			client:  clientInfo{Name: "cursor", Version: "1.0.0"},
+			refusal: "cursor is not allowed to connect to this MCP server",
+		},
+		"unnamed client with an allow list": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleAllow, "claude-desktop", nil)},
+			client:  clientInfo{Name: "", Version: ""},
+			refusal: "an unnamed client is not allowed to connect to this MCP server",
+		},
+		"blocked": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleBlock, "cursor", nil)},
+			client:  clientInfo{Name: " Cursor ", Version: "1.0.0"},
+			refusal: "Cursor is blocked from this MCP server",
+		},
+		"block wins over allow": {
+			rules: []*types.ToolsetClientRule{
+				clientRule(mv.ClientRuleAllow, "cursor", nil),
+				clientRule(mv.ClientRuleBlock, "cursor", nil),
+			},
+			client:  clientInfo{Name: "cursor", Version: "1.0.0"},
+			refusal: "cursor is blocked from this MCP server",
+		},
+		"other clients are not blocked": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleBlock, "cursor", nil)},
+			client:  clientInfo{Name: "vscode", Version: "1.0.0"},
+			refusal: "",
+		},
+		"at minimum version": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleMinimumVersion, "cursor", conv.Ptr("1.2.0"))},
+			client:  clientInfo{Name: "cursor", Version: "1.2.0"},
+			refusal: "",
+		},
+		"newer than minimum version": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleMinimumVersion, "cursor", conv.Ptr("1.2.0"))},
+			client:  clientInfo{Name: "cursor", Version: "1.10.0"},
+			refusal: "",
+		},
+		"older than minimum version": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleMinimumVersion, "cursor", conv.Ptr("1.2.0"))},
+			client:  clientInfo{Name: "cursor", Version: "1.1.9"},
+			refusal: "cursor 1.1.9 is not supported by this MCP server, version 1.2.0 or later is required",
+		},
+		"pre-release of minimum version": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleMinimumVersion, "cursor", conv.Ptr("1.2.0"))},
+			client:  clientInfo{Name: "cursor", Version: "1.2.0-beta.1"},
+			refusal: "cursor 1.2.0-beta.1 is not supported by this MCP server, version 1.2.0 or later is required",
+		},
+		"unparseable version": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleMinimumVersion, "cursor", conv.Ptr("1.2.0"))},
+			client:  clientInfo{Name: "cursor", Version: "nightly"},
+			refusal: "cursor nightly is not supported by this MCP server, version 1.2.0 or later is required",
+		},
+		"minimum version of another client": {
+			rules:   []*types.ToolsetClientRule{clientRule(mv.ClientRuleMinimumVersion, "cursor", conv.Ptr("1.2.0"))},
+			client:  clientInfo{Name: "vscode", Version: "0.1.0"},
+			refusal: "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.refusal, clientRefusal(tt.rules, tt.client))
+		})
+	}
+}
+
+func TestCheckClientRules(t *testing.T) {
+	t.Parallel()
+
+	blockCursor := []*types.ToolsetClientRule{clientRule(mv.ClientRuleBlock, "cursor", nil)}
+	withClient := func(name string) *mcpInputs {
+		payload := newTestInputs()
+		payload.sessionID = "session"
+		payload.session = &session{
+			ID:              "session",
+			ProjectID:       payload.projectID.String(),
+			Toolset:         payload.toolset,
+			ProtocolVersion: defaultProtocolVersion,
+			ClientInfo:      clientInfo{Name: name, Version: "1.0.0"},
+			Capabilities:    map[string]json.RawMessage{},
+			LogLevel:        "",
+			CreatedAt:       time.Now(),
+			LastActiveAt:    time.Now(),
+		}
+		return payload
+	}
+
+	tests := map[string]struct {
+		rules   []*types.ToolsetClientRule
+		payload *mcpInputs
+		allowed bool
+	}{
+		"no rules without a session": {rules: nil, payload: newTestInputs(), allowed: true},
+		"rules without a session":    {rules: blockCursor, payload: newTestInputs(), allowed: false},
+		"admitted client":            {rules: blockCursor, payload: withClient("vscode"), allowed: true},
+		"blocked client":             {rules: blockCursor, payload: withClient("cursor"), allowed: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkClientRules(t.Context(), testenv.NewLogger(t), tt.rules, tt.payload)
+			if tt.allowed {
+				require.NoError(t, err)
+				return
+			}
+
+			var oopsErr *oops.ShareableError
+			require.ErrorAs(t, err, &oopsErr)
+			require.Equal(t, oops.CodeForbidden, oopsErr.Code)
+		})
+	}
+}
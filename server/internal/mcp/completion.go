@@ -0,0 +1,610 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/itchyny/gojq"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/billing"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	tools_repo "github.com/speakeasy-api/gram/server/internal/tools/repo"
+	"github.com/speakeasy-api/gram/server/internal/toolsets"
+)
+
+const (
+	// maxCompletionValues is the largest number of suggestions the MCP
+	// specification allows in a single completion result.
+	maxCompletionValues = 100
+	// completionCacheTTL is how long the values fetched from a completion
+	// source tool are reused while the user keeps typing.
+	completionCacheTTL = time.Minute
+	// completionSourceKeyword is the JSON Schema keyword that names the GET
+	// tool supplying suggestions for an argument.
+	completionSourceKeyword = "x-gram-completion"
+)
+
+type completionRef struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+	URI  string `json:"uri,omitempty"`
+}
+
+type completionArgument struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type completionContext struct {
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+type completeParams struct {
+	Ref      completionRef      `json:"ref"`
+	Argument completionArgument `json:"argument"`
+	Context  *completionContext `json:"context,omitempty"`
+}
+
+type completeResult struct {
+	Completion completionValues `json:"completion"`
+}
+
+type completionValues struct {
+	Values  []string `json:"values"`
+	HasMore bool     `json:"hasMore"`
+}
+
+// completionSource designates a GET tool whose response supplies suggestions
+// for an argument. Values is an optional jq filter that selects them from
+// the response. Without it the values are guessed from the response shape.
+type completionSource struct {
+	Tool   string `json:"tool"`
+	Values string `json:"values,omitempty"`
+}
+
+// completionSchema holds the parts of an argument's JSON Schema that are used
+// to suggest values.
+type completionSchema struct {
+	Enum     []any             `json:"enum"`
+	Examples []any             `json:"examples"`
+	Example  any               `json:"example"`
+	Items    *completionSchema `json:"items"`
+	Source   *completionSource `json:"x-gram-completion"`
+}
+
+// staticValues returns the suggestions that are spelled out in the schema.
+func (s *completionSchema) staticValues() []string {
+	var values []string
+	for _, v := range slices.Concat(s.Enum, s.Examples, []any{s.Example}) {
+		values = appendCompletionValue(values, v)
+	}
+
+	if s.Items != nil {
+		values = append(values, s.Items.staticValues()...)
+	}
+
+	return values
+}
+
+func (s *completionSchema) source() *completionSource {
+	switch {
+	case s.Source != nil && s.Source.Tool != "":
+		return s.Source
+	case s.Items != nil:
+		return s.Items.source()
+	default:
+		return nil
+	}
+}
+
+// completionCandidates caches the values fetched from a completion source
+// tool.
+type completionCandidates struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+var _ cache.CacheableObject[completionCandidates] = (*completionCandidates)(nil)
+
+func completionCandidatesCacheKey(key string) string {
+	return "mcp_completion:" + key
+}
+
+func (c completionCandidates) CacheKey() string {
+	return completionCandidatesCacheKey(c.Key)
+}
+
+func (c completionCandidates) AdditionalCacheKeys() []string {
+	return []string{}
+}
+
+func (c completionCandidates) TTL() time.Duration {
+	return completionCacheTTL
+}
+
+// completions answers completion/complete requests for prompt arguments and
+// resource template variables.
+type completions struct {
+	logger            *slog.Logger
+	db                *pgxpool.Pool
+	env               gateway.EnvironmentLoader
+	toolProxy         *gateway.ToolProxy
+	billingTracker    billing.Tracker
+	billingRepository billing.Repository
+	confirmations     *confirmations
+	candidates        cache.TypedCacheObject[completionCandidates]
+}
+
+func newCompletions(
+	logger *slog.Logger,
+	db *pgxpool.Pool,
+	env gateway.EnvironmentLoader,
+	toolProxy *gateway.ToolProxy,
+	billingTracker billing.Tracker,
+	billingRepository billing.Repository,
+	confirmations *confirmations,
+	cacheImpl cache.Cache,
+) *completions {
+	return &completions{
+		logger:            logger,
+		db:                db,
+		env:               env,
+		toolProxy:         toolProxy,
+		billingTracker:    billingTracker,
+		billingRepository: billingRepository,
+		confirmations:     confirmations,
+		candidates:        cache.NewTypedObjectCache[completionCandidates](logger.With(attr.SlogCacheNamespace("mcp_completion")), cacheImpl, cache.SuffixNone),
+	}
+}
+
+func handleCompletionComplete(ctx context.Context, logger *slog.Logger, completions *completions, payload *mcpInputs, req *rawRequest) (json.RawMessage, error) {
+	var params completeParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "failed to parse completion/complete request").Log(ctx, logger)
+	}
+
+	toolset, err := mv.DescribeToolset(ctx, logger, completions.db, mv.ProjectID(payload.projectID), mv.ToolsetSlug(conv.ToLower(payload.toolset)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkClientRules(ctx, logger, toolset.ClientRules, payload); err != nil {
+		return nil, err
+	}
+
+	var schema *completionSchema
+	switch params.Ref.Type {
+	case "ref/prompt":
+		schema, err = promptArgumentSchema(toolset, params.Ref.Name, params.Argument.Name)
+	case "ref/resource":
+		schema, err = completions.resourceVariableSchema(ctx, payload, params.Ref.URI, params.Argument.Name)
+	default:
+		err = fmt.Errorf("unsupported completion reference type: %q", params.Ref.Type)
+	}
+	if err != nil {
+		return nil, &rpcError{
+			ID:      req.ID,
+			Code:    invalidParams,
+			Message: err.Error(),
+			Data:    nil,
+		}
+	}
+
+	var values []string
+	if schema != nil {
+		values = schema.staticValues()
+		if source := schema.source(); source != nil {
+			var contextArgs map[string]string
+			if params.Context != nil {
+				contextArgs = params.Context.Arguments
+			}
+			values = append(values, completions.fromTool(ctx, payload, toolset, source, params.Argument.Name, contextArgs)...)
+		}
+	}
+
+	// No total is reported because completion source tools may themselves
+	// return only part of the values, so hasMore is the only signal that
+	// more values exist.
+	matches := filterCompletionValues(values, params.Argument.Value)
+	completion := completionValues{
+		Values:  matches[:min(len(matches), maxCompletionValues)],
+		HasMore: len(matches) > maxCompletionValues,
+	}
+
+	bs, err := json.Marshal(result[completeResult]{
+		ID:     req.ID,
+		Result: completeResult{Completion: completion},
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize completion/complete response").Log(ctx, logger)
+	}
+
+	return bs, nil
+}
+
+// promptArgumentSchema finds the schema of a prompt argument. A nil schema
+// is returned for arguments that are not described.
+func promptArgumentSchema(toolset *types.Toolset, promptName string, argument string) (*completionSchema, error) {
+	for _, prompt := range toolset.PromptTemplates {
+		if string(prompt.Name) != promptName || prompt.Kind != "prompt" {
+			continue
+		}
+
+		raw := conv.PtrValOr(prompt.Arguments, "")
+		if raw == "" {
+			return nil, nil
+		}
+
+		return propertySchema([]byte(raw), argument)
+	}
+
+	return nil, fmt.Errorf("prompt not found: %s", promptName)
+}
+
+// resourceVariableSchema finds the schema of the tool parameter that a
+// resource template variable is bound to.
+func (c *completions) resourceVariableSchema(ctx context.Context, payload *mcpInputs, uriTemplate string, variable string) (*completionSchema, error) {
+	loaded, err := loadToolsetResources(ctx, c.logger, c.db, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range loaded.resources {
+		if row.Kind != mv.ResourceKindHTTPTemplate || row.UriTemplate.String != uriTemplate {
+			continue
+		}
+
+		defs, err := tools_repo.New(c.db).FindToolsByName(ctx, tools_repo.FindToolsByNameParams{
+			ProjectID:    payload.projectID,
+			Names:        []string{row.HttpToolName.String},
+			DeploymentID: uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		})
+		if err != nil || len(defs) == 0 {
+			return nil, fmt.Errorf("resource tool not found: %s", row.HttpToolName.String)
+		}
+
+		for _, group := range []string{"pathParameters", "queryParameters"} {
+			groupSchema, err := propertyRaw(defs[0].HttpToolDefinition.Schema, group)
+			if err != nil || groupSchema == nil {
+				continue
+			}
+
+			schema, err := propertySchema(groupSchema, variable)
+			if err == nil && schema != nil {
+				return schema, nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("resource template not found: %s", uriTemplate)
+}
+
+func propertyRaw(objectSchema []byte, name string) (json.RawMessage, error) {
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(objectSchema, &schema); err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+
+	return schema.Properties[name], nil
+}
+
+func propertySchema(objectSchema []byte, name string) (*completionSchema, error) {
+	raw, err := propertyRaw(objectSchema, name)
+	if err != nil || raw == nil {
+		return nil, err
+	}
+
+	var schema completionSchema
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, fmt.Errorf("parse %s schema: %w", name, err)
+	}
+
+	return &schema, nil
+}
+
+// fromTool calls a completion source tool and returns the values found in its
+// response. Arguments the client has already filled in are passed along when
+// the tool accepts a parameter of the same name. Failures are logged and
+// yield no values so that static suggestions are still returned.
+func (c *completions) fromTool(ctx context.Context, payload *mcpInputs, toolset *types.Toolset, source *completionSource, argument string, contextArgs map[string]string) []string {
+	logger := c.logger.With(attr.SlogToolName(source.Tool))
+
+	idx := slices.IndexFunc(toolset.HTTPTools, func(t *types.HTTPToolDefinition) bool { return t.Name == source.Tool })
+	if idx < 0 {
+		logger.WarnContext(ctx, "completion source tool is not in the toolset")
+		return nil
+	}
+	tool := toolset.HTTPTools[idx]
+	if !strings.EqualFold(tool.HTTPMethod, http.MethodGet) {
+		logger.WarnContext(ctx, "completion source tool must use the GET method", attr.SlogHTTPRequestMethod(tool.HTTPMethod))
+		return nil
+	}
+
+	// Completions are requested as the user types, so a source tool that
+	// needs confirmation is only called once the user has approved it for
+	// the session.
+	if !c.confirmations.confirmed(ctx, payload, tool, mv.EffectiveConfirm(tool.Confirm)) {
+		logger.InfoContext(ctx, "skipping completion source tool that requires confirmation")
+		return nil
+	}
+
+	// The cache is checked before the tool's execution plan and environment
+	// are loaded so that repeated keystrokes do not reach the database.
+	key := completionSourceKey(payload, tool, source, argument, contextArgs)
+	if cached, err := c.candidates.Get(ctx, completionCandidatesCacheKey(key)); err == nil {
+		return cached.Values
+	}
+
+	executionPlan, err := toolsets.NewToolsets(c.db).GetHTTPToolExecutionInfoByID(ctx, uuid.MustParse(tool.ID), payload.projectID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to load completion source tool", attr.SlogError(err))
+		return nil
+	}
+
+	values := make(map[string]string, len(contextArgs))
+	for name, value := range contextArgs {
+		_, isPath := executionPlan.Tool.PathParams[name]
+		_, isQuery := executionPlan.Tool.QueryParams[name]
+		if isPath || isQuery {
+			values[name] = value
+		}
+	}
+
+	requestBody, err := templateToolCallBody(executionPlan.Tool, values)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to build completion source request", attr.SlogError(err))
+		return nil
+	}
+
+	envVars, err := resolveToolEnvironment(ctx, logger, c.env, payload, executionPlan.Tool)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to resolve completion source environment", attr.SlogError(err))
+		return nil
+	}
+
+	if err := checkToolUsageLimits(ctx, logger, toolset.OrganizationID, toolset.AccountType, c.billingRepository); err != nil {
+		logger.WarnContext(ctx, "skipping completion source tool over usage limits", attr.SlogError(err))
+		return nil
+	}
+
+	rw := &toolCallResponseWriter{
+		headers:    make(http.Header),
+		body:       new(bytes.Buffer),
+		statusCode: http.StatusOK,
+		limit:      maxBufferedResponseBytes,
+		discarded:  0,
+	}
+	err = c.toolProxy.Do(ctx, rw, bytes.NewReader(requestBody), envVars, executionPlan.Tool)
+
+	client := payload.client()
+	go c.billingTracker.TrackToolCallUsage(context.WithoutCancel(ctx), billing.ToolCallUsageEvent{
+		OrganizationID:   toolset.OrganizationID,
+		RequestBytes:     int64(len(requestBody)),
+		OutputBytes:      int64(rw.body.Len()) + rw.discarded,
+		ToolID:           tool.ID,
+		ToolName:         tool.Name,
+		ProjectID:        payload.projectID.String(),
+		ProjectSlug:      &executionPlan.ProjectSlug,
+		OrganizationSlug: &executionPlan.OrganizationSlug,
+		ToolsetSlug:      &payload.toolset,
+		MCPURL:           nil,
+		ChatID:           nil,
+		MCPClientName:    conv.PtrEmpty(client.Name),
+		MCPClientVersion: conv.PtrEmpty(client.Version),
+		Type:             billing.ToolCallTypeHTTP,
+	})
+
+	if err != nil {
+		logger.WarnContext(ctx, "failed to call completion source tool", attr.SlogError(err))
+		return nil
+	}
+	if rw.statusCode < 200 || rw.statusCode >= 300 {
+		logger.WarnContext(ctx, "completion source tool returned an error", attr.SlogHTTPResponseStatusCode(rw.statusCode))
+		return nil
+	}
+	if rw.discarded > 0 {
+		logger.WarnContext(ctx, "completion source tool response is too large to read completion values from")
+		return nil
+	}
+
+	found, err := completionValuesFromResponse(rw.body.Bytes(), argument, source.Values)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to read completion values from response", attr.SlogError(err))
+		return nil
+	}
+
+	if err := c.candidates.Store(ctx, completionCandidates{Key: key, Values: found}); err != nil {
+		logger.WarnContext(ctx, "failed to cache completion values", attr.SlogError(err))
+	}
+
+	return found
+}
+
+// completionSourceKey identifies the values fetched from a completion source
+// tool. It covers the toolset, the deployed tool, the arguments the client
+// filled in and everything the caller's upstream credentials are taken from,
+// so that callers with different credentials never share cached values. It
+// is built from the request alone so that it can be computed before anything
+// is loaded from the database.
+func completionSourceKey(payload *mcpInputs, tool *types.HTTPToolDefinition, source *completionSource, argument string, contextArgs map[string]string) string {
+	h := sha256.New()
+	write := func(part string) {
+		_, _ = h.Write([]byte(part))
+		_, _ = h.Write([]byte{0})
+	}
+
+	for _, part := range []string{payload.projectID.String(), conv.ToLower(payload.toolset), tool.ID, source.Values, argument, payload.environment, strconv.FormatBool(payload.authenticated)} {
+		write(part)
+	}
+	for _, name := range slices.Sorted(maps.Keys(contextArgs)) {
+		write("arg:" + name + "=" + contextArgs[name])
+	}
+	for _, name := range slices.Sorted(maps.Keys(payload.mcpEnvVariables)) {
+		write("env:" + name + "=" + payload.mcpEnvVariables[name])
+	}
+	for _, token := range payload.oauthTokenInputs {
+		write("oauth:" + strings.Join(token.securityKeys, ",") + "=" + token.Token)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// completionValuesFromResponse extracts suggestions from a JSON response. A
+// jq filter selects them explicitly. Otherwise the response is expected to be
+// a list, or an object wrapping one, of scalars or of objects identified by a
+// field named after the argument, "id", "name" or "slug".
+func completionValuesFromResponse(body []byte, argument string, filter string) ([]string, error) {
+	var data any
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+
+	if filter != "" {
+		query, err := gojq.Parse(filter)
+		if err != nil {
+			return nil, fmt.Errorf("parse values filter: %w", err)
+		}
+
+		var values []string
+		iter := query.Run(data)
+		for {
+			v, ok := iter.Next()
+			if !ok {
+				break
+			}
+			if err, isErr := v.(error); isErr {
+				return nil, fmt.Errorf("run values filter: %w", err)
+			}
+			values = appendCompletionValue(values, v)
+		}
+
+		return values, nil
+	}
+
+	var items []any
+	switch v := data.(type) {
+	case []any:
+		items = v
+	case map[string]any:
+		for _, key := range []string{"data", "items", "results", "values"} {
+			if list, ok := v[key].([]any); ok {
+				items = list
+				break
+			}
+		}
+		if items == nil {
+			for _, key := range slices.Sorted(func(yield func(string) bool) {
+				for k := range v {
+					if !yield(k) {
+						return
+					}
+				}
+			}) {
+				if list, ok := v[key].([]any); ok {
+					items = list
+					break
+				}
+			}
+		}
+	}
+
+	var values []string
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			values = appendCompletionValue(values, item)
+			continue
+		}
+
+		for _, field := range []string{argument, "id", "name", "slug"} {
+			if s, ok := formatCompletionValue(obj[field]); ok {
+				values = append(values, s)
+				break
+			}
+		}
+	}
+
+	return values, nil
+}
+
+func appendCompletionValue(values []string, v any) []string {
+	if list, ok := v.([]any); ok {
+		for _, item := range list {
+			if s, ok := formatCompletionValue(item); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+
+	if s, ok := formatCompletionValue(v); ok {
+		values = append(values, s)
+	}
+
+	return values
+}
+
+func formatCompletionValue(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, v != ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// filterCompletionValues keeps the distinct values that match what the user
+// has typed so far. Prefix matches are listed before values that merely
+// contain the input.
+func filterCompletionValues(values []string, input string) []string {
+	input = strings.ToLower(input)
+	seen := make(map[string]struct{}, len(values))
+	prefixed := make([]string, 0, len(values))
+	var contained []string
+
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+
+		lower := strings.ToLower(v)
+		switch {
+		case strings.HasPrefix(lower, input):
+			prefixed = append(prefixed, v)
+		case strings.Contains(lower, input):
+			contained = append(contained, v)
+		}
+	}
+
+	return append(prefixed, contained...)
+}
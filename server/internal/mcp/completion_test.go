@@ -0,0 +1,206 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func TestCompletionValuesFromResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		argument string
+		filter   string
+		expected []string
+	}{
+		"list of scalars": {
+			body:     `["dog","cat",3,true,""]`,
+			argument: "species",
+			filter:   "",
+			expected: []string{"dog", "cat", "3", "true"},
+		},
+		"objects keyed by argument": {
+			body:     `[{"petId":"p1","name":"Rex"},{"petId":"p2","name":"Tom"}]`,
+			argument: "petId",
+			filter:   "",
+			expected: []string{"p1", "p2"},
+		},
+		"objects fall back to id": {
+			body:     `[{"id":1,"name":"Rex"},{"name":"Tom"}]`,
+			argument: "petId",
+			filter:   "",
+			expected: []string{"1", "Tom"},
+		},
+		"wrapped list": {
+			body:     `{"total":2,"data":[{"slug":"a"},{"slug":"b"}]}`,
+			argument: "toolset",
+			filter:   "",
+			expected: []string{"a", "b"},
+		},
+		"first list field": {
+			body:     `{"zeta":["z"],"alpha":["a"]}`,
+			argument: "letter",
+			filter:   "",
+			expected: []string{"a"},
+		},
+		"jq filter": {
+			body:     `{"pets":[{"tag":{"label":"x"}},{"tag":{"label":"y"}}]}`,
+			argument: "tag",
+			filter:   ".pets[].tag.label",
+			expected: []string{"x", "y"},
+		},
+		"jq filter returning a list": {
+			body:     `{"pets":[{"id":"a"},{"id":"b"}]}`,
+			argument: "id",
+			filter:   "[.pets[].id]",
+			expected: []string{"a", "b"},
+		},
+		"no list": {
+			body:     `{"message":"ok"}`,
+			argument: "id",
+			filter:   "",
+			expected: nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := completionValuesFromResponse([]byte(tt.body), tt.argument, tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestCompletionValuesFromResponse_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := completionValuesFromResponse([]byte(`<html>`), "id", "")
+	require.ErrorContains(t, err, "parse response")
+
+	_, err = completionValuesFromResponse([]byte(`{}`), "id", ".[")
+	require.ErrorContains(t, err, "parse values filter")
+
+	_, err = completionValuesFromResponse([]byte(`{"a":1}`), "id", ".a[]")
+	require.ErrorContains(t, err, "run values filter")
+}
+
+func TestFilterCompletionValues(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values   []string
+		input    string
+		expected []string
+	}{
+		"empty input keeps everything": {
+			values:   []string{"b", "a", "b"},
+			input:    "",
+			expected: []string{"b", "a"},
+		},
+		"prefix matches come first": {
+			values:   []string{"hotdog", "dog", "Dogfish", "cat"},
+			input:    "dog",
+			expected: []string{"dog", "Dogfish", "hotdog"},
+		},
+		"case insensitive": {
+			values:   []string{"Petstore"},
+			input:    "PET",
+			expected: []string{"Petstore"},
+		},
+		"no matches": {
+			values:   []string{"a", "b"},
+			input:    "z",
+			expected: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, filterCompletionValues(tt.values, tt.input))
+		})
+	}
+}
+
+func TestPropertySchema_StaticValuesAndSource(t *testing.T) {
+	t.Parallel()
+
+	objectSchema := []byte(`{
+		"properties": {
+			"status": {"enum": ["available", "sold"], "examples": ["pending"], "example": "available"},
+			"tags": {"type": "array", "items": {"enum": [1, 2], "x-gram-completion": {"tool": "list_tags", "values": ".[].name"}}}
+		}
+	}`)
+
+	status, err := propertySchema(objectSchema, "status")
+	require.NoError(t, err)
+	require.Equal(t, []string{"available", "sold", "pending", "available"}, status.staticValues())
+	require.Nil(t, status.source())
+
+	tags, err := propertySchema(objectSchema, "tags")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, tags.staticValues())
+	require.Equal(t, &completionSource{Tool: "list_tags", Values: ".[].name"}, tags.source())
+
+	missing, err := propertySchema(objectSchema, "missing")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+}
+
+func TestCompletionSourceKey(t *testing.T) {
+	t.Parallel()
+
+	source := &completionSource{Tool: "list_pets", Values: ""}
+	tool := newTestToolDefinition("list_pets")
+	args := map[string]string{"status": "sold"}
+	key := completionSourceKey(newTestInputs(), tool, source, "petId", args)
+
+	withSession := newTestInputs()
+	withSession.sessionID = "session"
+	require.Equal(t, key, completionSourceKey(withSession, tool, source, "petId", args), "keys do not depend on the session")
+
+	require.NotEqual(t, key, completionSourceKey(newTestInputs(), tool, source, "petId", map[string]string{"status": "available"}))
+	require.NotEqual(t, key, completionSourceKey(newTestInputs(), tool, source, "name", args))
+	require.NotEqual(t, key, completionSourceKey(newTestInputs(), newTestToolDefinition("list_pets"), source, "petId", args), "keys change with the deployed tool")
+
+	modified := map[string]func(payload *mcpInputs){
+		"toolset":       func(payload *mcpInputs) { payload.toolset = "bookstore" },
+		"environment":   func(payload *mcpInputs) { payload.environment = "staging" },
+		"authenticated": func(payload *mcpInputs) { payload.authenticated = false },
+		"env variables": func(payload *mcpInputs) { payload.mcpEnvVariables = map[string]string{"PETSTORE_API_KEY": "two"} },
+		"oauth token": func(payload *mcpInputs) {
+			payload.oauthTokenInputs = []oauthTokenInputs{{securityKeys: nil, Token: "token"}}
+		},
+	}
+	for name, modify := range modified {
+		payload := newTestInputs()
+		modify(payload)
+		require.NotEqual(t, key, completionSourceKey(payload, tool, source, "petId", args), name)
+	}
+}
+
+func TestConfirmations_Confirmed(t *testing.T) {
+	t.Parallel()
+
+	c := newConfirmations(testenv.NewLogger(t), newMemoryCache())
+	tool := newTestToolDefinition("list_pets")
+	payload := newTestInputs()
+	payload.sessionID = "session"
+
+	require.True(t, c.confirmed(t.Context(), payload, tool, mv.ConfirmNever))
+	require.False(t, c.confirmed(t.Context(), payload, tool, mv.ConfirmSession))
+	require.False(t, c.confirmed(t.Context(), payload, tool, mv.ConfirmAlways))
+
+	require.NoError(t, c.grants.Store(t.Context(), confirmationGrant{SessionID: "session", ToolName: "list_pets"}))
+	require.True(t, c.confirmed(t.Context(), payload, tool, mv.ConfirmSession))
+	require.False(t, c.confirmed(t.Context(), payload, tool, mv.ConfirmAlways))
+	require.False(t, c.confirmed(t.Context(), newTestInputs(), tool, mv.ConfirmSession), "sessionless requests have no grants")
+}
@@ -0,0 +1,260 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+)
+
+const (
+	// confirmationTimeout bounds how long a tool call waits for the user to
+	// answer a confirmation request.
+	confirmationTimeout = 5 * time.Minute
+)
+
+type elicitationParams struct {
+	Message         string          `json:"message"`
+	RequestedSchema json.RawMessage `json:"requestedSchema"`
+}
+
+type elicitationResult struct {
+	Action  string                     `json:"action"`
+	Content map[string]json.RawMessage `json:"content,omitempty"`
+}
+
+// emptyElicitationSchema asks the client for a plain accept or decline
+// without collecting any fields.
+var emptyElicitationSchema = json.RawMessage(`{"type":"object","properties":{}}`)
+
+// confirmationGrant remembers that the user approved a tool with the
+// session confirmation mode for the rest of an MCP session.
+type confirmationGrant struct {
+	SessionID string `json:"session_id"`
+	ToolName  string `json:"tool_name"`
+}
+
+var _ cache.CacheableObject[confirmationGrant] = (*confirmationGrant)(nil)
+
+func confirmationGrantCacheKey(sessionID string, toolName string) string {
+	return "mcp_confirmation_grant:" + sessionID + ":" + toolName
+}
+
+func (g confirmationGrant) CacheKey() string {
+	return confirmationGrantCacheKey(g.SessionID, g.ToolName)
+}
+
+func (g confirmationGrant) AdditionalCacheKeys() []string {
+	return []string{}
+}
+
+func (g confirmationGrant) TTL() time.Duration {
+	return sessionStateTTL
+}
+
+// pendingRequest identifies a server-initiated request. JSON-RPC ids are only
+// unique within a session so responses are matched on both.
+type pendingRequest struct {
+	sessionID string
+	requestID string
+}
+
+// pendingRequests tracks server-initiated requests that are waiting for the
+// client to respond. Responses arrive as separate POSTs, possibly on another
+// replica, so they are matched back to the waiting caller by session and
+// request id.
+type pendingRequests struct {
+	mu      sync.Mutex
+	waiting map[pendingRequest]chan *rawRequest
+}
+
+func newPendingRequests() *pendingRequests {
+	return &pendingRequests{
+		mu:      sync.Mutex{},
+		waiting: make(map[pendingRequest]chan *rawRequest),
+	}
+}
+
+func (p *pendingRequests) register(sessionID string, requestID string) (<-chan *rawRequest, func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := pendingRequest{sessionID: sessionID, requestID: requestID}
+	ch := make(chan *rawRequest, 1)
+	p.waiting[key] = ch
+
+	return ch, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		delete(p.waiting, key)
+	}
+}
+
+// resolve hands a client response to the request waiting on it. It reports
+// false if no request with that id is pending for the session on this server.
+func (p *pendingRequests) resolve(sessionID string, msg *rawRequest) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := pendingRequest{sessionID: sessionID, requestID: msg.ID.Value()}
+	ch, ok := p.waiting[key]
+	if !ok {
+		return false
+	}
+	delete(p.waiting, key)
+
+	ch <- msg
+	return true
+}
+
+// confirmations enforces the confirmation mode of HTTP tools by asking the
+// user through MCP elicitation before a tool runs.
+type confirmations struct {
+	logger  *slog.Logger
+	pending *pendingRequests
+	grants  cache.TypedCacheObject[confirmationGrant]
+}
+
+func newConfirmations(logger *slog.Logger, cacheImpl cache.Cache) *confirmations {
+	return &confirmations{
+		logger:  logger,
+		pending: newPendingRequests(),
+		grants:  cache.NewTypedObjectCache[confirmationGrant](logger.With(attr.SlogCacheNamespace("mcp_confirmation_grant")), cacheImpl, cache.SuffixNone),
+	}
+}
+
+func clientSupportsElicitation(payload *mcpInputs) bool {
+	if payload.messages == nil || !payload.protocolVersion.supportsElicitation() {
+		return false
+	}
+
+	return payload.session.hasCapability("elicitation")
+}
+
+// confirmed reports whether a tool can run without asking the user, either
+// because its mode does not require confirmation or because the user already
+// approved it for the session.
+func (c *confirmations) confirmed(ctx context.Context, payload *mcpInputs, tool *types.HTTPToolDefinition, mode mv.Confirm) bool {
+	switch mode {
+	case mv.ConfirmNever:
+		return true
+	case mv.ConfirmSession:
+		if payload.sessionID == "" {
+			return false
+		}
+		_, err := c.grants.Get(ctx, confirmationGrantCacheKey(payload.sessionID, tool.Name))
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// confirm asks the user to approve a tool call when a confirmation mode is
+// configured for the tool and requires it. A non-empty refusal is returned
+// when the call must not proceed, explaining why so that it can be relayed to
+// the model.
+func (c *confirmations) confirm(ctx context.Context, payload *mcpInputs, tool *types.HTTPToolDefinition, mode mv.Confirm, arguments json.RawMessage) (string, error) {
+	if c.confirmed(ctx, payload, tool, mode) {
+		return "", nil
+	}
+
+	if !clientSupportsElicitation(payload) {
+		return fmt.Sprintf("The %s tool requires user confirmation before it runs, but this client does not support MCP elicitation so it cannot ask for it. The tool was not called.", tool.Name), nil
+	}
+
+	id := uuid.NewString()
+	responses, done := c.pending.register(payload.sessionID, id)
+	defer done()
+
+	bs, err := json.Marshal(request[elicitationParams]{
+		ID:     msgID{format: 2, String: id, Number: 0},
+		Method: "elicitation/create",
+		Params: elicitationParams{
+			Message:         renderConfirmPrompt(tool, arguments),
+			RequestedSchema: emptyElicitationSchema,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal elicitation request: %w", err)
+	}
+
+	if err := payload.messages.send(ctx, bs); err != nil {
+		return "", fmt.Errorf("send elicitation request: %w", err)
+	}
+
+	timeout := time.NewTimer(confirmationTimeout)
+	defer timeout.Stop()
+
+	var response *rawRequest
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("wait for confirmation: %w", ctx.Err())
+	case <-timeout.C:
+		return fmt.Sprintf("Timed out waiting for the user to confirm the %s tool call. The tool was not called.", tool.Name), nil
+	case response = <-responses:
+	}
+
+	if len(response.Error) > 0 {
+		c.logger.WarnContext(ctx, "client failed to handle elicitation request", attr.SlogError(errors.New(string(response.Error))))
+		return fmt.Sprintf("The client could not ask the user to confirm the %s tool call. The tool was not called.", tool.Name), nil
+	}
+
+	var res elicitationResult
+	if err := json.Unmarshal(response.Result, &res); err != nil {
+		return "", fmt.Errorf("unmarshal elicitation result: %w", err)
+	}
+
+	switch res.Action {
+	case "accept":
+	case "decline":
+		return fmt.Sprintf("The user declined the %s tool call. The tool was not called.", tool.Name), nil
+	default:
+		return fmt.Sprintf("The user dismissed the confirmation for the %s tool call. The tool was not called.", tool.Name), nil
+	}
+
+	if mode == mv.ConfirmSession && payload.sessionID != "" {
+		if err := c.grants.Store(ctx, confirmationGrant{SessionID: payload.sessionID, ToolName: tool.Name}); err != nil {
+			c.logger.WarnContext(ctx, "failed to remember confirmation for session", attr.SlogError(err))
+		}
+	}
+
+	return "", nil
+}
+
+// renderConfirmPrompt builds the message shown to the user. Confirmation
+// prompts are mustache templates that can reference the tool arguments.
+func renderConfirmPrompt(tool *types.HTTPToolDefinition, arguments json.RawMessage) string {
+	fallback := fmt.Sprintf("Allow %s to run?", tool.Name)
+
+	prompt := strings.TrimSpace(conv.PtrValOr(tool.ConfirmPrompt, ""))
+	if prompt == "" {
+		return fallback
+	}
+
+	var args map[string]any
+	if len(arguments) > 0 {
+		if err := json.Unmarshal(arguments, &args); err != nil {
+			return prompt
+		}
+	}
+
+	rendered, err := executePrompt("mustache", prompt, args)
+	if err != nil || strings.TrimSpace(rendered) == "" {
+		return prompt
+	}
+
+	return rendered
+}
@@ -0,0 +1,186 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+// elicitingInputs returns the inputs of a session whose client supports
+// elicitation and answers every request with reply.
+func elicitingInputs(t *testing.T, c *confirmations, reply string, asked *atomic.Int32) *mcpInputs {
+	t.Helper()
+
+	payload := newTestInputs()
+	payload.sessionID = "session"
+	payload.protocolVersion = protocolVersion20250618
+	payload.session = &session{
+		ID:              "session",
+		ProjectID:       payload.projectID.String(),
+		Toolset:         payload.toolset,
+		ProtocolVersion: protocolVersion20250618,
+		ClientInfo:      clientInfo{Name: "test", Version: "1.0.0"},
+		Capabilities:    map[string]json.RawMessage{"elicitation": json.RawMessage(`{}`)},
+		LogLevel:        "",
+		CreatedAt:       time.Now(),
+		LastActiveAt:    time.Now(),
+	}
+	payload.messages = sinkFunc(func(_ context.Context, msg json.RawMessage) error {
+		asked.Add(1)
+
+		var req rawRequest
+		require.NoError(t, json.Unmarshal(msg, &req))
+		require.Equal(t, "elicitation/create", req.Method)
+
+		res := &rawRequest{JSONRPC: "2.0", ID: req.ID, Method: "", Params: nil, Result: nil, Error: nil}
+		if reply == "error" {
+			res.Error = json.RawMessage(`{"code":-32601,"message":"method not found"}`)
+		} else {
+			res.Result = json.RawMessage(`{"action":"` + reply + `"}`)
+		}
+		go c.pending.resolve("session", res)
+
+		return nil
+	})
+
+	return payload
+}
+
+func TestConfirmations_Confirm(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		mode    mv.Confirm
+		reply   string
+		refused bool
+		asked   int32
+	}{
+		"never runs without asking": {mode: mv.ConfirmNever, reply: "accept", refused: false, asked: 0},
+		"always accepted":           {mode: mv.ConfirmAlways, reply: "accept", refused: false, asked: 1},
+		"always declined":           {mode: mv.ConfirmAlways, reply: "decline", refused: true, asked: 1},
+		"always dismissed":          {mode: mv.ConfirmAlways, reply: "cancel", refused: true, asked: 1},
+		"client error":              {mode: mv.ConfirmSession, reply: "error", refused: true, asked: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newConfirmations(testenv.NewLogger(t), newMemoryCache())
+			var asked atomic.Int32
+			payload := elicitingInputs(t, c, tt.reply, &asked)
+
+			refusal, err := c.confirm(t.Context(), payload, newTestToolDefinition("delete_pet"), tt.mode, json.RawMessage(`{}`))
+			require.NoError(t, err)
+			require.Equal(t, tt.refused, refusal != "", refusal)
+			require.Equal(t, tt.asked, asked.Load())
+		})
+	}
+}
+
+func TestConfirmations_Confirm_SessionGrant(t *testing.T) {
+	t.Parallel()
+
+	c := newConfirmations(testenv.NewLogger(t), newMemoryCache())
+	var asked atomic.Int32
+	payload := elicitingInputs(t, c, "accept", &asked)
+	tool := newTestToolDefinition("delete_pet")
+
+	for range 3 {
+		refusal, err := c.confirm(t.Context(), payload, tool, mv.ConfirmSession, nil)
+		require.NoError(t, err)
+		require.Empty(t, refusal)
+	}
+	require.Equal(t, int32(1), asked.Load(), "the user is only asked once per session")
+
+	// Tools with the always mode are confirmed on every call.
+	for range 2 {
+		refusal, err := c.confirm(t.Context(), payload, tool, mv.ConfirmAlways, nil)
+		require.NoError(t, err)
+		require.Empty(t, refusal)
+	}
+	require.Equal(t, int32(3), asked.Load())
+}
+
+func TestConfirmations_Confirm_StoredAlwaysWithoutPrompt(t *testing.T) {
+	t.Parallel()
+
+	c := newConfirmations(testenv.NewLogger(t), newMemoryCache())
+	var asked atomic.Int32
+	payload := elicitingInputs(t, c, "decline", &asked)
+	tool := newTestToolDefinition("delete_pet")
+	tool.ConfirmPrompt = nil
+
+	// An operation marked with x-gram confirm: always and no prompt is stored
+	// as "always" and must still be confirmed.
+	refusal, err := c.confirm(t.Context(), payload, tool, mv.EffectiveConfirm("always"), json.RawMessage(`{"id":42}`))
+	require.NoError(t, err)
+	require.NotEmpty(t, refusal)
+	require.Equal(t, int32(1), asked.Load())
+}
+
+func TestConfirmations_Confirm_ElicitationUnsupported(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(payload *mcpInputs){
+		"no session":           func(payload *mcpInputs) { payload.session = nil },
+		"no capability":        func(payload *mcpInputs) { payload.session.Capabilities = map[string]json.RawMessage{} },
+		"no message stream":    func(payload *mcpInputs) { payload.messages = nil },
+		"old protocol version": func(payload *mcpInputs) { payload.protocolVersion = protocolVersion20250326 },
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newConfirmations(testenv.NewLogger(t), newMemoryCache())
+			var asked atomic.Int32
+			payload := elicitingInputs(t, c, "accept", &asked)
+			modify(payload)
+
+			refusal, err := c.confirm(t.Context(), payload, newTestToolDefinition("delete_pet"), mv.ConfirmAlways, nil)
+			require.NoError(t, err)
+			require.Contains(t, refusal, "does not support MCP elicitation")
+			require.Zero(t, asked.Load())
+		})
+	}
+}
+
+func TestRenderConfirmPrompt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prompt   *string
+		expected string
+	}{
+		"fallback":         {prompt: nil, expected: "Allow delete_pet to run?"},
+		"renders template": {prompt: conv.Ptr("Delete pet {{id}}?"), expected: "Delete pet 42?"},
+		"plain prompt":     {prompt: conv.Ptr("Are you sure?"), expected: "Are you sure?"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tool := newTestToolDefinition("delete_pet")
+			tool.ConfirmPrompt = tt.prompt
+			require.Equal(t, tt.expected, renderConfirmPrompt(tool, json.RawMessage(`{"id":42}`)))
+		})
+	}
+}
+
+func TestEffectiveConfirm(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, mv.ConfirmNever, mv.EffectiveConfirm(""))
+	require.Equal(t, mv.ConfirmSession, mv.EffectiveConfirm("Session"))
+	require.Equal(t, mv.ConfirmAlways, mv.EffectiveConfirm("sometimes"))
+}
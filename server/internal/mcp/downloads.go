@@ -0,0 +1,132 @@
+package mcp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+const (
+	// DefaultResourceLinkThreshold is the size in bytes above which binary
+	// tool responses are returned as a download link when a toolset does not
+	// set its own threshold.
+	DefaultResourceLinkThreshold = 1 << 20
+
+	// downloadLinkTTL bounds how long a download link for a tool response
+	// remains valid.
+	downloadLinkTTL = 15 * time.Minute
+)
+
+// toolResponseDownload is a binary tool response held back from a tool result
+// so that the client can fetch it separately.
+type toolResponseDownload struct {
+	Token     string `json:"token"`
+	Filename  string `json:"filename"`
+	MimeType  string `json:"mime_type"`
+	Body      []byte `json:"body"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+var _ cache.CacheableObject[toolResponseDownload] = (*toolResponseDownload)(nil)
+
+func toolResponseDownloadCacheKey(token string) string {
+	return "mcp_tool_response_download:" + token
+}
+
+func (d toolResponseDownload) CacheKey() string {
+	return toolResponseDownloadCacheKey(d.Token)
+}
+
+func (d toolResponseDownload) AdditionalCacheKeys() []string {
+	return []string{}
+}
+
+func (d toolResponseDownload) TTL() time.Duration {
+	return downloadLinkTTL
+}
+
+// downloadsPathSegment is the path segment under /mcp that download links are
+// served from. Slugs cannot start with an underscore, so no MCP server can be
+// shadowed by it.
+const downloadsPathSegment = "_downloads"
+
+// downloads stashes large binary tool responses and hands out short-lived
+// links to them. The token in a link is random and unguessable so the link
+// itself is the credential, much like a signed storage URL.
+type downloads struct {
+	logger    *slog.Logger
+	serverURL *url.URL
+	store     cache.TypedCacheObject[toolResponseDownload]
+}
+
+func newDownloads(logger *slog.Logger, serverURL *url.URL, cacheImpl cache.Cache) *downloads {
+	return &downloads{
+		logger:    logger,
+		serverURL: serverURL,
+		store:     cache.NewTypedObjectCache[toolResponseDownload](logger.With(attr.SlogCacheNamespace("mcp_tool_response_download")), cacheImpl, cache.SuffixNone),
+	}
+}
+
+// stash stores a response body and returns the URL it can be downloaded from.
+func (d *downloads) stash(ctx context.Context, filename string, mimeType string, body []byte) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate download token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	err := d.store.Store(ctx, toolResponseDownload{
+		Token:     token,
+		Filename:  filename,
+		MimeType:  mimeType,
+		Body:      body,
+		ExpiresAt: time.Now().Add(downloadLinkTTL).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("store download: %w", err)
+	}
+
+	return d.serverURL.JoinPath("mcp", downloadsPathSegment, token).String(), nil
+}
+
+// ServeDownload serves a binary tool response that was returned to an MCP
+// client as a resource link.
+func (s *Service) ServeDownload(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	token := chi.URLParam(r, "token")
+	if token == "" {
+		return oops.E(oops.CodeBadRequest, nil, "a download token must be provided").Log(ctx, s.logger)
+	}
+
+	download, err := s.downloads.store.Get(ctx, toolResponseDownloadCacheKey(token))
+	if err != nil || time.Now().Unix() > download.ExpiresAt {
+		return oops.E(oops.CodeNotFound, err, "download not found or expired").Log(ctx, s.logger)
+	}
+
+	w.Header().Set("Content-Type", download.MimeType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(download.Body)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": download.Filename}))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(download.Body); err != nil {
+		s.logger.ErrorContext(ctx, "failed to write download body", attr.SlogError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package mcp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func TestDownloads_StashAndServe(t *testing.T) {
+	t.Parallel()
+
+	serverURL, err := url.Parse("https://app.getgram.ai")
+	require.NoError(t, err)
+
+	s := newTestService(t)
+	s.downloads = newDownloads(testenv.NewLogger(t), serverURL, newMemoryCache())
+
+	link, err := s.downloads.stash(t.Context(), "report.pdf", "application/pdf", []byte("%PDF-1.7"))
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(parsed.Path, "/mcp/_downloads/"), parsed.Path)
+
+	// A toolset whose MCP slug is "downloads" is not shadowed by the route.
+	mux := chi.NewRouter()
+	mux.Get("/mcp/"+downloadsPathSegment+"/{token}", func(w http.ResponseWriter, r *http.Request) {
+		oops.ErrHandle(s.logger, s.ServeDownload).ServeHTTP(w, r)
+	})
+	mux.Get("/mcp/{mcpSlug}/{rest}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, parsed.Path, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+	require.Equal(t, "%PDF-1.7", w.Body.String())
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/mcp/downloads/install", nil))
+	require.Equal(t, http.StatusTeapot, w.Code)
+}
@@ -0,0 +1,427 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/bm25"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+const (
+	// toolSelectionModeDynamic lists meta-tools instead of the toolset's
+	// tools so that large toolsets do not flood the model's context.
+	toolSelectionModeDynamic = mv.ToolSelectionModeDynamic
+
+	metaToolSearch   = mv.MetaToolSearchTools
+	metaToolDescribe = mv.MetaToolDescribeTool
+	metaToolExecute  = mv.MetaToolExecuteTool
+
+	defaultToolSearchLimit = 10
+	maxToolSearchLimit     = 50
+	// toolSearchDescriptionLength caps the description returned with each
+	// search result. describe_tool returns the full text.
+	toolSearchDescriptionLength = 300
+	// maxCachedToolSearchIndexes bounds how many toolsets keep a search index
+	// in memory.
+	maxCachedToolSearchIndexes = 512
+)
+
+// usesDynamicTools reports whether a toolset exposes its tools through the
+// search, describe and execute meta-tools.
+func usesDynamicTools(toolset *types.Toolset) bool {
+	return conv.PtrValOr(toolset.ToolSelectionMode, "") == toolSelectionModeDynamic
+}
+
+var metaToolAnnotations = map[string]*toolAnnotations{
+	metaToolSearch:   {Title: "Search tools", ReadOnlyHint: conv.Ptr(true), DestructiveHint: nil, IdempotentHint: conv.Ptr(true), OpenWorldHint: conv.Ptr(false)},
+	metaToolDescribe: {Title: "Describe tool", ReadOnlyHint: conv.Ptr(true), DestructiveHint: nil, IdempotentHint: conv.Ptr(true), OpenWorldHint: conv.Ptr(false)},
+	metaToolExecute:  {Title: "Execute tool", ReadOnlyHint: nil, DestructiveHint: nil, IdempotentHint: nil, OpenWorldHint: conv.Ptr(true)},
+}
+
+// dynamicToolListEntries returns the meta-tools listed by toolsets in dynamic
+// mode.
+func dynamicToolListEntries(toolset *types.Toolset, version protocolVersion) []*toolListEntry {
+	count := len(toolset.HTTPTools)
+	for _, prompt := range toolset.PromptTemplates {
+		if prompt.Kind == "higher_order_tool" {
+			count++
+		}
+	}
+
+	entries := []*toolListEntry{
+		{
+			Name:         metaToolSearch,
+			Title:        "",
+			Description:  fmt.Sprintf("Search the %d tools available in %s by keyword. Returns the names and summaries of the best matching tools. Use %s to get a tool's input schema and %s to call it.", count, toolset.Name, metaToolDescribe, metaToolExecute),
+			InputSchema:  json.RawMessage(`{"type":"object","properties":{"query":{"type":"string","description":"Keywords describing the task, for example \"list open invoices\""},"limit":{"type":"integer","minimum":1,"maximum":50,"description":"Maximum number of tools to return. Defaults to 10."}},"required":["query"]}`),
+			OutputSchema: nil,
+			Annotations:  metaToolAnnotations[metaToolSearch],
+		},
+		{
+			Name:         metaToolDescribe,
+			Title:        "",
+			Description:  "Get the full description and input schema of a tool found with " + metaToolSearch + ".",
+			InputSchema:  json.RawMessage(`{"type":"object","properties":{"name":{"type":"string","description":"The name of the tool"}},"required":["name"]}`),
+			OutputSchema: nil,
+			Annotations:  metaToolAnnotations[metaToolDescribe],
+		},
+		{
+			Name:         metaToolExecute,
+			Title:        "",
+			Description:  "Call a tool found with " + metaToolSearch + ". The arguments must match the input schema returned by " + metaToolDescribe + ".",
+			InputSchema:  json.RawMessage(`{"type":"object","properties":{"name":{"type":"string","description":"The name of the tool"},"arguments":{"type":"object","description":"The arguments to call the tool with"}},"required":["name"]}`),
+			OutputSchema: nil,
+			Annotations:  metaToolAnnotations[metaToolExecute],
+		},
+	}
+
+	// Tools gained a top-level title in the 2025-06-18 revision.
+	if version.atLeast(protocolVersion20250618) {
+		for _, entry := range entries {
+			entry.Title = entry.Annotations.Title
+		}
+	}
+
+	return entries
+}
+
+type searchToolsArguments struct {
+	Query string `json:"query"`
+	Limit int    `json:"limit"`
+}
+
+type describeToolArguments struct {
+	Name string `json:"name"`
+}
+
+type executeToolArguments struct {
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+type toolSearchMatch struct {
+	Name        string   `json:"name"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+type toolSearchResult struct {
+	Tools []toolSearchMatch `json:"tools"`
+}
+
+type toolDescription struct {
+	Name         string           `json:"name"`
+	Title        string           `json:"title,omitempty"`
+	Description  string           `json:"description"`
+	InputSchema  json.RawMessage  `json:"inputSchema,omitempty"`
+	OutputSchema json.RawMessage  `json:"outputSchema,omitempty"`
+	Annotations  *toolAnnotations `json:"annotations,omitempty"`
+}
+
+// discoverableTool is a tool of a dynamic toolset that the meta-tools can
+// find.
+type discoverableTool struct {
+	name        string
+	summary     string
+	description string
+	tags        []string
+	http        *types.HTTPToolDefinition
+	prompt      *types.PromptTemplate
+}
+
+func (t discoverableTool) title() string {
+	if t.http != nil && t.http.Annotations != nil {
+		return conv.PtrValOr(t.http.Annotations.Title, "")
+	}
+
+	return ""
+}
+
+func discoverableTools(toolset *types.Toolset) []discoverableTool {
+	tools := make([]discoverableTool, 0, len(toolset.HTTPTools))
+	for _, tool := range toolset.HTTPTools {
+		tools = append(tools, discoverableTool{
+			name:        tool.Name,
+			summary:     tool.Summary,
+			description: tool.Description,
+			tags:        tool.Tags,
+			http:        tool,
+			prompt:      nil,
+		})
+	}
+
+	for _, prompt := range toolset.PromptTemplates {
+		if prompt.Kind != "higher_order_tool" {
+			continue
+		}
+
+		tools = append(tools, discoverableTool{
+			name:        string(prompt.Name),
+			summary:     "",
+			description: conv.PtrValOr(prompt.Description, ""),
+			tags:        nil,
+			http:        nil,
+			prompt:      prompt,
+		})
+	}
+
+	return tools
+}
+
+func findDiscoverableTool(toolset *types.Toolset, name string) (discoverableTool, bool) {
+	tools := discoverableTools(toolset)
+	idx := slices.IndexFunc(tools, func(t discoverableTool) bool { return t.name == name })
+	if idx < 0 {
+		return discoverableTool{}, false
+	}
+
+	return tools[idx], true
+}
+
+// toolSearchIndex is the search index of one version of a toolset's tools.
+type toolSearchIndex struct {
+	version string
+	index   *bm25.Index
+	tools   map[string]discoverableTool
+}
+
+// toolSearchIndexes keeps the search index of recently searched toolsets so
+// that it is only rebuilt when the toolset's tools change.
+type toolSearchIndexes struct {
+	indexes *lru.Cache[string, *toolSearchIndex]
+}
+
+func newToolSearchIndexes() *toolSearchIndexes {
+	// New only fails for a non-positive size.
+	indexes, _ := lru.New[string, *toolSearchIndex](maxCachedToolSearchIndexes)
+
+	return &toolSearchIndexes{indexes: indexes}
+}
+
+// get returns the search index for the current version of a toolset's tools,
+// building it if the cached one is missing or stale.
+func (t *toolSearchIndexes) get(toolset *types.Toolset) *toolSearchIndex {
+	version := toolSearchVersion(toolset)
+	if idx, ok := t.indexes.Get(toolset.ID); ok && idx.version == version {
+		return idx
+	}
+
+	idx := newToolSearchIndex(toolset, version)
+	t.indexes.Add(toolset.ID, idx)
+
+	return idx
+}
+
+// toolSearchVersion identifies the version of a toolset's tools. Tool rows
+// are replaced on every deployment and variations record when they were last
+// changed, so the text being searched cannot change without it changing too.
+func toolSearchVersion(toolset *types.Toolset) string {
+	parts := make([]string, 0, 2*len(toolset.HTTPTools)+len(toolset.PromptTemplates))
+	for _, tool := range toolset.HTTPTools {
+		parts = append(parts, tool.ID)
+		if tool.Variation != nil {
+			parts = append(parts, tool.Variation.ID+"@"+tool.Variation.UpdatedAt)
+		}
+	}
+	for _, prompt := range toolset.PromptTemplates {
+		parts = append(parts, prompt.ID)
+	}
+
+	return listingKey(parts)
+}
+
+// newToolSearchIndex indexes a toolset's tools. Names, summaries and tags are
+// weighted above descriptions because they are short and specific.
+func newToolSearchIndex(toolset *types.Toolset, version string) *toolSearchIndex {
+	tools := discoverableTools(toolset)
+
+	docs := make([]bm25.Document, 0, len(tools))
+	for _, tool := range tools {
+		docs = append(docs, bm25.Document{
+			ID: tool.name,
+			Fields: []bm25.Field{
+				{Text: tool.name, Weight: 3},
+				{Text: tool.title(), Weight: 3},
+				{Text: tool.summary, Weight: 2},
+				{Text: strings.Join(tool.tags, " "), Weight: 2},
+				{Text: tool.description, Weight: 1},
+			},
+		})
+	}
+
+	byName := make(map[string]discoverableTool, len(tools))
+	for _, tool := range tools {
+		byName[tool.name] = tool
+	}
+
+	return &toolSearchIndex{
+		version: version,
+		index:   bm25.New(docs),
+		tools:   byName,
+	}
+}
+
+// search ranks the indexed tools against a query.
+func (idx *toolSearchIndex) search(query string, limit int) []discoverableTool {
+	results := idx.index.Search(query, limit)
+
+	matches := make([]discoverableTool, 0, len(results))
+	for _, res := range results {
+		matches = append(matches, idx.tools[res.ID])
+	}
+
+	return matches
+}
+
+// handleSearchTools answers a search_tools call with the best matching tools.
+func handleSearchTools(ctx context.Context, logger *slog.Logger, indexes *toolSearchIndexes, req *rawRequest, toolset *types.Toolset, rawArgs json.RawMessage) (json.RawMessage, error) {
+	var args searchToolsArguments
+	if err := unmarshalMetaToolArguments(rawArgs, &args); err != nil {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid %s arguments", metaToolSearch).Log(ctx, logger)
+	}
+
+	limit := args.Limit
+	if limit <= 0 {
+		limit = defaultToolSearchLimit
+	}
+	limit = min(limit, maxToolSearchLimit)
+
+	matches := indexes.get(toolset).search(args.Query, limit)
+
+	res := toolSearchResult{Tools: make([]toolSearchMatch, 0, len(matches))}
+	for _, tool := range matches {
+		description := tool.summary
+		if description == "" {
+			description = tool.description
+		}
+		if runes := []rune(description); len(runes) > toolSearchDescriptionLength {
+			description = string(runes[:toolSearchDescriptionLength]) + "…"
+		}
+
+		res.Tools = append(res.Tools, toolSearchMatch{
+			Name:        tool.name,
+			Title:       tool.title(),
+			Description: description,
+			Tags:        tool.tags,
+		})
+	}
+
+	return formatMetaToolResult(ctx, logger, req, res)
+}
+
+// handleDescribeTool answers a describe_tool call with the tool's full
+// description and schemas.
+func handleDescribeTool(ctx context.Context, logger *slog.Logger, req *rawRequest, toolset *types.Toolset, rawArgs json.RawMessage) (json.RawMessage, error) {
+	var args describeToolArguments
+	if err := unmarshalMetaToolArguments(rawArgs, &args); err != nil || args.Name == "" {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid %s arguments: a tool name is required", metaToolDescribe).Log(ctx, logger)
+	}
+
+	tool, ok := findDiscoverableTool(toolset, args.Name)
+	if !ok {
+		return nil, oops.E(oops.CodeNotFound, nil, "tool not found: %s", args.Name).Log(ctx, logger)
+	}
+
+	desc := toolDescription{
+		Name:         tool.name,
+		Title:        tool.title(),
+		Description:  tool.description,
+		InputSchema:  json.RawMessage(mv.DefaultEmptyToolSchema),
+		OutputSchema: nil,
+		Annotations:  nil,
+	}
+
+	switch {
+	case tool.http != nil:
+		desc.InputSchema = json.RawMessage(tool.http.Schema)
+		desc.Annotations = newToolAnnotations(tool.http.Annotations)
+		desc.OutputSchema = advertisedOutputSchema(toolset, tool.http)
+	case tool.prompt != nil && tool.prompt.Arguments != nil:
+		desc.InputSchema = json.RawMessage(*tool.prompt.Arguments)
+	}
+
+	return formatMetaToolResult(ctx, logger, req, desc)
+}
+
+// unwrapExecuteTool turns an execute_tool call into a call of the tool it
+// names. A toolset tool that shares a meta-tool's name can only be called
+// this way.
+func unwrapExecuteTool(ctx context.Context, logger *slog.Logger, toolset *types.Toolset, params toolsCallParams) (toolsCallParams, error) {
+	var args executeToolArguments
+	if err := unmarshalMetaToolArguments(params.Arguments, &args); err != nil || args.Name == "" {
+		return params, oops.E(oops.CodeInvalid, err, "invalid %s arguments: a tool name is required", metaToolExecute).Log(ctx, logger)
+	}
+
+	isMetaTool := args.Name == metaToolExecute || args.Name == metaToolSearch || args.Name == metaToolDescribe || args.Name == metaToolReadResponse
+	if _, ok := findDiscoverableTool(toolset, args.Name); isMetaTool && !ok {
+		return params, oops.E(oops.CodeInvalid, nil, "%s cannot call %s", metaToolExecute, args.Name).Log(ctx, logger)
+	}
+
+	arguments := args.Arguments
+	if len(arguments) == 0 || string(arguments) == "null" {
+		arguments = json.RawMessage("{}")
+	}
+	if err := json.Unmarshal(arguments, &map[string]json.RawMessage{}); err != nil {
+		return params, oops.E(oops.CodeInvalid, err, "invalid %s arguments: arguments must be an object", metaToolExecute).Log(ctx, logger)
+	}
+
+	return toolsCallParams{
+		Name:      args.Name,
+		Arguments: arguments,
+		Meta:      params.Meta,
+	}, nil
+}
+
+func unmarshalMetaToolArguments(raw json.RawMessage, v any) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("parse arguments: %w", err)
+	}
+
+	return nil
+}
+
+func formatMetaToolResult(ctx context.Context, logger *slog.Logger, req *rawRequest, v any) (json.RawMessage, error) {
+	structured, err := json.Marshal(v)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to marshal meta-tool result").Log(ctx, logger)
+	}
+
+	content, err := json.Marshal(contentChunk[string, json.RawMessage]{
+		Type:     "text",
+		Text:     string(structured),
+		MimeType: nil,
+		Data:     nil,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to marshal content chunk").Log(ctx, logger)
+	}
+
+	bs, err := json.Marshal(result[toolCallResult]{
+		ID: req.ID,
+		Result: toolCallResult{
+			Content:           []json.RawMessage{content},
+			StructuredContent: nil,
+			IsError:           false,
+		},
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to marshal meta-tool call result").Log(ctx, logger)
+	}
+
+	return bs, nil
+}
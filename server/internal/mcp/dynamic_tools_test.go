@@ -0,0 +1,189 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+// metaToolResult decodes the JSON that a meta-tool returns as the text of its
+// result.
+func metaToolResult[T any](t *testing.T, bs json.RawMessage) T {
+	t.Helper()
+
+	var res struct {
+		Result toolCallResult `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(bs, &res))
+	require.Len(t, res.Result.Content, 1)
+
+	var chunk struct {
+		Text string `json:"text"`
+	}
+	require.NoError(t, json.Unmarshal(res.Result.Content[0], &chunk))
+
+	var v T
+	require.NoError(t, json.Unmarshal([]byte(chunk.Text), &v))
+	return v
+}
+
+func newSearchableToolset() *types.Toolset {
+	listInvoices := newTestToolDefinition("list_invoices")
+	listInvoices.Summary = "List invoices"
+	listInvoices.Tags = []string{"billing"}
+	createInvoice := newTestToolDefinition("create_invoice")
+	createInvoice.Summary = "Create an invoice"
+	createInvoice.Tags = []string{"billing"}
+	listPets := newTestToolDefinition("list_pets")
+	listPets.Summary = "List pets"
+	listPets.Description = "Lists the pets in the store, which have no invoices."
+
+	return newTestToolset(listPets, createInvoice, listInvoices)
+}
+
+func TestHandleSearchTools_Ranking(t *testing.T) {
+	t.Parallel()
+
+	toolset := newSearchableToolset()
+	indexes := newToolSearchIndexes()
+
+	tests := map[string]struct {
+		args     string
+		expected []string
+	}{
+		"best match first":     {args: `{"query":"list invoices"}`, expected: []string{"list_invoices", "list_pets", "create_invoice"}},
+		"tags":                 {args: `{"query":"billing"}`, expected: []string{"create_invoice", "list_invoices"}},
+		"limit":                {args: `{"query":"list invoices","limit":1}`, expected: []string{"list_invoices"}},
+		"no match":             {args: `{"query":"weather"}`, expected: []string{}},
+		"stop words only":      {args: `{"query":"the of"}`, expected: []string{}},
+		"description weighted": {args: `{"query":"pets"}`, expected: []string{"list_pets"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs, err := handleSearchTools(t.Context(), testenv.NewLogger(t), indexes, toolsCallRequest(t, metaToolSearch), toolset, json.RawMessage(tt.args))
+			require.NoError(t, err)
+
+			res := metaToolResult[toolSearchResult](t, bs)
+			names := make([]string, 0, len(res.Tools))
+			for _, tool := range res.Tools {
+				names = append(names, tool.Name)
+			}
+			require.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestHandleSearchTools_InvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	_, err := handleSearchTools(t.Context(), testenv.NewLogger(t), newToolSearchIndexes(), toolsCallRequest(t, metaToolSearch), newSearchableToolset(), json.RawMessage(`{"query":1}`))
+
+	var oopsErr *oops.ShareableError
+	require.ErrorAs(t, err, &oopsErr)
+	require.Equal(t, oops.CodeInvalid, oopsErr.Code)
+}
+
+func TestToolSearchIndexes_RebuildOnNewVersion(t *testing.T) {
+	t.Parallel()
+
+	toolset := newSearchableToolset()
+	indexes := newToolSearchIndexes()
+
+	first := indexes.get(toolset)
+	require.Same(t, first, indexes.get(toolset))
+
+	// A redeploy replaces the tool rows, so the tools get new ids.
+	redeployed := *toolset
+	redeployed.HTTPTools = append([]*types.HTTPToolDefinition{newTestToolDefinition("list_customers")}, toolset.HTTPTools...)
+	rebuilt := indexes.get(&redeployed)
+	require.NotSame(t, first, rebuilt)
+	require.Equal(t, "list_customers", rebuilt.search("customers", 1)[0].name)
+	require.Same(t, rebuilt, indexes.get(&redeployed))
+}
+
+func TestHandleDescribeTool(t *testing.T) {
+	t.Parallel()
+
+	toolset := newSearchableToolset()
+
+	bs, err := handleDescribeTool(t.Context(), testenv.NewLogger(t), toolsCallRequest(t, metaToolDescribe), toolset, json.RawMessage(`{"name":"list_pets"}`))
+	require.NoError(t, err)
+	desc := metaToolResult[toolDescription](t, bs)
+	require.Equal(t, "list_pets", desc.Name)
+	require.Equal(t, toolset.HTTPTools[0].Description, desc.Description)
+	require.JSONEq(t, toolset.HTTPTools[0].Schema, string(desc.InputSchema))
+
+	tests := map[string]struct {
+		args string
+		code oops.Code
+	}{
+		"unknown tool":  {args: `{"name":"list_customers"}`, code: oops.CodeNotFound},
+		"missing name":  {args: `{}`, code: oops.CodeInvalid},
+		"not an object": {args: `"list_pets"`, code: oops.CodeInvalid},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handleDescribeTool(t.Context(), testenv.NewLogger(t), toolsCallRequest(t, metaToolDescribe), toolset, json.RawMessage(tt.args))
+
+			var oopsErr *oops.ShareableError
+			require.ErrorAs(t, err, &oopsErr)
+			require.Equal(t, tt.code, oopsErr.Code)
+		})
+	}
+}
+
+func TestUnwrapExecuteTool(t *testing.T) {
+	t.Parallel()
+
+	// A toolset configured before meta-tool names were reserved can still
+	// have a tool named after one.
+	toolset := newTestToolset(newTestToolDefinition("list_pets"), newTestToolDefinition(metaToolSearch))
+
+	tests := map[string]struct {
+		args      string
+		name      string
+		arguments string
+		invalid   bool
+	}{
+		"unwraps":                 {args: `{"name":"list_pets","arguments":{"limit":1}}`, name: "list_pets", arguments: `{"limit":1}`, invalid: false},
+		"missing arguments":       {args: `{"name":"list_pets"}`, name: "list_pets", arguments: `{}`, invalid: false},
+		"null arguments":          {args: `{"name":"list_pets","arguments":null}`, name: "list_pets", arguments: `{}`, invalid: false},
+		"clashing toolset tool":   {args: `{"name":"search_tools"}`, name: metaToolSearch, arguments: `{}`, invalid: false},
+		"missing name":            {args: `{"arguments":{}}`, name: "", arguments: "", invalid: true},
+		"no arguments at all":     {args: ``, name: "", arguments: "", invalid: true},
+		"malformed":               {args: `{"name":1}`, name: "", arguments: "", invalid: true},
+		"arguments not an object": {args: `{"name":"list_pets","arguments":"limit=1"}`, name: "", arguments: "", invalid: true},
+		"meta-tool":               {args: `{"name":"execute_tool"}`, name: "", arguments: "", invalid: true},
+		"read_tool_response":      {args: `{"name":"read_tool_response"}`, name: "", arguments: "", invalid: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			params := toolsCallParams{Name: metaToolExecute, Arguments: json.RawMessage(tt.args), Meta: nil}
+			unwrapped, err := unwrapExecuteTool(t.Context(), testenv.NewLogger(t), toolset, params)
+			if tt.invalid {
+				var oopsErr *oops.ShareableError
+				require.ErrorAs(t, err, &oopsErr)
+				require.Equal(t, oops.CodeInvalid, oopsErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.name, unwrapped.Name)
+			require.JSONEq(t, tt.arguments, string(unwrapped.Arguments))
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+// memoryCache is a cache.Cache that keeps JSON encoded values in memory.
+type memoryCache struct {
+	mu    sync.Mutex
+	items map[string][]byte
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{mu: sync.Mutex{}, items: make(map[string][]byte)}
+}
+
+func (m *memoryCache) Get(_ context.Context, key string, value any) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.items[key]
+	if !ok {
+		return errors.New("cache miss")
+	}
+	return json.Unmarshal(data, value)
+}
+
+func (m *memoryCache) Set(_ context.Context, key string, value any, _ time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items[key] = data
+	return nil
+}
+
+func (m *memoryCache) Update(ctx context.Context, key string, value any) error {
+	return m.Set(ctx, key, value, 0)
+}
+
+func (m *memoryCache) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.items, key)
+	return nil
+}
+
+// sinkFunc adapts a function to a messageSink.
+type sinkFunc func(ctx context.Context, msg json.RawMessage) error
+
+func (f sinkFunc) send(ctx context.Context, msg json.RawMessage) error {
+	return f(ctx, msg)
+}
+
+func newTestInputs() *mcpInputs {
+	return &mcpInputs{
+		projectID:        uuid.Nil,
+		toolset:          "petstore",
+		environment:      "",
+		mcpEnvVariables:  map[string]string{},
+		oauthTokenInputs: []oauthTokenInputs{},
+		authenticated:    true,
+		sessionID:        "",
+		session:          nil,
+		protocolVersion:  defaultProtocolVersion,
+		messages:         nil,
+	}
+}
+
+func newTestToolDefinition(name string) *types.HTTPToolDefinition {
+	return &types.HTTPToolDefinition{
+		ID:                  uuid.NewString(),
+		ProjectID:           uuid.Nil.String(),
+		DeploymentID:        uuid.NewString(),
+		Name:                name,
+		CanonicalName:       name,
+		Summary:             "",
+		Description:         "",
+		Confirm:             "",
+		ConfirmPrompt:       nil,
+		Summarizer:          nil,
+		ResponseFilter:      nil,
+		Annotations:         nil,
+		ResponseBudget:      nil,
+		RetryPolicy:         nil,
+		Openapiv3DocumentID: nil,
+		Openapiv3Operation:  nil,
+		Tags:                []string{},
+		Security:            nil,
+		DefaultServerURL:    nil,
+		HTTPMethod:          "POST",
+		Path:                "/" + name,
+		SchemaVersion:       nil,
+		Schema:              "{}",
+		OutputSchema:        nil,
+		PackageName:         nil,
+		CreatedAt:           "",
+		UpdatedAt:           "",
+		Canonical:           nil,
+		Variation:           nil,
+	}
+}
+
+func newTestHTTPTool(name string) *gateway.HTTPTool {
+	return &gateway.HTTPTool{
+		ID:                 uuid.NewString(),
+		ProjectID:          uuid.Nil.String(),
+		DeploymentID:       uuid.NewString(),
+		OrganizationID:     uuid.NewString(),
+		Name:               name,
+		ServerEnvVar:       "PETSTORE_SERVER_URL",
+		DefaultServerUrl:   gateway.NullString{Value: "https://petstore.example.com", Valid: true},
+		Security:           []*gateway.HTTPToolSecurity{},
+		SecurityScopes:     map[string][]string{},
+		Method:             "POST",
+		Path:               "/" + name,
+		Schema:             []byte{},
+		HeaderParams:       map[string]*gateway.HTTPParameter{},
+		QueryParams:        map[string]*gateway.HTTPParameter{},
+		PathParams:         map[string]*gateway.HTTPParameter{},
+		RequestContentType: gateway.NullString{Value: "", Valid: false},
+		BodyEncoding:       nil,
+		ResponseFilter:     nil,
+		RetryPolicy:        nil,
+		XMLResponseSchema:  nil,
+		CachePolicy:        nil,
+	}
+}
+
+// newTestService returns a Service without a database or upstream
+// dependencies. Sessions are kept in memory.
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+
+	logger := testenv.NewLogger(t)
+
+	return &Service{
+		logger:            logger,
+		tracer:            nil,
+		metrics:           nil,
+		db:                nil,
+		authRepo:          nil,
+		toolsetsRepo:      nil,
+		orgsRepo:          nil,
+		auth:              nil,
+		env:               nil,
+		serverURL:         nil,
+		posthog:           nil,
+		toolProxy:         nil,
+		oauthService:      nil,
+		oauthRepo:         nil,
+		billingTracker:    nil,
+		billingRepository: nil,
+		streams:           newStreamHub(),
+		sessions:          newSessionStore(logger, newMemoryCache()),
+		assetStorage:      nil,
+		listPageSize:      0,
+		maxBatchSize:      0,
+		batchConcurrency:  DefaultBatchConcurrency,
+		confirmations:     nil,
+		logging:           nil,
+		completions:       nil,
+		downloads:         nil,
+		budgets:           nil,
+		searchIndexes:     nil,
+		relay:             nil,
+	}
+}
+
+// newTestSession stores a session for the toolset of newTestInputs.
+func newTestSession(t *testing.T, s *Service, sessionID string) *session {
+	t.Helper()
+
+	payload := newTestInputs()
+	payload.sessionID = sessionID
+	sess, err := s.sessions.create(t.Context(), payload, initializeParams{
+		ProtocolVersion: string(protocolVersion20250618),
+		Capabilities:    map[string]json.RawMessage{},
+		ClientInfo:      clientInfo{Name: "test", Version: "1.0.0"},
+	}, protocolVersion20250618)
+	require.NoError(t, err)
+
+	return sess
+}
+
+// openEventStream opens a session's GET stream against serveEventStream and
+// returns a reader positioned after the response headers.
+func openEventStream(t *testing.T, s *Service, headers http.Header) (*http.Response, *bufio.Reader) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := s.serveEventStream(r.Context(), w, r, newTestInputs()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header = headers.Clone()
+	req.Header.Set("Accept", "text/event-stream")
+
+	res, err := server.Client().Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = res.Body.Close() })
+
+	return res, bufio.NewReader(res.Body)
+}
+
+// sseEvent is an event read from an event stream.
+type sseEvent struct {
+	id   string
+	data string
+}
+
+// readSSEEvent reads the next event from an event stream, skipping comments.
+func readSSEEvent(t *testing.T, r *bufio.Reader) sseEvent {
+	t.Helper()
+
+	var ev sseEvent
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+
+		switch {
+		case line == "" && ev.data != "":
+			return ev
+		case strings.HasPrefix(line, "id: "):
+			ev.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			if ev.data != "" {
+				ev.data += "\n"
+			}
+			ev.data += strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func newTestToolset(tools ...*types.HTTPToolDefinition) *types.Toolset {
+	return &types.Toolset{
+		ID:                     uuid.NewString(),
+		ProjectID:              uuid.Nil.String(),
+		OrganizationID:         "",
+		AccountType:            "",
+		Name:                   "Petstore",
+		Slug:                   "petstore",
+		Description:            nil,
+		Instructions:           nil,
+		InstructionsVersion:    nil,
+		DefaultEnvironmentSlug: nil,
+		SecurityVariables:      nil,
+		ServerVariables:        nil,
+		HTTPTools:              tools,
+		PromptTemplates:        nil,
+		Resources:              nil,
+		ClientRules:            nil,
+		McpSlug:                nil,
+		McpIsPublic:            nil,
+		McpEnabled:             nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		CustomDomainID:         nil,
+		ExternalOauthServer:    nil,
+		OauthProxyServer:       nil,
+		CreatedAt:              "",
+		UpdatedAt:              "",
+	}
+}
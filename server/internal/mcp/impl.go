@@ -2,6 +2,7 @@ package mcp
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"database/sql"
 	_ "embed"
@@ -17,6 +18,7 @@ import (
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,6 +32,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/speakeasy-api/gram/server/internal/assets"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/auth"
 	auth_repo "github.com/speakeasy-api/gram/server/internal/auth/repo"
@@ -40,6 +43,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/gateway"
 	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
 	"github.com/speakeasy-api/gram/server/internal/mv"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oauth"
@@ -67,6 +71,32 @@ type Service struct {
 	oauthRepo         *oauth_repo.Queries
 	billingTracker    billing.Tracker
 	billingRepository billing.Repository
+	streams           *streamHub
+	sessions          *sessionStore
+	assetStorage      assets.BlobStore
+	listPageSize      int
+	maxBatchSize      int
+	batchConcurrency  int
+	confirmations     *confirmations
+	logging           *clientLogging
+	completions       *completions
+	downloads         *downloads
+	budgets           *responseBudgets
+	searchIndexes     *toolSearchIndexes
+	relay             *relay.Broker
+}
+
+// ServiceOptions holds tunables for the MCP service.
+type ServiceOptions struct {
+	// ListPageSize is the maximum number of items returned in a page of
+	// tools/list or prompts/list. Zero or less disables pagination.
+	ListPageSize int
+	// MaxBatchSize is the maximum number of messages accepted in a single
+	// JSON-RPC batch. Zero or less removes the limit.
+	MaxBatchSize int
+	// BatchConcurrency is the number of messages in a batch that are handled
+	// at the same time. Zero or less uses DefaultBatchConcurrency.
+	BatchConcurrency int
 }
 
 type oauthTokenInputs struct {
@@ -82,6 +112,15 @@ type mcpInputs struct {
 	oauthTokenInputs []oauthTokenInputs
 	authenticated    bool
 	sessionID        string
+	// session is the server-side record of the client's session. It is nil
+	// while a session is being initialized.
+	session *session
+	// protocolVersion is the MCP revision the client declared for this
+	// request.
+	protocolVersion protocolVersion
+	// messages receives server-to-client messages emitted while handling a
+	// request. It is nil if the client has no way of receiving them.
+	messages messageSink
 }
 
 //go:embed config_snippet.json.tmpl
@@ -104,35 +143,56 @@ func NewService(
 	oauthService *oauth.Service,
 	billingTracker billing.Tracker,
 	billingRepository billing.Repository,
+	assetStorage assets.BlobStore,
+	sessionRelay *relay.Broker,
+	opts ServiceOptions,
 ) *Service {
 	tracer := tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/mcp")
 	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/mcp")
 	logger = logger.With(attr.SlogComponent("mcp"))
 
+	toolProxy := gateway.NewToolProxy(
+		logger,
+		tracerProvider,
+		meterProvider,
+		gateway.ToolCallSourceMCP,
+		cacheImpl,
+		guardianPolicy,
+		assets.NewToolFiles(db, assetStorage),
+	)
+
+	confirmations := newConfirmations(logger, cacheImpl)
+
 	return &Service{
-		logger:       logger,
-		tracer:       tracer,
-		metrics:      newMetrics(meter, logger),
-		db:           db,
-		authRepo:     auth_repo.New(db),
-		toolsetsRepo: toolsets_repo.New(db),
-		orgsRepo:     organizations_repo.New(db),
-		auth:         auth.New(logger, db, sessions),
-		env:          env,
-		serverURL:    serverURL,
-		posthog:      posthog,
-		toolProxy: gateway.NewToolProxy(
-			logger,
-			tracerProvider,
-			meterProvider,
-			gateway.ToolCallSourceMCP,
-			cacheImpl,
-			guardianPolicy,
-		),
+		logger:            logger,
+		tracer:            tracer,
+		metrics:           newMetrics(meter, logger),
+		db:                db,
+		authRepo:          auth_repo.New(db),
+		toolsetsRepo:      toolsets_repo.New(db),
+		orgsRepo:          organizations_repo.New(db),
+		auth:              auth.New(logger, db, sessions),
+		env:               env,
+		serverURL:         serverURL,
+		posthog:           posthog,
+		toolProxy:         toolProxy,
 		oauthService:      oauthService,
 		oauthRepo:         oauth_repo.New(db),
 		billingTracker:    billingTracker,
 		billingRepository: billingRepository,
+		streams:           newStreamHub(),
+		sessions:          newSessionStore(logger, cacheImpl),
+		assetStorage:      assetStorage,
+		listPageSize:      opts.ListPageSize,
+		maxBatchSize:      opts.MaxBatchSize,
+		batchConcurrency:  cmp.Or(max(opts.BatchConcurrency, 0), DefaultBatchConcurrency),
+		confirmations:     confirmations,
+		logging:           newClientLogging(logger),
+		completions:       newCompletions(logger, db, env, toolProxy, billingTracker, billingRepository, confirmations, cacheImpl),
+		downloads:         newDownloads(logger, serverURL, cacheImpl),
+		budgets:           newResponseBudgets(logger, cacheImpl),
+		searchIndexes:     newToolSearchIndexes(),
+		relay:             sessionRelay,
 	}
 }
 
@@ -149,6 +209,11 @@ func Attach(mux goahttp.Muxer, service *Service) {
 			}
 		}
 
+		if acceptsEventStream(r.Header) {
+			oops.ErrHandle(service.logger, service.ServePublicStream).ServeHTTP(w, r)
+			return
+		}
+
 		body, err := json.Marshal(rpcError{
 			ID:      msgID{format: 0, String: "", Number: 0},
 			Code:    methodNotAllowed,
@@ -168,12 +233,24 @@ func Attach(mux goahttp.Muxer, service *Service) {
 			service.logger.ErrorContext(r.Context(), "failed to write response body", attr.SlogError(writeErr))
 		}
 	})
+	o11y.AttachHandler(mux, "DELETE", "/mcp/{mcpSlug}", func(w http.ResponseWriter, r *http.Request) {
+		oops.ErrHandle(service.logger, service.ServePublicTerminate).ServeHTTP(w, r)
+	})
 	o11y.AttachHandler(mux, "GET", "/mcp/{mcpSlug}/install", func(w http.ResponseWriter, r *http.Request) {
 		oops.ErrHandle(service.logger, service.ServeHostedPage).ServeHTTP(w, r)
 	})
+	o11y.AttachHandler(mux, "GET", "/mcp/"+downloadsPathSegment+"/{token}", func(w http.ResponseWriter, r *http.Request) {
+		oops.ErrHandle(service.logger, service.ServeDownload).ServeHTTP(w, r)
+	})
 	o11y.AttachHandler(mux, "POST", "/mcp/{project}/{toolset}/{environment}", func(w http.ResponseWriter, r *http.Request) {
 		oops.ErrHandle(service.logger, service.ServeAuthenticated).ServeHTTP(w, r)
 	})
+	o11y.AttachHandler(mux, "GET", "/mcp/{project}/{toolset}/{environment}", func(w http.ResponseWriter, r *http.Request) {
+		oops.ErrHandle(service.logger, service.ServeAuthenticatedStream).ServeHTTP(w, r)
+	})
+	o11y.AttachHandler(mux, "DELETE", "/mcp/{project}/{toolset}/{environment}", func(w http.ResponseWriter, r *http.Request) {
+		oops.ErrHandle(service.logger, service.ServeAuthenticatedTerminate).ServeHTTP(w, r)
+	})
 
 	// OAuth 2.1 Authorization Server Metadata
 	o11y.AttachHandler(mux, "GET", "/.well-known/oauth-authorization-server/mcp/{mcpSlug}", func(w http.ResponseWriter, r *http.Request) {
@@ -308,6 +385,7 @@ type hostedPageData struct {
 	jsonSnippetData
 	MCPConfig           string
 	MCPConfigURIEncoded string
+	Instructions        string
 	OrganizationName    string
 	SiteURL             string
 }
@@ -409,6 +487,7 @@ func (s *Service) ServeHostedPage(w http.ResponseWriter, r *http.Request) error
 		jsonSnippetData:     configSnippetData,
 		MCPConfig:           configSnippet.String(),
 		MCPConfigURIEncoded: url.QueryEscape(base64.StdEncoding.EncodeToString(configSnippet.Bytes())),
+		Instructions:        conv.PtrValOr(toolsetDetails.Instructions, ""),
 		OrganizationName:    organizationName,
 		SiteURL:             os.Getenv("GRAM_SITE_URL"),
 	}
@@ -435,19 +514,53 @@ func (s *Service) ServeHostedPage(w http.ResponseWriter, r *http.Request) error
 }
 
 func (s *Service) ServePublic(w http.ResponseWriter, r *http.Request) error {
-	ctx := r.Context()
-	defer o11y.LogDefer(ctx, s.logger, func() error {
+	defer o11y.LogDefer(r.Context(), s.logger, func() error {
 		return r.Body.Close()
 	})
 
+	ctx, inputs, err := s.authorizePublic(w, r)
+	if err != nil {
+		return err
+	}
+
+	return s.serveMessages(ctx, w, r, inputs)
+}
+
+// ServePublicStream opens the long-lived event stream that a client can use
+// to receive server-initiated messages for its session.
+func (s *Service) ServePublicStream(w http.ResponseWriter, r *http.Request) error {
+	ctx, inputs, err := s.authorizePublic(w, r)
+	if err != nil {
+		return err
+	}
+
+	return s.serveEventStream(ctx, w, r, inputs)
+}
+
+// ServePublicTerminate ends the caller's session when the client sends a
+// DELETE request.
+func (s *Service) ServePublicTerminate(w http.ResponseWriter, r *http.Request) error {
+	ctx, inputs, err := s.authorizePublic(w, r)
+	if err != nil {
+		return err
+	}
+
+	return s.terminateSession(ctx, w, r, inputs)
+}
+
+// authorizePublic resolves the toolset behind an MCP slug and checks that the
+// caller is allowed to access it.
+func (s *Service) authorizePublic(w http.ResponseWriter, r *http.Request) (context.Context, *mcpInputs, error) {
+	ctx := r.Context()
+
 	mcpSlug := chi.URLParam(r, "mcpSlug")
 	if mcpSlug == "" {
-		return oops.E(oops.CodeBadRequest, nil, "an mcp slug must be provided")
+		return ctx, nil, oops.E(oops.CodeBadRequest, nil, "an mcp slug must be provided")
 	}
 
 	toolset, customDomainCtx, err := s.loadToolsetFromMcpSlug(ctx, mcpSlug)
 	if err != nil {
-		return oops.E(oops.CodeNotFound, err, "mcp server not found").Log(ctx, s.logger)
+		return ctx, nil, oops.E(oops.CodeNotFound, err, "mcp server not found").Log(ctx, s.logger)
 	}
 
 	baseURL := s.serverURL.String()
@@ -465,7 +578,7 @@ func (s *Service) ServePublic(w http.ResponseWriter, r *http.Request) error {
 		if token == "" {
 			s.logger.WarnContext(ctx, "No authorization token provided")
 			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer resource_metadata=%s`, baseURL+"/.well-known/oauth-protected-resource/mcp/"+mcpSlug))
-			return oops.E(oops.CodeUnauthorized, nil, "unauthorized")
+			return ctx, nil, oops.E(oops.CodeUnauthorized, nil, "unauthorized")
 		}
 
 		tokenInputs = append(tokenInputs, oauthTokenInputs{
@@ -476,7 +589,7 @@ func (s *Service) ServePublic(w http.ResponseWriter, r *http.Request) error {
 		token, err := s.oauthService.ValidateAccessToken(ctx, toolset.ID, token)
 		if err != nil {
 			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer resource_metadata=%s`, baseURL+"/.well-known/oauth-protected-resource/mcp/"+mcpSlug))
-			return oops.E(oops.CodeUnauthorized, err, "invalid or expired access token").Log(ctx, s.logger)
+			return ctx, nil, oops.E(oops.CodeUnauthorized, err, "invalid or expired access token").Log(ctx, s.logger)
 		}
 		s.logger.InfoContext(ctx, "OAuth token validated successfully", attr.SlogToolsetID(toolset.ID.String()))
 
@@ -496,7 +609,7 @@ func (s *Service) ServePublic(w http.ResponseWriter, r *http.Request) error {
 			}
 			ctx, err = s.auth.Authorize(ctx, token, &sc)
 			if err != nil {
-				return oops.E(oops.CodeUnauthorized, err, "failed to authorize with API key").Log(ctx, s.logger)
+				return ctx, nil, oops.E(oops.CodeUnauthorized, err, "failed to authorize with API key").Log(ctx, s.logger)
 			}
 		}
 	}
@@ -507,9 +620,9 @@ func (s *Service) ServePublic(w http.ResponseWriter, r *http.Request) error {
 		projects, err := s.authRepo.ListProjectsByOrganization(ctx, authCtx.ActiveOrganizationID)
 		switch {
 		case errors.Is(err, sql.ErrNoRows):
-			return oops.E(oops.CodeForbidden, nil, "no projects found").Log(ctx, s.logger)
+			return ctx, nil, oops.E(oops.CodeForbidden, nil, "no projects found").Log(ctx, s.logger)
 		case err != nil:
-			return oops.E(oops.CodeUnexpected, err, "error checking project access").Log(ctx, s.logger, attr.SlogOrganizationID(authCtx.ActiveOrganizationID))
+			return ctx, nil, oops.E(oops.CodeUnexpected, err, "error checking project access").Log(ctx, s.logger, attr.SlogOrganizationID(authCtx.ActiveOrganizationID))
 		}
 
 		projectInOrg := false
@@ -521,14 +634,14 @@ func (s *Service) ServePublic(w http.ResponseWriter, r *http.Request) error {
 		}
 
 		if !projectInOrg {
-			return oops.C(oops.CodeUnauthorized)
+			return ctx, nil, oops.C(oops.CodeUnauthorized)
 		}
 
 		authenticated = true
 	}
 
 	if !toolset.McpIsPublic && !authenticated {
-		return oops.C(oops.CodeNotFound)
+		return ctx, nil, oops.C(oops.CodeNotFound)
 	}
 
 	// IMPORTANT: We should not use gram environments if we are not in an authenticated context
@@ -539,48 +652,18 @@ func (s *Service) ServePublic(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 
-	var batch batchedRawRequest
-	err = json.NewDecoder(r.Body).Decode(&batch)
-	switch {
-	case errors.Is(err, io.EOF):
-		return nil
-	case err != nil:
-		return oops.E(oops.CodeBadRequest, err, "failed to decode request body").Log(ctx, s.logger)
-	}
-
-	if len(batch) == 0 {
-		return respondWithNoContent(true, w)
-	}
-
-	sessionID := parseMcpSessionID(r.Header)
-	w.Header().Set("Mcp-Session-Id", sessionID)
-
-	mcpInputs := &mcpInputs{
+	return ctx, &mcpInputs{
 		projectID:        toolset.ProjectID,
 		toolset:          toolset.Slug,
 		environment:      selectedEnvironment,
 		mcpEnvVariables:  parseMcpEnvVariables(r),
 		authenticated:    authenticated,
 		oauthTokenInputs: tokenInputs,
-		sessionID:        sessionID,
-	}
-
-	body, err := s.handleBatch(ctx, mcpInputs, batch)
-	switch {
-	case body == nil && err == nil:
-		return respondWithNoContent(true, w)
-	case err != nil:
-		return NewErrorFromCause(batch[0].ID, err)
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, writeErr := w.Write(body)
-	if writeErr != nil {
-		return oops.E(oops.CodeUnexpected, writeErr, "failed to write response body")
-	}
-
-	return nil
+		sessionID:        "",
+		session:          nil,
+		protocolVersion:  defaultProtocolVersion,
+		messages:         nil,
+	}, nil
 }
 
 func (s *Service) loadToolsetFromMcpSlug(ctx context.Context, mcpSlug string) (*toolsets_repo.Toolset, *gateway.DomainContext, error) {
@@ -605,22 +688,57 @@ func (s *Service) loadToolsetFromMcpSlug(ctx context.Context, mcpSlug string) (*
 }
 
 func (s *Service) ServeAuthenticated(w http.ResponseWriter, r *http.Request) error {
+	defer o11y.LogDefer(r.Context(), s.logger, func() error {
+		return r.Body.Close()
+	})
+
+	ctx, inputs, err := s.authorizeAuthenticated(r)
+	if err != nil {
+		return err
+	}
+
+	return s.serveMessages(ctx, w, r, inputs)
+}
+
+// ServeAuthenticatedStream is the event stream counterpart of
+// ServeAuthenticated.
+func (s *Service) ServeAuthenticatedStream(w http.ResponseWriter, r *http.Request) error {
+	ctx, inputs, err := s.authorizeAuthenticated(r)
+	if err != nil {
+		return err
+	}
+
+	return s.serveEventStream(ctx, w, r, inputs)
+}
+
+// ServeAuthenticatedTerminate is the session termination counterpart of
+// ServeAuthenticated.
+func (s *Service) ServeAuthenticatedTerminate(w http.ResponseWriter, r *http.Request) error {
+	ctx, inputs, err := s.authorizeAuthenticated(r)
+	if err != nil {
+		return err
+	}
+
+	return s.terminateSession(ctx, w, r, inputs)
+}
+
+func (s *Service) authorizeAuthenticated(r *http.Request) (context.Context, *mcpInputs, error) {
 	ctx := r.Context()
 	var err error
 
 	projectSlug := chi.URLParam(r, "project")
 	if projectSlug == "" {
-		return oops.E(oops.CodeBadRequest, nil, "a project slug must be provided")
+		return ctx, nil, oops.E(oops.CodeBadRequest, nil, "a project slug must be provided")
 	}
 
 	toolsetSlug := chi.URLParam(r, "toolset")
 	if toolsetSlug == "" {
-		return oops.E(oops.CodeBadRequest, nil, "a toolset slug must be provided")
+		return ctx, nil, oops.E(oops.CodeBadRequest, nil, "a toolset slug must be provided")
 	}
 
 	environmentSlug := chi.URLParam(r, "environment")
 	if environmentSlug == "" {
-		return oops.E(oops.CodeBadRequest, nil, "an environment slug must be provided")
+		return ctx, nil, oops.E(oops.CodeBadRequest, nil, "an environment slug must be provided")
 	}
 
 	sc := security.APIKeyScheme{
@@ -633,7 +751,7 @@ func (s *Service) ServeAuthenticated(w http.ResponseWriter, r *http.Request) err
 	token = strings.TrimPrefix(token, "bearer ")
 	ctx, err = s.auth.Authorize(ctx, token, &sc)
 	if err != nil {
-		return oops.C(oops.CodeUnauthorized)
+		return ctx, nil, oops.C(oops.CodeUnauthorized)
 	}
 
 	// Authorize with project
@@ -644,21 +762,44 @@ func (s *Service) ServeAuthenticated(w http.ResponseWriter, r *http.Request) err
 	}
 	ctx, err = s.auth.Authorize(ctx, projectSlug, &sc)
 	if err != nil {
-		return oops.C(oops.CodeUnauthorized)
+		return ctx, nil, oops.C(oops.CodeUnauthorized)
 	}
 
-	defer o11y.LogDefer(ctx, s.logger, func() error {
-		return r.Body.Close()
-	})
-
 	// authorization check
 	authCtx, ok := contextvalues.GetAuthContext(ctx)
 	if !ok || authCtx == nil || authCtx.ProjectID == nil {
-		return oops.C(oops.CodeUnauthorized)
+		return ctx, nil, oops.C(oops.CodeUnauthorized)
 	}
 
-	var batch batchedRawRequest
-	err = json.NewDecoder(r.Body).Decode(&batch)
+	return ctx, &mcpInputs{
+		projectID:        *authCtx.ProjectID,
+		toolset:          toolsetSlug,
+		environment:      environmentSlug,
+		mcpEnvVariables:  parseMcpEnvVariables(r),
+		authenticated:    true,
+		oauthTokenInputs: []oauthTokenInputs{},
+		sessionID:        "",
+		session:          nil,
+		protocolVersion:  defaultProtocolVersion,
+		messages:         nil,
+	}, nil
+}
+
+// serveMessages handles a POST carrying one or more JSON-RPC messages. When
+// the client accepts text/event-stream, the response is streamed so that any
+// notifications emitted while handling the request are delivered ahead of the
+// final result. Otherwise the result is returned as a single JSON body and
+// notifications are routed to the session's GET stream, or dropped when the
+// request was made without a session.
+func (s *Service) serveMessages(ctx context.Context, w http.ResponseWriter, r *http.Request, inputs *mcpInputs) error {
+	version, ok := parseProtocolVersionHeader(r.Header)
+	if !ok {
+		return oops.E(oops.CodeBadRequest, nil, "unsupported %s: %s", headerProtocolVersion, r.Header.Get(headerProtocolVersion))
+	}
+	inputs.protocolVersion = version
+
+	var raw json.RawMessage
+	err := json.NewDecoder(r.Body).Decode(&raw)
 	switch {
 	case errors.Is(err, io.EOF):
 		return nil
@@ -666,29 +807,83 @@ func (s *Service) ServeAuthenticated(w http.ResponseWriter, r *http.Request) err
 		return oops.E(oops.CodeBadRequest, err, "failed to decode request body").Log(ctx, s.logger)
 	}
 
+	var batch batchedRawRequest
+	if err := json.Unmarshal(raw, &batch); err != nil {
+		return oops.E(oops.CodeBadRequest, err, "failed to decode request body").Log(ctx, s.logger)
+	}
+
 	if len(batch) == 0 {
 		return respondWithNoContent(true, w)
 	}
 
-	sessionID := parseMcpSessionID(r.Header)
-	w.Header().Set("Mcp-Session-Id", sessionID)
+	// The session decides which revision applies to requests that do not
+	// declare one, so it is resolved before checking what the revision
+	// permits.
+	if err := s.resolveSession(ctx, r, inputs, batch); err != nil {
+		return err
+	}
+	version = inputs.protocolVersion
 
-	mcpInputs := &mcpInputs{
-		projectID:        *authCtx.ProjectID,
-		toolset:          toolsetSlug,
-		environment:      environmentSlug,
-		mcpEnvVariables:  parseMcpEnvVariables(r),
-		authenticated:    true,
-		oauthTokenInputs: []oauthTokenInputs{},
-		sessionID:        sessionID,
+	if isJSONArray(raw) && !version.supportsBatching() {
+		return respondWithError(w, http.StatusBadRequest, &rpcError{
+			ID:      msgID{format: 0, String: "", Number: 0},
+			Code:    invalidRequest,
+			Message: fmt.Sprintf("json-rpc batching is not supported in protocol version %s", version),
+			Data:    nil,
+		})
 	}
 
-	body, err := s.handleBatch(ctx, mcpInputs, batch)
+	if s.maxBatchSize > 0 && len(batch) > s.maxBatchSize {
+		return respondWithError(w, http.StatusBadRequest, &rpcError{
+			ID:      msgID{format: 0, String: "", Number: 0},
+			Code:    invalidRequest,
+			Message: fmt.Sprintf("json-rpc batch of %d messages exceeds the limit of %d", len(batch), s.maxBatchSize),
+			Data:    nil,
+		})
+	}
+
+	if inputs.sessionID != "" {
+		w.Header().Set(headerSessionID, inputs.sessionID)
+	}
+	if inputs.session != nil {
+		s.streams.stream(inputs.sessionID).bind(inputs.session)
+	}
+
+	var sse *sseWriter
 	switch {
-	case body == nil && err == nil:
-		return respondWithNoContent(true, w)
+	case acceptsEventStream(r.Header) && batch.hasRequests():
+		sse = newSSEWriter(w)
+		inputs.messages = sse
+	case inputs.sessionID != "":
+		inputs.messages = s.streams.stream(inputs.sessionID)
+	}
+
+	body, err := handleBatch(ctx, s.logger, s.batchConcurrency, batch, func(ctx context.Context, req *rawRequest) (json.RawMessage, error) {
+		return s.handleCancellableRequest(ctx, inputs, req)
+	})
+	switch {
+	case err != nil && sse != nil && sse.Started():
+		// Headers have already been sent so the error can only be reported
+		// as a message on the stream.
+		bs, merr := json.Marshal(NewErrorFromCause(ctx, batch[0], err))
+		if merr != nil {
+			return oops.E(oops.CodeUnexpected, merr, "failed to serialize error response").Log(ctx, s.logger)
+		}
+		body = bs
 	case err != nil:
-		return NewErrorFromCause(batch[0].ID, err)
+		return NewErrorFromCause(ctx, batch[0], err)
+	case body == nil && (sse == nil || !sse.Started()):
+		return respondWithNoContent(true, w)
+	case body == nil:
+		return nil
+	}
+
+	if sse != nil {
+		if err := sse.writeEvent("", body); err != nil {
+			return oops.E(oops.CodeUnexpected, err, "failed to write response event").Log(ctx, s.logger)
+		}
+
+		return nil
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -697,37 +892,73 @@ func (s *Service) ServeAuthenticated(w http.ResponseWriter, r *http.Request) err
 	if writeErr != nil {
 		return oops.E(oops.CodeUnexpected, writeErr, "failed to write response body").Log(ctx, s.logger)
 	}
+
 	return nil
 }
 
-func (s *Service) handleBatch(ctx context.Context, payload *mcpInputs, batch batchedRawRequest) (json.RawMessage, error) {
-	results := make([]json.RawMessage, 0, len(batch))
-	for _, req := range batch {
-		result, err := s.handleRequest(ctx, payload, req)
-		switch {
-		case result == nil && err == nil:
-			return nil, nil
-		case err != nil:
-			bs, merr := json.Marshal(NewErrorFromCause(req.ID, err))
-			if merr != nil {
-				return nil, oops.E(oops.CodeUnexpected, merr, "failed to serialize error response").Log(ctx, s.logger)
-			}
+// serveEventStream holds open a GET request and forwards messages published
+// to the caller's session until the client disconnects. Clients resuming a
+// broken stream can pass Last-Event-ID to receive the events they missed.
+func (s *Service) serveEventStream(ctx context.Context, w http.ResponseWriter, r *http.Request, inputs *mcpInputs) error {
+	if !acceptsEventStream(r.Header) {
+		return oops.E(oops.CodeUnsupportedMedia, nil, "clients must accept text/event-stream to open a stream")
+	}
 
-			result = bs
-		}
+	version, ok := parseProtocolVersionHeader(r.Header)
+	if !ok {
+		return oops.E(oops.CodeBadRequest, nil, "unsupported %s: %s", headerProtocolVersion, r.Header.Get(headerProtocolVersion))
+	}
+	inputs.protocolVersion = version
 
-		results = append(results, result)
+	sess, err := s.sessions.load(ctx, r.Header, inputs)
+	if err != nil {
+		return err
 	}
+	inputs.session = sess
+	inputs.sessionID = sess.ID
 
-	if len(results) == 1 {
-		return results[0], nil
-	} else {
-		m, err := json.Marshal(results)
-		if err != nil {
-			return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize results").Log(ctx, s.logger)
+	lastEventID, err := parseLastEventID(r.Header)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid Last-Event-ID header")
+	}
+
+	stream := s.streams.stream(sess.ID)
+	stream.bind(sess)
+	replay, events, unsubscribe := stream.subscribe(lastEventID)
+	defer unsubscribe()
+
+	w.Header().Set(headerSessionID, sess.ID)
+	sse := newSSEWriter(w)
+	if err := sse.Open(); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to open event stream").Log(ctx, s.logger)
+	}
+
+	for _, ev := range replay {
+		if err := sse.writeEvent(strconv.FormatUint(ev.id, 10), ev.data); err != nil {
+			s.logger.WarnContext(ctx, "failed to replay stream event", attr.SlogError(err))
+			return nil
 		}
+	}
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
 
-		return m, nil
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-stream.terminated():
+			return nil
+		case ev := <-events:
+			if err := sse.writeEvent(strconv.FormatUint(ev.id, 10), ev.data); err != nil {
+				s.logger.WarnContext(ctx, "failed to write stream event", attr.SlogError(err))
+				return nil
+			}
+		case <-keepAlive.C:
+			if err := sse.writeComment("keepalive"); err != nil {
+				return nil
+			}
+		}
 	}
 }
 
@@ -736,6 +967,7 @@ func (s *Service) handleBatch(ctx context.Context, payload *mcpInputs, batch bat
 func parseMcpEnvVariables(r *http.Request) map[string]string {
 	ignoredHeaders := []string{
 		"mcp-session-id",
+		"mcp-protocol-version",
 	}
 	envVars := map[string]string{}
 	for k := range r.Header {
@@ -749,6 +981,37 @@ func parseMcpEnvVariables(r *http.Request) map[string]string {
 	return envVars
 }
 
+// handleCancellableRequest tracks a request for the lifetime of its handler
+// so that the client can abort it with notifications/cancelled. No response
+// is sent for a cancelled request. A request that reuses the id of one still
+// in flight on the session is rejected.
+func (s *Service) handleCancellableRequest(ctx context.Context, payload *mcpInputs, req *rawRequest) (json.RawMessage, error) {
+	if strings.HasPrefix(req.Method, "notifications/") || req.isResponse() || payload.sessionID == "" {
+		return s.handleRequest(ctx, payload, req)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	untrack, ok := s.streams.stream(payload.sessionID).trackRequest(req.ID.Value(), cancel)
+	if !ok {
+		return nil, &rpcError{
+			ID:      req.ID,
+			Code:    invalidRequest,
+			Message: fmt.Sprintf("request id %s is already in use by an in-flight request in this mcp session", req.ID.Value()),
+			Data:    nil,
+		}
+	}
+	defer untrack()
+
+	result, err := s.handleRequest(ctx, payload, req)
+	if errors.Is(context.Cause(ctx), errRequestCancelled) {
+		s.logger.InfoContext(ctx, "request cancelled by client", attr.SlogError(context.Cause(ctx)))
+		return nil, nil
+	}
+
+	return result, err
+}
+
 func (s *Service) handleRequest(ctx context.Context, payload *mcpInputs, req *rawRequest) (json.RawMessage, error) {
 	if requestContext, _ := contextvalues.GetRequestContext(ctx); requestContext != nil {
 		start := time.Now()
@@ -757,21 +1020,44 @@ func (s *Service) handleRequest(ctx context.Context, payload *mcpInputs, req *ra
 		}()
 	}
 
+	logger := withClient(ctx, s.logger, payload.client())
+
+	if req.isResponse() {
+		s.resolveResponse(ctx, logger, payload, req)
+		return nil, nil
+	}
+
 	switch req.Method {
 	case "ping":
-		return handlePing(ctx, s.logger, req.ID)
+		return handlePing(ctx, logger, req.ID)
 	case "initialize":
-		return handleInitialize(ctx, s.logger, req, payload, s.posthog)
-	case "notifications/initialized", "notifications/cancelled":
+		return handleInitialize(ctx, logger, s.db, req, payload, s.posthog, s.sessions)
+	case "notifications/initialized":
 		return nil, nil
+	case "notifications/cancelled":
+		return handleCancelled(ctx, logger, s.streams, s.relay, payload, req)
 	case "tools/list":
-		return handleToolsList(ctx, s.logger, s.db, payload, req, s.posthog)
+		return handleToolsList(ctx, logger, s.db, payload, req, s.posthog, s.listPageSize)
 	case "tools/call":
-		return handleToolsCall(ctx, s.logger, s.metrics, s.db, s.env, payload, req, s.toolProxy, s.billingTracker, s.billingRepository)
+		return handleToolsCall(ctx, logger, s.metrics, s.db, s.env, payload, req, s.toolProxy, s.billingTracker, s.billingRepository, s.confirmations, s.logging, s.downloads, s.budgets, s.searchIndexes)
 	case "prompts/list":
-		return handlePromptsList(ctx, s.logger, s.db, payload, req)
+		return handlePromptsList(ctx, logger, s.db, payload, req, s.listPageSize)
 	case "prompts/get":
-		return handlePromptsGet(ctx, s.logger, s.db, payload, req)
+		return handlePromptsGet(ctx, logger, s.db, payload, req)
+	case "resources/list":
+		return handleResourcesList(ctx, logger, s.db, payload, req)
+	case "resources/templates/list":
+		return handleResourceTemplatesList(ctx, logger, s.db, payload, req)
+	case "resources/read":
+		return handleResourcesRead(ctx, logger, s.db, s.env, payload, req, s.toolProxy, s.assetStorage, s.billingTracker, s.billingRepository, s.confirmations)
+	case "resources/subscribe":
+		return handleResourcesSubscribe(ctx, logger, s.db, s.streams, payload, req, true)
+	case "resources/unsubscribe":
+		return handleResourcesSubscribe(ctx, logger, s.db, s.streams, payload, req, false)
+	case "completion/complete":
+		return handleCompletionComplete(ctx, logger, s.completions, payload, req)
+	case "logging/setLevel":
+		return handleLoggingSetLevel(ctx, logger, s.sessions, payload, req)
 	default:
 		return nil, &rpcError{
 			ID:      req.ID,
@@ -781,11 +1067,3 @@ func (s *Service) handleRequest(ctx context.Context, payload *mcpInputs, req *ra
 		}
 	}
 }
-
-func parseMcpSessionID(headers http.Header) string {
-	session := headers.Get("Mcp-Session-Id")
-	if session == "" {
-		session = uuid.New().String()
-	}
-	return session
-}
@@ -0,0 +1,59 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
+)
+
+// notifyListChanged sends a list_changed notification to the sessions held on
+// this server for the toolset a relayed list change is addressed to.
+func (s *Service) notifyListChanged(ctx context.Context, msg relay.Message) {
+	var method string
+	switch msg.Kind {
+	case relay.KindToolsListChanged:
+		method = "notifications/tools/list_changed"
+	case relay.KindPromptsListChanged:
+		method = "notifications/prompts/list_changed"
+	default:
+		return
+	}
+
+	bs, err := json.Marshal(notification[struct{}]{Method: method, Params: struct{}{}})
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Sprintf("failed to marshal %s notification", method), attr.SlogError(err))
+		return
+	}
+
+	for _, stream := range s.streams.matching(msg.ProjectID, conv.ToLower(msg.Toolset)) {
+		if err := stream.send(ctx, bs); err != nil {
+			s.logger.WarnContext(ctx, fmt.Sprintf("failed to send %s notification", method), attr.SlogError(err))
+		}
+	}
+}
+
+// notifyResourcesUpdated sends a resources/updated notification for every
+// resource that the sessions held on this server for the addressed toolset
+// subscribed to.
+func (s *Service) notifyResourcesUpdated(ctx context.Context, msg relay.Message) {
+	for _, stream := range s.streams.matching(msg.ProjectID, conv.ToLower(msg.Toolset)) {
+		for _, uri := range stream.subscribedResources() {
+			bs, err := json.Marshal(notification[resourceURIParams]{
+				Method: "notifications/resources/updated",
+				Params: resourceURIParams{URI: uri},
+			})
+			if err != nil {
+				s.logger.ErrorContext(ctx, "failed to marshal notifications/resources/updated notification", attr.SlogError(err))
+				return
+			}
+
+			if err := stream.send(ctx, bs); err != nil {
+				s.logger.WarnContext(ctx, "failed to send notifications/resources/updated notification", attr.SlogError(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
+)
+
+func listChangedMessage(kind relay.Kind, projectID string, toolset string) relay.Message {
+	return relay.Message{
+		Kind:      kind,
+		SessionID: "",
+		ProjectID: projectID,
+		Toolset:   toolset,
+		RequestID: "",
+		Reason:    "",
+		Data:      nil,
+	}
+}
+
+func TestHandleRelayed_ListChangedReachesEventStream(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		kind    relay.Kind
+		toolset string
+		method  string
+	}{
+		"tools of the toolset":   {kind: relay.KindToolsListChanged, toolset: "Petstore", method: "notifications/tools/list_changed"},
+		"prompts of the toolset": {kind: relay.KindPromptsListChanged, toolset: "petstore", method: "notifications/prompts/list_changed"},
+		"every toolset":          {kind: relay.KindToolsListChanged, toolset: "", method: "notifications/tools/list_changed"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newTestService(t)
+			sess := newTestSession(t, s, "session")
+			res, events := openEventStream(t, s, http.Header{headerSessionID: {sess.ID}})
+			require.Equal(t, http.StatusOK, res.StatusCode)
+
+			// Changes to other servers are not announced to the session.
+			s.handleRelayed(t.Context(), listChangedMessage(tt.kind, sess.ProjectID, "bookstore"))
+			s.handleRelayed(t.Context(), listChangedMessage(tt.kind, "other-project", ""))
+			s.handleRelayed(t.Context(), listChangedMessage(tt.kind, sess.ProjectID, tt.toolset))
+
+			ev := readSSEEvent(t, events)
+			require.Equal(t, "1", ev.id)
+			require.JSONEq(t, `{"jsonrpc":"2.0","method":"`+tt.method+`"}`, ev.data)
+		})
+	}
+}
+
+func TestHandleRelayed_ResourcesUpdatedReachesSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+	sess := newTestSession(t, s, "session")
+	res, events := openEventStream(t, s, http.Header{headerSessionID: {sess.ID}})
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	stream := s.streams.stream(sess.ID)
+	stream.subscribeResource("gram://petstore/runbook")
+	stream.subscribeResource("gram://petstore/pet/42")
+	stream.subscribeResource("gram://petstore/changelog")
+	stream.unsubscribeResource("gram://petstore/changelog")
+
+	// Changes to other servers are not announced to the session.
+	s.handleRelayed(t.Context(), listChangedMessage(relay.KindResourcesUpdated, sess.ProjectID, "bookstore"))
+	s.handleRelayed(t.Context(), listChangedMessage(relay.KindResourcesUpdated, sess.ProjectID, "petstore"))
+
+	ev := readSSEEvent(t, events)
+	require.Equal(t, "1", ev.id)
+	require.JSONEq(t, `{"jsonrpc":"2.0","method":"notifications/resources/updated","params":{"uri":"gram://petstore/pet/42"}}`, ev.data)
+
+	ev = readSSEEvent(t, events)
+	require.Equal(t, "2", ev.id)
+	require.JSONEq(t, `{"jsonrpc":"2.0","method":"notifications/resources/updated","params":{"uri":"gram://petstore/runbook"}}`, ev.data)
+}
@@ -0,0 +1,176 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+// loggingLevel is one of the syslog severities used by the MCP logging
+// capability.
+type loggingLevel string
+
+const (
+	loggingLevelDebug     loggingLevel = "debug"
+	loggingLevelInfo      loggingLevel = "info"
+	loggingLevelNotice    loggingLevel = "notice"
+	loggingLevelWarning   loggingLevel = "warning"
+	loggingLevelError     loggingLevel = "error"
+	loggingLevelCritical  loggingLevel = "critical"
+	loggingLevelAlert     loggingLevel = "alert"
+	loggingLevelEmergency loggingLevel = "emergency"
+)
+
+// defaultLoggingLevel is used for sessions that never called
+// logging/setLevel.
+const defaultLoggingLevel = loggingLevelWarning
+
+// loggerName identifies the source of the log messages sent to clients.
+const loggerName = "gram-gateway"
+
+// slogLevel maps an MCP logging level to the slog level at or above which
+// records are forwarded. Levels that slog has no name for sit between or
+// above the standard ones.
+func (l loggingLevel) slogLevel() (slog.Level, bool) {
+	switch l {
+	case loggingLevelDebug:
+		return slog.LevelDebug, true
+	case loggingLevelInfo:
+		return slog.LevelInfo, true
+	case loggingLevelNotice:
+		return slog.LevelInfo + 2, true
+	case loggingLevelWarning:
+		return slog.LevelWarn, true
+	case loggingLevelError:
+		return slog.LevelError, true
+	case loggingLevelCritical:
+		return slog.LevelError + 4, true
+	case loggingLevelAlert:
+		return slog.LevelError + 8, true
+	case loggingLevelEmergency:
+		return slog.LevelError + 12, true
+	default:
+		return 0, false
+	}
+}
+
+func loggingLevelFromSlog(level slog.Level) loggingLevel {
+	switch {
+	case level >= slog.LevelError+12:
+		return loggingLevelEmergency
+	case level >= slog.LevelError+8:
+		return loggingLevelAlert
+	case level >= slog.LevelError+4:
+		return loggingLevelCritical
+	case level >= slog.LevelError:
+		return loggingLevelError
+	case level >= slog.LevelWarn:
+		return loggingLevelWarning
+	case level >= slog.LevelInfo+2:
+		return loggingLevelNotice
+	case level >= slog.LevelInfo:
+		return loggingLevelInfo
+	default:
+		return loggingLevelDebug
+	}
+}
+
+type loggingSetLevelParams struct {
+	Level loggingLevel `json:"level"`
+}
+
+type loggingMessageParams struct {
+	Level  loggingLevel   `json:"level"`
+	Logger string         `json:"logger,omitempty"`
+	Data   map[string]any `json:"data"`
+}
+
+// clientLogging forwards gateway diagnostics to MCP clients as
+// notifications/message at the level each session requested.
+type clientLogging struct {
+	logger *slog.Logger
+}
+
+func newClientLogging(logger *slog.Logger) *clientLogging {
+	return &clientLogging{
+		logger: logger,
+	}
+}
+
+// sessionLoggingLevel returns the level a session asked for with
+// logging/setLevel.
+func sessionLoggingLevel(sess *session) loggingLevel {
+	if sess == nil || sess.LogLevel == "" {
+		return defaultLoggingLevel
+	}
+
+	return sess.LogLevel
+}
+
+// withDiagnostics returns a context that forwards the diagnostics of a tool
+// call made on behalf of the client to it as log messages.
+func (c *clientLogging) withDiagnostics(ctx context.Context, payload *mcpInputs) context.Context {
+	if payload.messages == nil {
+		return ctx
+	}
+
+	level, ok := sessionLoggingLevel(payload.session).slogLevel()
+	if !ok {
+		return ctx
+	}
+
+	return gateway.WithDiagnostics(ctx, level, func(ctx context.Context, d gateway.Diagnostic) {
+		data := make(map[string]any, len(d.Data)+1)
+		for k, v := range d.Data {
+			data[k] = v
+		}
+		data["message"] = d.Message
+
+		err := sendNotification(ctx, payload, "notifications/message", loggingMessageParams{
+			Level:  loggingLevelFromSlog(d.Level),
+			Logger: loggerName,
+			Data:   data,
+		})
+		if err != nil {
+			c.logger.WarnContext(ctx, "failed to send log message notification", attr.SlogError(err))
+		}
+	})
+}
+
+func handleLoggingSetLevel(ctx context.Context, logger *slog.Logger, sessions *sessionStore, payload *mcpInputs, req *rawRequest) (json.RawMessage, error) {
+	var params loggingSetLevelParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "failed to parse logging/setLevel request").Log(ctx, logger)
+	}
+
+	if _, ok := params.Level.slogLevel(); !ok {
+		return nil, &rpcError{
+			ID:      req.ID,
+			Code:    invalidParams,
+			Message: fmt.Sprintf("unsupported logging level: %s", params.Level),
+			Data:    nil,
+		}
+	}
+
+	if payload.session != nil {
+		payload.session.LogLevel = params.Level
+		if err := sessions.save(ctx, payload.session); err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to store logging level").Log(ctx, logger)
+		}
+	}
+
+	bs, err := json.Marshal(result[json.RawMessage]{
+		ID:     req.ID,
+		Result: json.RawMessage("{}"),
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize logging/setLevel response").Log(ctx, logger)
+	}
+
+	return bs, nil
+}
@@ -42,7 +42,7 @@ func newMetrics(meter metric.Meter, logger *slog.Logger) *metrics {
 	}
 }
 
-func (m *metrics) RecordMCPToolCall(ctx context.Context, orgID string, mcpURL string, toolName string) {
+func (m *metrics) RecordMCPToolCall(ctx context.Context, orgID string, mcpURL string, toolName string, clientName string) {
 	if m.mcpToolCallCounter == nil {
 		return
 	}
@@ -52,6 +52,9 @@ func (m *metrics) RecordMCPToolCall(ctx context.Context, orgID string, mcpURL st
 		attr.ToolName(toolName),
 		attr.OrganizationID(orgID),
 	}
+	if clientName != "" {
+		kv = append(kv, attr.McpClientName(clientName))
+	}
 	m.mcpToolCallCounter.Add(ctx, 1, metric.WithAttributes(kv...))
 }
 
@@ -0,0 +1,207 @@
+package mcp
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+)
+
+// DefaultListPageSize is the number of items returned in each page of
+// tools/list and prompts/list when no page size is configured.
+const DefaultListPageSize = 500
+
+// errStaleListCursor is returned by paginate when a cursor was issued for a
+// different deployment or set of items than the one being listed.
+var errStaleListCursor = errors.New("cursor was issued for a different listing")
+
+type paginatedParams struct {
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// listCursor is the decoded form of the opaque cursor handed out in
+// nextCursor. It pins the deployment and the set of items the listing started
+// from and the name of the last item that was returned so the next page
+// resumes after it.
+type listCursor struct {
+	Deployment string `json:"d"`
+	Listing    string `json:"l"`
+	After      string `json:"a"`
+}
+
+func encodeListCursor(c listCursor) (string, error) {
+	bs, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+func decodeListCursor(raw string) (*listCursor, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", err)
+	}
+
+	var c listCursor
+	if err := json.Unmarshal(bs, &c); err != nil {
+		return nil, fmt.Errorf("unmarshal cursor: %w", err)
+	}
+
+	return &c, nil
+}
+
+// listingKey identifies the names of the items being listed, in order. Any
+// change to the names a toolset exposes, such as a tool being added or
+// removed or a variation renaming a tool, changes the key. Redeployments that
+// keep every name are caught by the cursor's deployment instead.
+func listingKey(names []string) string {
+	h := sha256.New()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+	}
+
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil)[:12])
+}
+
+// parseListCursor reads the optional cursor from the params of a list
+// request.
+func parseListCursor(req *rawRequest) (*listCursor, error) {
+	var params paginatedParams
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, &rpcError{
+				ID:      req.ID,
+				Code:    invalidParams,
+				Message: fmt.Sprintf("%s: invalid params: %s", req.Method, err.Error()),
+				Data:    nil,
+			}
+		}
+	}
+
+	if params.Cursor == "" {
+		return nil, nil
+	}
+
+	cursor, err := decodeListCursor(params.Cursor)
+	if err != nil {
+		return nil, &rpcError{
+			ID:      req.ID,
+			Code:    invalidParams,
+			Message: fmt.Sprintf("%s: invalid cursor", req.Method),
+			Data:    nil,
+		}
+	}
+
+	return cursor, nil
+}
+
+// staleListCursorError reports a cursor that no longer matches the listing
+// so that the client starts over instead of silently missing items.
+func staleListCursorError(req *rawRequest) *rpcError {
+	return &rpcError{
+		ID:      req.ID,
+		Code:    invalidParams,
+		Message: fmt.Sprintf("%s: cursor is no longer valid because the listing changed, list again without a cursor", req.Method),
+		Data:    nil,
+	}
+}
+
+// toolsetDeploymentID returns the project deployment that the toolset's tools
+// were described from. Tools from packages belong to the package's own
+// deployment, so it is empty when the toolset has no tools of the project's.
+func toolsetDeploymentID(toolset *types.Toolset) string {
+	for _, tool := range toolset.HTTPTools {
+		if conv.PtrValOr(tool.PackageName, "") == "" {
+			return tool.DeploymentID
+		}
+	}
+
+	return ""
+}
+
+// describeListedToolset describes the toolset whose tools are being listed.
+// Pages after the first are described from the deployment the listing started
+// from, so a redeploy while a client is paging does not change what it sees.
+// Changes to the toolset itself, such as its tools or their variations, still
+// invalidate the cursor.
+func describeListedToolset(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, payload *mcpInputs, req *rawRequest, cursor *listCursor) (*types.Toolset, error) {
+	projectID := mv.ProjectID(payload.projectID)
+	toolsetSlug := mv.ToolsetSlug(conv.ToLower(payload.toolset))
+
+	if cursor == nil || cursor.Deployment == "" {
+		return mv.DescribeToolset(ctx, logger, db, projectID, toolsetSlug)
+	}
+
+	deploymentID, err := uuid.Parse(cursor.Deployment)
+	if err != nil {
+		return nil, &rpcError{
+			ID:      req.ID,
+			Code:    invalidParams,
+			Message: fmt.Sprintf("%s: invalid cursor", req.Method),
+			Data:    nil,
+		}
+	}
+
+	return mv.DescribeToolsetAtDeployment(ctx, logger, db, projectID, toolsetSlug, mv.DeploymentID(deploymentID))
+}
+
+// paginate sorts items by name and returns the page that follows the cursor
+// along with the cursor for the next page, which is empty on the last page.
+// Items that do not come from a deployment are listed with an empty one. A
+// cursor issued for a different deployment or set of items is rejected with
+// errStaleListCursor. A page size of zero or less disables pagination.
+func paginate[T any](items []T, name func(T) string, deployment string, cursor *listCursor, pageSize int) ([]T, string, error) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return cmp.Compare(name(a), name(b))
+	})
+
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = name(item)
+	}
+	listing := listingKey(names)
+
+	start := 0
+	if cursor != nil {
+		if cursor.Deployment != deployment || cursor.Listing != listing {
+			return nil, "", errStaleListCursor
+		}
+
+		start, _ = slices.BinarySearch(names, cursor.After)
+		// The cursor points at the last item of the previous page so skip it.
+		if start < len(names) && names[start] == cursor.After {
+			start++
+		}
+	}
+
+	if pageSize <= 0 || len(items)-start <= pageSize {
+		return items[start:], "", nil
+	}
+
+	page := items[start : start+pageSize]
+	next, err := encodeListCursor(listCursor{
+		Deployment: deployment,
+		Listing:    listing,
+		After:      name(page[len(page)-1]),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return page, next, nil
+}
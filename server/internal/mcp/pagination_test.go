@@ -0,0 +1,164 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func identity(s string) string { return s }
+
+func listRequest(t *testing.T, cursor string) *rawRequest {
+	t.Helper()
+
+	params, err := json.Marshal(paginatedParams{Cursor: cursor})
+	require.NoError(t, err)
+
+	return &rawRequest{
+		JSONRPC: "2.0",
+		ID:      msgID{format: 1, Number: 1, String: ""},
+		Method:  "tools/list",
+		Params:  params,
+		Result:  nil,
+		Error:   nil,
+	}
+}
+
+// listAll walks every page of a listing the way a client would, feeding each
+// nextCursor back through a list request.
+func listAll(t *testing.T, items []string, pageSize int) [][]string {
+	t.Helper()
+
+	var pages [][]string
+	var cursor *listCursor
+	for range len(items) + 1 {
+		page, next, err := paginate(append([]string(nil), items...), identity, "deployment", cursor, pageSize)
+		require.NoError(t, err)
+		pages = append(pages, page)
+
+		if next == "" {
+			return pages
+		}
+
+		cursor, err = parseListCursor(listRequest(t, next))
+		require.NoError(t, err)
+	}
+
+	require.FailNow(t, "listing did not end")
+	return nil
+}
+
+func TestPaginate_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	items := []string{"e", "c", "a", "d", "b"}
+
+	tests := map[string]struct {
+		pageSize int
+		expected [][]string
+	}{
+		"pages of two":       {pageSize: 2, expected: [][]string{{"a", "b"}, {"c", "d"}, {"e"}}},
+		"exact fit":          {pageSize: 5, expected: [][]string{{"a", "b", "c", "d", "e"}}},
+		"larger than needed": {pageSize: 10, expected: [][]string{{"a", "b", "c", "d", "e"}}},
+		"disabled":           {pageSize: 0, expected: [][]string{{"a", "b", "c", "d", "e"}}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, listAll(t, items, tt.pageSize))
+		})
+	}
+}
+
+func TestPaginate_RejectsStaleCursor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		items      []string
+		deployment string
+	}{
+		"item added":            {items: []string{"a", "b", "c", "d", "e"}, deployment: "deployment"},
+		"item removed":          {items: []string{"a", "b", "d"}, deployment: "deployment"},
+		"item renamed":          {items: []string{"a", "b", "c", "z"}, deployment: "deployment"},
+		"same names redeployed": {items: []string{"a", "b", "c", "d"}, deployment: "redeployment"},
+	}
+
+	for name, changed := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, next, err := paginate([]string{"a", "b", "c", "d"}, identity, "deployment", nil, 2)
+			require.NoError(t, err)
+			req := listRequest(t, next)
+			cursor, err := parseListCursor(req)
+			require.NoError(t, err)
+
+			page, nextCursor, err := paginate(changed.items, identity, changed.deployment, cursor, 2)
+			require.ErrorIs(t, err, errStaleListCursor)
+			require.Nil(t, page)
+			require.Empty(t, nextCursor)
+
+			rpcErr := staleListCursorError(req)
+			require.Equal(t, invalidParams, rpcErr.Code)
+			require.Equal(t, req.ID, rpcErr.ID)
+		})
+	}
+}
+
+func TestParseListCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor, err := parseListCursor(listRequest(t, ""))
+	require.NoError(t, err)
+	require.Nil(t, cursor)
+
+	_, err = parseListCursor(listRequest(t, "not a cursor!"))
+	var rpcErr *rpcError
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, invalidParams, rpcErr.Code)
+
+	req := listRequest(t, "")
+	req.Params = json.RawMessage(`{"cursor":1}`)
+	_, err = parseListCursor(req)
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, invalidParams, rpcErr.Code)
+}
+
+func TestListingKey(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, listingKey([]string{"a", "b"}), listingKey([]string{"a", "b"}))
+	require.NotEqual(t, listingKey([]string{"a", "b"}), listingKey([]string{"b", "a"}))
+	// Names are delimited so that moving characters between them changes
+	// the key.
+	require.NotEqual(t, listingKey([]string{"ab", "c"}), listingKey([]string{"a", "bc"}))
+}
+
+func TestToolsetDeploymentID(t *testing.T) {
+	t.Parallel()
+
+	packaged := newTestToolDefinition("list_invoices")
+	packaged.PackageName = conv.Ptr("billing")
+	own := newTestToolDefinition("list_pets")
+	own.PackageName = conv.Ptr("")
+
+	require.Empty(t, toolsetDeploymentID(newTestToolset()))
+	require.Empty(t, toolsetDeploymentID(newTestToolset(packaged)))
+	require.Equal(t, own.DeploymentID, toolsetDeploymentID(newTestToolset(packaged, own)))
+}
+
+func TestDescribeListedToolset_InvalidDeployment(t *testing.T) {
+	t.Parallel()
+
+	req := listRequest(t, "")
+	_, err := describeListedToolset(t.Context(), testenv.NewLogger(t), nil, newTestInputs(), req, &listCursor{Deployment: "not-a-uuid", Listing: "", After: "a"})
+
+	var rpcErr *rpcError
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, invalidParams, rpcErr.Code)
+}
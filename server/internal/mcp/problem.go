@@ -0,0 +1,158 @@
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// problemDetails is an RFC 9457 problem details object returned by an
+// upstream API with the application/problem+json content type.
+type problemDetails struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail"`
+	Instance string `json:"instance"`
+	// Errors and InvalidParams are common extensions that list validation
+	// failures for individual fields.
+	Errors        json.RawMessage `json:"errors"`
+	InvalidParams json.RawMessage `json:"invalid-params"`
+}
+
+func isProblemJSON(mt string) bool {
+	return mt == "application/problem+json"
+}
+
+// summarizeProblem turns a problem details body into a readable summary for
+// the model. The original body is appended so that no detail is lost.
+func summarizeProblem(statusCode int, body []byte) string {
+	var problem problemDetails
+	if err := json.Unmarshal(body, &problem); err != nil {
+		return fmt.Sprintf("The upstream API returned an error (%s):\n%s", statusLine(statusCode), string(body))
+	}
+
+	status := statusCode
+	if problem.Status != 0 {
+		status = problem.Status
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "The upstream API returned an error (%s)", statusLine(status))
+	if problem.Title != "" {
+		fmt.Fprintf(&sb, ": %s", problem.Title)
+	}
+	sb.WriteString("\n")
+
+	if problem.Detail != "" {
+		fmt.Fprintf(&sb, "Detail: %s\n", problem.Detail)
+	}
+	if problem.Type != "" && problem.Type != "about:blank" {
+		fmt.Fprintf(&sb, "Type: %s\n", problem.Type)
+	}
+	if problem.Instance != "" {
+		fmt.Fprintf(&sb, "Instance: %s\n", problem.Instance)
+	}
+
+	fieldErrors := append(describeFieldErrors(problem.Errors), describeFieldErrors(problem.InvalidParams)...)
+	if len(fieldErrors) > 0 {
+		sb.WriteString("Errors:\n")
+		for _, fe := range fieldErrors {
+			fmt.Fprintf(&sb, "- %s\n", fe)
+		}
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, body); err == nil {
+		fmt.Fprintf(&sb, "Problem details: %s", compact.String())
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func statusLine(code int) string {
+	if text := http.StatusText(code); text != "" {
+		return fmt.Sprintf("%d %s", code, text)
+	}
+
+	return fmt.Sprintf("status %d", code)
+}
+
+// describeFieldErrors renders the entries of a validation error list. Entries
+// that name the offending field with pointer, field, name or parameter and
+// explain it with detail, message or reason are written as "field: reason".
+// Anything else is written as compact JSON.
+func describeFieldErrors(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var entries []json.RawMessage
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		// Some APIs key validation errors by field instead.
+		var byField map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &byField); err != nil {
+			return nil
+		}
+
+		out := make([]string, 0, len(byField))
+		for _, field := range slices.Sorted(maps.Keys(byField)) {
+			out = append(out, fmt.Sprintf("%s: %s", field, jsonText(byField[field])))
+		}
+		return out
+	}
+
+	out := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(entry, &fields); err != nil {
+			out = append(out, jsonText(entry))
+			continue
+		}
+
+		location := firstJSONText(fields, "pointer", "field", "name", "parameter")
+		reason := firstJSONText(fields, "detail", "message", "reason")
+		switch {
+		case location != "" && reason != "":
+			out = append(out, location+": "+reason)
+		case reason != "":
+			out = append(out, reason)
+		default:
+			out = append(out, jsonText(entry))
+		}
+	}
+
+	return out
+}
+
+func firstJSONText(fields map[string]json.RawMessage, keys ...string) string {
+	for _, key := range keys {
+		if raw, ok := fields[key]; ok {
+			if text := jsonText(raw); text != "" {
+				return text
+			}
+		}
+	}
+
+	return ""
+}
+
+// jsonText returns a JSON string as plain text and any other value as compact
+// JSON.
+func jsonText(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw); err != nil {
+		return string(raw)
+	}
+
+	return compact.String()
+}
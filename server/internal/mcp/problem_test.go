@@ -0,0 +1,107 @@
+package mcp
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeProblem(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		body     string
+		expected string
+	}{
+		"title and detail": {
+			status: http.StatusNotFound,
+			body:   `{"type":"about:blank","title":"Pet not found","detail":"No pet has the id 42."}`,
+			expected: "The upstream API returned an error (404 Not Found): Pet not found\n" +
+				"Detail: No pet has the id 42.\n" +
+				`Problem details: {"type":"about:blank","title":"Pet not found","detail":"No pet has the id 42."}`,
+		},
+		"status and type from body": {
+			status: http.StatusOK,
+			body:   `{"type":"https://example.com/probs/out-of-stock","title":"Out of stock","status":409,"instance":"/orders/7"}`,
+			expected: "The upstream API returned an error (409 Conflict): Out of stock\n" +
+				"Type: https://example.com/probs/out-of-stock\n" +
+				"Instance: /orders/7\n" +
+				`Problem details: {"type":"https://example.com/probs/out-of-stock","title":"Out of stock","status":409,"instance":"/orders/7"}`,
+		},
+		"errors as an array": {
+			status: http.StatusUnprocessableEntity,
+			body:   `{"title":"Validation failed","errors":[{"pointer":"#/name","detail":"is required"},{"message":"tag is too long"},42]}`,
+			expected: "The upstream API returned an error (422 Unprocessable Entity): Validation failed\n" +
+				"Errors:\n" +
+				"- #/name: is required\n" +
+				"- tag is too long\n" +
+				"- 42\n" +
+				`Problem details: {"title":"Validation failed","errors":[{"pointer":"#/name","detail":"is required"},{"message":"tag is too long"},42]}`,
+		},
+		"errors keyed by field": {
+			status: http.StatusBadRequest,
+			body:   `{"title":"Invalid request","errors":{"tag":["is too long"],"name":"is required","age":"must be positive"}}`,
+			expected: "The upstream API returned an error (400 Bad Request): Invalid request\n" +
+				"Errors:\n" +
+				"- age: must be positive\n" +
+				"- name: is required\n" +
+				`- tag: ["is too long"]` + "\n" +
+				`Problem details: {"title":"Invalid request","errors":{"tag":["is too long"],"name":"is required","age":"must be positive"}}`,
+		},
+		"invalid params": {
+			status: http.StatusBadRequest,
+			body:   `{"title":"Invalid parameters","invalid-params":[{"name":"age","reason":"must be a positive integer"}]}`,
+			expected: "The upstream API returned an error (400 Bad Request): Invalid parameters\n" +
+				"Errors:\n" +
+				"- age: must be a positive integer\n" +
+				`Problem details: {"title":"Invalid parameters","invalid-params":[{"name":"age","reason":"must be a positive integer"}]}`,
+		},
+		"not json": {
+			status:   http.StatusBadGateway,
+			body:     `upstream unavailable`,
+			expected: "The upstream API returned an error (502 Bad Gateway):\nupstream unavailable",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, summarizeProblem(tt.status, []byte(tt.body)))
+		})
+	}
+}
+
+func TestIsErrorResult(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status      int
+		contentType string
+		isError     bool
+	}{
+		"success":                {status: http.StatusOK, contentType: "application/json", isError: false},
+		"client error":           {status: http.StatusNotFound, contentType: "application/json", isError: true},
+		"server error":           {status: http.StatusInternalServerError, contentType: "text/plain", isError: true},
+		"problem with 2xx":       {status: http.StatusOK, contentType: "application/problem+json", isError: true},
+		"problem with parameter": {status: http.StatusAccepted, contentType: "application/problem+json; charset=utf-8", isError: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rw := toolCallResponseWriter{
+				statusCode: tt.status,
+				headers:    http.Header{"Content-Type": {tt.contentType}},
+				body:       bytes.NewBufferString(`{"title":"Out of stock"}`),
+				limit:      0,
+				discarded:  0,
+			}
+			require.Equal(t, tt.isError, isErrorResult(rw))
+		})
+	}
+}
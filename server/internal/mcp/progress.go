@@ -0,0 +1,101 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+)
+
+// requestMeta is the _meta object that clients may attach to request params.
+type requestMeta struct {
+	ProgressToken json.RawMessage `json:"progressToken,omitempty"`
+}
+
+type progressParams struct {
+	ProgressToken json.RawMessage `json:"progressToken"`
+	Progress      float64         `json:"progress"`
+	Total         *float64        `json:"total,omitempty"`
+	Message       string          `json:"message,omitempty"`
+}
+
+// progressReporter emits notifications/progress messages for a request that
+// carried a progress token. A reporter without a token is a no-op. The
+// reported progress counts the updates sent so far so that it always
+// increases, and the total is only known once the request completes.
+type progressReporter struct {
+	logger  *slog.Logger
+	payload *mcpInputs
+	token   json.RawMessage
+
+	mu       sync.Mutex
+	progress float64
+}
+
+func newProgressReporter(logger *slog.Logger, payload *mcpInputs, meta *requestMeta) *progressReporter {
+	var token json.RawMessage
+	if meta != nil && len(meta.ProgressToken) > 0 && string(meta.ProgressToken) != "null" {
+		token = meta.ProgressToken
+	}
+
+	return &progressReporter{
+		logger:   logger,
+		payload:  payload,
+		token:    token,
+		mu:       sync.Mutex{},
+		progress: 0,
+	}
+}
+
+// advance reports an intermediate step of the request.
+func (p *progressReporter) advance(ctx context.Context, message string) {
+	p.report(ctx, false, message)
+}
+
+// complete reports that the request has finished.
+func (p *progressReporter) complete(ctx context.Context, message string) {
+	p.report(ctx, true, message)
+}
+
+// attach returns a context that relays the progress of a proxied tool call
+// to the client.
+func (p *progressReporter) attach(ctx context.Context) context.Context {
+	if p == nil || p.token == nil {
+		return ctx
+	}
+
+	return gateway.WithProgress(ctx, p.advance)
+}
+
+func (p *progressReporter) report(ctx context.Context, final bool, message string) {
+	if p == nil || p.token == nil {
+		return
+	}
+
+	p.mu.Lock()
+	progress := p.progress
+	p.progress++
+	p.mu.Unlock()
+
+	var total *float64
+	if final {
+		total = &progress
+	}
+
+	if !p.payload.protocolVersion.supportsProgressMessage() {
+		message = ""
+	}
+
+	err := sendNotification(ctx, p.payload, "notifications/progress", progressParams{
+		ProgressToken: p.token,
+		Progress:      progress,
+		Total:         total,
+		Message:       message,
+	})
+	if err != nil {
+		p.logger.WarnContext(ctx, "failed to send progress notification", attr.SlogError(err))
+	}
+}
@@ -0,0 +1,96 @@
+package mcp
+
+import (
+	"net/http"
+	"slices"
+)
+
+// protocolVersion identifies a revision of the MCP specification. Revisions
+// are dates so they can be compared lexically.
+type protocolVersion string
+
+const (
+	protocolVersion20241105 protocolVersion = "2024-11-05"
+	protocolVersion20250326 protocolVersion = "2025-03-26"
+	protocolVersion20250618 protocolVersion = "2025-06-18"
+
+	// latestProtocolVersion is offered to clients that request a revision we
+	// do not support.
+	latestProtocolVersion = protocolVersion20250618
+
+	// defaultProtocolVersion is assumed for requests that do not carry an
+	// MCP-Protocol-Version header, as required by the 2025-06-18 revision.
+	defaultProtocolVersion = protocolVersion20250326
+
+	headerProtocolVersion = "Mcp-Protocol-Version"
+)
+
+var supportedProtocolVersions = []protocolVersion{
+	protocolVersion20241105,
+	protocolVersion20250326,
+	protocolVersion20250618,
+}
+
+func (v protocolVersion) supported() bool {
+	return slices.Contains(supportedProtocolVersions, v)
+}
+
+func (v protocolVersion) atLeast(other protocolVersion) bool {
+	return v >= other
+}
+
+// supportsBatching reports whether JSON-RPC batches are permitted. Batching
+// was removed in the 2025-06-18 revision.
+func (v protocolVersion) supportsBatching() bool {
+	return !v.atLeast(protocolVersion20250618)
+}
+
+// supportsProgressMessage reports whether progress notifications may carry
+// a human readable message.
+func (v protocolVersion) supportsProgressMessage() bool {
+	return v.atLeast(protocolVersion20250326)
+}
+
+// supportsStructuredOutput reports whether tools may declare an output schema
+// and return structured content alongside their regular content.
+func (v protocolVersion) supportsStructuredOutput() bool {
+	return v.atLeast(protocolVersion20250618)
+}
+
+// supportsElicitation reports whether the server may ask the client to
+// collect input from the user with elicitation/create.
+func (v protocolVersion) supportsElicitation() bool {
+	return v.atLeast(protocolVersion20250618)
+}
+
+// supportsResourceLinks reports whether tool results may contain
+// resource_link content that points at a resource instead of embedding it.
+func (v protocolVersion) supportsResourceLinks() bool {
+	return v.atLeast(protocolVersion20250618)
+}
+
+// negotiateProtocolVersion picks the revision to use for a session given the
+// one the client asked for during initialization. The requested revision is
+// echoed back when it is supported, otherwise the latest revision is offered
+// and the client can decide whether to disconnect.
+func negotiateProtocolVersion(requested string) protocolVersion {
+	v := protocolVersion(requested)
+	if v.supported() {
+		return v
+	}
+
+	return latestProtocolVersion
+}
+
+// parseProtocolVersionHeader reads the revision a client declared for a
+// request after initialization. The boolean result is false if the client
+// sent a revision that this server does not support.
+func parseProtocolVersionHeader(headers http.Header) (protocolVersion, bool) {
+	raw := headers.Get(headerProtocolVersion)
+	if raw == "" {
+		return defaultProtocolVersion, true
+	}
+
+	v := protocolVersion(raw)
+	return v, v.supported()
+}
@@ -0,0 +1,108 @@
+package mcp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateProtocolVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requested string
+		expected  protocolVersion
+	}{
+		"2024-11-05": {requested: "2024-11-05", expected: protocolVersion20241105},
+		"2025-03-26": {requested: "2025-03-26", expected: protocolVersion20250326},
+		"2025-06-18": {requested: "2025-06-18", expected: protocolVersion20250618},
+		"future":     {requested: "2099-01-01", expected: latestProtocolVersion},
+		"unknown":    {requested: "draft", expected: latestProtocolVersion},
+		"empty":      {requested: "", expected: latestProtocolVersion},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, negotiateProtocolVersion(tt.requested))
+		})
+	}
+}
+
+func TestParseProtocolVersionHeader(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		header   string
+		expected protocolVersion
+		ok       bool
+	}{
+		"missing":     {header: "", expected: defaultProtocolVersion, ok: true},
+		"supported":   {header: "2025-06-18", expected: protocolVersion20250618, ok: true},
+		"oldest":      {header: "2024-11-05", expected: protocolVersion20241105, ok: true},
+		"unsupported": {header: "2099-01-01", expected: "2099-01-01", ok: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			headers := http.Header{}
+			if tt.header != "" {
+				headers.Set(headerProtocolVersion, tt.header)
+			}
+
+			version, ok := parseProtocolVersionHeader(headers)
+			require.Equal(t, tt.expected, version)
+			require.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestServeMessages_SessionProtocolVersion(t *testing.T) {
+	t.Parallel()
+
+	initialized := `{"jsonrpc":"2.0","method":"notifications/initialized"}`
+
+	tests := map[string]struct {
+		header  string
+		body    string
+		status  int
+		message string
+	}{
+		"header omitted":            {header: "", body: initialized, status: http.StatusAccepted, message: ""},
+		"header matches":            {header: "2025-06-18", body: initialized, status: http.StatusAccepted, message: ""},
+		"header disagrees":          {header: "2025-03-26", body: initialized, status: 0, message: "does not match the protocol version 2025-06-18"},
+		"batch with header omitted": {header: "", body: "[" + initialized + "]", status: http.StatusBadRequest, message: "json-rpc batching is not supported in protocol version 2025-06-18"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newTestService(t)
+			sess := newTestSession(t, s, "session")
+
+			r := httptest.NewRequest(http.MethodPost, "/mcp/petstore", strings.NewReader(tt.body))
+			r.Header.Set(headerSessionID, sess.ID)
+			if tt.header != "" {
+				r.Header.Set(headerProtocolVersion, tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			err := s.serveMessages(t.Context(), w, r, newTestInputs())
+			if tt.status == 0 {
+				require.ErrorContains(t, err, tt.message)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.status, w.Code)
+			require.Contains(t, w.Body.String(), tt.message)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Package relay carries messages for MCP sessions between server replicas.
+// Any replica can serve a session's requests, but the state that some
+// messages are meant for, such as a tool call waiting on the client or a
+// request the client wants to cancel, only lives on the replica that created
+// it. Changes to what a toolset exposes are relayed the same way so that every
+// replica can notify the sessions it holds.
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+)
+
+// Channel is the Redis pub/sub channel that messages are published on.
+const Channel = "gram:mcp:session_relay"
+
+// Kind identifies what a relayed message asks the receiving replica to do.
+type Kind string
+
+const (
+	// KindResponse carries the client's response to a request that the
+	// server sent it.
+	KindResponse Kind = "response"
+	// KindCancel asks for an in-flight request to be cancelled.
+	KindCancel Kind = "cancel"
+	// KindTerminate reports that the client ended the session.
+	KindTerminate Kind = "terminate"
+	// KindToolsListChanged reports that the tools exposed by a toolset
+	// changed.
+	KindToolsListChanged Kind = "tools_list_changed"
+	// KindPromptsListChanged reports that the prompts exposed by a toolset
+	// changed.
+	KindPromptsListChanged Kind = "prompts_list_changed"
+	// KindResourcesUpdated reports that the resources exposed by a toolset
+	// may have changed, so every resource that its sessions subscribed to
+	// should be read again.
+	KindResourcesUpdated Kind = "resources_updated"
+)
+
+// Message is addressed to a single MCP session, or for list changes to every
+// session of a toolset. Replicas that hold no state for the addressee ignore
+// it.
+type Message struct {
+	Kind      Kind   `json:"kind"`
+	SessionID string `json:"session_id,omitempty"`
+	// ProjectID and Toolset address list changes. Toolset is the slug of the
+	// affected toolset and is empty when every toolset in the project is
+	// affected, such as after a deployment.
+	ProjectID string `json:"project_id,omitempty"`
+	Toolset   string `json:"toolset,omitempty"`
+	// RequestID is the JSON-RPC id of the request the message refers to.
+	RequestID string `json:"request_id,omitempty"`
+	// Reason is the client's explanation for cancelling a request.
+	Reason string `json:"reason,omitempty"`
+	// Data is the JSON-RPC message being relayed.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Broker publishes and receives messages through Redis. A nil Broker, or one
+// without a Redis client, discards published messages.
+type Broker struct {
+	logger *slog.Logger
+	client *redis.Client
+}
+
+func NewBroker(logger *slog.Logger, client *redis.Client) *Broker {
+	return &Broker{
+		logger: logger.With(attr.SlogComponent("mcp_session_relay")),
+		client: client,
+	}
+}
+
+// Publish sends a message to every subscribed server.
+func (b *Broker) Publish(ctx context.Context, msg Message) error {
+	if b == nil || b.client == nil {
+		return nil
+	}
+
+	bs, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshal relayed message: %w", err)
+	}
+
+	if err := b.client.Publish(ctx, Channel, bs).Err(); err != nil {
+		return fmt.Errorf("publish relayed message: %w", err)
+	}
+
+	return nil
+}
+
+// PublishListChanged tells every subscribed server that what a toolset
+// exposes changed. Each kind is published as a separate message.
+func (b *Broker) PublishListChanged(ctx context.Context, projectID string, toolset string, kinds ...Kind) error {
+	for _, kind := range kinds {
+		err := b.Publish(ctx, Message{
+			Kind:      kind,
+			SessionID: "",
+			ProjectID: projectID,
+			Toolset:   toolset,
+			RequestID: "",
+			Reason:    "",
+			Data:      nil,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Subscribe calls handle for every message published until ctx is done.
+func (b *Broker) Subscribe(ctx context.Context, handle func(context.Context, Message)) {
+	if b == nil || b.client == nil {
+		return
+	}
+
+	sub := b.client.Subscribe(ctx, Channel)
+	defer func() {
+		if err := sub.Close(); err != nil {
+			b.logger.WarnContext(ctx, "failed to close session relay subscription", attr.SlogError(err))
+		}
+	}()
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var relayed Message
+			if err := json.Unmarshal([]byte(msg.Payload), &relayed); err != nil {
+				b.logger.WarnContext(ctx, "discarding malformed relayed message", attr.SlogError(err))
+				continue
+			}
+
+			handle(ctx, relayed)
+		}
+	}
+}
@@ -0,0 +1,559 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+const (
+	// maxBufferedResponseBytes caps how much of an upstream response a tool
+	// call holds in memory. Tool calls whose response is larger fail rather
+	// than return part of it.
+	maxBufferedResponseBytes = 32 << 20
+
+	// maxStashedResponseBytes caps the size of a truncated response that is
+	// kept for read_tool_response. Larger responses are truncated without
+	// being kept.
+	maxStashedResponseBytes = 4 << 20
+
+	// stashedResponseTTL bounds how long the full body of a truncated
+	// response can be paged through.
+	stashedResponseTTL = 30 * time.Minute
+
+	// arraySearchDepth bounds how deep into nested JSON objects truncation
+	// looks for the array to shorten.
+	arraySearchDepth = 3
+
+	metaToolReadResponse = "read_tool_response"
+
+	stashedResponseItems = "items"
+	stashedResponseText  = "text"
+)
+
+var readResponseAnnotations = &toolAnnotations{
+	Title:           "Read tool response",
+	ReadOnlyHint:    conv.Ptr(true),
+	DestructiveHint: nil,
+	IdempotentHint:  conv.Ptr(true),
+	OpenWorldHint:   conv.Ptr(false),
+}
+
+// offersResponsePaging reports whether a toolset truncates responses and so
+// lists the read_tool_response tool. A toolset tool with the same name takes
+// precedence.
+func offersResponsePaging(toolset *types.Toolset) bool {
+	if slices.ContainsFunc(toolset.HTTPTools, func(t *types.HTTPToolDefinition) bool { return t.Name == metaToolReadResponse }) {
+		return false
+	}
+
+	if toolset.ResponseBudget != nil {
+		return true
+	}
+
+	return slices.ContainsFunc(toolset.HTTPTools, func(t *types.HTTPToolDefinition) bool { return t.ResponseBudget != nil })
+}
+
+// resolveResponseBudget returns the budget in bytes for a tool's responses,
+// preferring the tool's own budget over the toolset's. Zero means the
+// response is not truncated.
+func resolveResponseBudget(toolset *types.Toolset, tool *types.HTTPToolDefinition) int {
+	if tool != nil && tool.ResponseBudget != nil {
+		return int(*tool.ResponseBudget)
+	}
+
+	return int(conv.PtrValOr(toolset.ResponseBudget, 0))
+}
+
+func readResponseToolListEntry(version protocolVersion) *toolListEntry {
+	entry := &toolListEntry{
+		Name:         metaToolReadResponse,
+		Title:        "",
+		Description:  "Read more of a tool response that was truncated because it was too large. Pass the response_id from the truncation note and the offset it gives to continue where the response left off.",
+		InputSchema:  json.RawMessage(`{"type":"object","properties":{"response_id":{"type":"string","description":"The id of the truncated response"},"offset":{"type":"integer","minimum":0,"description":"The item index, or byte offset for text responses, to read from"},"limit":{"type":"integer","minimum":1,"description":"The maximum number of items to return. Defaults to as many as fit in the response budget."}},"required":["response_id"]}`),
+		OutputSchema: nil,
+		Annotations:  readResponseAnnotations,
+	}
+
+	if version.atLeast(protocolVersion20250618) {
+		entry.Title = readResponseAnnotations.Title
+	}
+
+	return entry
+}
+
+// stashedResponse is the full body of a truncated tool response, kept so that
+// the model can page through what was left out. It may have been fetched with
+// the caller's own credentials, so it can only be read from the MCP session
+// that received it.
+type stashedResponse struct {
+	ID        string   `json:"id"`
+	SessionID string   `json:"session_id"`
+	ProjectID string   `json:"project_id"`
+	Toolset   string   `json:"toolset"`
+	ToolName  string   `json:"tool_name"`
+	Kind      string   `json:"kind"`
+	Path      []string `json:"path"`
+	Body      []byte   `json:"body"`
+	Budget    int      `json:"budget"`
+}
+
+var _ cache.CacheableObject[stashedResponse] = (*stashedResponse)(nil)
+
+func stashedResponseCacheKey(id string) string {
+	return "mcp_stashed_response:" + id
+}
+
+func (r stashedResponse) CacheKey() string {
+	return stashedResponseCacheKey(r.ID)
+}
+
+func (r stashedResponse) AdditionalCacheKeys() []string {
+	return []string{}
+}
+
+func (r stashedResponse) TTL() time.Duration {
+	return stashedResponseTTL
+}
+
+// responseBudgets truncates tool responses that are over budget and keeps
+// their full bodies for read_tool_response.
+type responseBudgets struct {
+	logger *slog.Logger
+	stash  cache.TypedCacheObject[stashedResponse]
+}
+
+func newResponseBudgets(logger *slog.Logger, cacheImpl cache.Cache) *responseBudgets {
+	return &responseBudgets{
+		logger: logger,
+		stash:  cache.NewTypedObjectCache[stashedResponse](logger.With(attr.SlogCacheNamespace("mcp_stashed_response")), cacheImpl, cache.SuffixNone),
+	}
+}
+
+// truncation describes how an over budget response was shortened.
+type truncation struct {
+	body  []byte
+	kind  string
+	path  []string
+	kept  int
+	total int
+}
+
+// enforce shortens a text response body in place when it is over budget and
+// returns a note for the model explaining what was left out and how to read
+// it. The note is empty when the body was left alone. The full body is only
+// kept when it was truncated, and only up to maxStashedResponseBytes.
+func (b *responseBudgets) enforce(ctx context.Context, payload *mcpInputs, toolName string, budget int, rw *toolCallResponseWriter) string {
+	body := rw.body.Bytes()
+	if budget <= 0 || len(body) <= budget || !isTextResponse(rw.headers) {
+		return ""
+	}
+
+	cut, ok := truncateJSON(body, budget)
+	if !ok {
+		cut = truncateText(body, budget)
+	}
+
+	// The full body is only kept for sessions since it may only be read back
+	// from the session that received it.
+	id := uuid.NewString()
+	var err error
+	if payload.sessionID != "" && len(body) <= maxStashedResponseBytes {
+		err = b.stash.Store(ctx, stashedResponse{
+			ID:        id,
+			SessionID: payload.sessionID,
+			ProjectID: payload.projectID.String(),
+			Toolset:   conv.ToLower(payload.toolset),
+			ToolName:  toolName,
+			Kind:      cut.kind,
+			Path:      cut.path,
+			Body:      slices.Clone(body),
+			Budget:    budget,
+		})
+	}
+
+	rw.body = bytes.NewBuffer(cut.body)
+
+	var notes []string
+	switch cut.kind {
+	case stashedResponseItems:
+		notes = append(notes, fmt.Sprintf("This response was %d bytes, over the %d byte budget for %s, so only the first %d of %d items in %s are shown.", len(body), budget, toolName, cut.kept, cut.total, describeJSONPath(cut.path)))
+	default:
+		notes = append(notes, fmt.Sprintf("This response was %d bytes, over the %d byte budget for %s, so only the first %d bytes are shown.", len(body), budget, toolName, cut.kept))
+	}
+
+	switch {
+	case payload.sessionID == "":
+		notes = append(notes, "The rest of the response is not available because the MCP client did not start a session.")
+	case len(body) > maxStashedResponseBytes:
+		notes = append(notes, fmt.Sprintf("The rest of the response is not available because it is larger than the %d bytes that can be kept for %s.", maxStashedResponseBytes, metaToolReadResponse))
+	case err != nil:
+		b.logger.WarnContext(ctx, "failed to stash truncated tool response", attr.SlogError(err))
+		notes = append(notes, "The rest of the response is not available.")
+	default:
+		notes = append(notes, fmt.Sprintf("Call %s with response_id %q and offset %d to read the rest.", metaToolReadResponse, id, cut.kept))
+	}
+
+	return strings.Join(notes, " ")
+}
+
+func isTextResponse(headers http.Header) bool {
+	mt, _, err := mime.ParseMediaType(headers.Get("content-type"))
+	return err == nil && isTextMediaType(mt)
+}
+
+func describeJSONPath(path []string) string {
+	if len(path) == 0 {
+		return "the top-level array"
+	}
+
+	return "`" + strings.Join(path, ".") + "`"
+}
+
+// truncateJSON shortens the largest array in a JSON document, keeping as many
+// leading items as fit in the budget so that the result is still valid JSON.
+func truncateJSON(body []byte, budget int) (truncation, bool) {
+	doc := bytes.TrimSpace(body)
+	if !json.Valid(doc) {
+		return truncation{}, false
+	}
+
+	path, arr, ok := largestArray(doc, arraySearchDepth)
+	if !ok {
+		return truncation{}, false
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(arr, &items); err != nil {
+		return truncation{}, false
+	}
+
+	empty, err := replaceJSONAt(doc, path, json.RawMessage("[]"))
+	if err != nil {
+		return truncation{}, false
+	}
+
+	size := len(empty)
+	kept := 0
+	for _, item := range items {
+		next := size + len(item)
+		if kept > 0 {
+			next++
+		}
+		if next > budget {
+			break
+		}
+		size = next
+		kept++
+	}
+	if kept == 0 {
+		return truncation{}, false
+	}
+
+	shortened, err := replaceJSONAt(doc, path, joinJSONArray(items[:kept]))
+	if err != nil {
+		return truncation{}, false
+	}
+
+	return truncation{
+		body:  shortened,
+		kind:  stashedResponseItems,
+		path:  path,
+		kept:  kept,
+		total: len(items),
+	}, true
+}
+
+// truncateText cuts a body to the budget on a line boundary where one is
+// close by, and never in the middle of a UTF-8 sequence.
+func truncateText(body []byte, budget int) truncation {
+	cut := runeBoundary(body, budget)
+	if idx := bytes.LastIndexByte(body[:cut], '\n'); idx >= cut/2 {
+		cut = idx + 1
+	}
+
+	return truncation{
+		body:  body[:cut],
+		kind:  stashedResponseText,
+		path:  nil,
+		kept:  cut,
+		total: len(body),
+	}
+}
+
+// runeBoundary moves an offset back to the start of the UTF-8 sequence it
+// falls in.
+func runeBoundary(body []byte, offset int) int {
+	offset = min(offset, len(body))
+	for offset > 0 && offset < len(body) && !utf8.RuneStart(body[offset]) {
+		offset--
+	}
+
+	return offset
+}
+
+type jsonMember struct {
+	key   string
+	value json.RawMessage
+}
+
+// decodeJSONObject splits a JSON object into its members, keeping their
+// order so that a rebuilt object reads the same as the original.
+func decodeJSONObject(raw json.RawMessage) ([]jsonMember, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("read object start: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, errors.New("not a json object")
+	}
+
+	var members []jsonMember
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("read object key: %w", err)
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, errors.New("unexpected object key")
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, fmt.Errorf("read object value: %w", err)
+		}
+
+		members = append(members, jsonMember{key: key, value: value})
+	}
+
+	return members, nil
+}
+
+func encodeJSONObject(members []jsonMember) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, fmt.Errorf("encode object key: %w", err)
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func joinJSONArray(items []json.RawMessage) json.RawMessage {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, item := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(item)
+	}
+	buf.WriteByte(']')
+
+	return buf.Bytes()
+}
+
+// largestArray finds the array in a JSON document that takes up the most
+// bytes, which is the one worth shortening. A top-level array is always
+// chosen. The path lists the object keys leading to the array.
+func largestArray(raw json.RawMessage, depth int) ([]string, json.RawMessage, bool) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, nil, false
+	}
+
+	switch raw[0] {
+	case '[':
+		return []string{}, raw, true
+	case '{':
+		if depth == 0 {
+			return nil, nil, false
+		}
+
+		members, err := decodeJSONObject(raw)
+		if err != nil {
+			return nil, nil, false
+		}
+
+		var bestPath []string
+		var best json.RawMessage
+		for _, m := range members {
+			path, arr, ok := largestArray(m.value, depth-1)
+			if ok && len(arr) > len(best) {
+				bestPath = append([]string{m.key}, path...)
+				best = arr
+			}
+		}
+
+		return bestPath, best, best != nil
+	default:
+		return nil, nil, false
+	}
+}
+
+// lookupJSON returns the value at a path of object keys.
+func lookupJSON(raw json.RawMessage, path []string) (json.RawMessage, error) {
+	if len(path) == 0 {
+		return raw, nil
+	}
+
+	members, err := decodeJSONObject(bytes.TrimSpace(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range members {
+		if m.key == path[0] {
+			return lookupJSON(m.value, path[1:])
+		}
+	}
+
+	return nil, fmt.Errorf("key not found: %s", path[0])
+}
+
+// replaceJSONAt returns a copy of a document with the value at a path of
+// object keys swapped for another.
+func replaceJSONAt(raw json.RawMessage, path []string, replacement json.RawMessage) (json.RawMessage, error) {
+	if len(path) == 0 {
+		return replacement, nil
+	}
+
+	members, err := decodeJSONObject(bytes.TrimSpace(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	idx := slices.IndexFunc(members, func(m jsonMember) bool { return m.key == path[0] })
+	if idx < 0 {
+		return nil, fmt.Errorf("key not found: %s", path[0])
+	}
+
+	value, err := replaceJSONAt(members[idx].value, path[1:], replacement)
+	if err != nil {
+		return nil, err
+	}
+	members[idx].value = value
+
+	return encodeJSONObject(members)
+}
+
+type readResponseArguments struct {
+	ResponseID string `json:"response_id"`
+	Offset     int    `json:"offset"`
+	Limit      int    `json:"limit"`
+}
+
+type responsePage struct {
+	ResponseID string            `json:"response_id"`
+	Tool       string            `json:"tool"`
+	Offset     int               `json:"offset"`
+	Total      int               `json:"total"`
+	Items      []json.RawMessage `json:"items,omitempty"`
+	Text       string            `json:"text,omitempty"`
+	NextOffset *int              `json:"next_offset"`
+}
+
+// handleReadToolResponse answers a read_tool_response call with the next page
+// of a stashed response. Each page fits in the budget of the original tool.
+func handleReadToolResponse(ctx context.Context, logger *slog.Logger, budgets *responseBudgets, payload *mcpInputs, req *rawRequest, rawArgs json.RawMessage) (json.RawMessage, error) {
+	var args readResponseArguments
+	if err := unmarshalMetaToolArguments(rawArgs, &args); err != nil || args.ResponseID == "" {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid %s arguments: a response_id is required", metaToolReadResponse).Log(ctx, logger)
+	}
+
+	stashed, err := budgets.stash.Get(ctx, stashedResponseCacheKey(args.ResponseID))
+	if err != nil || payload.sessionID == "" || stashed.SessionID != payload.sessionID || stashed.ProjectID != payload.projectID.String() || stashed.Toolset != conv.ToLower(payload.toolset) {
+		return nil, oops.E(oops.CodeNotFound, err, "tool response not found or expired: %s", args.ResponseID).Log(ctx, logger)
+	}
+
+	page := responsePage{
+		ResponseID: stashed.ID,
+		Tool:       stashed.ToolName,
+		Offset:     max(args.Offset, 0),
+		Total:      0,
+		Items:      nil,
+		Text:       "",
+		NextOffset: nil,
+	}
+
+	switch stashed.Kind {
+	case stashedResponseItems:
+		arr, err := lookupJSON(bytes.TrimSpace(stashed.Body), stashed.Path)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to read stashed tool response").Log(ctx, logger)
+		}
+
+		var items []json.RawMessage
+		if err := json.Unmarshal(arr, &items); err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to read stashed tool response").Log(ctx, logger)
+		}
+
+		page.Total = len(items)
+		page.Offset = min(page.Offset, len(items))
+
+		size := 0
+		end := page.Offset
+		for end < len(items) {
+			if args.Limit > 0 && end-page.Offset >= args.Limit {
+				break
+			}
+			// Always return at least one item so that paging makes progress.
+			if end > page.Offset && size+len(items[end])+1 > stashed.Budget {
+				break
+			}
+			size += len(items[end]) + 1
+			end++
+		}
+
+		page.Items = items[page.Offset:end]
+		if end < len(items) {
+			page.NextOffset = &end
+		}
+	default:
+		page.Total = len(stashed.Body)
+		page.Offset = runeBoundary(stashed.Body, page.Offset)
+
+		end := runeBoundary(stashed.Body, page.Offset+stashed.Budget)
+		if end == page.Offset && end < len(stashed.Body) {
+			_, width := utf8.DecodeRune(stashed.Body[end:])
+			end += width
+		}
+
+		page.Text = string(stashed.Body[page.Offset:end])
+		if end < len(stashed.Body) {
+			page.NextOffset = &end
+		}
+	}
+
+	return formatMetaToolResult(ctx, logger, req, page)
+}
@@ -0,0 +1,318 @@
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func TestLargestArray(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		doc      string
+		depth    int
+		path     []string
+		expected string
+		found    bool
+	}{
+		"top-level array": {
+			doc:      ` [1,2,3] `,
+			depth:    arraySearchDepth,
+			path:     []string{},
+			expected: `[1,2,3]`,
+			found:    true,
+		},
+		"largest of several": {
+			doc:      `{"a":[1],"b":[1,2,3],"c":"long string value"}`,
+			depth:    arraySearchDepth,
+			path:     []string{"b"},
+			expected: `[1,2,3]`,
+			found:    true,
+		},
+		"nested object": {
+			doc:      `{"data":{"items":[{"id":1},{"id":2}]},"tags":["x"]}`,
+			depth:    arraySearchDepth,
+			path:     []string{"data", "items"},
+			expected: `[{"id":1},{"id":2}]`,
+			found:    true,
+		},
+		"nested arrays are taken whole": {
+			doc:      `{"rows":[[1,2],[3,4]]}`,
+			depth:    arraySearchDepth,
+			path:     []string{"rows"},
+			expected: `[[1,2],[3,4]]`,
+			found:    true,
+		},
+		"beyond search depth": {
+			doc:      `{"a":{"b":{"c":[1,2]}}}`,
+			depth:    2,
+			path:     nil,
+			expected: "",
+			found:    false,
+		},
+		"no array": {
+			doc:      `{"a":1,"b":{"c":"d"}}`,
+			depth:    arraySearchDepth,
+			path:     nil,
+			expected: "",
+			found:    false,
+		},
+		"scalar": {
+			doc:      `"text"`,
+			depth:    arraySearchDepth,
+			path:     nil,
+			expected: "",
+			found:    false,
+		},
+		"empty": {
+			doc:      `  `,
+			depth:    arraySearchDepth,
+			path:     nil,
+			expected: "",
+			found:    false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path, arr, ok := largestArray(json.RawMessage(tt.doc), tt.depth)
+			require.Equal(t, tt.found, ok)
+			require.Equal(t, tt.path, path)
+			require.Equal(t, tt.expected, string(arr))
+		})
+	}
+}
+
+func TestTruncateJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body      string
+		budget    int
+		expected  string
+		path      []string
+		kept      int
+		total     int
+		truncated bool
+	}{
+		"top-level array": {
+			body:      `[1,2,3,4,5]`,
+			budget:    6,
+			expected:  `[1,2]`,
+			path:      []string{},
+			kept:      2,
+			total:     5,
+			truncated: true,
+		},
+		"array inside an object": {
+			body:      `{"total":3,"items":["aaa","bbb","ccc"]}`,
+			budget:    33,
+			expected:  `{"total":3,"items":["aaa","bbb"]}`,
+			path:      []string{"items"},
+			kept:      2,
+			total:     3,
+			truncated: true,
+		},
+		"nested arrays keep whole rows": {
+			body:      `{"rows":[[1,2],[3,4],[5,6]]}`,
+			budget:    22,
+			expected:  `{"rows":[[1,2],[3,4]]}`,
+			path:      []string{"rows"},
+			kept:      2,
+			total:     3,
+			truncated: true,
+		},
+		"single oversized item": {
+			body:      `[` + `"` + strings.Repeat("x", 100) + `"]`,
+			budget:    50,
+			expected:  "",
+			path:      nil,
+			kept:      0,
+			total:     0,
+			truncated: false,
+		},
+		"not json": {
+			body:      `<html>error</html>`,
+			budget:    5,
+			expected:  "",
+			path:      nil,
+			kept:      0,
+			total:     0,
+			truncated: false,
+		},
+		"no array to shorten": {
+			body:      `{"message":"` + strings.Repeat("x", 100) + `"}`,
+			budget:    50,
+			expected:  "",
+			path:      nil,
+			kept:      0,
+			total:     0,
+			truncated: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, ok := truncateJSON([]byte(tt.body), tt.budget)
+			require.Equal(t, tt.truncated, ok)
+			if !ok {
+				return
+			}
+
+			require.Equal(t, tt.expected, string(result.body))
+			require.LessOrEqual(t, len(result.body), tt.budget)
+			require.True(t, json.Valid(result.body))
+			require.Equal(t, stashedResponseItems, result.kind)
+			require.Equal(t, tt.path, result.path)
+			require.Equal(t, tt.kept, result.kept)
+			require.Equal(t, tt.total, result.total)
+		})
+	}
+}
+
+func TestTruncateText(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		budget   int
+		expected string
+	}{
+		"cuts on a nearby line": {body: "line one\nline two\nline three", budget: 22, expected: "line one\nline two\n"},
+		"no nearby line":        {body: "0123456789\nabcdefghijklmnopqrstuvwxyz", budget: 30, expected: "0123456789\nabcdefghijklmnopqrs"},
+		"never splits runes":    {body: "aaé", budget: 3, expected: "aa"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := truncateText([]byte(tt.body), tt.budget)
+			require.Equal(t, tt.expected, string(result.body))
+			require.Equal(t, len(tt.expected), result.kept)
+			require.Equal(t, len(tt.body), result.total)
+		})
+	}
+}
+
+func truncatedResponse(t *testing.T, budgets *responseBudgets, payload *mcpInputs) string {
+	t.Helper()
+
+	rw := &toolCallResponseWriter{
+		statusCode: http.StatusOK,
+		headers:    http.Header{"Content-Type": {"application/json"}},
+		body:       bytes.NewBufferString(`[{"id":1},{"id":2},{"id":3},{"id":4},{"id":5},{"id":6}]`),
+		limit:      0,
+		discarded:  0,
+	}
+	return budgets.enforce(t.Context(), payload, "list_pets", 20, rw)
+}
+
+func TestHandleReadToolResponse_Session(t *testing.T) {
+	t.Parallel()
+
+	budgets := newResponseBudgets(testenv.NewLogger(t), newMemoryCache())
+	owner := newTestInputs()
+	owner.sessionID = "owner"
+
+	note := truncatedResponse(t, budgets, owner)
+	match := regexp.MustCompile(`response_id "([^"]+)"`).FindStringSubmatch(note)
+	require.Len(t, match, 2, note)
+	args, err := json.Marshal(readResponseArguments{ResponseID: match[1], Offset: 1, Limit: 0})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		sessionID string
+		found     bool
+	}{
+		"same session":  {sessionID: "owner", found: true},
+		"other session": {sessionID: "intruder", found: false},
+		"no session":    {sessionID: "", found: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := newTestInputs()
+			payload.sessionID = tt.sessionID
+
+			_, err := handleReadToolResponse(t.Context(), testenv.NewLogger(t), budgets, payload, toolsCallRequest(t, metaToolReadResponse), args)
+			if tt.found {
+				require.NoError(t, err)
+				return
+			}
+
+			var oopsErr *oops.ShareableError
+			require.ErrorAs(t, err, &oopsErr)
+			require.Equal(t, oops.CodeNotFound, oopsErr.Code)
+		})
+	}
+}
+
+func TestResponseBudgets_Enforce_WithoutSession(t *testing.T) {
+	t.Parallel()
+
+	budgets := newResponseBudgets(testenv.NewLogger(t), newMemoryCache())
+
+	note := truncatedResponse(t, budgets, newTestInputs())
+	require.Contains(t, note, "did not start a session")
+	require.NotContains(t, note, "response_id")
+}
+
+func TestResponseBudgets_Enforce_Stash(t *testing.T) {
+	t.Parallel()
+
+	large := `[` + strings.Repeat(`{"id":1},`, maxStashedResponseBytes/9) + `{"id":2}]`
+
+	tests := map[string]struct {
+		body    string
+		budget  int
+		stashed int
+		note    string
+	}{
+		"within budget":    {body: `[{"id":1},{"id":2}]`, budget: 1024, stashed: 0, note: ""},
+		"over budget":      {body: `[{"id":1},{"id":2},{"id":3},{"id":4},{"id":5},{"id":6}]`, budget: 20, stashed: 1, note: "Call read_tool_response"},
+		"over stash limit": {body: large, budget: 20, stashed: 0, note: "larger than the 4194304 bytes that can be kept for read_tool_response"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMemoryCache()
+			budgets := newResponseBudgets(testenv.NewLogger(t), store)
+			payload := newTestInputs()
+			payload.sessionID = "session"
+
+			rw := &toolCallResponseWriter{
+				statusCode: http.StatusOK,
+				headers:    http.Header{"Content-Type": {"application/json"}},
+				body:       bytes.NewBufferString(tt.body),
+				limit:      0,
+				discarded:  0,
+			}
+			note := budgets.enforce(t.Context(), payload, "list_pets", tt.budget, rw)
+
+			require.Contains(t, note, tt.note)
+			require.Len(t, store.items, tt.stashed)
+			if tt.note == "" {
+				require.Empty(t, note)
+				require.Equal(t, tt.body, rw.body.String())
+			}
+		})
+	}
+}
@@ -1,11 +1,16 @@
 package mcp
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/speakeasy-api/gram/server/internal/oops"
 )
@@ -19,6 +24,7 @@ const (
 	methodNotFound   errorCode = -32601
 	invalidParams    errorCode = -32602
 	internalError    errorCode = -32603
+	resourceNotFound errorCode = -32002
 )
 
 func (e errorCode) UserMessage() string {
@@ -35,6 +41,8 @@ func (e errorCode) UserMessage() string {
 		return "invalid method parameters"
 	case internalError:
 		return "internal json-rpc error"
+	case resourceNotFound:
+		return "resource not found"
 	default:
 		return "an unexpected error occurred"
 	}
@@ -141,11 +149,79 @@ func (m *result[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type notificationEnvelope[T any] struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  T      `json:"params,omitempty,omitzero"`
+}
+
+type notification[T any] struct {
+	Method string
+	Params T
+}
+
+func (n notification[T]) MarshalJSON() ([]byte, error) {
+	bs, err := json.Marshal(notificationEnvelope[T]{
+		JSONRPC: "2.0",
+		Method:  n.Method,
+		Params:  n.Params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal notification: %w", err)
+	}
+
+	return bs, nil
+}
+
+type requestEnvelope[T any] struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      msgID  `json:"id"`
+	Method  string `json:"method"`
+	Params  T      `json:"params,omitempty,omitzero"`
+}
+
+// request is a JSON-RPC request sent from the server to the client.
+type request[T any] struct {
+	ID     msgID
+	Method string
+	Params T
+}
+
+func (r request[T]) MarshalJSON() ([]byte, error) {
+	bs, err := json.Marshal(requestEnvelope[T]{
+		JSONRPC: "2.0",
+		ID:      r.ID,
+		Method:  r.Method,
+		Params:  r.Params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	return bs, nil
+}
+
 type rawRequest struct {
 	JSONRPC string          `json:"jsonrpc"`
 	ID      msgID           `json:"id"`
 	Method  string          `json:"method"`
 	Params  json.RawMessage `json:"params"`
+	// Result and Error are only set when the client is responding to a
+	// request that the server sent it.
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// isResponse reports whether the message is the client's response to a
+// server-initiated request rather than a request of its own.
+func (r *rawRequest) isResponse() bool {
+	return r.Method == "" && (len(r.Result) > 0 || len(r.Error) > 0)
+}
+
+// expectsResponse reports whether the message is a request that the client
+// awaits a response to. Notifications carry no id and must never be answered.
+func (r *rawRequest) expectsResponse() bool {
+	return r.ID.format != 0 && !r.isResponse()
 }
 
 type batchedRawRequest []*rawRequest
@@ -169,6 +245,29 @@ func (b *batchedRawRequest) UnmarshalJSON(data []byte) error {
 	}
 }
 
+// hasRequests reports whether the batch contains at least one message that
+// expects a response.
+func (b batchedRawRequest) hasRequests() bool {
+	for _, req := range b {
+		if !strings.HasPrefix(req.Method, "notifications/") && !req.isResponse() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasInitialize reports whether the batch starts a new session.
+func (b batchedRawRequest) hasInitialize() bool {
+	for _, req := range b {
+		if req.Method == "initialize" {
+			return true
+		}
+	}
+
+	return false
+}
+
 type rpcError struct {
 	ID      msgID
 	Code    errorCode
@@ -176,37 +275,111 @@ type rpcError struct {
 	Data    any
 }
 
-func NewErrorFromCause(id msgID, source error) *rpcError {
+// errorData is the data member of JSON-RPC errors that Gram MCP servers return
+// for failed requests. It lets clients and agents react to failures without
+// parsing error messages, for example by retrying retryable errors or fixing
+// their arguments after a validation error.
+//
+//   - code is the Gram error code such as "invalid", "not_found",
+//     "unauthorized" or "gateway_error".
+//   - error_id identifies the error in Gram's logs and should be quoted when
+//     reporting a problem.
+//   - trace_id is the OpenTelemetry trace id of the request, when it is traced.
+//   - tool is the name of the tool that was being called by a tools/call
+//     request.
+//   - retryable reports whether sending the same request again may succeed.
+//     Failed upstream calls are only retryable when the tool's retry policy
+//     retries its method, so that non-idempotent calls are not repeated.
+type errorData struct {
+	Code      string `json:"code"`
+	ErrorID   string `json:"error_id,omitempty"`
+	TraceID   string `json:"trace_id,omitempty"`
+	Tool      string `json:"tool,omitempty"`
+	Retryable bool   `json:"retryable"`
+}
+
+// NewErrorFromCause converts an error returned while handling a request into
+// a JSON-RPC error. Gram errors are mapped to the closest JSON-RPC code and
+// described with errorData. Any other error is reported as an internal error
+// without exposing its message.
+func NewErrorFromCause(ctx context.Context, req *rawRequest, source error) *rpcError {
 	var rpce *rpcError
 	var oopse *oops.ShareableError
 
+	var id msgID
+	if req != nil {
+		id = req.ID
+	}
+
+	var traceID string
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.HasTraceID() {
+		traceID = spanCtx.TraceID().String()
+	}
+
 	switch {
 	case errors.As(source, &rpce):
 		return rpce
 	case errors.As(source, &oopse):
 		var code errorCode
 		switch oopse.Code {
-		case oops.CodeBadRequest:
-			code = parseError
+		case oops.CodeBadRequest, oops.CodeInvalid:
+			code = invalidParams
 		case oops.CodeUnauthorized, oops.CodeForbidden, oops.CodeConflict, oops.CodeUnsupportedMedia, oops.CodeNotFound:
 			code = invalidRequest
-		case oops.CodeInvalid:
-			code = invalidParams
 		case oops.CodeUnexpected:
 			code = internalError
 		default:
 			code = internalError
 		}
 
-		return &rpcError{ID: id, Code: code, Message: oopse.Error(), Data: nil}
+		return &rpcError{
+			ID:      id,
+			Code:    code,
+			Message: oopse.Error(),
+			Data: &errorData{
+				Code:      string(oopse.Code),
+				ErrorID:   oopse.ID(),
+				TraceID:   traceID,
+				Tool:      toolNameFromRequest(req),
+				Retryable: oopse.Temporary(),
+			},
+		}
 	default:
 		return &rpcError{
 			ID:      id,
 			Code:    internalError,
 			Message: internalError.UserMessage(),
-			Data:    nil,
+			Data: &errorData{
+				Code:      string(oops.CodeUnexpected),
+				ErrorID:   "",
+				TraceID:   traceID,
+				Tool:      toolNameFromRequest(req),
+				Retryable: false,
+			},
+		}
+	}
+}
+
+// toolNameFromRequest returns the tool a tools/call request is calling,
+// looking through execute_tool calls made by toolsets in dynamic mode.
+func toolNameFromRequest(req *rawRequest) string {
+	if req == nil || req.Method != "tools/call" {
+		return ""
+	}
+
+	var params toolsCallParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return ""
+	}
+
+	if params.Name == metaToolExecute {
+		var args executeToolArguments
+		if err := unmarshalMetaToolArguments(params.Arguments, &args); err == nil && args.Name != "" {
+			return args.Name
 		}
 	}
+
+	return params.Name
 }
 
 func (e *rpcError) Error() string {
@@ -244,6 +417,31 @@ func (e *rpcError) MarshalJSON() ([]byte, error) {
 	return bs, nil
 }
 
+// respondWithError writes a JSON-RPC error as the body of a non-2xx
+// response. It is used for failures that occur before any message in the
+// request has been handled.
+func respondWithError(w http.ResponseWriter, status int, rpcErr *rpcError) error {
+	body, err := json.Marshal(rpcErr)
+	if err != nil {
+		return fmt.Errorf("marshal jsonrpc error: %w", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("write jsonrpc error: %w", err)
+	}
+
+	return nil
+}
+
+// isJSONArray reports whether a raw JSON value is an array, which is how
+// JSON-RPC batches are distinguished from single messages.
+func isJSONArray(raw json.RawMessage) bool {
+	trimmed := bytes.TrimLeft(raw, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
 func respondWithNoContent(ack bool, w http.ResponseWriter) error {
 	acks := strconv.FormatBool(ack)
 	w.Header().Set("Noop", acks)
@@ -0,0 +1,66 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
+)
+
+// errRequestCancelled is the cause attached to the context of a request that
+// the client cancelled with notifications/cancelled.
+var errRequestCancelled = errors.New("request cancelled by client")
+
+type cancelledParams struct {
+	RequestID msgID  `json:"requestId"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// handleCancelled aborts the in-flight request named by a
+// notifications/cancelled message, which in turn cancels any upstream call it
+// is making. Requests that are not running on this server may be running on
+// another replica, so the cancellation is relayed there. Cancellations for
+// requests that already finished are ignored.
+func handleCancelled(ctx context.Context, logger *slog.Logger, streams *streamHub, sessionRelay *relay.Broker, payload *mcpInputs, req *rawRequest) (json.RawMessage, error) {
+	var params cancelledParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		logger.WarnContext(ctx, "ignoring malformed cancellation", attr.SlogError(err))
+		return nil, nil
+	}
+
+	if payload.sessionID == "" {
+		logger.DebugContext(ctx, "ignoring cancellation outside of a session", attr.SlogValueString(params.RequestID.Value()))
+		return nil, nil
+	}
+
+	if streams.stream(payload.sessionID).cancelRequest(params.RequestID.Value(), cancellationCause(params.Reason)) {
+		return nil, nil
+	}
+
+	err := sessionRelay.Publish(ctx, relay.Message{
+		Kind:      relay.KindCancel,
+		SessionID: payload.sessionID,
+		ProjectID: "",
+		Toolset:   "",
+		RequestID: params.RequestID.Value(),
+		Reason:    params.Reason,
+		Data:      nil,
+	})
+	if err != nil {
+		logger.WarnContext(ctx, "failed to relay cancellation to other servers", attr.SlogError(err))
+	}
+
+	return nil, nil
+}
+
+func cancellationCause(reason string) error {
+	if reason == "" {
+		return errRequestCancelled
+	}
+
+	return fmt.Errorf("%w: %s", errRequestCancelled, reason)
+}
@@ -5,31 +5,80 @@ import (
 	"encoding/json"
 	"log/slog"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
 	"github.com/speakeasy-api/gram/server/internal/oops"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/posthog"
 )
 
+type initializeParams struct {
+	ProtocolVersion string                     `json:"protocolVersion"`
+	Capabilities    map[string]json.RawMessage `json:"capabilities"`
+	ClientInfo      clientInfo                 `json:"clientInfo"`
+}
+
+type clientInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
 type initializeResult struct {
 	ProtocolVersion string                     `json:"protocolVersion"`
 	Capabilities    map[string]json.RawMessage `json:"capabilities"`
 	ServerInfo      serverInfo                 `json:"serverInfo"`
+	Instructions    string                     `json:"instructions,omitempty"`
 }
 type serverInfo struct {
 	Name    string `json:"name"`
+	Title   string `json:"title,omitempty"`
 	Version string `json:"version"`
 }
 
-func handleInitialize(ctx context.Context, logger *slog.Logger, req *rawRequest, payload *mcpInputs, productMetrics *posthog.Posthog) (json.RawMessage, error) {
+func handleInitialize(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, req *rawRequest, payload *mcpInputs, productMetrics *posthog.Posthog, sessions *sessionStore) (json.RawMessage, error) {
+	var params initializeParams
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, oops.E(oops.CodeBadRequest, err, "failed to parse initialize request").Log(ctx, logger)
+		}
+	}
+
+	version := negotiateProtocolVersion(params.ProtocolVersion)
+	payload.protocolVersion = version
+
+	logger = withClient(ctx, logger, params.ClientInfo)
+
+	toolset, err := mv.DescribeToolset(ctx, logger, db, mv.ProjectID(payload.projectID), mv.ToolsetSlug(conv.ToLower(payload.toolset)))
+	if err != nil {
+		return nil, err
+	}
+
+	if refusal := clientRefusal(toolset.ClientRules, params.ClientInfo); refusal != "" {
+		return nil, oops.E(oops.CodeForbidden, nil, "%s", refusal).Log(ctx, logger)
+	}
+
+	sess, err := sessions.create(ctx, payload, params, version)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to create mcp session").Log(ctx, logger)
+	}
+	payload.session = sess
+
 	if requestContext, _ := contextvalues.GetRequestContext(ctx); requestContext != nil {
 		if err := productMetrics.CaptureEvent(ctx, "mcp_initialized", payload.sessionID, map[string]interface{}{
-			"project_id":          payload.projectID.String(),
-			"authenticated":       payload.authenticated,
-			"mcp_domain":          requestContext.Host,
-			"mcp_url":             requestContext.Host + requestContext.ReqURL,
-			"disable_noification": true,
-			"mcp_session_id":      payload.sessionID,
+			"project_id":           payload.projectID.String(),
+			"authenticated":        payload.authenticated,
+			"mcp_domain":           requestContext.Host,
+			"mcp_url":              requestContext.Host + requestContext.ReqURL,
+			"disable_noification":  true,
+			"mcp_session_id":       payload.sessionID,
+			"protocol_version":     string(version),
+			"client_name":          params.ClientInfo.Name,
+			"client_version":       params.ClientInfo.Version,
+			"instructions_version": conv.PtrValOr(toolset.InstructionsVersion, 0),
 		}); err != nil {
 			logger.ErrorContext(ctx, "failed to capture mcp_initialized event", attr.SlogError(err))
 		}
@@ -38,15 +87,16 @@ func handleInitialize(ctx context.Context, logger *slog.Logger, req *rawRequest,
 	result := &result[initializeResult]{
 		ID: req.ID,
 		Result: initializeResult{
-			ProtocolVersion: "2024-11-05",
+			ProtocolVersion: string(version),
 			Capabilities: map[string]json.RawMessage{
-				"tools":   json.RawMessage("{}"),
-				"prompts": json.RawMessage("{}"),
-			},
-			ServerInfo: serverInfo{
-				Name:    "Gram",
-				Version: "0.0.0",
+				"tools":       json.RawMessage(`{"listChanged":true}`),
+				"prompts":     json.RawMessage(`{"listChanged":true}`),
+				"resources":   json.RawMessage(`{"subscribe":true,"listChanged":false}`),
+				"logging":     json.RawMessage("{}"),
+				"completions": json.RawMessage("{}"),
 			},
+			ServerInfo:   describeServer(toolset, version),
+			Instructions: serverInstructions(toolset),
 		},
 	}
 
@@ -57,3 +107,41 @@ func handleInitialize(ctx context.Context, logger *slog.Logger, req *rawRequest,
 
 	return bs, nil
 }
+
+// describeServer identifies the toolset being served. The version tracks the
+// deployment that the toolset's tools are sourced from so that clients can
+// tell when the set of tools has changed.
+func describeServer(toolset *types.Toolset, version protocolVersion) serverInfo {
+	info := serverInfo{
+		Name:    string(toolset.Slug),
+		Title:   "",
+		Version: toolset.UpdatedAt,
+	}
+
+	for _, tool := range toolset.HTTPTools {
+		if tool.DeploymentID != "" {
+			info.Version = tool.DeploymentID
+			break
+		}
+	}
+
+	// serverInfo.title was introduced in 2025-06-18. Older clients display
+	// the name instead.
+	if version.atLeast(protocolVersion20250618) {
+		info.Title = toolset.Name
+	} else {
+		info.Name = toolset.Name
+	}
+
+	return info
+}
+
+// serverInstructions returns the toolset's instructions for MCP clients. Older
+// toolsets without instructions fall back to their short description.
+func serverInstructions(toolset *types.Toolset) string {
+	if toolset.Instructions != nil {
+		return *toolset.Instructions
+	}
+
+	return conv.PtrValOr(toolset.Description, "")
+}
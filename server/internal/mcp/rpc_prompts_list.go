@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -16,7 +17,8 @@ import (
 )
 
 type promptsListResult struct {
-	Prompts []*promptsListEntry `json:"prompts"`
+	Prompts    []*promptsListEntry `json:"prompts"`
+	NextCursor string              `json:"nextCursor,omitempty"`
 }
 
 type promptArgument struct {
@@ -65,7 +67,7 @@ func parsePromptArgumentsFromJSONSchema(schemaStr string, logger *slog.Logger, c
 	return args
 }
 
-func handlePromptsList(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, payload *mcpInputs, req *rawRequest) (json.RawMessage, error) {
+func handlePromptsList(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, payload *mcpInputs, req *rawRequest, pageSize int) (json.RawMessage, error) {
 	projectID := mv.ProjectID(payload.projectID)
 
 	toolset, err := mv.DescribeToolset(ctx, logger, db, projectID, mv.ToolsetSlug(conv.ToLower(payload.toolset)))
@@ -73,6 +75,11 @@ func handlePromptsList(ctx context.Context, logger *slog.Logger, db *pgxpool.Poo
 		return nil, err
 	}
 
+	cursor, err := parseListCursor(req)
+	if err != nil {
+		return nil, err
+	}
+
 	prompts := make([]*promptsListEntry, 0)
 
 	for _, prompt := range toolset.PromptTemplates {
@@ -95,10 +102,20 @@ func handlePromptsList(ctx context.Context, logger *slog.Logger, db *pgxpool.Poo
 		}
 	}
 
+	// Prompts are not deployed, so a redeploy does not change their listing.
+	prompts, nextCursor, err := paginate(prompts, func(p *promptsListEntry) string { return p.Name }, "", cursor, pageSize)
+	switch {
+	case errors.Is(err, errStaleListCursor):
+		return nil, staleListCursorError(req)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to paginate prompts/list response").Log(ctx, logger)
+	}
+
 	result := &result[promptsListResult]{
 		ID: req.ID,
 		Result: promptsListResult{
-			Prompts: prompts,
+			Prompts:    prompts,
+			NextCursor: nextCursor,
 		},
 	}
 
@@ -0,0 +1,632 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/assets"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/billing"
+	"github.com/speakeasy-api/gram/server/internal/contenttypes"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolsets"
+	toolsets_repo "github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+	"github.com/speakeasy-api/gram/server/internal/uritemplate"
+)
+
+type resourcesListResult struct {
+	Resources []*resourceEntry `json:"resources"`
+}
+
+type resourceEntry struct {
+	URI         string  `json:"uri"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	MimeType    *string `json:"mimeType,omitempty"`
+	Size        *int64  `json:"size,omitempty"`
+}
+
+type resourceTemplatesListResult struct {
+	ResourceTemplates []*resourceTemplateEntry `json:"resourceTemplates"`
+}
+
+type resourceTemplateEntry struct {
+	URITemplate string  `json:"uriTemplate"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	MimeType    *string `json:"mimeType,omitempty"`
+}
+
+type resourceURIParams struct {
+	URI string `json:"uri"`
+}
+
+type resourcesReadResult struct {
+	Contents []*resourceContents `json:"contents"`
+}
+
+type resourceContents struct {
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType,omitempty"`
+	Text     string `json:"text,omitempty"`
+	Blob     string `json:"blob,omitempty"`
+}
+
+type toolsetResources struct {
+	toolset   toolsets_repo.Toolset
+	resources []toolsets_repo.ListToolsetResourcesRow
+}
+
+func loadToolsetResources(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, payload *mcpInputs) (*toolsetResources, error) {
+	repo := toolsets_repo.New(db)
+
+	toolset, err := repo.GetToolset(ctx, toolsets_repo.GetToolsetParams{
+		Slug:      conv.ToLower(payload.toolset),
+		ProjectID: payload.projectID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeNotFound, err, "toolset not found").Log(ctx, logger)
+	}
+
+	rows, err := repo.ListToolsetResources(ctx, toolsets_repo.ListToolsetResourcesParams{
+		ToolsetID: toolset.ID,
+		ProjectID: payload.projectID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to list toolset resources").Log(ctx, logger)
+	}
+
+	return &toolsetResources{toolset: toolset, resources: rows}, nil
+}
+
+func handleResourcesList(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, payload *mcpInputs, req *rawRequest) (json.RawMessage, error) {
+	loaded, err := loadToolsetResources(ctx, logger, db, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*resourceEntry, 0, len(loaded.resources))
+	for _, row := range loaded.resources {
+		if row.Kind != mv.ResourceKindAsset || !row.AssetUrl.Valid {
+			continue
+		}
+
+		var size *int64
+		if row.AssetContentLength.Valid {
+			size = &row.AssetContentLength.Int64
+		}
+
+		entries = append(entries, &resourceEntry{
+			URI:         mv.StaticResourceURI(loaded.toolset.Slug, row.Name),
+			Name:        row.Name,
+			Description: conv.FromPGText[string](row.Description),
+			MimeType:    resourceMimeType(row),
+			Size:        size,
+		})
+	}
+
+	bs, err := json.Marshal(result[resourcesListResult]{
+		ID:     req.ID,
+		Result: resourcesListResult{Resources: entries},
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize resources/list response").Log(ctx, logger)
+	}
+
+	return bs, nil
+}
+
+func handleResourceTemplatesList(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, payload *mcpInputs, req *rawRequest) (json.RawMessage, error) {
+	loaded, err := loadToolsetResources(ctx, logger, db, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*resourceTemplateEntry, 0, len(loaded.resources))
+	for _, row := range loaded.resources {
+		if row.Kind != mv.ResourceKindHTTPTemplate || !row.UriTemplate.Valid {
+			continue
+		}
+
+		entries = append(entries, &resourceTemplateEntry{
+			URITemplate: row.UriTemplate.String,
+			Name:        row.Name,
+			Description: conv.FromPGText[string](row.Description),
+			MimeType:    resourceMimeType(row),
+		})
+	}
+
+	bs, err := json.Marshal(result[resourceTemplatesListResult]{
+		ID:     req.ID,
+		Result: resourceTemplatesListResult{ResourceTemplates: entries},
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize resources/templates/list response").Log(ctx, logger)
+	}
+
+	return bs, nil
+}
+
+func handleResourcesRead(
+	ctx context.Context,
+	logger *slog.Logger,
+	db *pgxpool.Pool,
+	env gateway.EnvironmentLoader,
+	payload *mcpInputs,
+	req *rawRequest,
+	toolProxy *gateway.ToolProxy,
+	storage assets.BlobStore,
+	billingTracker billing.Tracker,
+	billingRepository billing.Repository,
+	confirmations *confirmations,
+) (json.RawMessage, error) {
+	var params resourceURIParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "failed to parse resources/read request").Log(ctx, logger)
+	}
+
+	if params.URI == "" {
+		return nil, oops.E(oops.CodeInvalid, nil, "resource uri is required").Log(ctx, logger)
+	}
+
+	loaded, err := loadToolsetResources(ctx, logger, db, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := mv.DescribeToolsetClientRules(ctx, toolsets_repo.New(db), payload.projectID, loaded.toolset)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to get client rules for toolset").Log(ctx, logger)
+	}
+	if err := checkClientRules(ctx, logger, rules, payload); err != nil {
+		return nil, err
+	}
+
+	var contents *resourceContents
+	for _, row := range loaded.resources {
+		switch row.Kind {
+		case mv.ResourceKindAsset:
+			if params.URI != mv.StaticResourceURI(loaded.toolset.Slug, row.Name) {
+				continue
+			}
+
+			contents, err = readAssetResource(ctx, logger, storage, params.URI, row)
+		case mv.ResourceKindHTTPTemplate:
+			tpl, perr := uritemplate.Parse(row.UriTemplate.String)
+			if perr != nil {
+				logger.WarnContext(ctx, "skipping resource with invalid uri template", attr.SlogError(perr))
+				continue
+			}
+
+			values, ok := tpl.Match(params.URI)
+			if !ok {
+				continue
+			}
+
+			contents, err = readTemplatedResource(ctx, logger, db, env, payload, loaded.toolset, row, params.URI, values, toolProxy, billingTracker, billingRepository, confirmations)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		if contents != nil {
+			break
+		}
+	}
+
+	if contents == nil {
+		return nil, &rpcError{
+			ID:      req.ID,
+			Code:    resourceNotFound,
+			Message: fmt.Sprintf("%s: %s", resourceNotFound.UserMessage(), params.URI),
+			Data:    map[string]string{"uri": params.URI},
+		}
+	}
+
+	bs, err := json.Marshal(result[resourcesReadResult]{
+		ID:     req.ID,
+		Result: resourcesReadResult{Contents: []*resourceContents{contents}},
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize resources/read response").Log(ctx, logger)
+	}
+
+	return bs, nil
+}
+
+// handleResourcesSubscribe records that the client wants to be told when a
+// resource of the toolset changes, or no longer does. Subscriptions are held
+// on the session's stream and notifications/resources/updated is sent for
+// them when the toolset's resources are replaced or the project is deployed.
+func handleResourcesSubscribe(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, streams *streamHub, payload *mcpInputs, req *rawRequest, subscribe bool) (json.RawMessage, error) {
+	var params resourceURIParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "failed to parse %s request", req.Method).Log(ctx, logger)
+	}
+
+	if params.URI == "" {
+		return nil, oops.E(oops.CodeInvalid, nil, "resource uri is required").Log(ctx, logger)
+	}
+
+	if payload.sessionID == "" {
+		return nil, oops.E(oops.CodeBadRequest, nil, "resource subscriptions require an mcp session").Log(ctx, logger)
+	}
+
+	stream := streams.stream(payload.sessionID)
+	if !subscribe {
+		stream.unsubscribeResource(params.URI)
+		return formatResourceSubscription(ctx, logger, req)
+	}
+
+	loaded, err := loadToolsetResources(ctx, logger, db, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !loaded.has(ctx, logger, params.URI) {
+		return nil, &rpcError{
+			ID:      req.ID,
+			Code:    resourceNotFound,
+			Message: fmt.Sprintf("%s: %s", resourceNotFound.UserMessage(), params.URI),
+			Data:    map[string]string{"uri": params.URI},
+		}
+	}
+
+	stream.subscribeResource(params.URI)
+
+	return formatResourceSubscription(ctx, logger, req)
+}
+
+func formatResourceSubscription(ctx context.Context, logger *slog.Logger, req *rawRequest) (json.RawMessage, error) {
+	bs, err := json.Marshal(result[json.RawMessage]{
+		ID:     req.ID,
+		Result: json.RawMessage("{}"),
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize %s response", req.Method).Log(ctx, logger)
+	}
+
+	return bs, nil
+}
+
+// has reports whether a URI names one of the toolset's documents or matches
+// one of its resource templates.
+func (r *toolsetResources) has(ctx context.Context, logger *slog.Logger, uri string) bool {
+	for _, row := range r.resources {
+		switch row.Kind {
+		case mv.ResourceKindAsset:
+			if uri == mv.StaticResourceURI(r.toolset.Slug, row.Name) {
+				return true
+			}
+		case mv.ResourceKindHTTPTemplate:
+			tpl, err := uritemplate.Parse(row.UriTemplate.String)
+			if err != nil {
+				logger.WarnContext(ctx, "skipping resource with invalid uri template", attr.SlogError(err))
+				continue
+			}
+
+			if _, ok := tpl.Match(uri); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func readAssetResource(ctx context.Context, logger *slog.Logger, storage assets.BlobStore, uri string, row toolsets_repo.ListToolsetResourcesRow) (*resourceContents, error) {
+	if !row.AssetUrl.Valid {
+		return nil, oops.E(oops.CodeNotFound, nil, "resource asset no longer exists").Log(ctx, logger)
+	}
+
+	assetURL, err := url.Parse(row.AssetUrl.String)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "invalid resource asset url").Log(ctx, logger)
+	}
+
+	rdr, err := storage.Read(ctx, assetURL)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to read resource asset").Log(ctx, logger)
+	}
+	defer o11y.LogDefer(ctx, logger, func() error {
+		return rdr.Close()
+	})
+
+	body, err := io.ReadAll(io.LimitReader(rdr, assets.MaxFileSizeDocument+1))
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to read resource asset").Log(ctx, logger)
+	}
+	if len(body) > assets.MaxFileSizeDocument {
+		return nil, oops.E(oops.CodeUnexpected, nil, "resource asset exceeds the maximum size of %d bytes", assets.MaxFileSizeDocument).Log(ctx, logger)
+	}
+
+	return newResourceContents(uri, conv.PtrValOr(resourceMimeType(row), "application/octet-stream"), body), nil
+}
+
+func readTemplatedResource(
+	ctx context.Context,
+	logger *slog.Logger,
+	db *pgxpool.Pool,
+	env gateway.EnvironmentLoader,
+	payload *mcpInputs,
+	toolset toolsets_repo.Toolset,
+	row toolsets_repo.ListToolsetResourcesRow,
+	uri string,
+	values map[string]string,
+	toolProxy *gateway.ToolProxy,
+	billingTracker billing.Tracker,
+	billingRepository billing.Repository,
+	confirmations *confirmations,
+) (*resourceContents, error) {
+	described, err := mv.DescribeToolset(ctx, logger, db, mv.ProjectID(payload.projectID), mv.ToolsetSlug(toolset.Slug))
+	if err != nil {
+		return nil, err
+	}
+
+	// Resources can only be served by tools that the toolset exposes.
+	var httpTool *types.HTTPToolDefinition
+	for _, tool := range described.HTTPTools {
+		if tool.CanonicalName == row.HttpToolName.String {
+			httpTool = tool
+			break
+		}
+	}
+	if httpTool == nil {
+		return nil, oops.E(oops.CodeNotFound, nil, "resource tool is not part of this toolset: %s", row.HttpToolName.String).Log(ctx, logger)
+	}
+
+	executionPlan, err := toolsets.NewToolsets(db).GetHTTPToolExecutionInfoByID(ctx, uuid.MustParse(httpTool.ID), payload.projectID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "resource tool not found: %s", row.HttpToolName.String).Log(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "failed get tool execution plan").Log(ctx, logger)
+	}
+	ctx, logger = o11y.EnrichToolCallContext(ctx, logger, executionPlan.OrganizationSlug, executionPlan.ProjectSlug)
+
+	envVars, err := resolveToolEnvironment(ctx, logger, env, payload, executionPlan.Tool)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkToolUsageLimits(ctx, logger, toolset.OrganizationID, described.AccountType, billingRepository); err != nil {
+		return nil, err
+	}
+
+	requestBody, err := templateToolCallBody(executionPlan.Tool, values)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to build resource request").Log(ctx, logger)
+	}
+
+	// Reading a resource runs the tool, so it is confirmed in the same way as
+	// a tools/call request.
+	refusal, err := confirmations.confirm(ctx, payload, httpTool, executionPlan.Confirm, requestBody)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to confirm resource read").Log(ctx, logger)
+	}
+	if refusal != "" {
+		return nil, oops.E(oops.CodeForbidden, nil, "%s", refusal).Log(ctx, logger)
+	}
+
+	rw := &toolCallResponseWriter{
+		headers:    make(http.Header),
+		body:       new(bytes.Buffer),
+		statusCode: http.StatusOK,
+		limit:      maxBufferedResponseBytes,
+		discarded:  0,
+	}
+
+	err = toolProxy.Do(ctx, rw, bytes.NewReader(requestBody), envVars, executionPlan.Tool)
+	var shareable *oops.ShareableError
+	switch {
+	case errors.As(err, &shareable):
+		// Keep the gateway's code so that clients can tell invalid URI
+		// variables apart from upstream failures.
+		return nil, shareable
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to read templated resource").Log(ctx, logger)
+	}
+
+	go billingTracker.TrackToolCallUsage(context.WithoutCancel(ctx), billing.ToolCallUsageEvent{
+		OrganizationID:   toolset.OrganizationID,
+		RequestBytes:     int64(len(requestBody)),
+		OutputBytes:      int64(rw.body.Len()) + rw.discarded,
+		ToolID:           executionPlan.Tool.ID,
+		ToolName:         executionPlan.Tool.Name,
+		ProjectID:        payload.projectID.String(),
+		ProjectSlug:      &executionPlan.ProjectSlug,
+		OrganizationSlug: &executionPlan.OrganizationSlug,
+		ToolsetSlug:      &toolset.Slug,
+		MCPURL:           nil,
+		ChatID:           nil,
+		MCPClientName:    conv.PtrEmpty(payload.client().Name),
+		MCPClientVersion: conv.PtrEmpty(payload.client().Version),
+		Type:             billing.ToolCallTypeHTTP,
+	})
+
+	if rw.statusCode < 200 || rw.statusCode >= 300 {
+		return nil, upstreamResourceError(ctx, logger, executionPlan.Tool, rw.statusCode, uri)
+	}
+
+	// Unlike tool results, resource contents cannot be shortened without
+	// corrupting them.
+	if rw.discarded > 0 {
+		return nil, oops.E(oops.CodeUnexpected, nil, "resource %s exceeds the maximum size of %d bytes", uri, maxBufferedResponseBytes).Log(ctx, logger)
+	}
+
+	mimeType := conv.PtrValOr(conv.FromPGText[string](row.MimeType), "")
+	if mimeType == "" {
+		if mt, _, err := mime.ParseMediaType(rw.headers.Get("Content-Type")); err == nil {
+			mimeType = mt
+		}
+	}
+
+	return newResourceContents(uri, conv.Default(mimeType, "application/octet-stream"), rw.body.Bytes()), nil
+}
+
+// upstreamResourceError reports an unsuccessful upstream response to a
+// templated resource read with the closest client error. Upstream failures
+// are presented as retryable when the tool's calls may be repeated.
+func upstreamResourceError(ctx context.Context, logger *slog.Logger, tool *gateway.HTTPTool, statusCode int, uri string) error {
+	var code oops.Code
+	var cause error
+	switch {
+	case statusCode == http.StatusNotFound || statusCode == http.StatusGone:
+		code = oops.CodeNotFound
+	case statusCode == http.StatusUnauthorized:
+		code = oops.CodeUnauthorized
+	case statusCode == http.StatusForbidden:
+		code = oops.CodeForbidden
+	case statusCode == http.StatusTooManyRequests || statusCode >= 500:
+		code = oops.CodeGatewayError
+		if tool.Retryable() {
+			cause = oops.Temp(fmt.Errorf("upstream status %d", statusCode))
+		}
+	case statusCode >= 400:
+		code = oops.CodeBadRequest
+	default:
+		code = oops.CodeGatewayError
+	}
+
+	return oops.E(code, cause, "upstream server returned status %d for resource %s", statusCode, uri).Log(ctx, logger)
+}
+
+// templateToolCallBody turns the variables matched from a resource URI into
+// the request body accepted by the tool proxy. Values are converted to the
+// types declared in the tool's input schema so that schema validation passes.
+func templateToolCallBody(tool *gateway.HTTPTool, values map[string]string) ([]byte, error) {
+	var schema struct {
+		Properties map[string]struct {
+			Properties map[string]struct {
+				Type any `json:"type"`
+			} `json:"properties"`
+		} `json:"properties"`
+	}
+	if len(tool.Schema) > 0 {
+		if err := json.Unmarshal(tool.Schema, &schema); err != nil {
+			return nil, fmt.Errorf("parse tool schema: %w", err)
+		}
+	}
+
+	group := func(name string) map[string]any {
+		out := make(map[string]any)
+		for key, value := range values {
+			prop, ok := schema.Properties[name].Properties[key]
+			var typ any
+			if ok {
+				typ = prop.Type
+			}
+			out[key] = coerceTemplateValue(typ, value)
+		}
+		return out
+	}
+
+	pathValues := group("pathParameters")
+	queryValues := group("queryParameters")
+	for key := range values {
+		if _, isPath := tool.PathParams[key]; isPath {
+			delete(queryValues, key)
+		} else {
+			delete(pathValues, key)
+		}
+	}
+
+	body := map[string]any{}
+	if len(pathValues) > 0 {
+		body["pathParameters"] = pathValues
+	}
+	if len(queryValues) > 0 {
+		body["queryParameters"] = queryValues
+	}
+
+	bs, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("marshal tool call body: %w", err)
+	}
+
+	return bs, nil
+}
+
+func coerceTemplateValue(typ any, value string) any {
+	types := []string{}
+	switch t := typ.(type) {
+	case string:
+		types = append(types, t)
+	case []any:
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				types = append(types, s)
+			}
+		}
+	}
+
+	for _, t := range types {
+		switch t {
+		case "integer":
+			if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+				return n
+			}
+		case "number":
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				return n
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(value); err == nil {
+				return b
+			}
+		case "array":
+			return strings.Split(value, ",")
+		}
+	}
+
+	return value
+}
+
+func newResourceContents(uri string, mimeType string, body []byte) *resourceContents {
+	contents := &resourceContents{
+		URI:      uri,
+		MimeType: mimeType,
+		Text:     "",
+		Blob:     "",
+	}
+
+	if isTextMimeType(mimeType) {
+		contents.Text = string(body)
+	} else {
+		contents.Blob = base64.StdEncoding.EncodeToString(body)
+	}
+
+	return contents
+}
+
+func isTextMimeType(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "text/") || contenttypes.IsJSON(mimeType) || contenttypes.IsYAML(mimeType)
+}
+
+func resourceMimeType(row toolsets_repo.ListToolsetResourcesRow) *string {
+	if mt := conv.FromPGText[string](row.MimeType); mt != nil {
+		return mt
+	}
+
+	return conv.FromPGText[string](row.AssetContentType)
+}
@@ -0,0 +1,161 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/assets"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	toolsets_repo "github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+)
+
+// sizedBlobStore serves a blob of a fixed size for every asset URL.
+type sizedBlobStore struct {
+	size int
+}
+
+var _ assets.BlobStore = sizedBlobStore{size: 0}
+
+func (s sizedBlobStore) Exists(context.Context, *url.URL) (bool, error) {
+	return true, nil
+}
+
+func (s sizedBlobStore) Read(context.Context, *url.URL) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(bytes.Repeat([]byte("a"), s.size))), nil
+}
+
+func (s sizedBlobStore) ReadAt(context.Context, *url.URL) (assets.ReaderAtCloser, int64, error) {
+	return nil, 0, errors.New("not implemented")
+}
+
+func (s sizedBlobStore) Write(context.Context, string, io.Reader, string) (io.WriteCloser, *url.URL, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+// newTestResourceRow returns a document resource backed by an asset, or a
+// templated resource when uriTemplate is set.
+func newTestResourceRow(kind string, name string, uriTemplate string) toolsets_repo.ListToolsetResourcesRow {
+	row := toolsets_repo.ListToolsetResourcesRow{
+		ID:                 uuid.New(),
+		Kind:               kind,
+		Name:               name,
+		Description:        pgtype.Text{String: "", Valid: false},
+		MimeType:           pgtype.Text{String: "", Valid: false},
+		AssetID:            uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		HttpToolName:       pgtype.Text{String: "", Valid: false},
+		UriTemplate:        pgtype.Text{String: "", Valid: false},
+		CreatedAt:          pgtype.Timestamptz{},
+		UpdatedAt:          pgtype.Timestamptz{},
+		AssetUrl:           pgtype.Text{String: "", Valid: false},
+		AssetContentType:   pgtype.Text{String: "", Valid: false},
+		AssetContentLength: pgtype.Int8{Int64: 0, Valid: false},
+	}
+
+	switch kind {
+	case mv.ResourceKindAsset:
+		row.AssetID = uuid.NullUUID{UUID: uuid.New(), Valid: true}
+		row.AssetUrl = pgtype.Text{String: "file:///" + name + ".md", Valid: true}
+	case mv.ResourceKindHTTPTemplate:
+		row.HttpToolName = pgtype.Text{String: "get_pet", Valid: true}
+		row.UriTemplate = pgtype.Text{String: uriTemplate, Valid: true}
+	}
+
+	return row
+}
+
+func TestReadAssetResource_Size(t *testing.T) {
+	t.Parallel()
+
+	row := newTestResourceRow(mv.ResourceKindAsset, "runbook", "")
+	row.MimeType = pgtype.Text{String: "text/markdown", Valid: true}
+	row.AssetContentType = pgtype.Text{String: "text/markdown", Valid: true}
+
+	tests := map[string]struct {
+		size    int
+		allowed bool
+	}{
+		"at the limit":   {size: assets.MaxFileSizeDocument, allowed: true},
+		"over the limit": {size: assets.MaxFileSizeDocument + 1, allowed: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			contents, err := readAssetResource(t.Context(), testenv.NewLogger(t), sizedBlobStore{size: tt.size}, "gram://petstore/runbook", row)
+			if tt.allowed {
+				require.NoError(t, err)
+				require.Len(t, contents.Text, tt.size)
+				return
+			}
+
+			var oopsErr *oops.ShareableError
+			require.ErrorAs(t, err, &oopsErr)
+			require.Nil(t, contents)
+		})
+	}
+}
+
+func TestToolsetResources_Has(t *testing.T) {
+	t.Parallel()
+
+	var toolset toolsets_repo.Toolset
+	toolset.Slug = "petstore"
+	loaded := &toolsetResources{
+		toolset: toolset,
+		resources: []toolsets_repo.ListToolsetResourcesRow{
+			newTestResourceRow(mv.ResourceKindAsset, "runbook", ""),
+			newTestResourceRow(mv.ResourceKindHTTPTemplate, "pet", "gram://petstore/pet/{petId}"),
+		},
+	}
+
+	tests := map[string]struct {
+		uri string
+		has bool
+	}{
+		"document":                  {uri: "gram://petstore/runbook", has: true},
+		"templated resource":        {uri: "gram://petstore/pet/42", has: true},
+		"document of other toolset": {uri: "gram://bookstore/runbook", has: false},
+		"unknown document":          {uri: "gram://petstore/changelog", has: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.has, loaded.has(t.Context(), testenv.NewLogger(t), tt.uri))
+		})
+	}
+}
+
+func TestHandleResourcesSubscribe_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	streams := newStreamHub()
+	payload := newTestInputs()
+	req := &rawRequest{JSONRPC: "2.0", ID: msgID{format: 1, Number: 3, String: ""}, Method: "resources/unsubscribe", Params: json.RawMessage(`{"uri":"gram://petstore/runbook"}`), Result: nil, Error: nil}
+
+	_, err := handleResourcesSubscribe(t.Context(), testenv.NewLogger(t), nil, streams, payload, req, false)
+	require.ErrorContains(t, err, "resource subscriptions require an mcp session")
+
+	payload.sessionID = "session"
+	stream := streams.stream(payload.sessionID)
+	stream.subscribeResource("gram://petstore/runbook")
+	stream.subscribeResource("gram://petstore/pet/42")
+
+	res, err := handleResourcesSubscribe(t.Context(), testenv.NewLogger(t), nil, streams, payload, req, false)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":3,"result":{}}`, string(res))
+	require.Equal(t, []string{"gram://petstore/pet/42"}, stream.subscribedResources())
+}
@@ -0,0 +1,148 @@
+package mcp
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func toolsCallRequest(t *testing.T, name string) *rawRequest {
+	t.Helper()
+
+	params, err := json.Marshal(toolsCallParams{Name: name, Arguments: json.RawMessage(`{}`), Meta: nil})
+	require.NoError(t, err)
+
+	return &rawRequest{
+		JSONRPC: "2.0",
+		ID:      msgID{format: 1, Number: 7, String: ""},
+		Method:  "tools/call",
+		Params:  params,
+		Result:  nil,
+		Error:   nil,
+	}
+}
+
+func TestNewErrorFromCause_Codes(t *testing.T) {
+	t.Parallel()
+
+	tests := map[oops.Code]errorCode{
+		oops.CodeBadRequest:         invalidParams,
+		oops.CodeInvalid:            invalidParams,
+		oops.CodeUnauthorized:       invalidRequest,
+		oops.CodeForbidden:          invalidRequest,
+		oops.CodeConflict:           invalidRequest,
+		oops.CodeUnsupportedMedia:   invalidRequest,
+		oops.CodeNotFound:           invalidRequest,
+		oops.CodeUnexpected:         internalError,
+		oops.CodeInvariantViolation: internalError,
+		oops.CodeGatewayError:       internalError,
+	}
+
+	for code, expected := range tests {
+		t.Run(string(code), func(t *testing.T) {
+			t.Parallel()
+
+			source := oops.E(code, nil, "something went wrong")
+			rpce := NewErrorFromCause(t.Context(), toolsCallRequest(t, "list_pets"), source)
+
+			require.Equal(t, expected, rpce.Code)
+			require.Equal(t, "7", rpce.ID.Value())
+			require.Equal(t, source.Error(), rpce.Message)
+			require.Equal(t, &errorData{
+				Code:      string(code),
+				ErrorID:   source.ID(),
+				TraceID:   "",
+				Tool:      "list_pets",
+				Retryable: false,
+			}, rpce.Data)
+		})
+	}
+}
+
+func TestNewErrorFromCause_Retryable(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		source    error
+		retryable bool
+	}{
+		"temporary gateway error": {source: oops.E(oops.CodeGatewayError, oops.Temp(errors.New("connection reset")), "failed to execute request"), retryable: true},
+		"permanent gateway error": {source: oops.E(oops.CodeGatewayError, errors.New("connection reset"), "failed to execute request"), retryable: false},
+		"temporary wrapped cause": {source: oops.E(oops.CodeUnexpected, oops.E(oops.CodeGatewayError, oops.Temp(errors.New("timeout")), "failed to execute request"), "failed execute tool call"), retryable: true},
+		"invalid arguments":       {source: oops.E(oops.CodeInvalid, nil, "tool name is required"), retryable: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rpce := NewErrorFromCause(t.Context(), toolsCallRequest(t, "create_pet"), tt.source)
+
+			data, ok := rpce.Data.(*errorData)
+			require.True(t, ok)
+			require.Equal(t, tt.retryable, data.Retryable)
+		})
+	}
+}
+
+func TestNewErrorFromCause_Tool(t *testing.T) {
+	t.Parallel()
+
+	executeArgs, err := json.Marshal(executeToolArguments{Name: "delete_pet", Arguments: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	execute := toolsCallRequest(t, metaToolExecute)
+	execute.Params, err = json.Marshal(toolsCallParams{Name: metaToolExecute, Arguments: executeArgs, Meta: nil})
+	require.NoError(t, err)
+
+	list := toolsCallRequest(t, "")
+	list.Method = "tools/list"
+
+	tests := map[string]struct {
+		req  *rawRequest
+		tool string
+	}{
+		"tools/call":        {req: toolsCallRequest(t, "list_pets"), tool: "list_pets"},
+		"execute_tool call": {req: execute, tool: "delete_pet"},
+		"other method":      {req: list, tool: ""},
+		"no request":        {req: nil, tool: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rpce := NewErrorFromCause(t.Context(), tt.req, oops.E(oops.CodeNotFound, nil, "tool not found"))
+
+			data, ok := rpce.Data.(*errorData)
+			require.True(t, ok)
+			require.Equal(t, tt.tool, data.Tool)
+		})
+	}
+}
+
+func TestNewErrorFromCause_UnknownError(t *testing.T) {
+	t.Parallel()
+
+	rpce := NewErrorFromCause(t.Context(), toolsCallRequest(t, "list_pets"), errors.New("database password is hunter2"))
+
+	require.Equal(t, internalError, rpce.Code)
+	require.Equal(t, internalError.UserMessage(), rpce.Message)
+	require.Equal(t, &errorData{
+		Code:      string(oops.CodeUnexpected),
+		ErrorID:   "",
+		TraceID:   "",
+		Tool:      "list_pets",
+		Retryable: false,
+	}, rpce.Data)
+}
+
+func TestNewErrorFromCause_KeepsRPCErrors(t *testing.T) {
+	t.Parallel()
+
+	source := &rpcError{ID: msgID{format: 1, Number: 3, String: ""}, Code: methodNotFound, Message: "method not found", Data: nil}
+	require.Same(t, source, NewErrorFromCause(t.Context(), nil, source))
+}
@@ -11,6 +11,8 @@ import (
 	"maps"
 	"mime"
 	"net/http"
+	"net/url"
+	"path"
 	"slices"
 	"strings"
 
@@ -27,11 +29,13 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oops"
 	"github.com/speakeasy-api/gram/server/internal/toolsets"
+	"gopkg.in/yaml.v3"
 )
 
 type toolsCallParams struct {
 	Name      string          `json:"name"`
 	Arguments json.RawMessage `json:"arguments"`
+	Meta      *requestMeta    `json:"_meta,omitempty"`
 }
 
 func handleToolsCall(
@@ -45,6 +49,11 @@ func handleToolsCall(
 	toolProxy *gateway.ToolProxy,
 	billingTracker billing.Tracker,
 	billingRepository billing.Repository,
+	confirmations *confirmations,
+	logging *clientLogging,
+	downloads *downloads,
+	budgets *responseBudgets,
+	searchIndexes *toolSearchIndexes,
 ) (json.RawMessage, error) {
 	var params toolsCallParams
 	if err := json.Unmarshal(req.Params, &params); err != nil {
@@ -62,19 +71,45 @@ func handleToolsCall(
 		return nil, err
 	}
 
+	// Rules are checked again on every call so that sessions opened before a
+	// client was blocked do not outlive the change.
+	if err := checkClientRules(ctx, logger, toolset.ClientRules, payload); err != nil {
+		return nil, err
+	}
+	client := payload.client()
+
+	if params.Name == metaToolReadResponse && offersResponsePaging(toolset) {
+		return handleReadToolResponse(ctx, logger, budgets, payload, req, params.Arguments)
+	}
+
+	if usesDynamicTools(toolset) {
+		switch params.Name {
+		case metaToolSearch:
+			return handleSearchTools(ctx, logger, searchIndexes, req, toolset, params.Arguments)
+		case metaToolDescribe:
+			return handleDescribeTool(ctx, logger, req, toolset, params.Arguments)
+		case metaToolExecute:
+			params, err = unwrapExecuteTool(ctx, logger, toolset, params)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	var mcpURL string
 	if requestContext, _ := contextvalues.GetRequestContext(ctx); requestContext != nil {
 		mcpURL = requestContext.Host + requestContext.ReqURL
-		metrics.RecordMCPToolCall(ctx, toolset.OrganizationID, mcpURL, params.Name)
+		metrics.RecordMCPToolCall(ctx, toolset.OrganizationID, mcpURL, params.Name, client.Name)
 	}
 
 	toolsetHelpers := toolsets.NewToolsets(db)
-	envSlug := payload.environment
 	var higherOrderTool *types.PromptTemplate
+	var httpTool *types.HTTPToolDefinition
 	var toolID *string
 
 	for _, tool := range toolset.HTTPTools {
 		if tool.Name == params.Name {
+			httpTool = tool
 			toolID = &tool.ID
 			break
 		}
@@ -125,57 +160,38 @@ func handleToolsCall(
 			ProjectSlug:      nil, // This data is only there for debugging, but we don't have it here
 			OrganizationSlug: nil,
 			ChatID:           nil,
+			MCPClientName:    conv.PtrEmpty(client.Name),
+			MCPClientVersion: conv.PtrEmpty(client.Version),
 		})
 
 		return formatHigherOrderToolResult(ctx, logger, req, promptData)
 	}
 
-	// Transform environment entries into a map
-	envVars := make(map[string]string)
-
-	// IMPORTANT: MCP servers accessed in a public manner or not gram authenticated, there is no concept of using stored environments for them
-	if envSlug != "" && payload.authenticated {
-		storedEnvVars, err := env.Load(ctx, payload.projectID, gateway.Slug(envSlug))
-		switch {
-		case errors.Is(err, gateway.ErrNotFound):
-			return nil, oops.E(oops.CodeBadRequest, err, "environment not found").Log(ctx, logger)
-		case err != nil:
-			return nil, oops.E(oops.CodeUnexpected, err, "failed to load environment").Log(ctx, logger)
-		}
-
-		if len(storedEnvVars) > 0 {
-			maps.Copy(envVars, storedEnvVars)
-		}
-	}
-
-	if len(payload.mcpEnvVariables) > 0 {
-		// apply user provided env variable overrides
-		maps.Copy(envVars, payload.mcpEnvVariables)
-	}
-
 	executionPlan, err := toolsetHelpers.GetHTTPToolExecutionInfoByID(ctx, uuid.MustParse(*toolID), uuid.UUID(projectID))
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "failed get tool execution plan").Log(ctx, logger)
 	}
 	ctx, logger = o11y.EnrichToolCallContext(ctx, logger, executionPlan.OrganizationSlug, executionPlan.ProjectSlug)
 
-	// map provided oauth tokens into the relevant security env variables
-	for _, security := range executionPlan.Tool.Security {
-		for _, token := range payload.oauthTokenInputs {
-			if slices.Contains(security.OAuthTypes, "authorization_code") && (len(token.securityKeys) == 0 || slices.Contains(token.securityKeys, security.Key)) {
-				for _, envVar := range security.EnvVariables {
-					if strings.HasSuffix(envVar, "ACCESS_TOKEN") {
-						envVars[envVar] = token.Token
-					}
-				}
-			}
-		}
+	envVars, err := resolveToolEnvironment(ctx, logger, env, payload, executionPlan.Tool)
+	if err != nil {
+		return nil, err
+	}
+
+	refusal, err := confirmations.confirm(ctx, payload, httpTool, executionPlan.Confirm, params.Arguments)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to confirm tool call").Log(ctx, logger)
+	}
+	if refusal != "" {
+		return formatToolCallRefusal(ctx, logger, req, refusal)
 	}
 
 	rw := &toolCallResponseWriter{
 		headers:    make(http.Header),
 		body:       new(bytes.Buffer),
 		statusCode: http.StatusOK,
+		limit:      maxBufferedResponseBytes,
+		discarded:  0,
 	}
 
 	requestBodyBytes := params.Arguments
@@ -200,28 +216,87 @@ func handleToolsCall(
 			ToolsetSlug:      &payload.toolset,
 			MCPURL:           &mcpURL,
 			ChatID:           nil,
+			MCPClientName:    conv.PtrEmpty(client.Name),
+			MCPClientVersion: conv.PtrEmpty(client.Version),
 			Type:             billing.ToolCallTypeHTTP,
 		})
 
 	}()
 
-	err = toolProxy.Do(ctx, rw, bytes.NewBuffer(params.Arguments), envVars, executionPlan.Tool)
-	if err != nil {
+	progress := newProgressReporter(logger, payload, params.Meta)
+	progress.advance(ctx, fmt.Sprintf("Calling %s", params.Name))
+
+	callCtx := progress.attach(logging.withDiagnostics(ctx, payload))
+	if canSendAssetFiles(payload, executionPlan.Tool) {
+		callCtx = gateway.WithAssetFiles(callCtx)
+	}
+	err = toolProxy.Do(callCtx, rw, bytes.NewBuffer(params.Arguments), envVars, executionPlan.Tool)
+	var shareable *oops.ShareableError
+	switch {
+	case errors.As(err, &shareable):
+		// Keep the gateway's code so that clients see whether the call can
+		// be retried.
+		return nil, shareable
+	case err != nil:
 		return nil, oops.E(oops.CodeUnexpected, err, "failed execute tool call").Log(ctx, logger)
 	}
 
+	progress.complete(ctx, fmt.Sprintf("Received response from %s", params.Name))
+
 	// Track tool call usage
-	outputBytes = int64(rw.body.Len())
-	chunk, err := formatResult(*rw)
+	outputBytes = int64(rw.body.Len()) + rw.discarded
+	// A response cut off at the buffer limit could be mistaken for the whole
+	// of it, so the call fails instead.
+	if rw.discarded > 0 {
+		return formatToolCallRefusal(ctx, logger, req, oversizedResponseMessage(params.Name, outputBytes))
+	}
+
+	note := budgets.enforce(ctx, payload, params.Name, resolveResponseBudget(toolset, httpTool), rw)
+
+	chunk, err := formatResult(ctx, *rw, binaryResults{
+		downloads:       downloads,
+		protocolVersion: payload.protocolVersion,
+		toolName:        params.Name,
+		linkThreshold:   conv.PtrValOr(toolset.ResourceLinkThreshold, DefaultResourceLinkThreshold),
+	})
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "failed format tool call result").Log(ctx, logger)
 	}
 
+	isError := isErrorResult(*rw)
+
+	var structured json.RawMessage
+	if !isError && payload.protocolVersion.supportsStructuredOutput() {
+		structured = structuredContent(*rw)
+		// Clients reject successful results of tools with an output schema
+		// that carry no structured content, so bodies that cannot be decoded
+		// are reported as failed calls instead.
+		if structured == nil && rw.body.Len() > 0 && advertisedOutputSchema(toolset, httpTool) != nil {
+			isError = true
+			note = strings.TrimSpace(note + " The response could not be decoded into the structured output that " + params.Name + " declares.")
+		}
+	}
+
+	content := []json.RawMessage{chunk}
+	if note != "" {
+		noteChunk, err := json.Marshal(contentChunk[string, json.RawMessage]{
+			Type:     "text",
+			Text:     note,
+			MimeType: nil,
+			Data:     nil,
+		})
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize truncation note").Log(ctx, logger)
+		}
+		content = append(content, noteChunk)
+	}
+
 	bs, err := json.Marshal(result[toolCallResult]{
 		ID: req.ID,
 		Result: toolCallResult{
-			Content: []json.RawMessage{chunk},
-			IsError: rw.statusCode < 200 || rw.statusCode >= 300,
+			Content:           content,
+			StructuredContent: structured,
+			IsError:           isError,
 		},
 	})
 	if err != nil {
@@ -231,6 +306,69 @@ func handleToolsCall(
 	return bs, nil
 }
 
+// canSendAssetFiles reports whether a tool call may attach files uploaded to
+// the project by asset_id. Only authenticated callers may do so, and not when
+// they have pointed the tool at another server with an MCP-* header, since
+// the files would then be sent to a destination of their choosing.
+func canSendAssetFiles(payload *mcpInputs, tool *gateway.HTTPTool) bool {
+	if !payload.authenticated {
+		return false
+	}
+
+	if tool.ServerEnvVar != "" {
+		for name := range payload.mcpEnvVariables {
+			if strings.EqualFold(name, tool.ServerEnvVar) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// resolveToolEnvironment assembles the variables used to call an HTTP tool:
+// the stored Gram environment for authenticated callers, MCP-* header
+// overrides and any OAuth tokens presented to the server.
+func resolveToolEnvironment(ctx context.Context, logger *slog.Logger, env gateway.EnvironmentLoader, payload *mcpInputs, tool *gateway.HTTPTool) (map[string]string, error) {
+	// Transform environment entries into a map
+	envVars := make(map[string]string)
+
+	// IMPORTANT: MCP servers accessed in a public manner or not gram authenticated, there is no concept of using stored environments for them
+	if payload.environment != "" && payload.authenticated {
+		storedEnvVars, err := env.Load(ctx, payload.projectID, gateway.Slug(payload.environment))
+		switch {
+		case errors.Is(err, gateway.ErrNotFound):
+			return nil, oops.E(oops.CodeBadRequest, err, "environment not found").Log(ctx, logger)
+		case err != nil:
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to load environment").Log(ctx, logger)
+		}
+
+		if len(storedEnvVars) > 0 {
+			maps.Copy(envVars, storedEnvVars)
+		}
+	}
+
+	if len(payload.mcpEnvVariables) > 0 {
+		// apply user provided env variable overrides
+		maps.Copy(envVars, payload.mcpEnvVariables)
+	}
+
+	// map provided oauth tokens into the relevant security env variables
+	for _, security := range tool.Security {
+		for _, token := range payload.oauthTokenInputs {
+			if slices.Contains(security.OAuthTypes, "authorization_code") && (len(token.securityKeys) == 0 || slices.Contains(token.securityKeys, security.Key)) {
+				for _, envVar := range security.EnvVariables {
+					if strings.HasSuffix(envVar, "ACCESS_TOKEN") {
+						envVars[envVar] = token.Token
+					}
+				}
+			}
+		}
+	}
+
+	return envVars, nil
+}
+
 func checkToolUsageLimits(ctx context.Context, logger *slog.Logger, orgID string, accountType string, billingRepository billing.Repository) error {
 	if accountType != string(billing.TierFree) {
 		return nil
@@ -260,6 +398,10 @@ type toolCallResponseWriter struct {
 	statusCode int
 	headers    http.Header
 	body       *bytes.Buffer
+	// limit caps the bytes kept in body when positive. Bytes past it are
+	// counted in discarded.
+	limit     int
+	discarded int64
 }
 
 func (w *toolCallResponseWriter) Header() http.Header {
@@ -271,6 +413,16 @@ func (w *toolCallResponseWriter) WriteHeader(statusCode int) {
 }
 
 func (w *toolCallResponseWriter) Write(p []byte) (int, error) {
+	if w.limit > 0 && w.body.Len()+len(p) > w.limit {
+		keep := max(w.limit-w.body.Len(), 0)
+		w.discarded += int64(len(p) - keep)
+		if _, err := w.body.Write(p[:keep]); err != nil {
+			return 0, fmt.Errorf("write response body: %w", err)
+		}
+
+		return len(p), nil
+	}
+
 	n, err := w.body.Write(p)
 	if err != nil {
 		return n, fmt.Errorf("write response body: %w", err)
@@ -279,6 +431,40 @@ func (w *toolCallResponseWriter) Write(p []byte) (int, error) {
 	return n, nil
 }
 
+// formatToolCallRefusal reports a tool call that was not made as an error
+// result so that the model can relay the reason to the user.
+// oversizedResponseMessage explains to the model why a tool call whose
+// response did not fit in memory returned nothing.
+func oversizedResponseMessage(toolName string, size int64) string {
+	return fmt.Sprintf("The response from %s was %d bytes, larger than the %d bytes that a tool call can return, so none of it was returned. Call the tool again with arguments that select less data, such as filters or a smaller page size.", toolName, size, maxBufferedResponseBytes)
+}
+
+func formatToolCallRefusal(ctx context.Context, logger *slog.Logger, req *rawRequest, reason string) (json.RawMessage, error) {
+	content, err := json.Marshal(contentChunk[string, json.RawMessage]{
+		Type:     "text",
+		Text:     reason,
+		MimeType: nil,
+		Data:     nil,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to marshal content chunk").Log(ctx, logger)
+	}
+
+	bs, err := json.Marshal(result[toolCallResult]{
+		ID: req.ID,
+		Result: toolCallResult{
+			Content:           []json.RawMessage{content},
+			StructuredContent: nil,
+			IsError:           true,
+		},
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to marshal tool call refusal").Log(ctx, logger)
+	}
+
+	return bs, nil
+}
+
 func formatHigherOrderToolResult(ctx context.Context, logger *slog.Logger, req *rawRequest, promptData string) (json.RawMessage, error) {
 	content, err := json.Marshal(contentChunk[string, json.RawMessage]{
 		Type:     "text",
@@ -293,8 +479,9 @@ func formatHigherOrderToolResult(ctx context.Context, logger *slog.Logger, req *
 	bs, err := json.Marshal(result[toolCallResult]{
 		ID: req.ID,
 		Result: toolCallResult{
-			Content: []json.RawMessage{content},
-			IsError: false,
+			Content:           []json.RawMessage{content},
+			StructuredContent: nil,
+			IsError:           false,
 		},
 	})
 	if err != nil {
@@ -304,7 +491,16 @@ func formatHigherOrderToolResult(ctx context.Context, logger *slog.Logger, req *
 	return bs, nil
 }
 
-func formatResult(rw toolCallResponseWriter) (json.RawMessage, error) {
+// binaryResults decides how binary and document tool responses that have no
+// dedicated MCP content type are returned to the client.
+type binaryResults struct {
+	downloads       *downloads
+	protocolVersion protocolVersion
+	toolName        string
+	linkThreshold   int64
+}
+
+func formatResult(ctx context.Context, rw toolCallResponseWriter, binary binaryResults) (json.RawMessage, error) {
 	body := rw.body.Bytes()
 	if len(body) == 0 {
 		return nil, nil
@@ -313,11 +509,25 @@ func formatResult(rw toolCallResponseWriter) (json.RawMessage, error) {
 	ct := rw.headers.Get("content-type")
 	mt, _, err := mime.ParseMediaType(ct)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse content type %q: %w", ct, err)
+		// Upstreams that omit or mangle the content type are treated as
+		// returning opaque bytes rather than failing the whole call.
+		mt = "application/octet-stream"
 	}
 
 	switch {
-	case strings.HasPrefix(mt, "text/"), contenttypes.IsJSON(mt), contenttypes.IsYAML(mt):
+	case isProblemJSON(mt):
+		bs, err := json.Marshal(contentChunk[string, json.RawMessage]{
+			Type:     "text",
+			Text:     summarizeProblem(rw.statusCode, body),
+			MimeType: nil,
+			Data:     nil,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("serialize problem details: %w", err)
+		}
+
+		return bs, nil
+	case isTextMediaType(mt):
 		bs, err := json.Marshal(contentChunk[string, json.RawMessage]{
 			Type:     "text",
 			Text:     string(body),
@@ -356,8 +566,165 @@ func formatResult(rw toolCallResponseWriter) (json.RawMessage, error) {
 
 		return bs, nil
 	default:
-		return nil, fmt.Errorf("unsupported content type %q", ct)
+		return formatBinaryResult(ctx, rw, mt, binary)
+	}
+}
+
+// isErrorResult reports whether an upstream response is returned to the model
+// as a failed tool call. Some APIs report failures as problem details with a
+// 2xx status.
+func isErrorResult(rw toolCallResponseWriter) bool {
+	return rw.statusCode < 200 || rw.statusCode >= 300 || isProblemResponse(rw)
+}
+
+func isProblemResponse(rw toolCallResponseWriter) bool {
+	mt, _, err := mime.ParseMediaType(rw.headers.Get("content-type"))
+	return err == nil && isProblemJSON(mt)
+}
+
+// isTextMediaType reports whether a response is returned to the model as
+// text content.
+func isTextMediaType(mt string) bool {
+	return strings.HasPrefix(mt, "text/") || contenttypes.IsJSON(mt) || contenttypes.IsYAML(mt) || contenttypes.IsXML(mt)
+}
+
+type embeddedResourceChunk struct {
+	Type     string            `json:"type"`
+	Resource *resourceContents `json:"resource"`
+}
+
+type resourceLinkChunk struct {
+	Type     string `json:"type"`
+	URI      string `json:"uri"`
+	Name     string `json:"name"`
+	MimeType string `json:"mimeType,omitempty"`
+	Size     int    `json:"size"`
+}
+
+// formatBinaryResult returns a response body such as a PDF, spreadsheet or
+// archive as an embedded resource. Bodies over the toolset's threshold are
+// stashed instead and the client receives a link to download them from Gram.
+func formatBinaryResult(ctx context.Context, rw toolCallResponseWriter, mt string, binary binaryResults) (json.RawMessage, error) {
+	body := rw.body.Bytes()
+	filename := responseFilename(rw.headers, binary.toolName, mt)
+
+	if int64(len(body)) <= binary.linkThreshold {
+		bs, err := json.Marshal(embeddedResourceChunk{
+			Type: "resource",
+			Resource: &resourceContents{
+				URI:      "gram://tool-responses/" + url.PathEscape(binary.toolName) + "/" + uuid.NewString() + "/" + url.PathEscape(filename),
+				MimeType: mt,
+				Text:     "",
+				Blob:     base64.StdEncoding.EncodeToString(body),
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("serialize resource content: %w", err)
+		}
+
+		return bs, nil
+	}
+
+	link, err := binary.downloads.stash(ctx, filename, mt, body)
+	if err != nil {
+		return nil, fmt.Errorf("stash %s response: %w", mt, err)
+	}
+
+	if !binary.protocolVersion.supportsResourceLinks() {
+		bs, err := json.Marshal(contentChunk[string, json.RawMessage]{
+			Type:     "text",
+			Text:     fmt.Sprintf("The response is a %d byte %s file named %s. It can be downloaded for the next %s from %s", len(body), mt, filename, downloadLinkTTL, link),
+			MimeType: nil,
+			Data:     nil,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("serialize download link: %w", err)
+		}
+
+		return bs, nil
+	}
+
+	bs, err := json.Marshal(resourceLinkChunk{
+		Type:     "resource_link",
+		URI:      link,
+		Name:     filename,
+		MimeType: mt,
+		Size:     len(body),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("serialize resource link: %w", err)
+	}
+
+	return bs, nil
+}
+
+// responseFilename names a binary response after the filename the upstream
+// suggested in Content-Disposition, falling back to the tool name with an
+// extension matching the media type.
+func responseFilename(headers http.Header, toolName string, mt string) string {
+	if _, params, err := mime.ParseMediaType(headers.Get("content-disposition")); err == nil {
+		if name := path.Base(params["filename"]); name != "" && name != "." && name != "/" {
+			return name
+		}
 	}
+
+	ext := ".bin"
+	if exts, err := mime.ExtensionsByType(mt); err == nil && len(exts) > 0 {
+		ext = exts[0]
+	}
+
+	return toolName + ext
+}
+
+// advertisedOutputSchema returns the output schema that tools/list advertises
+// for a tool. A response filter lets the caller reshape the result and a
+// response budget may cut it short, so the response schema is not advertised
+// for tools with either since their results may not conform to it.
+func advertisedOutputSchema(toolset *types.Toolset, tool *types.HTTPToolDefinition) json.RawMessage {
+	if tool == nil || tool.OutputSchema == nil || tool.ResponseFilter != nil || resolveResponseBudget(toolset, tool) > 0 {
+		return nil
+	}
+
+	return json.RawMessage(*tool.OutputSchema)
+}
+
+// structuredContent returns a JSON or YAML response body as MCP structured
+// content. XML responses arrive here already converted to JSON by the gateway.
+// The text content is still returned for clients that do not read structured
+// content. Bodies that are not objects are nested under
+// mv.WrappedOutputProperty to match the tool's output schema.
+func structuredContent(rw toolCallResponseWriter) json.RawMessage {
+	body := bytes.TrimSpace(rw.body.Bytes())
+	if len(body) == 0 {
+		return nil
+	}
+
+	mt, _, err := mime.ParseMediaType(rw.headers.Get("content-type"))
+	switch {
+	case err != nil:
+		return nil
+	case contenttypes.IsYAML(mt):
+		var doc any
+		if err := yaml.Unmarshal(body, &doc); err != nil {
+			return nil
+		}
+		if body, err = json.Marshal(doc); err != nil {
+			return nil
+		}
+	case !contenttypes.IsJSON(mt) || !json.Valid(body):
+		return nil
+	}
+
+	if body[0] == '{' {
+		return body
+	}
+
+	wrapped, err := json.Marshal(map[string]json.RawMessage{mv.WrappedOutputProperty: body})
+	if err != nil {
+		return nil
+	}
+
+	return wrapped
 }
 
 type contentChunk[T any, D any] struct {
@@ -368,6 +735,7 @@ type contentChunk[T any, D any] struct {
 }
 
 type toolCallResult struct {
-	Content []json.RawMessage `json:"content"`
-	IsError bool              `json:"isError,omitzero"`
+	Content           []json.RawMessage `json:"content"`
+	StructuredContent json.RawMessage   `json:"structuredContent,omitempty"`
+	IsError           bool              `json:"isError,omitzero"`
 }
@@ -0,0 +1,139 @@
+package mcp
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+)
+
+func TestCanSendAssetFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		authenticated bool
+		overrides     map[string]string
+		allowed       bool
+	}{
+		"authenticated":                  {authenticated: true, overrides: map[string]string{}, allowed: true},
+		"unauthenticated":                {authenticated: false, overrides: map[string]string{}, allowed: false},
+		"other variables overridden":     {authenticated: true, overrides: map[string]string{"PETSTORE_API_KEY": "key"}, allowed: true},
+		"server url overridden":          {authenticated: true, overrides: map[string]string{"PETSTORE_SERVER_URL": "https://attacker.example.com"}, allowed: false},
+		"server url overridden any case": {authenticated: true, overrides: map[string]string{"petstore_server_url": "https://attacker.example.com"}, allowed: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := newTestInputs()
+			payload.authenticated = tt.authenticated
+			payload.mcpEnvVariables = tt.overrides
+			require.Equal(t, tt.allowed, canSendAssetFiles(payload, newTestHTTPTool("upload_document")))
+		})
+	}
+}
+
+func TestAdvertisedOutputSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := `{"type":"object","properties":{"id":{"type":"integer"}}}`
+
+	tests := map[string]struct {
+		modify     func(toolset *types.Toolset, tool *types.HTTPToolDefinition)
+		advertised bool
+	}{
+		"output schema": {modify: func(*types.Toolset, *types.HTTPToolDefinition) {}, advertised: true},
+		"no schema":     {modify: func(_ *types.Toolset, tool *types.HTTPToolDefinition) { tool.OutputSchema = nil }, advertised: false},
+		"tool budget":   {modify: func(_ *types.Toolset, tool *types.HTTPToolDefinition) { tool.ResponseBudget = conv.Ptr(int64(1024)) }, advertised: false},
+		"toolset budget": {modify: func(toolset *types.Toolset, _ *types.HTTPToolDefinition) {
+			toolset.ResponseBudget = conv.Ptr(int64(1024))
+		}, advertised: false},
+		"response filter": {modify: func(_ *types.Toolset, tool *types.HTTPToolDefinition) {
+			tool.ResponseFilter = &types.ResponseFilter{Type: "jq", StatusCodes: nil, ContentTypes: nil}
+		}, advertised: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tool := newTestToolDefinition("get_pet")
+			tool.OutputSchema = conv.Ptr(schema)
+			toolset := newTestToolset(tool)
+			tt.modify(toolset, tool)
+
+			if tt.advertised {
+				require.JSONEq(t, schema, string(advertisedOutputSchema(toolset, tool)))
+			} else {
+				require.Nil(t, advertisedOutputSchema(toolset, tool))
+			}
+		})
+	}
+}
+
+func TestStructuredContent(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		contentType string
+		body        string
+		expected    string
+	}{
+		"json object":     {contentType: "application/json", body: `{"id":1}`, expected: `{"id":1}`},
+		"json array":      {contentType: "application/json", body: `[1,2]`, expected: `{"` + mv.WrappedOutputProperty + `":[1,2]}`},
+		"yaml object":     {contentType: "application/yaml", body: "id: 1\nname: Rex\n", expected: `{"id":1,"name":"Rex"}`},
+		"yaml list":       {contentType: "text/yaml", body: "- 1\n- 2\n", expected: `{"` + mv.WrappedOutputProperty + `":[1,2]}`},
+		"truncated json":  {contentType: "application/json", body: `{"id":1,"name":"R`, expected: ""},
+		"unconverted xml": {contentType: "application/xml", body: `<pet><id>1</id></pet>`, expected: ""},
+		"plain text":      {contentType: "text/plain", body: `hello`, expected: ""},
+		"empty":           {contentType: "application/json", body: ``, expected: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rw := toolCallResponseWriter{
+				statusCode: http.StatusOK,
+				headers:    http.Header{"Content-Type": {tt.contentType}},
+				body:       bytes.NewBufferString(tt.body),
+				limit:      0,
+				discarded:  0,
+			}
+
+			got := structuredContent(rw)
+			if tt.expected == "" {
+				require.Nil(t, got)
+			} else {
+				require.JSONEq(t, tt.expected, string(got))
+			}
+		})
+	}
+}
+
+func TestToolCallResponseWriter_Limit(t *testing.T) {
+	t.Parallel()
+
+	rw := &toolCallResponseWriter{
+		statusCode: http.StatusOK,
+		headers:    http.Header{},
+		body:       new(bytes.Buffer),
+		limit:      8,
+		discarded:  0,
+	}
+
+	for _, chunk := range []string{"abcde", "fghij", "klmno"} {
+		n, err := rw.Write([]byte(chunk))
+		require.NoError(t, err)
+		require.Equal(t, len(chunk), n)
+	}
+
+	require.Equal(t, "abcdefgh", rw.body.String())
+	require.Equal(t, int64(7), rw.discarded)
+}
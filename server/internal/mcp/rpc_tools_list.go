@@ -3,10 +3,12 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/speakeasy-api/gram/server/gen/types"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/conv"
@@ -16,24 +18,55 @@ import (
 )
 
 type toolsListResult struct {
-	Tools []*toolListEntry `json:"tools"`
+	Tools      []*toolListEntry `json:"tools"`
+	NextCursor string           `json:"nextCursor,omitempty"`
 }
 
 type toolListEntry struct {
-	Name        string          `json:"name"`
-	Description string          `json:"description"`
-	InputSchema json.RawMessage `json:"inputSchema,omitempty,omitzero"`
+	Name         string           `json:"name"`
+	Title        string           `json:"title,omitempty"`
+	Description  string           `json:"description"`
+	InputSchema  json.RawMessage  `json:"inputSchema,omitempty,omitzero"`
+	OutputSchema json.RawMessage  `json:"outputSchema,omitempty,omitzero"`
+	Annotations  *toolAnnotations `json:"annotations,omitempty"`
 }
 
-func handleToolsList(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, payload *mcpInputs, req *rawRequest, productMetrics *posthog.Posthog) (json.RawMessage, error) {
-	projectID := mv.ProjectID(payload.projectID)
+type toolAnnotations struct {
+	Title           string `json:"title,omitempty"`
+	ReadOnlyHint    *bool  `json:"readOnlyHint,omitempty"`
+	DestructiveHint *bool  `json:"destructiveHint,omitempty"`
+	IdempotentHint  *bool  `json:"idempotentHint,omitempty"`
+	OpenWorldHint   *bool  `json:"openWorldHint,omitempty"`
+}
+
+func newToolAnnotations(annotations *types.ToolAnnotations) *toolAnnotations {
+	if annotations == nil {
+		return nil
+	}
+
+	return &toolAnnotations{
+		Title:           conv.PtrValOr(annotations.Title, ""),
+		ReadOnlyHint:    annotations.ReadOnlyHint,
+		DestructiveHint: annotations.DestructiveHint,
+		IdempotentHint:  annotations.IdempotentHint,
+		OpenWorldHint:   annotations.OpenWorldHint,
+	}
+}
 
-	toolset, err := mv.DescribeToolset(ctx, logger, db, projectID, mv.ToolsetSlug(conv.ToLower(payload.toolset)))
+func handleToolsList(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool, payload *mcpInputs, req *rawRequest, productMetrics *posthog.Posthog, pageSize int) (json.RawMessage, error) {
+	cursor, err := parseListCursor(req)
 	if err != nil {
 		return nil, err
 	}
 
-	if requestContext, _ := contextvalues.GetRequestContext(ctx); requestContext != nil {
+	toolset, err := describeListedToolset(ctx, logger, db, payload, req, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only count the first page so that paginating clients are not counted
+	// once per page.
+	if requestContext, _ := contextvalues.GetRequestContext(ctx); requestContext != nil && cursor == nil {
 		if err := productMetrics.CaptureEvent(ctx, "mcp_server_count", payload.projectID.String(), map[string]interface{}{
 			"project_id":          payload.projectID.String(),
 			"organization_id":     toolset.OrganizationID,
@@ -50,12 +83,36 @@ func handleToolsList(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool,
 	}
 
 	tools := make([]*toolListEntry, 0)
+	if usesDynamicTools(toolset) {
+		entries := dynamicToolListEntries(toolset, payload.protocolVersion)
+		if offersResponsePaging(toolset) {
+			entries = append(entries, readResponseToolListEntry(payload.protocolVersion))
+		}
+		return formatToolsList(ctx, logger, req, entries, "")
+	}
 
 	for _, tool := range toolset.HTTPTools {
+		annotations := newToolAnnotations(tool.Annotations)
+
+		// Tools gained a top-level title in the 2025-06-18 revision. Earlier
+		// revisions only carry it in the annotations.
+		var title string
+		if annotations != nil && payload.protocolVersion.atLeast(protocolVersion20250618) {
+			title = annotations.Title
+		}
+
+		var outputSchema json.RawMessage
+		if payload.protocolVersion.supportsStructuredOutput() {
+			outputSchema = advertisedOutputSchema(toolset, tool)
+		}
+
 		tools = append(tools, &toolListEntry{
-			Name:        tool.Name,
-			Description: tool.Description,
-			InputSchema: json.RawMessage(tool.Schema),
+			Name:         tool.Name,
+			Title:        title,
+			Description:  tool.Description,
+			InputSchema:  json.RawMessage(tool.Schema),
+			OutputSchema: outputSchema,
+			Annotations:  annotations,
 		})
 	}
 
@@ -70,17 +127,37 @@ func handleToolsList(ctx context.Context, logger *slog.Logger, db *pgxpool.Pool,
 				desc = *prompt.Description
 			}
 			tools = append(tools, &toolListEntry{
-				Name:        string(prompt.Name),
-				Description: desc,
-				InputSchema: json.RawMessage(promptArgs),
+				Name:         string(prompt.Name),
+				Title:        "",
+				Description:  desc,
+				InputSchema:  json.RawMessage(promptArgs),
+				OutputSchema: nil,
+				Annotations:  nil,
 			})
 		}
 	}
 
+	if offersResponsePaging(toolset) {
+		tools = append(tools, readResponseToolListEntry(payload.protocolVersion))
+	}
+
+	tools, nextCursor, err := paginate(tools, func(t *toolListEntry) string { return t.Name }, toolsetDeploymentID(toolset), cursor, pageSize)
+	switch {
+	case errors.Is(err, errStaleListCursor):
+		return nil, staleListCursorError(req)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to paginate tools/list response").Log(ctx, logger)
+	}
+
+	return formatToolsList(ctx, logger, req, tools, nextCursor)
+}
+
+func formatToolsList(ctx context.Context, logger *slog.Logger, req *rawRequest, tools []*toolListEntry, nextCursor string) (json.RawMessage, error) {
 	result := &result[toolsListResult]{
 		ID: req.ID,
 		Result: toolsListResult{
-			Tools: tools,
+			Tools:      tools,
+			NextCursor: nextCursor,
 		},
 	}
 
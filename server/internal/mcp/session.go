@@ -0,0 +1,228 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+const (
+	headerSessionID = "Mcp-Session-Id"
+
+	// sessionStateTTL is how long a session and the state attached to it,
+	// such as confirmation approvals, are kept after the client last used it.
+	sessionStateTTL = 24 * time.Hour
+	// sessionTouchInterval limits how often using a session extends its
+	// lifetime so that every request does not write to the cache.
+	sessionTouchInterval = 5 * time.Minute
+)
+
+// errSessionTerminated cancels the requests that are still running when a
+// client ends its session.
+var errSessionTerminated = fmt.Errorf("%w: session terminated", errRequestCancelled)
+
+// session is the server-side record of an MCP session. It is created by
+// initialize and is bound to the MCP server it was created on.
+type session struct {
+	ID              string                     `json:"id"`
+	ProjectID       string                     `json:"project_id"`
+	Toolset         string                     `json:"toolset"`
+	ProtocolVersion protocolVersion            `json:"protocol_version"`
+	ClientInfo      clientInfo                 `json:"client_info"`
+	Capabilities    map[string]json.RawMessage `json:"capabilities"`
+	// LogLevel is the minimum level of log messages the client asked to
+	// receive with logging/setLevel. It is empty until then.
+	LogLevel     loggingLevel `json:"log_level,omitempty"`
+	CreatedAt    time.Time    `json:"created_at"`
+	LastActiveAt time.Time    `json:"last_active_at"`
+}
+
+var _ cache.CacheableObject[session] = (*session)(nil)
+
+func sessionCacheKey(sessionID string) string {
+	return "mcp_session:" + sessionID
+}
+
+func (s session) CacheKey() string {
+	return sessionCacheKey(s.ID)
+}
+
+func (s session) AdditionalCacheKeys() []string {
+	return []string{}
+}
+
+func (s session) TTL() time.Duration {
+	return sessionStateTTL
+}
+
+// hasCapability reports whether the client declared an optional feature when
+// it initialized the session.
+func (s *session) hasCapability(name string) bool {
+	if s == nil {
+		return false
+	}
+
+	_, ok := s.Capabilities[name]
+	return ok
+}
+
+// belongsTo reports whether the session was created on the MCP server that a
+// request is addressed to.
+func (s *session) belongsTo(payload *mcpInputs) bool {
+	return s.ProjectID == payload.projectID.String() && s.Toolset == conv.ToLower(payload.toolset)
+}
+
+// sessionStore persists MCP sessions in the shared cache so that any replica
+// can authenticate a session and answer its requests. Event streams are held
+// by a single replica, see streamHub for the routing they need.
+type sessionStore struct {
+	logger   *slog.Logger
+	sessions cache.TypedCacheObject[session]
+}
+
+func newSessionStore(logger *slog.Logger, cacheImpl cache.Cache) *sessionStore {
+	return &sessionStore{
+		logger:   logger,
+		sessions: cache.NewTypedObjectCache[session](logger.With(attr.SlogCacheNamespace("mcp_session")), cacheImpl, cache.SuffixNone),
+	}
+}
+
+// create starts a new session for the client that sent an initialize
+// request.
+func (s *sessionStore) create(ctx context.Context, payload *mcpInputs, params initializeParams, version protocolVersion) (*session, error) {
+	now := time.Now()
+	sess := &session{
+		ID:              payload.sessionID,
+		ProjectID:       payload.projectID.String(),
+		Toolset:         conv.ToLower(payload.toolset),
+		ProtocolVersion: version,
+		ClientInfo:      params.ClientInfo,
+		Capabilities:    params.Capabilities,
+		LogLevel:        "",
+		CreatedAt:       now,
+		LastActiveAt:    now,
+	}
+
+	if err := s.sessions.Store(ctx, *sess); err != nil {
+		return nil, fmt.Errorf("store session: %w", err)
+	}
+
+	return sess, nil
+}
+
+// load finds the session named in a request's Mcp-Session-Id header. As
+// required by the Streamable HTTP transport, unknown and expired sessions
+// are reported as not found so that the client starts a new one.
+func (s *sessionStore) load(ctx context.Context, headers http.Header, payload *mcpInputs) (*session, error) {
+	sessionID := headers.Get(headerSessionID)
+	if sessionID == "" {
+		return nil, oops.E(oops.CodeBadRequest, nil, "an %s header is required, initialize a session first", headerSessionID)
+	}
+
+	sess, err := s.sessions.Get(ctx, sessionCacheKey(sessionID))
+	if err != nil || !sess.belongsTo(payload) {
+		return nil, oops.E(oops.CodeNotFound, err, "mcp session not found or expired")
+	}
+
+	if now := time.Now(); now.Sub(sess.LastActiveAt) > sessionTouchInterval {
+		sess.LastActiveAt = now
+		if err := s.sessions.Store(ctx, sess); err != nil {
+			s.logger.WarnContext(ctx, "failed to extend mcp session", attr.SlogError(err))
+		}
+	}
+
+	return &sess, nil
+}
+
+func (s *sessionStore) save(ctx context.Context, sess *session) error {
+	if err := s.sessions.Store(ctx, *sess); err != nil {
+		return fmt.Errorf("store session: %w", err)
+	}
+
+	return nil
+}
+
+func (s *sessionStore) delete(ctx context.Context, sess *session) error {
+	if err := s.sessions.Delete(ctx, *sess); err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+
+	return nil
+}
+
+// resolveSession attaches the session to a POST request. Initialize requests
+// start a new session. Other requests without an Mcp-Session-Id header are
+// served without a session, for stateless clients, while those naming one
+// must belong to an existing session.
+func (s *Service) resolveSession(ctx context.Context, r *http.Request, inputs *mcpInputs, batch batchedRawRequest) error {
+	if batch.hasInitialize() {
+		inputs.sessionID = uuid.NewString()
+		return nil
+	}
+
+	if r.Header.Get(headerSessionID) == "" {
+		return nil
+	}
+
+	sess, err := s.sessions.load(ctx, r.Header, inputs)
+	if err != nil {
+		return err
+	}
+
+	inputs.session = sess
+	inputs.sessionID = sess.ID
+
+	// Clients that omit the protocol version header are assumed to speak
+	// the revision negotiated for their session. Those that declare one must
+	// declare that revision.
+	if sess.ProtocolVersion != "" {
+		declared := r.Header.Get(headerProtocolVersion)
+		if declared != "" && protocolVersion(declared) != sess.ProtocolVersion {
+			return oops.E(oops.CodeBadRequest, nil, "%s %s does not match the protocol version %s negotiated for the mcp session", headerProtocolVersion, declared, sess.ProtocolVersion)
+		}
+		inputs.protocolVersion = sess.ProtocolVersion
+	}
+
+	return nil
+}
+
+// terminateSession ends the session named in a DELETE request, aborting its
+// in-flight requests and closing its event streams.
+func (s *Service) terminateSession(ctx context.Context, w http.ResponseWriter, r *http.Request, inputs *mcpInputs) error {
+	sess, err := s.sessions.load(ctx, r.Header, inputs)
+	if err != nil {
+		return err
+	}
+
+	if err := s.sessions.delete(ctx, sess); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to terminate mcp session").Log(ctx, s.logger)
+	}
+
+	s.streams.remove(sess.ID)
+	err = s.relay.Publish(ctx, relay.Message{
+		Kind:      relay.KindTerminate,
+		SessionID: sess.ID,
+		ProjectID: "",
+		Toolset:   "",
+		RequestID: "",
+		Reason:    "",
+		Data:      nil,
+	})
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to relay session termination to other servers", attr.SlogError(err))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,76 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
+)
+
+// ListenForSessionRelay handles messages that other servers relay to the
+// sessions whose state is held on this server, including changes to the
+// toolsets they are connected to. It blocks until ctx is done.
+func (s *Service) ListenForSessionRelay(ctx context.Context) {
+	s.relay.Subscribe(ctx, s.handleRelayed)
+}
+
+func (s *Service) handleRelayed(ctx context.Context, msg relay.Message) {
+	switch msg.Kind {
+	case relay.KindResponse:
+		var res rawRequest
+		if err := json.Unmarshal(msg.Data, &res); err != nil {
+			s.logger.WarnContext(ctx, "discarding malformed relayed response", attr.SlogError(err))
+			return
+		}
+
+		// Every server receives the message but only the one with the
+		// waiting request resolves it.
+		s.confirmations.pending.resolve(msg.SessionID, &res)
+	case relay.KindCancel:
+		if stream, ok := s.streams.lookup(msg.SessionID); ok {
+			stream.cancelRequest(msg.RequestID, cancellationCause(msg.Reason))
+		}
+	case relay.KindTerminate:
+		s.streams.remove(msg.SessionID)
+	case relay.KindToolsListChanged, relay.KindPromptsListChanged:
+		s.notifyListChanged(ctx, msg)
+	case relay.KindResourcesUpdated:
+		s.notifyResourcesUpdated(ctx, msg)
+	default:
+		s.logger.WarnContext(ctx, "discarding relayed message of unknown kind", attr.SlogValueString(string(msg.Kind)))
+	}
+}
+
+// resolveResponse hands a client's response to the request waiting on it. If
+// the request was sent from another server, the response is relayed there.
+func (s *Service) resolveResponse(ctx context.Context, logger *slog.Logger, payload *mcpInputs, res *rawRequest) {
+	if payload.sessionID == "" {
+		logger.WarnContext(ctx, "received response outside of a session", attr.SlogValueString(res.ID.Value()))
+		return
+	}
+
+	if s.confirmations.pending.resolve(payload.sessionID, res) {
+		return
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to marshal response for relay", attr.SlogError(err))
+		return
+	}
+
+	err = s.relay.Publish(ctx, relay.Message{
+		Kind:      relay.KindResponse,
+		SessionID: payload.sessionID,
+		ProjectID: "",
+		Toolset:   "",
+		RequestID: res.ID.Value(),
+		Reason:    "",
+		Data:      data,
+	})
+	if err != nil {
+		logger.WarnContext(ctx, "failed to relay response to other servers", attr.SlogError(err))
+	}
+}
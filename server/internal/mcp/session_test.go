@@ -0,0 +1,87 @@
+package mcp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func TestSessionStore_Load(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+	created := newTestSession(t, s, "f7c1e2b4-session")
+
+	headers := http.Header{}
+	headers.Set(headerSessionID, created.ID)
+	sess, err := s.sessions.load(t.Context(), headers, newTestInputs())
+	require.NoError(t, err)
+	require.Equal(t, created.ID, sess.ID)
+	require.Equal(t, protocolVersion20250618, sess.ProtocolVersion)
+	require.Equal(t, clientInfo{Name: "test", Version: "1.0.0"}, sess.ClientInfo)
+}
+
+func TestSessionStore_Load_Errors(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+	newTestSession(t, s, "f7c1e2b4-session")
+
+	otherToolset := newTestInputs()
+	otherToolset.toolset = "bookstore"
+
+	tests := map[string]struct {
+		sessionID string
+		inputs    *mcpInputs
+		code      oops.Code
+	}{
+		"missing header":             {sessionID: "", inputs: newTestInputs(), code: oops.CodeBadRequest},
+		"unknown session":            {sessionID: "unknown", inputs: newTestInputs(), code: oops.CodeNotFound},
+		"session of another toolset": {sessionID: "f7c1e2b4-session", inputs: otherToolset, code: oops.CodeNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			headers := http.Header{}
+			if tt.sessionID != "" {
+				headers.Set(headerSessionID, tt.sessionID)
+			}
+
+			_, err := s.sessions.load(t.Context(), headers, tt.inputs)
+
+			var oopsErr *oops.ShareableError
+			require.ErrorAs(t, err, &oopsErr)
+			require.Equal(t, tt.code, oopsErr.Code)
+		})
+	}
+}
+
+func TestTerminateSession(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+	sess := newTestSession(t, s, "f7c1e2b4-session")
+
+	terminate := func() (*httptest.ResponseRecorder, error) {
+		r := httptest.NewRequest(http.MethodDelete, "/mcp/petstore", nil)
+		r.Header.Set(headerSessionID, sess.ID)
+		w := httptest.NewRecorder()
+		return w, s.terminateSession(t.Context(), w, r, newTestInputs())
+	}
+
+	w, err := terminate()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, w.Code)
+
+	// The session is gone, so ending it again is reported as not found.
+	_, err = terminate()
+	var oopsErr *oops.ShareableError
+	require.ErrorAs(t, err, &oopsErr)
+	require.Equal(t, oops.CodeNotFound, oopsErr.Code)
+}
@@ -0,0 +1,487 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// streamKeepAliveInterval is how often a comment line is written to idle
+	// event streams so that intermediaries do not close the connection.
+	streamKeepAliveInterval = 25 * time.Second
+	// streamReplayBufferSize is the number of events retained per session so
+	// that a client reconnecting with Last-Event-ID can resume its stream.
+	streamReplayBufferSize = 256
+	// streamIdleTTL is how long a session's stream state is kept after the
+	// last time it was used.
+	streamIdleTTL = 30 * time.Minute
+	// streamListenerBufferSize is the number of events buffered for a
+	// connected GET stream before new events are left pending for replay.
+	streamListenerBufferSize = 64
+)
+
+var errStreamingUnsupported = errors.New("response writer does not support streaming")
+
+// messageSink receives JSON-RPC messages that the server sends to the client
+// outside of a direct response to a request, such as notifications.
+type messageSink interface {
+	send(ctx context.Context, msg json.RawMessage) error
+}
+
+// sendNotification delivers a JSON-RPC notification to the client using
+// whichever stream is associated with the current request. Notifications are
+// dropped if the client has no way of receiving them.
+func sendNotification[T any](ctx context.Context, payload *mcpInputs, method string, params T) error {
+	if payload == nil || payload.messages == nil {
+		return nil
+	}
+
+	bs, err := json.Marshal(notification[T]{Method: method, Params: params})
+	if err != nil {
+		return fmt.Errorf("marshal %s notification: %w", method, err)
+	}
+
+	return payload.messages.send(ctx, bs)
+}
+
+// acceptsEventStream reports whether the client listed text/event-stream in
+// its Accept header.
+func acceptsEventStream(headers http.Header) bool {
+	for _, value := range headers.Values("Accept") {
+		for mediaTypeFull := range strings.SplitSeq(value, ",") {
+			if mediatype, _, err := mime.ParseMediaType(mediaTypeFull); err == nil && mediatype == "text/event-stream" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// sseWriter writes server-sent events to an HTTP response. Headers are only
+// written when the first event is sent so that callers can still fall back
+// to a regular error response if nothing has been streamed yet.
+type sseWriter struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	started bool
+}
+
+func newSSEWriter(w http.ResponseWriter) *sseWriter {
+	return &sseWriter{
+		mu:      sync.Mutex{},
+		w:       w,
+		rc:      http.NewResponseController(w),
+		started: false,
+	}
+}
+
+var _ messageSink = (*sseWriter)(nil)
+
+func (s *sseWriter) send(_ context.Context, msg json.RawMessage) error {
+	return s.writeEvent("", msg)
+}
+
+// Started reports whether any part of the event stream has been written.
+func (s *sseWriter) Started() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.started
+}
+
+// Open writes the event stream headers without sending an event.
+func (s *sseWriter) Open() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.open()
+}
+
+func (s *sseWriter) open() error {
+	if s.started {
+		return nil
+	}
+
+	s.w.Header().Set("Content-Type", "text/event-stream")
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.Header().Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+	s.started = true
+
+	return s.flush()
+}
+
+func (s *sseWriter) writeEvent(id string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if id != "" {
+		fmt.Fprintf(&buf, "id: %s\n", id)
+	}
+	buf.WriteString("event: message\n")
+	for line := range bytes.SplitSeq(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+
+	if _, err := s.w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+
+	return s.flush()
+}
+
+func (s *sseWriter) writeComment(text string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(s.w, ": %s\n\n", text); err != nil {
+		return fmt.Errorf("write comment: %w", err)
+	}
+
+	return s.flush()
+}
+
+func (s *sseWriter) flush() error {
+	if err := s.rc.Flush(); err != nil {
+		if errors.Is(err, http.ErrNotSupported) {
+			return errStreamingUnsupported
+		}
+		return fmt.Errorf("flush event stream: %w", err)
+	}
+
+	return nil
+}
+
+type streamEvent struct {
+	id        uint64
+	data      json.RawMessage
+	delivered bool
+}
+
+// sessionStream holds the server-to-client messages for a single MCP session
+// that are not tied to a specific request. Messages are delivered to the most
+// recently connected GET stream and are retained in a bounded buffer so that
+// clients can resume after a disconnect.
+type sessionStream struct {
+	mu         sync.Mutex
+	seq        uint64
+	events     []*streamEvent
+	listeners  []chan *streamEvent
+	lastActive time.Time
+	// resources holds the URIs the client subscribed to with
+	// resources/subscribe.
+	resources map[string]struct{}
+	// inflight holds the cancel functions of the client's requests that are
+	// still being handled, keyed by JSON-RPC id.
+	inflight map[string]context.CancelCauseFunc
+	// done is closed when the session is terminated.
+	done chan struct{}
+	// projectID and toolset identify the MCP server the session belongs to
+	// so that changes to it can be announced. They are empty until bound.
+	projectID string
+	toolset   string
+}
+
+var _ messageSink = (*sessionStream)(nil)
+
+func (st *sessionStream) send(_ context.Context, msg json.RawMessage) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.seq++
+	ev := &streamEvent{id: st.seq, data: msg, delivered: false}
+	st.events = append(st.events, ev)
+	if len(st.events) > streamReplayBufferSize {
+		st.events = st.events[len(st.events)-streamReplayBufferSize:]
+	}
+	st.lastActive = time.Now()
+
+	if n := len(st.listeners); n > 0 {
+		select {
+		case st.listeners[n-1] <- ev:
+			ev.delivered = true
+		default:
+		}
+	}
+
+	return nil
+}
+
+// subscribe registers a new listener. If lastEventID is non-nil, every
+// retained event after it is replayed. Otherwise only events that were never
+// delivered to a listener are replayed.
+func (st *sessionStream) subscribe(lastEventID *uint64) ([]*streamEvent, <-chan *streamEvent, func()) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	var replay []*streamEvent
+	for _, ev := range st.events {
+		switch {
+		case lastEventID != nil && ev.id > *lastEventID:
+			replay = append(replay, ev)
+		case lastEventID == nil && !ev.delivered:
+			replay = append(replay, ev)
+		}
+	}
+	for _, ev := range replay {
+		ev.delivered = true
+	}
+
+	ch := make(chan *streamEvent, streamListenerBufferSize)
+	st.listeners = append(st.listeners, ch)
+	st.lastActive = time.Now()
+
+	unsubscribe := func() {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		for i, l := range st.listeners {
+			if l == ch {
+				st.listeners = append(st.listeners[:i], st.listeners[i+1:]...)
+				break
+			}
+		}
+		st.lastActive = time.Now()
+	}
+
+	return replay, ch, unsubscribe
+}
+
+func (st *sessionStream) subscribeResource(uri string) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.resources[uri] = struct{}{}
+	st.lastActive = time.Now()
+}
+
+func (st *sessionStream) unsubscribeResource(uri string) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	delete(st.resources, uri)
+	st.lastActive = time.Now()
+}
+
+// subscribedResources returns the URIs the client subscribed to in sorted
+// order.
+func (st *sessionStream) subscribedResources() []string {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(st.resources))
+}
+
+// trackRequest registers a request as in flight until the returned function
+// is called. It reports false, and registers nothing, if a request with the
+// same id is already in flight on the session, since concurrent POSTs could
+// otherwise cancel or untrack each other's requests.
+func (st *sessionStream) trackRequest(id string, cancel context.CancelCauseFunc) (func(), bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, ok := st.inflight[id]; ok {
+		return nil, false
+	}
+
+	st.inflight[id] = cancel
+	st.lastActive = time.Now()
+
+	return func() {
+		st.mu.Lock()
+		defer st.mu.Unlock()
+
+		delete(st.inflight, id)
+	}, true
+}
+
+// cancelRequest aborts an in-flight request. It reports false if no request
+// with that id is running on this server.
+func (st *sessionStream) cancelRequest(id string, cause error) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	cancel, ok := st.inflight[id]
+	if !ok {
+		return false
+	}
+	delete(st.inflight, id)
+
+	cancel(cause)
+	return true
+}
+
+// bind records the MCP server that the session was created on.
+func (st *sessionStream) bind(sess *session) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.projectID = sess.ProjectID
+	st.toolset = sess.Toolset
+}
+
+func (st *sessionStream) boundTo(projectID string, toolset string) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return st.projectID == projectID && (toolset == "" || st.toolset == toolset)
+}
+
+// terminated is closed when the session ends.
+func (st *sessionStream) terminated() <-chan struct{} {
+	return st.done
+}
+
+func (st *sessionStream) idleSince(now time.Time) time.Duration {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if len(st.listeners) > 0 || len(st.inflight) > 0 {
+		return 0
+	}
+
+	return now.Sub(st.lastActive)
+}
+
+// streamHub tracks the session streams that are live on this server. Stream
+// state, including the replay buffer, connected listeners and resource
+// subscriptions, is held in memory. A session's GET stream and its POSTs that
+// do not stream their response must therefore be routed to the same replica,
+// for example by a load balancer that is sticky on Mcp-Session-Id, for
+// notifications and Last-Event-ID resumption to reach the client. Responses
+// to server-initiated requests, cancellations and terminations are relayed
+// to the other replicas and do not depend on it.
+type streamHub struct {
+	mu        sync.Mutex
+	streams   map[string]*sessionStream
+	lastSweep time.Time
+}
+
+func newStreamHub() *streamHub {
+	return &streamHub{
+		mu:        sync.Mutex{},
+		streams:   make(map[string]*sessionStream),
+		lastSweep: time.Now(),
+	}
+}
+
+// stream returns the stream for a session, creating it if necessary.
+func (h *streamHub) stream(sessionID string) *sessionStream {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(h.lastSweep) > streamIdleTTL/2 {
+		for id, st := range h.streams {
+			if st.idleSince(now) > streamIdleTTL {
+				delete(h.streams, id)
+			}
+		}
+		h.lastSweep = now
+	}
+
+	st, ok := h.streams[sessionID]
+	if !ok {
+		st = &sessionStream{
+			mu:         sync.Mutex{},
+			seq:        0,
+			events:     nil,
+			listeners:  nil,
+			lastActive: now,
+			resources:  make(map[string]struct{}),
+			inflight:   make(map[string]context.CancelCauseFunc),
+			done:       make(chan struct{}),
+			projectID:  "",
+			toolset:    "",
+		}
+		h.streams[sessionID] = st
+	}
+
+	return st
+}
+
+// lookup returns the stream for a session if one is live on this server.
+func (h *streamHub) lookup(sessionID string) (*sessionStream, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	st, ok := h.streams[sessionID]
+	return st, ok
+}
+
+// remove discards a terminated session's stream. Requests still running for
+// the session are cancelled and connected event streams are closed.
+func (h *streamHub) remove(sessionID string) {
+	h.mu.Lock()
+	st, ok := h.streams[sessionID]
+	delete(h.streams, sessionID)
+	h.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	for id, cancel := range st.inflight {
+		cancel(errSessionTerminated)
+		delete(st.inflight, id)
+	}
+	close(st.done)
+}
+
+// matching returns the live streams of sessions on a project's MCP servers.
+// An empty toolset matches every toolset in the project.
+func (h *streamHub) matching(projectID string, toolset string) []*sessionStream {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var matches []*sessionStream
+	for _, st := range h.streams {
+		if st.boundTo(projectID, toolset) {
+			matches = append(matches, st)
+		}
+	}
+
+	return matches
+}
+
+func parseLastEventID(headers http.Header) (*uint64, error) {
+	raw := headers.Get("Last-Event-ID")
+	if raw == "" {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse last event id: %w", err)
+	}
+
+	return &id, nil
+}
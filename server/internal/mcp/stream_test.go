@@ -0,0 +1,201 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func sendTestEvents(t *testing.T, st *sessionStream, from, to int) {
+	t.Helper()
+
+	for i := from; i <= to; i++ {
+		require.NoError(t, st.send(t.Context(), json.RawMessage(fmt.Sprintf(`{"n":%d}`, i))))
+	}
+}
+
+func eventIDs(events []*streamEvent) []uint64 {
+	ids := make([]uint64, 0, len(events))
+	for _, ev := range events {
+		ids = append(ids, ev.id)
+	}
+
+	return ids
+}
+
+func TestSessionStream_Subscribe_Replay(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(v uint64) *uint64 { return &v }
+
+	tests := map[string]struct {
+		lastEventID *uint64
+		expected    []uint64
+	}{
+		"without last event id only undelivered events": {lastEventID: nil, expected: []uint64{4, 5}},
+		"resumes after last event id":                   {lastEventID: ptr(2), expected: []uint64{3, 4, 5}},
+		"from the start":                                {lastEventID: ptr(0), expected: []uint64{1, 2, 3, 4, 5}},
+		"nothing missed":                                {lastEventID: ptr(5), expected: []uint64{}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			st := newStreamHub().stream("session")
+
+			// Events 1 to 3 reach a connected listener, 4 and 5 are sent
+			// after it disconnects.
+			_, events, unsubscribe := st.subscribe(nil)
+			sendTestEvents(t, st, 1, 3)
+			for range 3 {
+				<-events
+			}
+			unsubscribe()
+			sendTestEvents(t, st, 4, 5)
+
+			replay, _, unsubscribe := st.subscribe(tt.lastEventID)
+			defer unsubscribe()
+
+			require.Equal(t, tt.expected, eventIDs(replay))
+		})
+	}
+}
+
+func TestSessionStream_Subscribe_ReplaysOnce(t *testing.T) {
+	t.Parallel()
+
+	st := newStreamHub().stream("session")
+	sendTestEvents(t, st, 1, 2)
+
+	replay, _, unsubscribe := st.subscribe(nil)
+	unsubscribe()
+	require.Equal(t, []uint64{1, 2}, eventIDs(replay))
+	require.JSONEq(t, `{"n":1}`, string(replay[0].data))
+
+	// Replayed events count as delivered.
+	replay, _, unsubscribe = st.subscribe(nil)
+	unsubscribe()
+	require.Empty(t, replay)
+}
+
+func TestSessionStream_Subscribe_ReplayBufferIsBounded(t *testing.T) {
+	t.Parallel()
+
+	st := newStreamHub().stream("session")
+	sendTestEvents(t, st, 1, streamReplayBufferSize+10)
+
+	var first uint64
+	replay, _, unsubscribe := st.subscribe(&first)
+	defer unsubscribe()
+
+	require.Len(t, replay, streamReplayBufferSize)
+	require.Equal(t, uint64(11), replay[0].id)
+}
+
+func TestSessionStream_Send_DeliversToLatestListener(t *testing.T) {
+	t.Parallel()
+
+	st := newStreamHub().stream("session")
+
+	_, older, unsubscribeOlder := st.subscribe(nil)
+	defer unsubscribeOlder()
+	_, newer, unsubscribeNewer := st.subscribe(nil)
+	defer unsubscribeNewer()
+
+	sendTestEvents(t, st, 1, 1)
+
+	require.Equal(t, uint64(1), (<-newer).id)
+	require.Empty(t, older)
+}
+
+func TestParseLastEventID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		header   string
+		expected *uint64
+		wantErr  bool
+	}{
+		"absent":  {header: "", expected: nil, wantErr: false},
+		"valid":   {header: "42", expected: func() *uint64 { v := uint64(42); return &v }(), wantErr: false},
+		"invalid": {header: "abc", expected: nil, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			headers := http.Header{}
+			if tt.header != "" {
+				headers.Set("Last-Event-ID", tt.header)
+			}
+
+			id, err := parseLastEventID(headers)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, id)
+		})
+	}
+}
+
+func TestSessionStream_TrackRequest_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	st := newStreamHub().stream("session")
+
+	first, cancelFirst := context.WithCancelCause(t.Context())
+	defer cancelFirst(nil)
+	untrack, ok := st.trackRequest("7", cancelFirst)
+	require.True(t, ok)
+
+	// A second request reusing the id is refused and cannot take over the
+	// first request's entry.
+	_, cancelSecond := context.WithCancelCause(t.Context())
+	defer cancelSecond(nil)
+	_, ok = st.trackRequest("7", cancelSecond)
+	require.False(t, ok)
+
+	require.True(t, st.cancelRequest("7", errRequestCancelled))
+	require.ErrorIs(t, context.Cause(first), errRequestCancelled)
+	untrack()
+
+	// Once the first request is done its id can be used again.
+	untrack, ok = st.trackRequest("7", cancelSecond)
+	require.True(t, ok)
+	untrack()
+}
+
+func TestHandleCancellableRequest_DuplicateID(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+	payload := newTestInputs()
+	payload.sessionID = "session"
+
+	_, cancel := context.WithCancelCause(t.Context())
+	defer cancel(nil)
+	untrack, ok := s.streams.stream(payload.sessionID).trackRequest("7", cancel)
+	require.True(t, ok)
+	defer untrack()
+
+	req := &rawRequest{JSONRPC: "2.0", ID: msgID{format: 1, Number: 7, String: ""}, Method: "ping", Params: nil, Result: nil, Error: nil}
+	_, err := s.handleCancellableRequest(t.Context(), payload, req)
+
+	var rpce *rpcError
+	require.ErrorAs(t, err, &rpce)
+	require.Equal(t, invalidRequest, rpce.Code)
+	require.Equal(t, "7", rpce.ID.Value())
+
+	// Requests in another session may use the same id.
+	payload.sessionID = "other"
+	_, err = s.handleCancellableRequest(t.Context(), payload, req)
+	require.NoError(t, err)
+}
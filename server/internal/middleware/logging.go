@@ -42,6 +42,12 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	return n, nil
 }
 
+// Unwrap allows [http.ResponseController] to reach the underlying writer so
+// that handlers can flush streamed responses.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 func NewHTTPLoggingMiddleware(logger *slog.Logger) func(next http.Handler) http.Handler {
 	logger = logger.With(attr.SlogComponent("http"))
 
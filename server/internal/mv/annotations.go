@@ -0,0 +1,69 @@
+package mv
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+)
+
+// ToolAnnotationsFromColumns collects the annotation overrides stored
+// alongside a tool definition or variation. It returns nil when none are set.
+func ToolAnnotationsFromColumns(title pgtype.Text, readOnly, destructive, idempotent, openWorld pgtype.Bool) *types.ToolAnnotations {
+	if !title.Valid && !readOnly.Valid && !destructive.Valid && !idempotent.Valid && !openWorld.Valid {
+		return nil
+	}
+
+	return &types.ToolAnnotations{
+		Title:           conv.FromPGText[string](title),
+		ReadOnlyHint:    conv.FromPGBool[bool](readOnly),
+		DestructiveHint: conv.FromPGBool[bool](destructive),
+		IdempotentHint:  conv.FromPGBool[bool](idempotent),
+		OpenWorldHint:   conv.FromPGBool[bool](openWorld),
+	}
+}
+
+// ResolveToolAnnotations returns the annotations advertised for an HTTP tool.
+// Hints start out derived from the semantics of the HTTP method and the title
+// defaults to the tool's summary. Each set of overrides is then applied in
+// order so that later ones, such as a variation, take precedence.
+func ResolveToolAnnotations(httpMethod string, summary string, overrides ...*types.ToolAnnotations) *types.ToolAnnotations {
+	annotations := &types.ToolAnnotations{
+		Title:           conv.PtrEmpty(strings.TrimSpace(summary)),
+		ReadOnlyHint:    nil,
+		DestructiveHint: nil,
+		IdempotentHint:  nil,
+		OpenWorldHint:   nil,
+	}
+
+	switch strings.ToUpper(httpMethod) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		annotations.ReadOnlyHint = conv.Ptr(true)
+	case http.MethodDelete:
+		annotations.ReadOnlyHint = conv.Ptr(false)
+		annotations.DestructiveHint = conv.Ptr(true)
+		annotations.IdempotentHint = conv.Ptr(true)
+	case http.MethodPut:
+		annotations.ReadOnlyHint = conv.Ptr(false)
+		annotations.IdempotentHint = conv.Ptr(true)
+	case http.MethodPost, http.MethodPatch:
+		annotations.ReadOnlyHint = conv.Ptr(false)
+	}
+
+	for _, o := range overrides {
+		if o == nil {
+			continue
+		}
+
+		annotations.Title = conv.Default(o.Title, annotations.Title)
+		annotations.ReadOnlyHint = conv.Default(o.ReadOnlyHint, annotations.ReadOnlyHint)
+		annotations.DestructiveHint = conv.Default(o.DestructiveHint, annotations.DestructiveHint)
+		annotations.IdempotentHint = conv.Default(o.IdempotentHint, annotations.IdempotentHint)
+		annotations.OpenWorldHint = conv.Default(o.OpenWorldHint, annotations.OpenWorldHint)
+	}
+
+	return annotations
+}
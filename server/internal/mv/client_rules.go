@@ -0,0 +1,41 @@
+package mv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	tsr "github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+)
+
+const (
+	ClientRuleAllow          = "allow"
+	ClientRuleBlock          = "block"
+	ClientRuleMinimumVersion = "minimum_version"
+)
+
+// DescribeToolsetClientRules lists the rules that decide which MCP clients may
+// use a toolset.
+func DescribeToolsetClientRules(ctx context.Context, repo *tsr.Queries, projectID uuid.UUID, toolset tsr.Toolset) ([]*types.ToolsetClientRule, error) {
+	rows, err := repo.ListToolsetClientRules(ctx, tsr.ListToolsetClientRulesParams{
+		ToolsetID: toolset.ID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list toolset client rules: %w", err)
+	}
+
+	rules := make([]*types.ToolsetClientRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, &types.ToolsetClientRule{
+			ClientName: row.ClientName,
+			Kind:       row.Kind,
+			MinVersion: conv.FromPGText[string](row.MinVersion),
+		})
+	}
+
+	return rules, nil
+}
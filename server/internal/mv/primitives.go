@@ -61,6 +61,18 @@ func SanitizeConfirmPtr(confirm *string) (Confirm, bool) {
 	return SanitizeConfirm(*confirm)
 }
 
+// EffectiveConfirm returns the confirmation mode that is enforced for a tool.
+// Tools without a configured mode run without asking and unrecognized modes
+// are enforced as the strictest one.
+func EffectiveConfirm(confirm string) Confirm {
+	if confirm == "" {
+		return ConfirmNever
+	}
+
+	mode, _ := SanitizeConfirm(confirm)
+	return mode
+}
+
 func (c Confirm) IsValid() bool {
 	_, ok := SanitizeConfirm(string(c))
 	return ok
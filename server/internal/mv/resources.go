@@ -0,0 +1,64 @@
+package mv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	tsr "github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+)
+
+const (
+	ResourceKindAsset        = "asset"
+	ResourceKindHTTPTemplate = "http_template"
+)
+
+// StaticResourceURI is the URI that MCP clients use to read a toolset
+// resource backed by an uploaded asset. It is also the default prefix for the
+// URI template of a resource backed by a GET tool.
+func StaticResourceURI(toolsetSlug string, name string) string {
+	return fmt.Sprintf("gram://%s/%s", toolsetSlug, name)
+}
+
+func describeToolsetResources(ctx context.Context, repo *tsr.Queries, projectID uuid.UUID, toolset tsr.Toolset) ([]*types.ToolsetResource, error) {
+	rows, err := repo.ListToolsetResources(ctx, tsr.ListToolsetResourcesParams{
+		ToolsetID: toolset.ID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list toolset resources: %w", err)
+	}
+
+	resources := make([]*types.ToolsetResource, 0, len(rows))
+	for _, row := range rows {
+		mimeType := conv.FromPGText[string](row.MimeType)
+		if mimeType == nil {
+			mimeType = conv.FromPGText[string](row.AssetContentType)
+		}
+
+		var uri *string
+		if row.Kind == ResourceKindAsset {
+			uri = conv.Ptr(StaticResourceURI(toolset.Slug, row.Name))
+		}
+
+		resources = append(resources, &types.ToolsetResource{
+			ID:           row.ID.String(),
+			Kind:         row.Kind,
+			Name:         types.Slug(row.Name),
+			Description:  conv.FromPGText[string](row.Description),
+			MimeType:     mimeType,
+			URI:          uri,
+			URITemplate:  conv.FromPGText[string](row.UriTemplate),
+			AssetID:      conv.FromNullableUUID(row.AssetID),
+			HTTPToolName: conv.FromPGText[string](row.HttpToolName),
+			CreatedAt:    row.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt:    row.UpdatedAt.Time.Format(time.RFC3339),
+		})
+	}
+
+	return resources, nil
+}
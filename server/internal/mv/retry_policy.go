@@ -0,0 +1,51 @@
+package mv
+
+import (
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/tools/repo/models"
+)
+
+// DescribeToolRetryPolicy converts the retry policy stored alongside a tool
+// definition or variation. It returns nil when no setting is overridden.
+func DescribeToolRetryPolicy(policy *models.RetryPolicy) *types.ToolRetryPolicy {
+	if policy.IsEmpty() {
+		return nil
+	}
+
+	return &types.ToolRetryPolicy{
+		MaxAttempts:           policy.MaxAttempts,
+		StatusCodes:           policy.StatusCodes,
+		Methods:               policy.Methods,
+		RetryConnectionErrors: policy.RetryConnectionErrors,
+		InitialIntervalMs:     policy.InitialIntervalMs,
+		MaxIntervalMs:         policy.MaxIntervalMs,
+		Exponent:              policy.Exponent,
+		MaxElapsedTimeMs:      policy.MaxElapsedTimeMs,
+		TimeoutMs:             policy.TimeoutMs,
+	}
+}
+
+// ToolRetryPolicyModel converts a retry policy submitted through the API to
+// the form stored in the database. It returns nil when no setting is set.
+func ToolRetryPolicyModel(policy *types.ToolRetryPolicy) *models.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	model := &models.RetryPolicy{
+		MaxAttempts:           policy.MaxAttempts,
+		StatusCodes:           policy.StatusCodes,
+		Methods:               policy.Methods,
+		RetryConnectionErrors: policy.RetryConnectionErrors,
+		InitialIntervalMs:     policy.InitialIntervalMs,
+		MaxIntervalMs:         policy.MaxIntervalMs,
+		Exponent:              policy.Exponent,
+		MaxElapsedTimeMs:      policy.MaxElapsedTimeMs,
+		TimeoutMs:             policy.TimeoutMs,
+	}
+	if model.IsEmpty() {
+		return nil
+	}
+
+	return model
+}
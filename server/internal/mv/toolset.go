@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/speakeasy-api/gram/server/gen/types"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/inv"
@@ -19,6 +20,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/oops"
 	org "github.com/speakeasy-api/gram/server/internal/organizations/repo"
 	tr "github.com/speakeasy-api/gram/server/internal/tools/repo"
+	"github.com/speakeasy-api/gram/server/internal/tools/repo/models"
 	"github.com/speakeasy-api/gram/server/internal/tools/security"
 	tsr "github.com/speakeasy-api/gram/server/internal/toolsets/repo"
 	vr "github.com/speakeasy-api/gram/server/internal/variations/repo"
@@ -26,6 +28,23 @@ import (
 
 const DefaultEmptyToolSchema = `{"type":"object","properties":{}}`
 
+// ToolSelectionModeDynamic is the tool selection mode of toolsets that list
+// search, describe and execute meta-tools instead of their own tools.
+const ToolSelectionModeDynamic = "dynamic"
+
+// The meta-tools listed by toolsets in dynamic tool selection mode. A tool in
+// such a toolset cannot share one of their names.
+const (
+	MetaToolSearchTools  = "search_tools"
+	MetaToolDescribeTool = "describe_tool"
+	MetaToolExecuteTool  = "execute_tool"
+)
+
+// WrappedOutputProperty is the property that holds a tool's structured output
+// when the underlying response is not a JSON object. MCP requires structured
+// tool output to be an object so arrays and scalars are nested under it.
+const WrappedOutputProperty = "result"
+
 func DescribeToolsetEntry(
 	ctx context.Context,
 	logger *slog.Logger,
@@ -168,6 +187,31 @@ func DescribeToolset(
 	tx DBTX,
 	projectID ProjectID,
 	toolsetSlug ToolsetSlug,
+) (*types.Toolset, error) {
+	return describeToolset(ctx, logger, tx, projectID, toolsetSlug, uuid.NullUUID{UUID: uuid.Nil, Valid: false})
+}
+
+// DescribeToolsetAtDeployment describes a toolset with the tools of one of
+// the project's deployments instead of its latest one. The toolset's own
+// settings, variations and prompts are always the current ones.
+func DescribeToolsetAtDeployment(
+	ctx context.Context,
+	logger *slog.Logger,
+	tx DBTX,
+	projectID ProjectID,
+	toolsetSlug ToolsetSlug,
+	deploymentID DeploymentID,
+) (*types.Toolset, error) {
+	return describeToolset(ctx, logger, tx, projectID, toolsetSlug, uuid.NullUUID{UUID: uuid.UUID(deploymentID), Valid: true})
+}
+
+func describeToolset(
+	ctx context.Context,
+	logger *slog.Logger,
+	tx DBTX,
+	projectID ProjectID,
+	toolsetSlug ToolsetSlug,
+	deploymentID uuid.NullUUID,
 ) (*types.Toolset, error) {
 	toolsetRepo := tsr.New(tx)
 	orgRepo := org.New(tx)
@@ -202,7 +246,7 @@ func DescribeToolset(
 		definitions, err := toolsRepo.FindToolsByName(ctx, tr.FindToolsByNameParams{
 			ProjectID:    pid,
 			Names:        toolset.HttpToolNames,
-			DeploymentID: uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+			DeploymentID: deploymentID,
 		})
 		if err != nil {
 			return nil, oops.E(oops.CodeUnexpected, err, "failed to list tools in toolset").Log(ctx, logger)
@@ -224,18 +268,21 @@ func DescribeToolset(
 		keyedVariations := make(map[string]types.ToolVariation, len(allVariations))
 		for _, variation := range allVariations {
 			keyedVariations[variation.SrcToolName] = types.ToolVariation{
-				ID:            variation.ID.String(),
-				GroupID:       variation.GroupID.String(),
-				SrcToolName:   variation.SrcToolName,
-				Confirm:       conv.FromPGText[string](variation.Confirm),
-				ConfirmPrompt: conv.FromPGText[string](variation.ConfirmPrompt),
-				Name:          conv.FromPGText[string](variation.Name),
-				Summary:       conv.FromPGText[string](variation.Summary),
-				Description:   conv.FromPGText[string](variation.Description),
-				Tags:          variation.Tags,
-				Summarizer:    conv.FromPGText[string](variation.Summarizer),
-				CreatedAt:     variation.CreatedAt.Time.Format(time.RFC3339),
-				UpdatedAt:     variation.UpdatedAt.Time.Format(time.RFC3339),
+				ID:             variation.ID.String(),
+				GroupID:        variation.GroupID.String(),
+				SrcToolName:    variation.SrcToolName,
+				Confirm:        conv.FromPGText[string](variation.Confirm),
+				ConfirmPrompt:  conv.FromPGText[string](variation.ConfirmPrompt),
+				Name:           conv.FromPGText[string](variation.Name),
+				Summary:        conv.FromPGText[string](variation.Summary),
+				Description:    conv.FromPGText[string](variation.Description),
+				Tags:           variation.Tags,
+				Summarizer:     conv.FromPGText[string](variation.Summarizer),
+				Annotations:    ToolAnnotationsFromColumns(variation.Title, variation.ReadOnlyHint, variation.DestructiveHint, variation.IdempotentHint, variation.OpenWorldHint),
+				ResponseBudget: conv.FromPGInt8(variation.ResponseBudget),
+				RetryPolicy:    DescribeToolRetryPolicy(variation.RetryPolicy),
+				CreatedAt:      variation.CreatedAt.Time.Format(time.RFC3339),
+				UpdatedAt:      variation.UpdatedAt.Time.Format(time.RFC3339),
 			}
 		}
 
@@ -258,6 +305,16 @@ func DescribeToolset(
 			confirmPrompt := conv.FromPGText[string](def.HttpToolDefinition.ConfirmPrompt)
 			summarizer := conv.FromPGText[string](def.HttpToolDefinition.Summarizer)
 			tags := def.HttpToolDefinition.Tags
+			annotations := ToolAnnotationsFromColumns(
+				def.HttpToolDefinition.Title,
+				def.HttpToolDefinition.ReadOnlyHint,
+				def.HttpToolDefinition.DestructiveHint,
+				def.HttpToolDefinition.IdempotentHint,
+				def.HttpToolDefinition.OpenWorldHint,
+			)
+			var variedAnnotations *types.ToolAnnotations
+			responseBudget := conv.FromPGInt8(def.HttpToolDefinition.ResponseBudget)
+			retryPolicy := def.HttpToolDefinition.RetryPolicy
 
 			variations, ok := keyedVariations[def.HttpToolDefinition.Name]
 			if ok {
@@ -270,17 +327,23 @@ func DescribeToolset(
 				if len(variations.Tags) > 0 {
 					tags = variations.Tags
 				}
+				variedAnnotations = variations.Annotations
+				responseBudget = conv.Default(variations.ResponseBudget, responseBudget)
+				retryPolicy = models.MergeRetryPolicies(retryPolicy, ToolRetryPolicyModel(variations.RetryPolicy))
 
 				variation = &variations
 				canonical = &types.CanonicalToolAttributes{
-					VariationID:   variations.ID,
-					Name:          def.HttpToolDefinition.Name,
-					Summary:       conv.PtrEmpty(def.HttpToolDefinition.Summary),
-					Description:   conv.PtrEmpty(def.HttpToolDefinition.Description),
-					Tags:          def.HttpToolDefinition.Tags,
-					Confirm:       conv.FromPGText[string](def.HttpToolDefinition.Confirm),
-					ConfirmPrompt: conv.FromPGText[string](def.HttpToolDefinition.ConfirmPrompt),
-					Summarizer:    conv.FromPGText[string](def.HttpToolDefinition.Summarizer),
+					VariationID:    variations.ID,
+					Name:           def.HttpToolDefinition.Name,
+					Summary:        conv.PtrEmpty(def.HttpToolDefinition.Summary),
+					Description:    conv.PtrEmpty(def.HttpToolDefinition.Description),
+					Tags:           def.HttpToolDefinition.Tags,
+					Confirm:        conv.FromPGText[string](def.HttpToolDefinition.Confirm),
+					ConfirmPrompt:  conv.FromPGText[string](def.HttpToolDefinition.ConfirmPrompt),
+					Summarizer:     conv.FromPGText[string](def.HttpToolDefinition.Summarizer),
+					Annotations:    annotations,
+					ResponseBudget: conv.FromPGInt8(def.HttpToolDefinition.ResponseBudget),
+					RetryPolicy:    DescribeToolRetryPolicy(def.HttpToolDefinition.RetryPolicy),
 				}
 			}
 
@@ -289,7 +352,7 @@ func DescribeToolset(
 				canonicalName = canonical.Name
 			}
 
-			confirm, _ := SanitizeConfirm(confirmRaw)
+			confirm := EffectiveConfirm(confirmRaw)
 
 			var responseFilter *types.ResponseFilter
 			if def.HttpToolDefinition.ResponseFilter != nil {
@@ -320,12 +383,16 @@ func DescribeToolset(
 				Path:                def.HttpToolDefinition.Path,
 				SchemaVersion:       &def.HttpToolDefinition.SchemaVersion,
 				Schema:              string(def.HttpToolDefinition.Schema),
+				OutputSchema:        conv.FromBytes(def.HttpToolDefinition.OutputSchema),
 				CreatedAt:           def.HttpToolDefinition.CreatedAt.Time.Format(time.RFC3339),
 				UpdatedAt:           def.HttpToolDefinition.UpdatedAt.Time.Format(time.RFC3339),
 				Canonical:           canonical,
 				Variation:           variation,
 				PackageName:         &def.PackageName,
 				ResponseFilter:      responseFilter,
+				ResponseBudget:      responseBudget,
+				RetryPolicy:         DescribeToolRetryPolicy(retryPolicy),
+				Annotations:         ResolveToolAnnotations(def.HttpToolDefinition.HttpMethod, summary, annotations, variedAnnotations),
 			}
 
 			if newSchema, err := variedToolSchema(ctx, logger, tool); err == nil {
@@ -388,6 +455,31 @@ func DescribeToolset(
 		})
 	}
 
+	resources, err := describeToolsetResources(ctx, toolsetRepo, pid, toolset)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to get resources for toolset").Log(ctx, logger)
+	}
+
+	clientRules, err := DescribeToolsetClientRules(ctx, toolsetRepo, pid, toolset)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to get client rules for toolset").Log(ctx, logger)
+	}
+
+	var instructions *string
+	var instructionsVersion *int32
+	latestInstructions, err := toolsetRepo.GetLatestToolsetInstructions(ctx, tsr.GetLatestToolsetInstructionsParams{
+		ProjectID: pid,
+		ToolsetID: toolset.ID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to get instructions for toolset").Log(ctx, logger)
+	default:
+		instructions = conv.FromPGText[string](latestInstructions.Content)
+		instructionsVersion = &latestInstructions.Version
+	}
+
 	var externalOAuthServer *types.ExternalOAuthServer
 	var oauthProxyServer *types.OAuthProxyServer
 
@@ -475,10 +567,17 @@ func DescribeToolset(
 		SecurityVariables:      securityVars,
 		ServerVariables:        serverVars,
 		Description:            conv.FromPGText[string](toolset.Description),
+		Instructions:           instructions,
+		InstructionsVersion:    instructionsVersion,
 		HTTPTools:              httpTools,
 		PromptTemplates:        promptTemplates,
+		Resources:              resources,
+		ClientRules:            clientRules,
 		McpSlug:                conv.FromPGText[types.Slug](toolset.McpSlug),
 		McpEnabled:             &toolset.McpEnabled,
+		ToolSelectionMode:      &toolset.ToolSelectionMode,
+		ResourceLinkThreshold:  conv.FromPGInt8(toolset.ResourceLinkThreshold),
+		ResponseBudget:         conv.FromPGInt8(toolset.ResponseBudget),
 		CustomDomainID:         conv.FromNullableUUID(toolset.CustomDomainID),
 		McpIsPublic:            &toolset.McpIsPublic,
 		CreatedAt:              toolset.CreatedAt.Time.Format(time.RFC3339),
@@ -138,12 +138,8 @@ func (e *ShareableError) Log(ctx context.Context, logger *slog.Logger, args ...a
 // code, id, and cause. It also sets the timeout, temporary, and fault flags
 // based on the error code and cause.
 func (e *ShareableError) AsGoa() *goa.ServiceError {
-	var timeout, temporary, fault bool
-
-	var re *retryError
-	if errors.As(e.cause, &re) {
-		temporary = !re.permanent
-	}
+	var timeout, fault bool
+	temporary := e.Temporary()
 
 	switch e.Code {
 	case CodeUnexpected, CodeInvariantViolation:
@@ -157,6 +153,23 @@ func (e *ShareableError) AsGoa() *goa.ServiceError {
 	return goaErr
 }
 
+// ID returns the identifier of the error that is included in logs and in
+// responses sent to clients.
+func (e *ShareableError) ID() string {
+	return e.id
+}
+
+// Temporary reports whether the error was caused by a failure marked as
+// temporary with Temp, meaning that retrying the operation may succeed.
+func (e *ShareableError) Temporary() bool {
+	var re *retryError
+	if errors.As(e.cause, &re) {
+		return !re.permanent
+	}
+
+	return false
+}
+
 // HTTPStatus returns the appropriate HTTP status code for the error based on
 // its code. If the code is not recognized, it defaults to 500 Internal Server
 // Error.
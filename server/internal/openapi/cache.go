@@ -0,0 +1,60 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/speakeasy-api/gram/server/internal/tools/repo/models"
+)
+
+// cacheExtension is the cache key of x-gram. It is either a boolean that opts
+// the operation into response caching driven by the upstream's caching
+// headers, or a mapping that additionally sets a fixed TTL in milliseconds.
+type cacheExtension struct {
+	Enabled bool
+	TTL     *int64
+}
+
+func (c *cacheExtension) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		if err := node.Decode(&c.Enabled); err != nil {
+			return fmt.Errorf("decode cache setting: %w", err)
+		}
+		return nil
+	}
+
+	var settings struct {
+		Enabled *bool  `yaml:"enabled"`
+		TTL     *int64 `yaml:"ttl"`
+	}
+	if err := node.Decode(&settings); err != nil {
+		return fmt.Errorf("decode cache settings: %w", err)
+	}
+
+	c.Enabled = settings.Enabled == nil || *settings.Enabled
+	c.TTL = settings.TTL
+	return nil
+}
+
+// parseCachePolicy returns the response cache policy of an operation or nil
+// when it has not opted into caching. Invalid policies are logged and ignored.
+func parseCachePolicy(ctx context.Context, logger *slog.Logger, op operation, gramExt *gramExtension) *models.CachePolicy {
+	if gramExt == nil || gramExt.Cache == nil || !gramExt.Cache.Enabled {
+		return nil
+	}
+
+	policy := &models.CachePolicy{
+		TTLMs: gramExt.Cache.TTL,
+	}
+
+	if err := policy.Validate(); err != nil {
+		msg := fmt.Sprintf("ignoring invalid cache policy: [%d:%d]: %s", op.gramExtension.Line, op.gramExtension.Column, err.Error())
+		logger.WarnContext(ctx, msg)
+		return nil
+	}
+
+	return policy
+}
@@ -440,10 +440,11 @@ func extractToolDefLibOpenAPI(ctx context.Context, logger *slog.Logger, tx *repo
 	}
 
 	descriptor := parseToolDescriptor(ctx, logger, docInfo, opID, operation{
-		summary:               op.Summary,
-		description:           op.Description,
-		gramExtension:         op.Extensions.GetOrZero("x-gram"),
-		speakeasyMCPExtension: op.Extensions.GetOrZero("x-speakeasy-mcp"),
+		summary:                   op.Summary,
+		description:               op.Description,
+		gramExtension:             op.Extensions.GetOrZero("x-gram"),
+		speakeasyMCPExtension:     op.Extensions.GetOrZero("x-speakeasy-mcp"),
+		speakeasyRetriesExtension: op.Extensions.GetOrZero("x-speakeasy-retries"),
 	})
 
 	responseFilter, responseFilterSchema, err := getResponseFilterLibOpenAPI(ctx, logger, op, descriptor.responseFilterType)
@@ -454,6 +455,18 @@ func extractToolDefLibOpenAPI(ctx context.Context, logger *slog.Logger, tx *repo
 		merged.Properties.Set("responseFilter", json.RawMessage(responseFilterSchema))
 	}
 
+	outputSchema, err := getOutputSchemaLibOpenAPI(ctx, logger, op)
+	if err != nil {
+		logger.WarnContext(ctx, fmt.Sprintf("skipping output schema for %s: %s", opID, err.Error()))
+		outputSchema = nil
+	}
+
+	xmlResponseSchema, err := getXMLResponseSchemaLibOpenAPI(ctx, logger, op)
+	if err != nil {
+		logger.WarnContext(ctx, fmt.Sprintf("skipping xml response schema for %s: %s", opID, err.Error()))
+		xmlResponseSchema = nil
+	}
+
 	var schemaBytes []byte
 	if merged.Properties.Len() > 0 {
 		schemaBytes, err = json.Marshal(merged)
@@ -462,6 +475,20 @@ func extractToolDefLibOpenAPI(ctx context.Context, logger *slog.Logger, tx *repo
 		}
 	}
 
+	var bodySettings []byte
+	if bodyResult.valid && multipartFormDataType.MatchString(bodyResult.contentType) {
+		schemaBytes, bodySettings, err = describeMultipartBody(schemaBytes, bodyResult.encodings)
+		if err != nil {
+			return repo.CreateOpenAPIv3ToolDefinitionParams{}, fmt.Errorf("error describing multipart request body: %w", err)
+		}
+	}
+	if bodyResult.valid && xmlType.MatchString(bodyResult.contentType) {
+		schemaBytes, err = describeXMLBody(schemaBytes, bodyResult.xmlRootName)
+		if err != nil {
+			return repo.CreateOpenAPIv3ToolDefinitionParams{}, fmt.Errorf("error describing xml request body: %w", err)
+		}
+	}
+
 	security, err := serializeSecurityLibOpenAPI(op.Security)
 	if err != nil {
 		low := op.GoLow()
@@ -492,6 +519,14 @@ func extractToolDefLibOpenAPI(ctx context.Context, logger *slog.Logger, tx *repo
 		tags = []string{}
 	}
 
+	annotations := conv.PtrValOr(descriptor.annotations, types.ToolAnnotations{
+		Title:           nil,
+		ReadOnlyHint:    nil,
+		DestructiveHint: nil,
+		IdempotentHint:  nil,
+		OpenWorldHint:   nil,
+	})
+
 	return repo.CreateOpenAPIv3ToolDefinitionParams{
 		ProjectID:           projectID,
 		DeploymentID:        deploymentID,
@@ -507,6 +542,14 @@ func extractToolDefLibOpenAPI(ctx context.Context, logger *slog.Logger, tx *repo
 		Description:         descriptor.description,
 		Confirm:             conv.PtrToPGTextEmpty(confirm),
 		ConfirmPrompt:       conv.PtrToPGTextEmpty(descriptor.confirmPrompt),
+		Title:               conv.PtrToPGTextEmpty(annotations.Title),
+		ReadOnlyHint:        conv.PtrToPGBool(annotations.ReadOnlyHint),
+		DestructiveHint:     conv.PtrToPGBool(annotations.DestructiveHint),
+		IdempotentHint:      conv.PtrToPGBool(annotations.IdempotentHint),
+		OpenWorldHint:       conv.PtrToPGBool(annotations.OpenWorldHint),
+		ResponseBudget:      conv.PtrToPGInt8(descriptor.responseBudget),
+		RetryPolicy:         descriptor.retryPolicy,
+		CachePolicy:         descriptor.cachePolicy,
 		OriginalName:        conv.PtrToPGTextEmpty(descriptor.originalName),
 		OriginalSummary:     conv.PtrToPGTextEmpty(descriptor.originalSummary),
 		OriginalDescription: conv.PtrToPGTextEmpty(descriptor.originalDescription),
@@ -519,7 +562,10 @@ func extractToolDefLibOpenAPI(ctx context.Context, logger *slog.Logger, tx *repo
 		QuerySettings:       querySettings,
 		PathSettings:        pathSettings,
 		RequestContentType:  conv.PtrToPGText(requestContentType),
+		BodySettings:        bodySettings,
 		ResponseFilter:      responseFilter,
+		OutputSchema:        outputSchema,
+		XmlResponseSchema:   xmlResponseSchema,
 	}, nil
 }
 
@@ -528,6 +574,8 @@ type capturedRequestBodyLibOpenAPI struct {
 	schema      []byte
 	required    bool
 	contentType string
+	encodings   map[string]string
+	xmlRootName string
 }
 
 func captureRequestBodyLibOpenAPI(op *v3.Operation) (capturedRequestBodyLibOpenAPI, error) {
@@ -536,6 +584,8 @@ func captureRequestBodyLibOpenAPI(op *v3.Operation) (capturedRequestBodyLibOpenA
 		schema:      nil,
 		required:    false,
 		contentType: "",
+		encodings:   nil,
+		xmlRootName: "",
 	}
 
 	if op.RequestBody == nil || op.RequestBody.Content == nil || op.RequestBody.Content.Len() == 0 {
@@ -571,6 +621,8 @@ func captureRequestBodyLibOpenAPI(op *v3.Operation) (capturedRequestBodyLibOpenA
 			schema:      []byte(`{"type":"object","additionalProperties":true}`),
 			required:    required,
 			contentType: contentType,
+			encodings:   nil,
+			xmlRootName: "",
 		}, nil
 	}
 
@@ -579,11 +631,22 @@ func captureRequestBodyLibOpenAPI(op *v3.Operation) (capturedRequestBodyLibOpenA
 		return empty, fmt.Errorf("failed to extract json schema: %w", err)
 	}
 
+	encodings := make(map[string]string)
+	if spec.Encoding != nil {
+		for name, encoding := range spec.Encoding.FromOldest() {
+			if encoding != nil && encoding.ContentType != "" {
+				encodings[name] = encoding.ContentType
+			}
+		}
+	}
+
 	return capturedRequestBodyLibOpenAPI{
 		valid:       true,
 		schema:      schemaBytes,
 		required:    required,
 		contentType: contentType,
+		encodings:   encodings,
+		xmlRootName: xmlRootName(spec.Schema.GetReference()),
 	}, nil
 }
 
@@ -489,10 +489,11 @@ func extractToolDefSpeakeasy(ctx context.Context, logger *slog.Logger, tx *repo.
 	}
 
 	descriptor := parseToolDescriptor(ctx, logger, docInfo, opID, operation{
-		summary:               op.GetSummary(),
-		description:           op.GetDescription(),
-		gramExtension:         op.GetExtensions().GetOrZero("x-gram"),
-		speakeasyMCPExtension: op.GetExtensions().GetOrZero("x-speakeasy-mcp"),
+		summary:                   op.GetSummary(),
+		description:               op.GetDescription(),
+		gramExtension:             op.GetExtensions().GetOrZero("x-gram"),
+		speakeasyMCPExtension:     op.GetExtensions().GetOrZero("x-speakeasy-mcp"),
+		speakeasyRetriesExtension: op.GetExtensions().GetOrZero("x-speakeasy-retries"),
 	})
 
 	responseFilter, responseFilterSchema, err := getResponseFilterSpeakeasy(ctx, logger, doc, op, descriptor.responseFilterType)
@@ -503,6 +504,18 @@ func extractToolDefSpeakeasy(ctx context.Context, logger *slog.Logger, tx *repo.
 		schema.Properties.Set("responseFilter", responseFilterSchema)
 	}
 
+	outputSchema, err := getOutputSchemaSpeakeasy(ctx, logger, doc, op)
+	if err != nil {
+		logger.WarnContext(ctx, fmt.Sprintf("skipping output schema for %s: %s", opID, err.Error()))
+		outputSchema = nil
+	}
+
+	xmlResponseSchema, err := getXMLResponseSchemaSpeakeasy(ctx, logger, doc, op)
+	if err != nil {
+		logger.WarnContext(ctx, fmt.Sprintf("skipping xml response schema for %s: %s", opID, err.Error()))
+		xmlResponseSchema = nil
+	}
+
 	var schemaBytes bytes.Buffer
 	if schema.Properties.Len() > 0 {
 		ctx = yml.ContextWithConfig(ctx, &yml.Config{
@@ -514,6 +527,21 @@ func extractToolDefSpeakeasy(ctx context.Context, logger *slog.Logger, tx *repo.
 		}
 	}
 
+	toolSchema := schemaBytes.Bytes()
+	var bodySettings []byte
+	if bodyResult.valid && multipartFormDataType.MatchString(bodyResult.contentType) {
+		toolSchema, bodySettings, err = describeMultipartBody(toolSchema, bodyResult.encodings)
+		if err != nil {
+			return empty, fmt.Errorf("error describing multipart request body: %w", err)
+		}
+	}
+	if bodyResult.valid && xmlType.MatchString(bodyResult.contentType) {
+		toolSchema, err = describeXMLBody(toolSchema, bodyResult.xmlRootName)
+		if err != nil {
+			return empty, fmt.Errorf("error describing xml request body: %w", err)
+		}
+	}
+
 	security, err := serializeSecuritySpeakeasy(op.GetSecurity())
 	if err != nil {
 		loc := "-"
@@ -544,6 +572,14 @@ func extractToolDefSpeakeasy(ctx context.Context, logger *slog.Logger, tx *repo.
 		tags = []string{}
 	}
 
+	annotations := conv.PtrValOr(descriptor.annotations, types.ToolAnnotations{
+		Title:           nil,
+		ReadOnlyHint:    nil,
+		DestructiveHint: nil,
+		IdempotentHint:  nil,
+		OpenWorldHint:   nil,
+	})
+
 	return repo.CreateOpenAPIv3ToolDefinitionParams{
 		ProjectID:           projectID,
 		DeploymentID:        deploymentID,
@@ -559,19 +595,30 @@ func extractToolDefSpeakeasy(ctx context.Context, logger *slog.Logger, tx *repo.
 		Description:         descriptor.description,
 		Confirm:             conv.PtrToPGTextEmpty(confirm),
 		ConfirmPrompt:       conv.PtrToPGTextEmpty(descriptor.confirmPrompt),
+		Title:               conv.PtrToPGTextEmpty(annotations.Title),
+		ReadOnlyHint:        conv.PtrToPGBool(annotations.ReadOnlyHint),
+		DestructiveHint:     conv.PtrToPGBool(annotations.DestructiveHint),
+		IdempotentHint:      conv.PtrToPGBool(annotations.IdempotentHint),
+		OpenWorldHint:       conv.PtrToPGBool(annotations.OpenWorldHint),
+		ResponseBudget:      conv.PtrToPGInt8(descriptor.responseBudget),
+		RetryPolicy:         descriptor.retryPolicy,
+		CachePolicy:         descriptor.cachePolicy,
 		OriginalName:        conv.PtrToPGTextEmpty(descriptor.originalName),
 		OriginalSummary:     conv.PtrToPGTextEmpty(descriptor.originalSummary),
 		OriginalDescription: conv.PtrToPGTextEmpty(descriptor.originalDescription),
 		XGram:               pgtype.Bool{Bool: descriptor.xGramFound, Valid: true},
 		SchemaVersion:       "1.0.0",
-		Schema:              schemaBytes.Bytes(),
+		Schema:              toolSchema,
 		ServerEnvVar:        serverEnvVar,
 		DefaultServerUrl:    conv.PtrToPGText(defaultServer),
 		HeaderSettings:      headerSettings,
 		QuerySettings:       querySettings,
 		PathSettings:        pathSettings,
 		RequestContentType:  conv.PtrToPGText(requestContentType),
+		BodySettings:        bodySettings,
 		ResponseFilter:      responseFilter,
+		OutputSchema:        outputSchema,
+		XmlResponseSchema:   xmlResponseSchema,
 	}, nil
 }
 
@@ -582,6 +629,8 @@ type capturedRequestBodySpeakeasy struct {
 	schema      *oas3.JSONSchema[oas3.Referenceable]
 	required    bool
 	contentType string
+	encodings   map[string]string
+	xmlRootName string
 	defs        Defs
 }
 
@@ -618,6 +667,8 @@ func captureRequestBodySpeakeasy(ctx context.Context, doc *openapi.OpenAPI, requ
 			schema:      oas3.NewJSONSchemaFromSchema[oas3.Referenceable](&oas3.Schema{Type: oas3.NewTypeFromString("object"), AdditionalProperties: oas3.NewJSONSchemaFromBool(true)}),
 			required:    required,
 			contentType: contentType,
+			encodings:   nil,
+			xmlRootName: "",
 			defs:        nil,
 		}, nil
 	}
@@ -627,11 +678,20 @@ func captureRequestBodySpeakeasy(ctx context.Context, doc *openapi.OpenAPI, requ
 		return empty, fmt.Errorf("failed to extract json schema: %w", err)
 	}
 
+	encodings := make(map[string]string, spec.GetEncoding().Len())
+	for name, encoding := range spec.GetEncoding().All() {
+		if ct := encoding.GetContentTypeValue(); ct != "" {
+			encodings[name] = ct
+		}
+	}
+
 	return capturedRequestBodySpeakeasy{
 		valid:       true,
 		schema:      schema,
 		required:    required,
 		contentType: contentType,
+		encodings:   encodings,
+		xmlRootName: xmlRootName(string(spec.Schema.GetRef())),
 		defs:        defs,
 	}, nil
 }
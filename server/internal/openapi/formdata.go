@@ -0,0 +1,168 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+)
+
+var multipartFormDataType = regexp.MustCompile(`^multipart/form-data\b`)
+
+// fileInputDescription tells the model how to supply the contents of a file
+// field in a multipart request body.
+const fileInputDescription = "A file to upload. Provide exactly one of `data` with the base64-encoded file contents, `url` with an http or https URL to download the file from, or `asset_id` with the ID of a file uploaded to Gram."
+
+// describeMultipartBody rewrites the file fields in the body of a tool schema
+// so that they accept base64 data, a URL or a Gram asset reference instead of
+// raw binary strings, which a model cannot produce. It returns the rewritten
+// schema along with the encoding settings the gateway needs to assemble the
+// multipart request. encodings maps body properties to the content types set
+// in the media type's encoding object.
+func describeMultipartBody(toolSchema []byte, encodings map[string]string) ([]byte, []byte, error) {
+	var root map[string]any
+	if err := json.Unmarshal(toolSchema, &root); err != nil {
+		return nil, nil, fmt.Errorf("parse tool schema: %w", err)
+	}
+
+	properties, _ := root["properties"].(map[string]any)
+	body, _ := properties["body"].(map[string]any)
+	if ref, ok := body["$ref"].(string); ok {
+		// Schemas that could not be inlined are hoisted into $defs. Copy the
+		// definition so that rewriting it does not affect other references.
+		defs, _ := root["$defs"].(map[string]any)
+		def, _ := defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any)
+		body = maps.Clone(def)
+	}
+
+	fields, _ := body["properties"].(map[string]any)
+	if len(fields) == 0 {
+		return toolSchema, nil, nil
+	}
+	fields = maps.Clone(fields)
+
+	settings := make(map[string]*OpenapiV3EncodingProxy, len(fields))
+	for name, raw := range fields {
+		field, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		setting := &OpenapiV3EncodingProxy{
+			ContentType: encodings[name],
+			File:        false,
+		}
+
+		switch {
+		case isFileSchema(field, setting.ContentType):
+			fields[name] = fileInputSchema(field)
+			setting.File = true
+		case field["type"] == "array":
+			items, ok := field["items"].(map[string]any)
+			if ok && isFileSchema(items, setting.ContentType) {
+				field = maps.Clone(field)
+				field["items"] = fileInputSchema(items)
+				fields[name] = field
+				setting.File = true
+			}
+		}
+
+		if setting.File || setting.ContentType != "" {
+			settings[name] = setting
+		}
+	}
+
+	if len(settings) == 0 {
+		return toolSchema, nil, nil
+	}
+
+	body["properties"] = fields
+	properties["body"] = body
+
+	schema, err := json.Marshal(root)
+	if err != nil {
+		return nil, nil, fmt.Errorf("serialize tool schema: %w", err)
+	}
+
+	settingsBytes, err := json.Marshal(settings)
+	if err != nil {
+		return nil, nil, fmt.Errorf("serialize body settings: %w", err)
+	}
+
+	return schema, settingsBytes, nil
+}
+
+// isFileSchema reports whether a multipart body property carries file
+// contents: a binary string, a string with a content media type, or a string
+// whose part is encoded with a non-text content type.
+func isFileSchema(schema map[string]any, encodingContentType string) bool {
+	if typ, ok := schema["type"]; ok && typ != "string" {
+		return false
+	}
+
+	if schema["format"] == "binary" {
+		return true
+	}
+
+	if _, ok := schema["contentMediaType"]; ok {
+		_, encoded := schema["contentEncoding"]
+		return !encoded
+	}
+
+	if encodingContentType == "" || schema["format"] == "byte" {
+		return false
+	}
+
+	for _, contentType := range strings.Split(encodingContentType, ",") {
+		contentType = strings.TrimSpace(contentType)
+		if !strings.HasPrefix(contentType, "text/") && !strings.Contains(contentType, "json") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func fileInputSchema(original map[string]any) map[string]any {
+	description := fileInputDescription
+	if desc, ok := original["description"].(string); ok && strings.TrimSpace(desc) != "" {
+		description = strings.TrimSpace(desc) + "\n\n" + fileInputDescription
+	}
+
+	contentTypeDescription := "The media type of the file, such as image/png. Detected from the file when omitted."
+	if mediaType, ok := original["contentMediaType"].(string); ok && mediaType != "" {
+		contentTypeDescription = fmt.Sprintf("The media type of the file. Expected to be %s.", mediaType)
+	}
+
+	return map[string]any{
+		"type":        "object",
+		"description": description,
+		"properties": map[string]any{
+			"data": map[string]any{
+				"type":            "string",
+				"contentEncoding": "base64",
+				"description":     "The file contents encoded as base64",
+			},
+			"url": map[string]any{
+				"type":        "string",
+				"format":      "uri",
+				"description": "An http or https URL to download the file from",
+			},
+			"asset_id": map[string]any{
+				"type":        "string",
+				"format":      "uuid",
+				"description": "The ID of a file uploaded to Gram",
+			},
+			"filename": map[string]any{
+				"type":        "string",
+				"description": "The file name to send with the upload",
+			},
+			"content_type": map[string]any{
+				"type":        "string",
+				"description": contentTypeDescription,
+			},
+		},
+		"additionalProperties": false,
+	}
+}
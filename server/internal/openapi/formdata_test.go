@@ -0,0 +1,114 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeMultipartBody(t *testing.T) {
+	t.Parallel()
+
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"body": {
+				"type": "object",
+				"properties": {
+					"title": {"type": "string"},
+					"metadata": {"type": "object"},
+					"avatar": {"type": "string", "format": "binary", "description": "Profile picture"},
+					"attachments": {"type": "array", "items": {"type": "string", "format": "binary"}},
+					"scan": {"type": "string"},
+					"checksum": {"type": "string", "format": "byte"}
+				}
+			}
+		}
+	}`)
+
+	rewritten, settings, err := describeMultipartBody(schema, map[string]string{
+		"metadata": "application/json",
+		"scan":     "image/png, image/jpeg",
+	})
+	require.NoError(t, err)
+
+	var parsedSettings map[string]*OpenapiV3EncodingProxy
+	require.NoError(t, json.Unmarshal(settings, &parsedSettings))
+	require.Equal(t, map[string]*OpenapiV3EncodingProxy{
+		"metadata":    {ContentType: "application/json", File: false},
+		"avatar":      {ContentType: "", File: true},
+		"attachments": {ContentType: "", File: true},
+		"scan":        {ContentType: "image/png, image/jpeg", File: true},
+	}, parsedSettings)
+
+	var parsed struct {
+		Properties struct {
+			Body struct {
+				Properties map[string]map[string]any `json:"properties"`
+			} `json:"body"`
+		} `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal(rewritten, &parsed))
+	fields := parsed.Properties.Body.Properties
+
+	require.Equal(t, map[string]any{"type": "string"}, fields["title"])
+	require.Equal(t, map[string]any{"type": "string", "format": "byte"}, fields["checksum"])
+
+	require.Equal(t, "object", fields["avatar"]["type"])
+	require.Contains(t, fields["avatar"]["description"], "Profile picture")
+	require.Contains(t, fields["avatar"]["description"], fileInputDescription)
+	require.Contains(t, fields["avatar"]["properties"], "data")
+	require.Contains(t, fields["avatar"]["properties"], "url")
+	require.Contains(t, fields["avatar"]["properties"], "asset_id")
+
+	items, ok := fields["attachments"]["items"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "object", items["type"])
+	require.Equal(t, "object", fields["scan"]["type"])
+}
+
+func TestDescribeMultipartBody_Refs(t *testing.T) {
+	t.Parallel()
+
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"body": {"$ref": "#/$defs/Upload"}
+		},
+		"$defs": {
+			"Upload": {
+				"type": "object",
+				"properties": {
+					"file": {"type": "string", "contentMediaType": "application/pdf"}
+				}
+			}
+		}
+	}`)
+
+	rewritten, settings, err := describeMultipartBody(schema, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"file":{"file":true}}`, string(settings))
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal(rewritten, &parsed))
+
+	body := parsed["properties"].(map[string]any)["body"].(map[string]any)
+	file := body["properties"].(map[string]any)["file"].(map[string]any)
+	require.Equal(t, "object", file["type"])
+
+	def := parsed["$defs"].(map[string]any)["Upload"].(map[string]any)
+	original := def["properties"].(map[string]any)["file"].(map[string]any)
+	require.Equal(t, "string", original["type"], "shared definitions should not be rewritten")
+}
+
+func TestDescribeMultipartBody_NoFiles(t *testing.T) {
+	t.Parallel()
+
+	schema := []byte(`{"type":"object","properties":{"body":{"type":"object","properties":{"name":{"type":"string"}}}}}`)
+
+	rewritten, settings, err := describeMultipartBody(schema, nil)
+	require.NoError(t, err)
+	require.Nil(t, settings)
+	require.Equal(t, schema, rewritten)
+}
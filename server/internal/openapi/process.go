@@ -9,7 +9,9 @@ import (
 	"log/slog"
 	"net/url"
 	"regexp"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/ettle/strcase"
 	"github.com/google/uuid"
@@ -75,7 +77,8 @@ func (e *ProcessError) Unwrap() error {
 var preferredRequestTypes = []*regexp.Regexp{
 	regexp.MustCompile(`\bjson\b`),
 	regexp.MustCompile(`^application/x-www-form-urlencoded\b`),
-	regexp.MustCompile(`^multipart/form-data\b`),
+	multipartFormDataType,
+	xmlType,
 	regexp.MustCompile(`^text/`),
 }
 
@@ -255,10 +258,11 @@ type operationMetadata[T any] struct {
 }
 
 type operation struct {
-	summary               string
-	description           string
-	gramExtension         *yaml.Node
-	speakeasyMCPExtension *yaml.Node
+	summary                   string
+	description               string
+	gramExtension             *yaml.Node
+	speakeasyMCPExtension     *yaml.Node
+	speakeasyRetriesExtension *yaml.Node
 }
 
 type gramExtension struct {
@@ -268,6 +272,23 @@ type gramExtension struct {
 	Summary            *string            `yaml:"summary"`
 	Description        *string            `yaml:"description"`
 	ResponseFilterType *models.FilterType `yaml:"responseFilterType"`
+	Annotations        *gramAnnotations   `yaml:"annotations"`
+	ResponseBudget     *int64             `yaml:"responseBudget"`
+	Retries            *retriesExtension  `yaml:"retries"`
+	// Timeout bounds a single attempt at calling the operation, in
+	// milliseconds.
+	Timeout *int64          `yaml:"timeout"`
+	Cache   *cacheExtension `yaml:"cache"`
+}
+
+// gramAnnotations overrides the MCP tool annotations that would otherwise be
+// derived from an operation's HTTP method.
+type gramAnnotations struct {
+	Title           *string `yaml:"title"`
+	ReadOnlyHint    *bool   `yaml:"readOnlyHint"`
+	DestructiveHint *bool   `yaml:"destructiveHint"`
+	IdempotentHint  *bool   `yaml:"idempotentHint"`
+	OpenWorldHint   *bool   `yaml:"openWorldHint"`
 }
 
 type speakeasyExtension struct {
@@ -288,6 +309,10 @@ type toolDescriptor struct {
 	originalSummary     *string
 	originalDescription *string
 	responseFilterType  *models.FilterType
+	annotations         *types.ToolAnnotations
+	responseBudget      *int64
+	retryPolicy         *models.RetryPolicy
+	cachePolicy         *models.CachePolicy
 }
 
 func parseToolDescriptor(ctx context.Context, logger *slog.Logger, docInfo *types.OpenAPIv3DeploymentAsset, opID string, op operation) toolDescriptor {
@@ -303,7 +328,7 @@ func parseToolDescriptor(ctx context.Context, logger *slog.Logger, docInfo *type
 	toolDesc := toolDescriptor{
 		xGramFound:          false,
 		xSpeakeasyMCPFound:  false,
-		confirm:             conv.Ptr(mv.ConfirmAlways),
+		confirm:             nil,
 		confirmPrompt:       nil,
 		name:                name,
 		untruncatedName:     untruncatedName,
@@ -313,6 +338,10 @@ func parseToolDescriptor(ctx context.Context, logger *slog.Logger, docInfo *type
 		originalSummary:     nil,
 		originalDescription: nil,
 		responseFilterType:  nil,
+		annotations:         nil,
+		responseBudget:      nil,
+		retryPolicy:         nil,
+		cachePolicy:         nil,
 	}
 
 	var xgram, xspeakeasy bool
@@ -337,9 +366,18 @@ func parseToolDescriptor(ctx context.Context, logger *slog.Logger, docInfo *type
 		}
 	}
 
+	if xgram {
+		toolDesc.retryPolicy = parseRetryPolicy(ctx, logger, op, &gramExt)
+		toolDesc.cachePolicy = parseCachePolicy(ctx, logger, op, &gramExt)
+	} else {
+		toolDesc.retryPolicy = parseRetryPolicy(ctx, logger, op, nil)
+	}
+
 	var extLine, extColumn int
 	var customName, customSummary, customDescription, customConfirm, customConfirmPrompt *string
 	var responseFilterType *models.FilterType
+	var annotations *types.ToolAnnotations
+	var responseBudget *int64
 	switch {
 	case xgram:
 		extLine, extColumn = op.gramExtension.Line, op.gramExtension.Column
@@ -349,6 +387,26 @@ func parseToolDescriptor(ctx context.Context, logger *slog.Logger, docInfo *type
 		customConfirm = gramExt.Confirm
 		customConfirmPrompt = gramExt.ConfirmPrompt
 		responseFilterType = gramExt.ResponseFilterType
+		if gramExt.Annotations != nil {
+			annotations = &types.ToolAnnotations{
+				Title:           conv.PtrEmpty(strings.TrimSpace(conv.PtrValOr(gramExt.Annotations.Title, ""))),
+				ReadOnlyHint:    gramExt.Annotations.ReadOnlyHint,
+				DestructiveHint: gramExt.Annotations.DestructiveHint,
+				IdempotentHint:  gramExt.Annotations.IdempotentHint,
+				OpenWorldHint:   gramExt.Annotations.OpenWorldHint,
+			}
+			if annotations.Title != nil && utf8.RuneCountInString(*annotations.Title) > 100 {
+				msg := fmt.Sprintf("ignoring tool title longer than 100 characters: [%d:%d]", extLine, extColumn)
+				logger.WarnContext(ctx, msg)
+				annotations.Title = nil
+			}
+		}
+		responseBudget = gramExt.ResponseBudget
+		if responseBudget != nil && *responseBudget <= 0 {
+			msg := fmt.Sprintf("ignoring non-positive tool response budget: [%d:%d]: %d", extLine, extColumn, *responseBudget)
+			logger.WarnContext(ctx, msg)
+			responseBudget = nil
+		}
 	case xspeakeasy:
 		extLine, extColumn = op.speakeasyMCPExtension.Line, op.speakeasyMCPExtension.Column
 		customName = speakeasyExt.Name
@@ -368,11 +426,17 @@ func parseToolDescriptor(ctx context.Context, logger *slog.Logger, docInfo *type
 
 	sanitizedName := strcase.ToSnake(tools.SanitizeName(conv.PtrValOr(customName, "")))
 
-	confirm, valid := mv.SanitizeConfirmPtr(customConfirm)
-	if !valid {
-		msg := fmt.Sprintf("invalid tool confirmation mode: [%d:%d]: %v", extLine, extColumn, customConfirm)
-		logger.WarnContext(ctx, msg)
-		confirm = mv.ConfirmAlways
+	// Only store a confirmation mode when one is configured. The MCP server
+	// only enforces modes that are stored on a tool or its variations.
+	var confirm *mv.Confirm
+	if customConfirm != nil {
+		sanitized, valid := mv.SanitizeConfirm(*customConfirm)
+		if !valid {
+			msg := fmt.Sprintf("invalid tool confirmation mode: [%d:%d]: %v", extLine, extColumn, *customConfirm)
+			logger.WarnContext(ctx, msg)
+			sanitized = mv.ConfirmAlways
+		}
+		confirm = &sanitized
 	}
 
 	return toolDescriptor{
@@ -385,8 +449,12 @@ func parseToolDescriptor(ctx context.Context, logger *slog.Logger, docInfo *type
 		originalName:        conv.PtrEmpty(name),
 		originalSummary:     conv.PtrEmpty(summary),
 		originalDescription: conv.PtrEmpty(description),
-		confirm:             conv.Ptr(confirm),
+		confirm:             confirm,
 		confirmPrompt:       customConfirmPrompt,
 		responseFilterType:  responseFilterType,
+		annotations:         annotations,
+		responseBudget:      responseBudget,
+		retryPolicy:         toolDesc.retryPolicy,
+		cachePolicy:         toolDesc.cachePolicy,
 	}
 }
@@ -1,6 +1,7 @@
 package openapi
 
 import (
+	"log/slog"
 	"strings"
 	"testing"
 
@@ -8,6 +9,12 @@ import (
 	"github.com/pb33f/libopenapi/datamodel"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/tools/repo/models"
 )
 
 func TestExtractJSONSchemaFromYaml_ExtractAndInlineLocalRef(t *testing.T) {
@@ -382,3 +389,413 @@ func TestConstraintLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestParseToolDescriptor_Annotations(t *testing.T) {
+	t.Parallel()
+
+	docInfo := &types.OpenAPIv3DeploymentAsset{
+		ID:      "doc-id",
+		AssetID: "asset-id",
+		Name:    "Petstore",
+		Slug:    "petstore",
+	}
+
+	tests := []struct {
+		name     string
+		xgram    string
+		expected *types.ToolAnnotations
+	}{
+		{
+			name:     "no annotations",
+			xgram:    `name: search_pets`,
+			expected: nil,
+		},
+		{
+			name: "all annotations",
+			xgram: `
+annotations:
+  title: Search pets
+  readOnlyHint: true
+  destructiveHint: false
+  idempotentHint: true
+  openWorldHint: false
+`,
+			expected: &types.ToolAnnotations{
+				Title:           conv.Ptr("Search pets"),
+				ReadOnlyHint:    conv.Ptr(true),
+				DestructiveHint: conv.Ptr(false),
+				IdempotentHint:  conv.Ptr(true),
+				OpenWorldHint:   conv.Ptr(false),
+			},
+		},
+		{
+			name: "overlong title is ignored",
+			xgram: `
+annotations:
+  title: ` + strings.Repeat("a", 101) + `
+  readOnlyHint: true
+`,
+			expected: &types.ToolAnnotations{
+				Title:           nil,
+				ReadOnlyHint:    conv.Ptr(true),
+				DestructiveHint: nil,
+				IdempotentHint:  nil,
+				OpenWorldHint:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc yaml.Node
+			require.NoError(t, yaml.Unmarshal([]byte(tt.xgram), &doc))
+
+			descriptor := parseToolDescriptor(t.Context(), slog.New(slog.DiscardHandler), docInfo, "searchPets", operation{
+				summary:                   "Search for pets",
+				description:               "",
+				gramExtension:             doc.Content[0],
+				speakeasyMCPExtension:     nil,
+				speakeasyRetriesExtension: nil,
+			})
+
+			require.True(t, descriptor.xGramFound)
+			require.Equal(t, tt.expected, descriptor.annotations)
+		})
+	}
+}
+
+func TestParseToolDescriptor_Confirm(t *testing.T) {
+	t.Parallel()
+
+	docInfo := &types.OpenAPIv3DeploymentAsset{
+		ID:      "doc-id",
+		AssetID: "asset-id",
+		Name:    "Petstore",
+		Slug:    "petstore",
+	}
+
+	tests := []struct {
+		name     string
+		xgram    string
+		expected *mv.Confirm
+	}{
+		{
+			name:     "unset",
+			xgram:    `name: delete_pet`,
+			expected: nil,
+		},
+		{
+			name:     "always without a prompt",
+			xgram:    `confirm: always`,
+			expected: conv.Ptr(mv.ConfirmAlways),
+		},
+		{
+			name:     "session",
+			xgram:    `confirm: session`,
+			expected: conv.Ptr(mv.ConfirmSession),
+		},
+		{
+			name:     "never",
+			xgram:    `confirm: NEVER`,
+			expected: conv.Ptr(mv.ConfirmNever),
+		},
+		{
+			name:     "invalid falls back to always",
+			xgram:    `confirm: sometimes`,
+			expected: conv.Ptr(mv.ConfirmAlways),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc yaml.Node
+			require.NoError(t, yaml.Unmarshal([]byte(tt.xgram), &doc))
+
+			descriptor := parseToolDescriptor(t.Context(), slog.New(slog.DiscardHandler), docInfo, "deletePet", operation{
+				summary:                   "Delete a pet",
+				description:               "",
+				gramExtension:             doc.Content[0],
+				speakeasyMCPExtension:     nil,
+				speakeasyRetriesExtension: nil,
+			})
+
+			require.True(t, descriptor.xGramFound)
+			require.Equal(t, tt.expected, descriptor.confirm)
+		})
+	}
+}
+
+func TestParseToolDescriptor_ResponseBudget(t *testing.T) {
+	t.Parallel()
+
+	docInfo := &types.OpenAPIv3DeploymentAsset{
+		ID:      "doc-id",
+		AssetID: "asset-id",
+		Name:    "Petstore",
+		Slug:    "petstore",
+	}
+
+	tests := []struct {
+		name     string
+		xgram    string
+		expected *int64
+	}{
+		{
+			name:     "unset",
+			xgram:    `name: list_pets`,
+			expected: nil,
+		},
+		{
+			name:     "set",
+			xgram:    `responseBudget: 65536`,
+			expected: conv.Ptr(int64(65536)),
+		},
+		{
+			name:     "non-positive is ignored",
+			xgram:    `responseBudget: 0`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc yaml.Node
+			require.NoError(t, yaml.Unmarshal([]byte(tt.xgram), &doc))
+
+			descriptor := parseToolDescriptor(t.Context(), slog.New(slog.DiscardHandler), docInfo, "listPets", operation{
+				summary:                   "List pets",
+				description:               "",
+				gramExtension:             doc.Content[0],
+				speakeasyMCPExtension:     nil,
+				speakeasyRetriesExtension: nil,
+			})
+
+			require.True(t, descriptor.xGramFound)
+			require.Equal(t, tt.expected, descriptor.responseBudget)
+		})
+	}
+}
+
+func TestParseToolDescriptor_RetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	docInfo := &types.OpenAPIv3DeploymentAsset{
+		ID:      "doc-id",
+		AssetID: "asset-id",
+		Name:    "Reports",
+		Slug:    "reports",
+	}
+
+	tests := []struct {
+		name     string
+		xgram    string
+		xretries string
+		expected *models.RetryPolicy
+	}{
+		{
+			name:     "unset",
+			xgram:    `name: run_report`,
+			xretries: "",
+			expected: nil,
+		},
+		{
+			name: "x-gram retries and timeout",
+			xgram: `
+timeout: 300000
+retries:
+  maxAttempts: 4
+  methods: [post]
+  statusCodes: [429, 5xx]
+  retryConnectionErrors: false
+  backoff:
+    initialInterval: 1000
+    maxInterval: 30000
+    maxElapsedTime: 600000
+    exponent: 1.5
+`,
+			xretries: "",
+			expected: &models.RetryPolicy{
+				MaxAttempts:           conv.Ptr(4),
+				StatusCodes:           []string{"429", "5XX"},
+				Methods:               []string{"POST"},
+				RetryConnectionErrors: conv.Ptr(false),
+				InitialIntervalMs:     conv.Ptr(int64(1000)),
+				MaxIntervalMs:         conv.Ptr(int64(30000)),
+				Exponent:              conv.Ptr(1.5),
+				MaxElapsedTimeMs:      conv.Ptr(int64(600000)),
+				TimeoutMs:             conv.Ptr(int64(300000)),
+			},
+		},
+		{
+			name:  "x-speakeasy-retries with x-gram timeout",
+			xgram: `timeout: 120000`,
+			xretries: `
+strategy: backoff
+backoff:
+  initialInterval: 500
+  maxInterval: 60000
+  maxElapsedTime: 900000
+  exponent: 2
+statusCodes: ["5XX"]
+retryConnectionErrors: true
+`,
+			expected: &models.RetryPolicy{
+				MaxAttempts:           nil,
+				StatusCodes:           []string{"5XX"},
+				Methods:               nil,
+				RetryConnectionErrors: conv.Ptr(true),
+				InitialIntervalMs:     conv.Ptr(int64(500)),
+				MaxIntervalMs:         conv.Ptr(int64(60000)),
+				Exponent:              conv.Ptr(2.0),
+				MaxElapsedTimeMs:      conv.Ptr(int64(900000)),
+				TimeoutMs:             conv.Ptr(int64(120000)),
+			},
+		},
+		{
+			name:     "x-speakeasy-retries strategy none disables retries",
+			xgram:    "",
+			xretries: `strategy: none`,
+			expected: &models.RetryPolicy{
+				MaxAttempts:           conv.Ptr(1),
+				StatusCodes:           nil,
+				Methods:               nil,
+				RetryConnectionErrors: nil,
+				InitialIntervalMs:     nil,
+				MaxIntervalMs:         nil,
+				Exponent:              nil,
+				MaxElapsedTimeMs:      nil,
+				TimeoutMs:             nil,
+			},
+		},
+		{
+			name:     "x-gram retries take precedence",
+			xgram:    "retries:\n  maxAttempts: 2",
+			xretries: `strategy: none`,
+			expected: &models.RetryPolicy{
+				MaxAttempts:           conv.Ptr(2),
+				StatusCodes:           nil,
+				Methods:               nil,
+				RetryConnectionErrors: nil,
+				InitialIntervalMs:     nil,
+				MaxIntervalMs:         nil,
+				Exponent:              nil,
+				MaxElapsedTimeMs:      nil,
+				TimeoutMs:             nil,
+			},
+		},
+		{
+			name:     "invalid policy is ignored",
+			xgram:    "retries:\n  maxAttempts: 50",
+			xretries: "",
+			expected: nil,
+		},
+		{
+			name:     "unsupported strategy is ignored",
+			xgram:    "",
+			xretries: `strategy: jitter`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			op := operation{
+				summary:                   "Run report",
+				description:               "",
+				gramExtension:             nil,
+				speakeasyMCPExtension:     nil,
+				speakeasyRetriesExtension: nil,
+			}
+			if tt.xgram != "" {
+				var doc yaml.Node
+				require.NoError(t, yaml.Unmarshal([]byte(tt.xgram), &doc))
+				op.gramExtension = doc.Content[0]
+			}
+			if tt.xretries != "" {
+				var doc yaml.Node
+				require.NoError(t, yaml.Unmarshal([]byte(tt.xretries), &doc))
+				op.speakeasyRetriesExtension = doc.Content[0]
+			}
+
+			descriptor := parseToolDescriptor(t.Context(), slog.New(slog.DiscardHandler), docInfo, "runReport", op)
+
+			require.Equal(t, tt.expected, descriptor.retryPolicy)
+		})
+	}
+}
+
+func TestParseToolDescriptor_CachePolicy(t *testing.T) {
+	t.Parallel()
+
+	docInfo := &types.OpenAPIv3DeploymentAsset{
+		ID:      "doc-id",
+		AssetID: "asset-id",
+		Name:    "Petstore",
+		Slug:    "petstore",
+	}
+
+	tests := []struct {
+		name     string
+		xgram    string
+		expected *models.CachePolicy
+	}{
+		{
+			name:     "unset",
+			xgram:    `name: get_pet`,
+			expected: nil,
+		},
+		{
+			name:     "enabled",
+			xgram:    `cache: true`,
+			expected: &models.CachePolicy{TTLMs: nil},
+		},
+		{
+			name:     "disabled",
+			xgram:    `cache: false`,
+			expected: nil,
+		},
+		{
+			name:     "ttl",
+			xgram:    "cache:\n  ttl: 60000",
+			expected: &models.CachePolicy{TTLMs: conv.Ptr(int64(60000))},
+		},
+		{
+			name:     "disabled with ttl",
+			xgram:    "cache:\n  enabled: false\n  ttl: 60000",
+			expected: nil,
+		},
+		{
+			name:     "ttl out of range is ignored",
+			xgram:    "cache:\n  ttl: 0",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc yaml.Node
+			require.NoError(t, yaml.Unmarshal([]byte(tt.xgram), &doc))
+
+			descriptor := parseToolDescriptor(t.Context(), slog.New(slog.DiscardHandler), docInfo, "getPet", operation{
+				summary:                   "Get a pet",
+				description:               "",
+				gramExtension:             doc.Content[0],
+				speakeasyMCPExtension:     nil,
+				speakeasyRetriesExtension: nil,
+			})
+
+			require.True(t, descriptor.xGramFound)
+			require.Equal(t, tt.expected, descriptor.cachePolicy)
+		})
+	}
+}
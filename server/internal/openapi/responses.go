@@ -1,7 +1,11 @@
 package openapi
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/speakeasy-api/gram/server/internal/contenttypes"
+	"github.com/speakeasy-api/gram/server/internal/mv"
 )
 
 const responseFilterSchema = `{
@@ -50,3 +54,62 @@ func contentTypeSpecificity(contentType string) int {
 		return 100
 	}
 }
+
+// isStructuredResponse reports whether responses with the given content type
+// can be decoded into JSON, either natively or, for XML, by the gateway. Only
+// these responses describe a tool's output schema and response filter.
+func isStructuredResponse(contentType string) bool {
+	return contenttypes.IsJSON(contentType) || contenttypes.IsYAML(contentType) || contenttypes.IsXML(contentType)
+}
+
+// outputSchemaFromResponse converts the JSON schema of a tool's selected 2xx
+// response into an MCP output schema. Schemas that do not describe an object
+// are nested under mv.WrappedOutputProperty, with any definitions hoisted to
+// the root so that references still resolve.
+func outputSchemaFromResponse(schema []byte) ([]byte, error) {
+	if len(schema) == 0 {
+		return nil, nil
+	}
+
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal(schema, &root); err == nil && describesObject(root) {
+		return schema, nil
+	}
+
+	wrapped := map[string]json.RawMessage{}
+	if root != nil {
+		if defs, ok := root["$defs"]; ok {
+			wrapped["$defs"] = defs
+			delete(root, "$defs")
+			bs, err := json.Marshal(root)
+			if err != nil {
+				return nil, fmt.Errorf("marshal response schema: %w", err)
+			}
+			schema = bs
+		}
+	}
+
+	wrapped["type"] = json.RawMessage(`"object"`)
+	wrapped["properties"] = json.RawMessage(fmt.Sprintf(`{%q:%s}`, mv.WrappedOutputProperty, schema))
+	wrapped["required"] = json.RawMessage(fmt.Sprintf(`[%q]`, mv.WrappedOutputProperty))
+
+	bs, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshal output schema: %w", err)
+	}
+
+	return bs, nil
+}
+
+func describesObject(schema map[string]json.RawMessage) bool {
+	var typ string
+	if raw, ok := schema["type"]; ok {
+		if err := json.Unmarshal(raw, &typ); err != nil {
+			return false
+		}
+		return typ == "object"
+	}
+
+	_, hasProperties := schema["properties"]
+	return hasProperties
+}
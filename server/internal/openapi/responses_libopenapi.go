@@ -51,6 +51,35 @@ func getResponseFilterLibOpenAPI(ctx context.Context, logger *slog.Logger, op *v
 	return responseFilter, schemaBytes, nil
 }
 
+// getOutputSchemaLibOpenAPI returns the MCP output schema for an operation
+// based on its selected 2xx response, or nil if it has no JSON response.
+func getOutputSchemaLibOpenAPI(ctx context.Context, logger *slog.Logger, op *v3.Operation) ([]byte, error) {
+	capturedResponseBody, err := captureResponseBodyLibOpenAPI(ctx, logger, op)
+	if err != nil {
+		return nil, fmt.Errorf("error capturing response body: %w", err)
+	}
+	if capturedResponseBody == nil {
+		return nil, nil
+	}
+
+	return outputSchemaFromResponse(capturedResponseBody.schema)
+}
+
+// getXMLResponseSchemaLibOpenAPI returns the JSON schema of an operation's
+// selected 2xx response when it may be returned as XML, or nil otherwise. The
+// gateway uses it to convert XML responses to JSON.
+func getXMLResponseSchemaLibOpenAPI(ctx context.Context, logger *slog.Logger, op *v3.Operation) ([]byte, error) {
+	capturedResponseBody, err := captureResponseBodyLibOpenAPI(ctx, logger, op)
+	if err != nil {
+		return nil, fmt.Errorf("error capturing response body: %w", err)
+	}
+	if capturedResponseBody == nil || !slices.ContainsFunc(capturedResponseBody.contentTypes, contenttypes.IsXML) {
+		return nil, nil
+	}
+
+	return capturedResponseBody.schema, nil
+}
+
 type capturedResponseBodyLibOpenAPI struct {
 	schema       []byte
 	contentTypes []string
@@ -97,7 +126,7 @@ func selectResponseLibOpenAPI(ctx context.Context, logger *slog.Logger, op *v3.O
 		}
 
 		for contentType, content := range response.Content.FromOldest() {
-			if contenttypes.IsJSON(contentType) || contenttypes.IsYAML(contentType) {
+			if isStructuredResponse(contentType) {
 				hashBytes := content.Schema.GoLow().Schema().Hash()
 				hash := string(hashBytes[:])
 
@@ -177,7 +206,7 @@ func selectResponseLibOpenAPI(ctx context.Context, logger *slog.Logger, op *v3.O
 			// Find the schema hash for this status code using our stored mapping
 			var selectedSchemaHash string
 			for contentType := range selectedResponse.Content.FromOldest() {
-				if contenttypes.IsJSON(contentType) || contenttypes.IsYAML(contentType) {
+				if isStructuredResponse(contentType) {
 					codeContentKey := selectedCode + "|" + contentType
 					if hash, exists := codeContentToHash[codeContentKey]; exists {
 						selectedSchemaHash = hash
@@ -70,6 +70,51 @@ func getResponseFilterSpeakeasy(ctx context.Context, logger *slog.Logger, doc *o
 	return responseFilter, schema, nil
 }
 
+// getOutputSchemaSpeakeasy returns the MCP output schema for an operation
+// based on its selected 2xx response, or nil if it has no JSON response.
+func getOutputSchemaSpeakeasy(ctx context.Context, logger *slog.Logger, doc *openapi.OpenAPI, op *openapi.Operation) ([]byte, error) {
+	capturedResponseBody, err := captureResponseBodySpeakeasy(ctx, logger, doc, op)
+	if err != nil {
+		return nil, fmt.Errorf("error capturing response body: %w", err)
+	}
+	if capturedResponseBody == nil {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	ctx = yml.ContextWithConfig(ctx, &yml.Config{
+		OutputFormat: yml.OutputFormatJSON,
+	})
+	if err := marshaller.Marshal(ctx, capturedResponseBody.schema, &buf); err != nil {
+		return nil, fmt.Errorf("error marshalling response schema: %w", err)
+	}
+
+	return outputSchemaFromResponse(buf.Bytes())
+}
+
+// getXMLResponseSchemaSpeakeasy returns the JSON schema of an operation's
+// selected 2xx response when it may be returned as XML, or nil otherwise. The
+// gateway uses it to convert XML responses to JSON.
+func getXMLResponseSchemaSpeakeasy(ctx context.Context, logger *slog.Logger, doc *openapi.OpenAPI, op *openapi.Operation) ([]byte, error) {
+	capturedResponseBody, err := captureResponseBodySpeakeasy(ctx, logger, doc, op)
+	if err != nil {
+		return nil, fmt.Errorf("error capturing response body: %w", err)
+	}
+	if capturedResponseBody == nil || !slices.ContainsFunc(capturedResponseBody.contentTypes, contenttypes.IsXML) {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	ctx = yml.ContextWithConfig(ctx, &yml.Config{
+		OutputFormat: yml.OutputFormatJSON,
+	})
+	if err := marshaller.Marshal(ctx, capturedResponseBody.schema, &buf); err != nil {
+		return nil, fmt.Errorf("error marshalling response schema: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
 type capturedResponseBodySpeakeasy struct {
 	schema       *oas3.JSONSchema[oas3.Referenceable]
 	contentTypes []string
@@ -132,7 +177,7 @@ func selectResponseSpeakeasy(ctx context.Context, logger *slog.Logger, doc *open
 		}
 
 		for contentType, content := range response.GetContent().All() {
-			if contenttypes.IsJSON(contentType) || contenttypes.IsYAML(contentType) {
+			if isStructuredResponse(contentType) {
 				hash := hashing.Hash(content.Schema)
 
 				// Add this status code to the schema group
@@ -207,7 +252,7 @@ func selectResponseSpeakeasy(ctx context.Context, logger *slog.Logger, doc *open
 			// Find the schema hash for this status code using our stored mapping
 			var selectedSchemaHash string
 			for contentType, content := range selectedResponse.GetContent().All() {
-				if contenttypes.IsJSON(contentType) || contenttypes.IsYAML(contentType) {
+				if isStructuredResponse(contentType) {
 					hash := hashing.Hash(content.Schema)
 
 					if schemaToBestMediaType[hash] != nil {
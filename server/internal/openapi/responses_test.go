@@ -370,3 +370,94 @@ paths:
 	require.Contains(t, contentTypes, "application/yaml")
 	require.Contains(t, statusCodes, "200")
 }
+
+func TestOutputSchemaFromResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		schema   string
+		expected string
+	}{
+		{
+			name:     "object schema is kept",
+			schema:   `{"type":"object","properties":{"id":{"type":"string"}}}`,
+			expected: `{"type":"object","properties":{"id":{"type":"string"}}}`,
+		},
+		{
+			name:     "untyped schema with properties is kept",
+			schema:   `{"properties":{"id":{"type":"string"}}}`,
+			expected: `{"properties":{"id":{"type":"string"}}}`,
+		},
+		{
+			name:     "array schema is wrapped",
+			schema:   `{"type":"array","items":{"type":"string"}}`,
+			expected: `{"type":"object","properties":{"result":{"type":"array","items":{"type":"string"}}},"required":["result"]}`,
+		},
+		{
+			name:     "definitions are hoisted when wrapping",
+			schema:   `{"type":"array","items":{"$ref":"#/$defs/Pet"},"$defs":{"Pet":{"type":"object"}}}`,
+			expected: `{"type":"object","properties":{"result":{"type":"array","items":{"$ref":"#/$defs/Pet"}}},"required":["result"],"$defs":{"Pet":{"type":"object"}}}`,
+		},
+		{
+			name:     "boolean schema is wrapped",
+			schema:   `true`,
+			expected: `{"type":"object","properties":{"result":true},"required":["result"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := outputSchemaFromResponse([]byte(tt.schema))
+			require.NoError(t, err)
+			require.JSONEq(t, tt.expected, string(result))
+		})
+	}
+}
+
+func TestGetOutputSchema_LibOpenAPI(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	spec := `
+openapi: 3.0.0
+info:
+  title: Test API
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        '200':
+          description: Success
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: string
+    delete:
+      responses:
+        '204':
+          description: Deleted
+`
+
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	require.NoError(t, err)
+
+	model, errs := doc.BuildV3Model()
+	require.Empty(t, errs)
+
+	pathItem := model.Model.Paths.PathItems.GetOrZero("/pets")
+
+	schema, err := getOutputSchemaLibOpenAPI(ctx, logger, pathItem.Get)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"object","properties":{"result":{"type":"array","items":{"type":"string"}}},"required":["result"]}`, string(schema))
+
+	schema, err = getOutputSchemaLibOpenAPI(ctx, logger, pathItem.Delete)
+	require.NoError(t, err)
+	require.Nil(t, schema)
+}
@@ -0,0 +1,115 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/tools/repo/models"
+)
+
+// retriesExtension is the shape of x-speakeasy-retries. The same shape is
+// accepted under the retries key of x-gram, which additionally understands
+// maxAttempts and methods.
+type retriesExtension struct {
+	Strategy              *string           `yaml:"strategy"`
+	Backoff               *backoffExtension `yaml:"backoff"`
+	StatusCodes           []string          `yaml:"statusCodes"`
+	RetryConnectionErrors *bool             `yaml:"retryConnectionErrors"`
+	MaxAttempts           *int              `yaml:"maxAttempts"`
+	Methods               []string          `yaml:"methods"`
+}
+
+// backoffExtension holds the backoff settings of a retries extension. All
+// intervals are in milliseconds.
+type backoffExtension struct {
+	InitialInterval *int64   `yaml:"initialInterval"`
+	MaxInterval     *int64   `yaml:"maxInterval"`
+	MaxElapsedTime  *int64   `yaml:"maxElapsedTime"`
+	Exponent        *float64 `yaml:"exponent"`
+}
+
+// parseRetryPolicy collects the retry and timeout settings of an operation.
+// Retries set under x-gram take precedence over x-speakeasy-retries. Invalid
+// policies are logged and ignored so that the tool falls back to the
+// gateway's defaults.
+func parseRetryPolicy(ctx context.Context, logger *slog.Logger, op operation, gramExt *gramExtension) *models.RetryPolicy {
+	var retries *retriesExtension
+	var node *yaml.Node
+	if gramExt != nil && gramExt.Retries != nil {
+		retries, node = gramExt.Retries, op.gramExtension
+	} else if op.speakeasyRetriesExtension != nil {
+		var ext retriesExtension
+		if err := op.speakeasyRetriesExtension.Decode(&ext); err != nil {
+			msg := fmt.Sprintf("error parsing x-speakeasy-retries extension: [%d:%d]: %s", op.speakeasyRetriesExtension.Line, op.speakeasyRetriesExtension.Column, err.Error())
+			logger.WarnContext(ctx, msg)
+		} else {
+			retries, node = &ext, op.speakeasyRetriesExtension
+		}
+	}
+
+	if retries != nil {
+		switch strategy := conv.PtrValOr(retries.Strategy, "backoff"); strategy {
+		case "backoff", "none":
+		default:
+			msg := fmt.Sprintf("ignoring unsupported retry strategy: [%d:%d]: %s", node.Line, node.Column, strategy)
+			logger.WarnContext(ctx, msg)
+			retries = nil
+		}
+	}
+
+	policy := &models.RetryPolicy{
+		MaxAttempts:           nil,
+		StatusCodes:           nil,
+		Methods:               nil,
+		RetryConnectionErrors: nil,
+		InitialIntervalMs:     nil,
+		MaxIntervalMs:         nil,
+		Exponent:              nil,
+		MaxElapsedTimeMs:      nil,
+		TimeoutMs:             nil,
+	}
+
+	if retries != nil {
+		policy.MaxAttempts = retries.MaxAttempts
+		if conv.PtrValOr(retries.Strategy, "") == "none" {
+			policy.MaxAttempts = conv.Ptr(1)
+		}
+		policy.RetryConnectionErrors = retries.RetryConnectionErrors
+		for _, code := range retries.StatusCodes {
+			policy.StatusCodes = append(policy.StatusCodes, strings.ToUpper(strings.TrimSpace(code)))
+		}
+		for _, method := range retries.Methods {
+			policy.Methods = append(policy.Methods, strings.ToUpper(strings.TrimSpace(method)))
+		}
+		if retries.Backoff != nil {
+			policy.InitialIntervalMs = retries.Backoff.InitialInterval
+			policy.MaxIntervalMs = retries.Backoff.MaxInterval
+			policy.MaxElapsedTimeMs = retries.Backoff.MaxElapsedTime
+			policy.Exponent = retries.Backoff.Exponent
+		}
+	}
+
+	if gramExt != nil {
+		policy.TimeoutMs = gramExt.Timeout
+	}
+
+	if policy.IsEmpty() {
+		return nil
+	}
+
+	if err := policy.Validate(); err != nil {
+		if node == nil {
+			node = op.gramExtension
+		}
+		msg := fmt.Sprintf("ignoring invalid retry policy: [%d:%d]: %s", node.Line, node.Column, err.Error())
+		logger.WarnContext(ctx, msg)
+		return nil
+	}
+
+	return policy
+}
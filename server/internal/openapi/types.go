@@ -15,3 +15,14 @@ type OpenapiV3ParameterProxy struct {
 	Style           string          `json:"style,omitempty" yaml:"style,omitempty"`
 	Explode         *bool           `json:"explode,omitempty" yaml:"explode,omitempty"`
 }
+
+// OpenapiV3EncodingProxy holds the settings for encoding a property of a
+// multipart request body.
+type OpenapiV3EncodingProxy struct {
+	// ContentType is the content type set for the property in the media
+	// type's encoding object. It may be a list or contain wildcards.
+	ContentType string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	// File is set when the property carries file contents. Its schema is
+	// rewritten so that tools accept base64 data, a URL or a Gram asset.
+	File bool `json:"file,omitempty" yaml:"file,omitempty"`
+}
@@ -0,0 +1,97 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var xmlType = regexp.MustCompile(`\bxml\b`)
+
+// xmlRootName returns the default name of the root element of an XML request
+// body whose schema is a reference. As with OpenAPI's xml object, elements
+// are named after the component schema they were defined by.
+func xmlRootName(ref string) string {
+	if ref == "" {
+		return ""
+	}
+
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// describeXMLBody records the name of the root element on the body of a tool
+// schema when the body's own xml object does not set one. Request bodies are
+// inlined into tool schemas, which loses the name of the component schema
+// they referred to, so it must be kept for the gateway to build the XML
+// document.
+func describeXMLBody(toolSchema []byte, rootName string) ([]byte, error) {
+	if rootName == "" || len(toolSchema) == 0 {
+		return toolSchema, nil
+	}
+
+	// Only the levels leading to the body's xml object are decoded so that the
+	// order of properties, which is the order of elements in the document, is
+	// kept intact.
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal(toolSchema, &root); err != nil {
+		return nil, fmt.Errorf("parse tool schema: %w", err)
+	}
+
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(root["properties"], &properties); err != nil || properties["body"] == nil {
+		return toolSchema, nil
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(properties["body"], &body); err != nil {
+		return toolSchema, nil
+	}
+
+	var xmlObject map[string]any
+	_ = json.Unmarshal(body["xml"], &xmlObject)
+	if xmlObjectName(xmlObject) != "" {
+		return toolSchema, nil
+	}
+
+	var ref string
+	if err := json.Unmarshal(body["$ref"], &ref); err == nil {
+		// A definition that names its own root element takes precedence over
+		// the name of the component it was hoisted from.
+		var defs map[string]struct {
+			XML map[string]any `json:"xml"`
+		}
+		_ = json.Unmarshal(root["$defs"], &defs)
+		if xmlObjectName(defs[strings.TrimPrefix(ref, "#/$defs/")].XML) != "" {
+			return toolSchema, nil
+		}
+	}
+
+	if xmlObject == nil {
+		xmlObject = make(map[string]any, 1)
+	}
+	xmlObject["name"] = rootName
+
+	var err error
+	if body["xml"], err = json.Marshal(xmlObject); err != nil {
+		return nil, fmt.Errorf("serialize xml object: %w", err)
+	}
+	if properties["body"], err = json.Marshal(body); err != nil {
+		return nil, fmt.Errorf("serialize request body schema: %w", err)
+	}
+	if root["properties"], err = json.Marshal(properties); err != nil {
+		return nil, fmt.Errorf("serialize tool schema properties: %w", err)
+	}
+
+	bs, err := json.Marshal(root)
+	if err != nil {
+		return nil, fmt.Errorf("serialize tool schema: %w", err)
+	}
+
+	return bs, nil
+}
+
+func xmlObjectName(obj map[string]any) string {
+	name, _ := obj["name"].(string)
+	return name
+}
@@ -0,0 +1,30 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeXMLBody(t *testing.T) {
+	t.Parallel()
+
+	schema := []byte(`{"type":"object","properties":{"body":{"type":"object","properties":{"name":{"type":"string"},"id":{"type":"integer"}}}}}`)
+
+	rewritten, err := describeXMLBody(schema, "Pet")
+	require.NoError(t, err)
+	// Properties keep their order since it is the order of the document's
+	// elements.
+	require.JSONEq(t, `{"type":"object","properties":{"body":{"type":"object","properties":{"name":{"type":"string"},"id":{"type":"integer"}},"xml":{"name":"Pet"}}}}`, string(rewritten))
+	require.Contains(t, string(rewritten), `{"name":{"type":"string"},"id":{"type":"integer"}}`)
+
+	named := []byte(`{"type":"object","properties":{"body":{"type":"object","xml":{"name":"pet"}}}}`)
+	rewritten, err = describeXMLBody(named, "Pet")
+	require.NoError(t, err)
+	require.Equal(t, string(named), string(rewritten))
+
+	referenced := []byte(`{"type":"object","properties":{"body":{"$ref":"#/$defs/Pet"}},"$defs":{"Pet":{"type":"object","xml":{"name":"pet"}}}}`)
+	rewritten, err = describeXMLBody(referenced, "Pet")
+	require.NoError(t, err)
+	require.Equal(t, string(referenced), string(rewritten))
+}
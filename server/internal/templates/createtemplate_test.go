@@ -136,6 +136,11 @@ func TestTemplatesService_CreateTemplate_InvalidArguments(t *testing.T) {
 			arguments: `{"type": "array"}`,
 			errorMsg:  "invalid arguments schema",
 		},
+		{
+			name:      "completion-without-tool",
+			arguments: `{"type": "object", "properties": {"project_id": {"type": "string", "x-gram-completion": {"values": ".[].id"}}}}`,
+			errorMsg:  "invalid arguments schema",
+		},
 	}
 
 	for _, tt := range tests {
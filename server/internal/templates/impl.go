@@ -95,7 +95,7 @@ func (s *Service) CreateTemplate(ctx context.Context, payload *gen.CreateTemplat
 
 		err := validateInputSchema(bytes.NewReader(args))
 		switch {
-		case errors.Is(err, errSchemaUnsupportedType) || errors.Is(err, errSchemaNotObject):
+		case errors.Is(err, errSchemaUnsupportedType) || errors.Is(err, errSchemaNotObject) || errors.Is(err, errSchemaBadCompletion):
 			return nil, oops.E(oops.CodeInvalid, err, "invalid arguments schema").Log(ctx, logger)
 		case errors.Is(err, errSchemaHasNoProperties):
 			// This is allowed, it means the schema is empty, which is valid.
@@ -179,7 +179,7 @@ func (s *Service) UpdateTemplate(ctx context.Context, payload *gen.UpdateTemplat
 
 		err := validateInputSchema(bytes.NewReader(args))
 		switch {
-		case errors.Is(err, errSchemaUnsupportedType) || errors.Is(err, errSchemaNotObject):
+		case errors.Is(err, errSchemaUnsupportedType) || errors.Is(err, errSchemaNotObject) || errors.Is(err, errSchemaBadCompletion):
 			return nil, oops.E(oops.CodeInvalid, err, "invalid arguments schema").Log(ctx, s.logger)
 		case errors.Is(err, errSchemaHasNoProperties):
 			// This is allowed, it means the schema is empty, which is valid.
@@ -13,6 +13,7 @@ var (
 	errSchemaHasNoProperties = jsonSchemaValidationError("schema has no properties or additionalProperties defined")
 	errSchemaUnsupportedType = jsonSchemaValidationError("schema type is not supported")
 	errSchemaNotObject       = jsonSchemaValidationError("schema type must be object")
+	errSchemaBadCompletion   = jsonSchemaValidationError("x-gram-completion must name a tool")
 )
 
 func (e jsonSchemaValidationError) Error() string {
@@ -25,6 +26,10 @@ func validateInputSchema(rawInput io.Reader) error {
 		return fmt.Errorf("unmarshal schema: %w", err)
 	}
 
+	if err := validateCompletionSources(rawSchema); err != nil {
+		return err
+	}
+
 	compiler := jsonschema.NewCompiler()
 	if err := compiler.AddResource("file:///schema.json", rawSchema); err != nil {
 		return fmt.Errorf("add schema: %w", err)
@@ -79,3 +84,40 @@ func hasAdditionalProperties(schema *jsonschema.Schema) bool {
 		return false
 	}
 }
+
+// validateCompletionSources checks the x-gram-completion keyword that
+// arguments can use to have MCP clients suggest values fetched by a GET tool.
+func validateCompletionSources(rawSchema any) error {
+	root, ok := rawSchema.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	properties, _ := root["properties"].(map[string]any)
+	for name, prop := range properties {
+		propSchema, ok := prop.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		source, found := propSchema["x-gram-completion"]
+		if !found {
+			continue
+		}
+
+		sourceObj, ok := source.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: %s", errSchemaBadCompletion, name)
+		}
+		if tool, _ := sourceObj["tool"].(string); tool == "" {
+			return fmt.Errorf("%w: %s", errSchemaBadCompletion, name)
+		}
+		if values, found := sourceObj["values"]; found {
+			if _, ok := values.(string); !ok {
+				return fmt.Errorf("%w: %s: values must be a jq filter string", errSchemaBadCompletion, name)
+			}
+		}
+	}
+
+	return nil
+}
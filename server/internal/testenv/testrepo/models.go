@@ -18,6 +18,11 @@ type HttpToolDefinition struct {
 	Confirm             pgtype.Text
 	ConfirmPrompt       pgtype.Text
 	Summarizer          pgtype.Text
+	Title               pgtype.Text
+	ReadOnlyHint        pgtype.Bool
+	DestructiveHint     pgtype.Bool
+	IdempotentHint      pgtype.Bool
+	OpenWorldHint       pgtype.Bool
 	Name                string
 	UntruncatedName     pgtype.Text
 	Summary             string
@@ -39,7 +44,13 @@ type HttpToolDefinition struct {
 	QuerySettings       []byte
 	PathSettings        []byte
 	RequestContentType  pgtype.Text
+	BodySettings        []byte
 	ResponseFilter      *models.ResponseFilter
+	OutputSchema        []byte
+	XmlResponseSchema   []byte
+	ResponseBudget      pgtype.Int8
+	RetryPolicy         *models.RetryPolicy
+	CachePolicy         *models.CachePolicy
 	CreatedAt           pgtype.Timestamptz
 	UpdatedAt           pgtype.Timestamptz
 	DeletedAt           pgtype.Timestamptz
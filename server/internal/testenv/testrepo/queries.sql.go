@@ -12,7 +12,7 @@ import (
 )
 
 const listDeploymentTools = `-- name: ListDeploymentTools :many
-SELECT id, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, response_filter, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, body_settings, response_filter, output_schema, xml_response_schema, response_budget, retry_policy, cache_policy, created_at, updated_at, deleted_at, deleted
 FROM http_tool_definitions
 WHERE deployment_id = $1
 `
@@ -34,6 +34,11 @@ func (q *Queries) ListDeploymentTools(ctx context.Context, deploymentID uuid.UUI
 			&i.Confirm,
 			&i.ConfirmPrompt,
 			&i.Summarizer,
+			&i.Title,
+			&i.ReadOnlyHint,
+			&i.DestructiveHint,
+			&i.IdempotentHint,
+			&i.OpenWorldHint,
 			&i.Name,
 			&i.UntruncatedName,
 			&i.Summary,
@@ -55,7 +60,13 @@ func (q *Queries) ListDeploymentTools(ctx context.Context, deploymentID uuid.UUI
 			&i.QuerySettings,
 			&i.PathSettings,
 			&i.RequestContentType,
+			&i.BodySettings,
 			&i.ResponseFilter,
+			&i.OutputSchema,
+			&i.XmlResponseSchema,
+			&i.ResponseBudget,
+			&i.RetryPolicy,
+			&i.CachePolicy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
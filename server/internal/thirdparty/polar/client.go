@@ -235,6 +235,18 @@ func (p *Client) TrackToolCallUsage(ctx context.Context, event billing.ToolCallU
 		}
 	}
 
+	if event.MCPClientName != nil {
+		metadata["mcp_client_name"] = polarComponents.EventCreateExternalCustomerMetadata{
+			Str: event.MCPClientName,
+		}
+	}
+
+	if event.MCPClientVersion != nil {
+		metadata["mcp_client_version"] = polarComponents.EventCreateExternalCustomerMetadata{
+			Str: event.MCPClientVersion,
+		}
+	}
+
 	_, err := p.polar.Events.Ingest(ctx, polarComponents.EventsIngest{
 		Events: []polarComponents.Events{
 			{
@@ -24,6 +24,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/mv"
 	"github.com/speakeasy-api/gram/server/internal/oops"
 	"github.com/speakeasy-api/gram/server/internal/tools/repo"
+	"github.com/speakeasy-api/gram/server/internal/tools/repo/models"
 	vr "github.com/speakeasy-api/gram/server/internal/variations/repo"
 )
 
@@ -140,6 +141,10 @@ func (s *Service) ListTools(ctx context.Context, payload *gen.ListToolsPayload)
 		confirmRaw := conv.PtrValOr(conv.FromPGText[string](tool.Confirm), "")
 		confirmPrompt := conv.FromPGText[string](tool.ConfirmPrompt)
 		tags := tool.Tags
+		annotations := mv.ToolAnnotationsFromColumns(tool.Title, tool.ReadOnlyHint, tool.DestructiveHint, tool.IdempotentHint, tool.OpenWorldHint)
+		var variedAnnotations *types.ToolAnnotations
+		responseBudget := conv.FromPGInt8(tool.ResponseBudget)
+		retryPolicy := tool.RetryPolicy
 		variations, ok := keyedVariations[tool.Name]
 		if ok {
 			name = conv.Default(variations.Name.String, name)
@@ -150,35 +155,44 @@ func (s *Service) ListTools(ctx context.Context, payload *gen.ListToolsPayload)
 			if len(variations.Tags) > 0 {
 				tags = variations.Tags
 			}
+			variedAnnotations = mv.ToolAnnotationsFromColumns(variations.Title, variations.ReadOnlyHint, variations.DestructiveHint, variations.IdempotentHint, variations.OpenWorldHint)
+			responseBudget = conv.Default(conv.FromPGInt8(variations.ResponseBudget), responseBudget)
+			retryPolicy = models.MergeRetryPolicies(retryPolicy, variations.RetryPolicy)
 
 			canonical = &types.CanonicalToolAttributes{
-				VariationID:   variations.ID.String(),
-				Name:          tool.Name,
-				Summary:       conv.PtrEmpty(tool.Summary),
-				Description:   conv.PtrEmpty(tool.Description),
-				Tags:          tool.Tags,
-				Confirm:       conv.FromPGText[string](tool.Confirm),
-				ConfirmPrompt: conv.FromPGText[string](tool.ConfirmPrompt),
-				Summarizer:    conv.FromPGText[string](tool.Summarizer),
+				VariationID:    variations.ID.String(),
+				Name:           tool.Name,
+				Summary:        conv.PtrEmpty(tool.Summary),
+				Description:    conv.PtrEmpty(tool.Description),
+				Tags:           tool.Tags,
+				Confirm:        conv.FromPGText[string](tool.Confirm),
+				ConfirmPrompt:  conv.FromPGText[string](tool.ConfirmPrompt),
+				Summarizer:     conv.FromPGText[string](tool.Summarizer),
+				Annotations:    annotations,
+				ResponseBudget: conv.FromPGInt8(tool.ResponseBudget),
+				RetryPolicy:    mv.DescribeToolRetryPolicy(tool.RetryPolicy),
 			}
 
 			variation = &types.ToolVariation{
-				ID:            variations.ID.String(),
-				GroupID:       variations.GroupID.String(),
-				SrcToolName:   tool.Name,
-				Confirm:       conv.FromPGText[string](variations.Confirm),
-				ConfirmPrompt: conv.FromPGText[string](variations.ConfirmPrompt),
-				Name:          conv.PtrEmpty(variations.Name.String),
-				Summary:       conv.PtrEmpty(variations.Summary.String),
-				Description:   conv.PtrEmpty(variations.Description.String),
-				Tags:          variations.Tags,
-				Summarizer:    conv.FromPGText[string](variations.Summarizer),
-				CreatedAt:     variations.CreatedAt.Time.Format(time.RFC3339),
-				UpdatedAt:     variations.UpdatedAt.Time.Format(time.RFC3339),
+				ID:             variations.ID.String(),
+				GroupID:        variations.GroupID.String(),
+				SrcToolName:    tool.Name,
+				Confirm:        conv.FromPGText[string](variations.Confirm),
+				ConfirmPrompt:  conv.FromPGText[string](variations.ConfirmPrompt),
+				Name:           conv.PtrEmpty(variations.Name.String),
+				Summary:        conv.PtrEmpty(variations.Summary.String),
+				Description:    conv.PtrEmpty(variations.Description.String),
+				Tags:           variations.Tags,
+				Summarizer:     conv.FromPGText[string](variations.Summarizer),
+				Annotations:    variedAnnotations,
+				ResponseBudget: conv.FromPGInt8(variations.ResponseBudget),
+				RetryPolicy:    mv.DescribeToolRetryPolicy(variations.RetryPolicy),
+				CreatedAt:      variations.CreatedAt.Time.Format(time.RFC3339),
+				UpdatedAt:      variations.UpdatedAt.Time.Format(time.RFC3339),
 			}
 		}
 
-		confirm, _ := mv.SanitizeConfirm(confirmRaw)
+		confirm := mv.EffectiveConfirm(confirmRaw)
 
 		canonicalName := name
 		if canonical != nil {
@@ -206,6 +220,9 @@ func (s *Service) ListTools(ctx context.Context, payload *gen.ListToolsPayload)
 			ConfirmPrompt:       confirmPrompt,
 			Summarizer:          conv.FromPGText[string](tool.Summarizer),
 			ResponseFilter:      responseFilter,
+			Annotations:         mv.ResolveToolAnnotations(tool.HttpMethod, summary, annotations, variedAnnotations),
+			ResponseBudget:      responseBudget,
+			RetryPolicy:         mv.DescribeToolRetryPolicy(retryPolicy),
 			HTTPMethod:          tool.HttpMethod,
 			Path:                tool.Path,
 			Tags:                tags,
@@ -213,6 +230,7 @@ func (s *Service) ListTools(ctx context.Context, payload *gen.ListToolsPayload)
 			Openapiv3Operation:  conv.Ptr(tool.Openapiv3Operation.String),
 			SchemaVersion:       conv.Ptr(tool.SchemaVersion),
 			Schema:              string(tool.Schema),
+			OutputSchema:        conv.FromBytes(tool.OutputSchema),
 			Security:            conv.Ptr(string(tool.Security)),
 			DefaultServerURL:    conv.FromPGText[string](tool.DefaultServerUrl),
 			PackageName:         pkg,
@@ -48,7 +48,15 @@ SELECT
   htd.confirm,
   htd.confirm_prompt,
   htd.summarizer,
+  htd.title,
+  htd.read_only_hint,
+  htd.destructive_hint,
+  htd.idempotent_hint,
+  htd.open_world_hint,
   htd.response_filter,
+  htd.output_schema,
+  htd.response_budget,
+  htd.retry_policy,
   htd.path,
   htd.openapiv3_document_id,
   htd.openapiv3_operation,
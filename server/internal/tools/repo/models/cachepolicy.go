@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// MaxCacheTTL caps how long a tool's upstream responses may be served from the
+// response cache without revalidation.
+const MaxCacheTTL = 24 * time.Hour
+
+// CachePolicy opts a tool into the gateway's upstream response cache. Only
+// responses to safe methods are cached. Durations are stored in milliseconds.
+type CachePolicy struct {
+	// TTLMs, when set, is how long responses are served from the cache. It
+	// takes precedence over the caching headers of the upstream API. When
+	// unset, the upstream's Cache-Control and Expires headers decide.
+	TTLMs *int64 `json:"ttl_ms,omitempty"`
+}
+
+// Validate reports the first setting in the policy that is out of range.
+func (p *CachePolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	return validateMillis("cache ttl", p.TTLMs, MaxCacheTTL)
+}
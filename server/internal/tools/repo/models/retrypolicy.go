@@ -0,0 +1,176 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxRetryAttempts caps the number of attempts, including the first, that
+	// a retry policy may allow.
+	MaxRetryAttempts = 10
+	// MaxAttemptTimeout caps how long a single attempt at an upstream request
+	// may take.
+	MaxAttemptTimeout = 15 * time.Minute
+	// MaxRetryDeadline caps the overall time spent on a tool call across all
+	// of its attempts.
+	MaxRetryDeadline = 30 * time.Minute
+)
+
+var retryStatusCodePattern = regexp.MustCompile(`^[1-5](?:[0-9]{2}|XX)$`)
+
+var retryMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodOptions,
+}
+
+// RetryPolicy controls how calls to a tool's upstream API are timed out and
+// retried. Unset fields fall back to the gateway's defaults. Durations are
+// stored in milliseconds.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts made including the first. A
+	// value of one disables retries.
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+	// StatusCodes lists the response status codes that are retried. Entries
+	// may be exact codes such as "429" or ranges such as "5XX".
+	StatusCodes []string `json:"status_codes,omitempty"`
+	// Methods lists the HTTP methods that may be retried.
+	Methods []string `json:"methods,omitempty"`
+	// RetryConnectionErrors controls whether requests that fail before a
+	// response is received are retried.
+	RetryConnectionErrors *bool `json:"retry_connection_errors,omitempty"`
+	// InitialIntervalMs is the delay before the first retry.
+	InitialIntervalMs *int64 `json:"initial_interval_ms,omitempty"`
+	// MaxIntervalMs caps the delay between two attempts.
+	MaxIntervalMs *int64 `json:"max_interval_ms,omitempty"`
+	// Exponent is the factor the delay grows by after each retry.
+	Exponent *float64 `json:"exponent,omitempty"`
+	// MaxElapsedTimeMs is the overall deadline for a call across all of its
+	// attempts.
+	MaxElapsedTimeMs *int64 `json:"max_elapsed_time_ms,omitempty"`
+	// TimeoutMs bounds a single attempt, including reading the response.
+	TimeoutMs *int64 `json:"timeout_ms,omitempty"`
+}
+
+// Validate reports the first setting in the policy that is out of range.
+func (p *RetryPolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if p.MaxAttempts != nil && (*p.MaxAttempts < 1 || *p.MaxAttempts > MaxRetryAttempts) {
+		return fmt.Errorf("max attempts must be between 1 and %d", MaxRetryAttempts)
+	}
+
+	for _, code := range p.StatusCodes {
+		if !retryStatusCodePattern.MatchString(code) {
+			return fmt.Errorf("invalid retry status code %q: expected a status code such as 429 or a range such as 5XX", code)
+		}
+	}
+
+	for _, method := range p.Methods {
+		if !slices.Contains(retryMethods, method) {
+			return fmt.Errorf("invalid retry method %q: expected one of %s", method, strings.Join(retryMethods, ", "))
+		}
+	}
+
+	if err := validateMillis("initial interval", p.InitialIntervalMs, MaxRetryDeadline); err != nil {
+		return err
+	}
+	if err := validateMillis("max interval", p.MaxIntervalMs, MaxRetryDeadline); err != nil {
+		return err
+	}
+	if err := validateMillis("max elapsed time", p.MaxElapsedTimeMs, MaxRetryDeadline); err != nil {
+		return err
+	}
+	if err := validateMillis("timeout", p.TimeoutMs, MaxAttemptTimeout); err != nil {
+		return err
+	}
+
+	if p.InitialIntervalMs != nil && p.MaxIntervalMs != nil && *p.InitialIntervalMs > *p.MaxIntervalMs {
+		return errors.New("initial interval must not exceed max interval")
+	}
+
+	if p.Exponent != nil && (*p.Exponent < 1 || *p.Exponent > 10) {
+		return errors.New("exponent must be between 1 and 10")
+	}
+
+	return nil
+}
+
+// IsEmpty reports whether the policy leaves every setting at its default.
+func (p *RetryPolicy) IsEmpty() bool {
+	return p == nil || (p.MaxAttempts == nil &&
+		len(p.StatusCodes) == 0 &&
+		len(p.Methods) == 0 &&
+		p.RetryConnectionErrors == nil &&
+		p.InitialIntervalMs == nil &&
+		p.MaxIntervalMs == nil &&
+		p.Exponent == nil &&
+		p.MaxElapsedTimeMs == nil &&
+		p.TimeoutMs == nil)
+}
+
+// MergeRetryPolicies returns base with every setting that override sets
+// replaced by the override's value.
+func MergeRetryPolicies(base *RetryPolicy, override *RetryPolicy) *RetryPolicy {
+	switch {
+	case override.IsEmpty():
+		return base
+	case base.IsEmpty():
+		return override
+	}
+
+	merged := *base
+	if override.MaxAttempts != nil {
+		merged.MaxAttempts = override.MaxAttempts
+	}
+	if len(override.StatusCodes) > 0 {
+		merged.StatusCodes = override.StatusCodes
+	}
+	if len(override.Methods) > 0 {
+		merged.Methods = override.Methods
+	}
+	if override.RetryConnectionErrors != nil {
+		merged.RetryConnectionErrors = override.RetryConnectionErrors
+	}
+	if override.InitialIntervalMs != nil {
+		merged.InitialIntervalMs = override.InitialIntervalMs
+	}
+	if override.MaxIntervalMs != nil {
+		merged.MaxIntervalMs = override.MaxIntervalMs
+	}
+	if override.Exponent != nil {
+		merged.Exponent = override.Exponent
+	}
+	if override.MaxElapsedTimeMs != nil {
+		merged.MaxElapsedTimeMs = override.MaxElapsedTimeMs
+	}
+	if override.TimeoutMs != nil {
+		merged.TimeoutMs = override.TimeoutMs
+	}
+
+	return &merged
+}
+
+func validateMillis(name string, value *int64, limit time.Duration) error {
+	if value == nil {
+		return nil
+	}
+
+	if *value <= 0 || *value > limit.Milliseconds() {
+		return fmt.Errorf("%s must be between 1 and %d milliseconds", name, limit.Milliseconds())
+	}
+
+	return nil
+}
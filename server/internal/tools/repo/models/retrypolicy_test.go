@@ -0,0 +1,90 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestRetryPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := &RetryPolicy{
+		MaxAttempts:           ptr(5),
+		StatusCodes:           []string{"429", "5XX"},
+		Methods:               []string{"GET", "POST"},
+		RetryConnectionErrors: ptr(true),
+		InitialIntervalMs:     ptr(int64(500)),
+		MaxIntervalMs:         ptr(int64(60000)),
+		Exponent:              ptr(1.5),
+		MaxElapsedTimeMs:      ptr(int64(600000)),
+		TimeoutMs:             ptr(int64(300000)),
+	}
+	require.NoError(t, valid.Validate())
+
+	var unset *RetryPolicy
+	require.NoError(t, unset.Validate())
+
+	tests := []struct {
+		name   string
+		mutate func(p *RetryPolicy)
+	}{
+		{name: "too many attempts", mutate: func(p *RetryPolicy) { p.MaxAttempts = ptr(MaxRetryAttempts + 1) }},
+		{name: "zero attempts", mutate: func(p *RetryPolicy) { p.MaxAttempts = ptr(0) }},
+		{name: "bad status code", mutate: func(p *RetryPolicy) { p.StatusCodes = []string{"5xx0"} }},
+		{name: "bad method", mutate: func(p *RetryPolicy) { p.Methods = []string{"get"} }},
+		{name: "timeout too long", mutate: func(p *RetryPolicy) { p.TimeoutMs = ptr(MaxAttemptTimeout.Milliseconds() + 1) }},
+		{name: "non-positive interval", mutate: func(p *RetryPolicy) { p.InitialIntervalMs = ptr(int64(0)) }},
+		{name: "initial above max interval", mutate: func(p *RetryPolicy) { p.InitialIntervalMs = ptr(int64(120000)) }},
+		{name: "exponent below one", mutate: func(p *RetryPolicy) { p.Exponent = ptr(0.5) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := *valid
+			tt.mutate(&policy)
+			require.Error(t, policy.Validate())
+		})
+	}
+}
+
+func TestMergeRetryPolicies(t *testing.T) {
+	t.Parallel()
+
+	base := &RetryPolicy{
+		MaxAttempts:           ptr(3),
+		StatusCodes:           []string{"503"},
+		Methods:               nil,
+		RetryConnectionErrors: nil,
+		InitialIntervalMs:     nil,
+		MaxIntervalMs:         nil,
+		Exponent:              nil,
+		MaxElapsedTimeMs:      nil,
+		TimeoutMs:             ptr(int64(60000)),
+	}
+	override := &RetryPolicy{
+		MaxAttempts:           nil,
+		StatusCodes:           nil,
+		Methods:               []string{"POST"},
+		RetryConnectionErrors: nil,
+		InitialIntervalMs:     nil,
+		MaxIntervalMs:         nil,
+		Exponent:              nil,
+		MaxElapsedTimeMs:      nil,
+		TimeoutMs:             ptr(int64(300000)),
+	}
+
+	merged := MergeRetryPolicies(base, override)
+	require.Equal(t, ptr(3), merged.MaxAttempts)
+	require.Equal(t, []string{"503"}, merged.StatusCodes)
+	require.Equal(t, []string{"POST"}, merged.Methods)
+	require.Equal(t, ptr(int64(300000)), merged.TimeoutMs)
+	require.Equal(t, ptr(int64(60000)), base.TimeoutMs, "the base policy should not be modified")
+
+	require.Same(t, base, MergeRetryPolicies(base, nil))
+	require.Same(t, override, MergeRetryPolicies(nil, override))
+}
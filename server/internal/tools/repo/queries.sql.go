@@ -100,7 +100,7 @@ external_deployments AS (
   WHERE deployments_packages.deployment_id = (SELECT id FROM deployment)
 )
 SELECT 
-  http_tool_definitions.id, http_tool_definitions.project_id, http_tool_definitions.deployment_id, http_tool_definitions.openapiv3_document_id, http_tool_definitions.confirm, http_tool_definitions.confirm_prompt, http_tool_definitions.summarizer, http_tool_definitions.name, http_tool_definitions.untruncated_name, http_tool_definitions.summary, http_tool_definitions.description, http_tool_definitions.openapiv3_operation, http_tool_definitions.tags, http_tool_definitions.x_gram, http_tool_definitions.original_name, http_tool_definitions.original_summary, http_tool_definitions.original_description, http_tool_definitions.server_env_var, http_tool_definitions.default_server_url, http_tool_definitions.security, http_tool_definitions.http_method, http_tool_definitions.path, http_tool_definitions.schema_version, http_tool_definitions.schema, http_tool_definitions.header_settings, http_tool_definitions.query_settings, http_tool_definitions.path_settings, http_tool_definitions.request_content_type, http_tool_definitions.response_filter, http_tool_definitions.created_at, http_tool_definitions.updated_at, http_tool_definitions.deleted_at, http_tool_definitions.deleted,
+  http_tool_definitions.id, http_tool_definitions.project_id, http_tool_definitions.deployment_id, http_tool_definitions.openapiv3_document_id, http_tool_definitions.confirm, http_tool_definitions.confirm_prompt, http_tool_definitions.summarizer, http_tool_definitions.title, http_tool_definitions.read_only_hint, http_tool_definitions.destructive_hint, http_tool_definitions.idempotent_hint, http_tool_definitions.open_world_hint, http_tool_definitions.name, http_tool_definitions.untruncated_name, http_tool_definitions.summary, http_tool_definitions.description, http_tool_definitions.openapiv3_operation, http_tool_definitions.tags, http_tool_definitions.x_gram, http_tool_definitions.original_name, http_tool_definitions.original_summary, http_tool_definitions.original_description, http_tool_definitions.server_env_var, http_tool_definitions.default_server_url, http_tool_definitions.security, http_tool_definitions.http_method, http_tool_definitions.path, http_tool_definitions.schema_version, http_tool_definitions.schema, http_tool_definitions.header_settings, http_tool_definitions.query_settings, http_tool_definitions.path_settings, http_tool_definitions.request_content_type, http_tool_definitions.body_settings, http_tool_definitions.response_filter, http_tool_definitions.output_schema, http_tool_definitions.xml_response_schema, http_tool_definitions.response_budget, http_tool_definitions.retry_policy, http_tool_definitions.cache_policy, http_tool_definitions.created_at, http_tool_definitions.updated_at, http_tool_definitions.deleted_at, http_tool_definitions.deleted,
   (select id from deployment) as owning_deployment_id,
   (CASE
     WHEN http_tool_definitions.project_id = $1 THEN ''
@@ -145,6 +145,11 @@ func (q *Queries) FindToolsByName(ctx context.Context, arg FindToolsByNameParams
 			&i.HttpToolDefinition.Confirm,
 			&i.HttpToolDefinition.ConfirmPrompt,
 			&i.HttpToolDefinition.Summarizer,
+			&i.HttpToolDefinition.Title,
+			&i.HttpToolDefinition.ReadOnlyHint,
+			&i.HttpToolDefinition.DestructiveHint,
+			&i.HttpToolDefinition.IdempotentHint,
+			&i.HttpToolDefinition.OpenWorldHint,
 			&i.HttpToolDefinition.Name,
 			&i.HttpToolDefinition.UntruncatedName,
 			&i.HttpToolDefinition.Summary,
@@ -166,7 +171,13 @@ func (q *Queries) FindToolsByName(ctx context.Context, arg FindToolsByNameParams
 			&i.HttpToolDefinition.QuerySettings,
 			&i.HttpToolDefinition.PathSettings,
 			&i.HttpToolDefinition.RequestContentType,
+			&i.HttpToolDefinition.BodySettings,
 			&i.HttpToolDefinition.ResponseFilter,
+			&i.HttpToolDefinition.OutputSchema,
+			&i.HttpToolDefinition.XmlResponseSchema,
+			&i.HttpToolDefinition.ResponseBudget,
+			&i.HttpToolDefinition.RetryPolicy,
+			&i.HttpToolDefinition.CachePolicy,
 			&i.HttpToolDefinition.CreatedAt,
 			&i.HttpToolDefinition.UpdatedAt,
 			&i.HttpToolDefinition.DeletedAt,
@@ -204,7 +215,7 @@ third_party AS (
     AND NOT EXISTS(SELECT 1 FROM first_party)
   LIMIT 1
 )
-SELECT id, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, response_filter, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, body_settings, response_filter, output_schema, xml_response_schema, response_budget, retry_policy, cache_policy, created_at, updated_at, deleted_at, deleted
 FROM http_tool_definitions
 WHERE id = COALESCE((SELECT id FROM first_party), (SELECT id FROM  third_party))
 `
@@ -226,6 +237,11 @@ func (q *Queries) GetHTTPToolDefinitionByID(ctx context.Context, arg GetHTTPTool
 		&i.Confirm,
 		&i.ConfirmPrompt,
 		&i.Summarizer,
+		&i.Title,
+		&i.ReadOnlyHint,
+		&i.DestructiveHint,
+		&i.IdempotentHint,
+		&i.OpenWorldHint,
 		&i.Name,
 		&i.UntruncatedName,
 		&i.Summary,
@@ -247,7 +263,13 @@ func (q *Queries) GetHTTPToolDefinitionByID(ctx context.Context, arg GetHTTPTool
 		&i.QuerySettings,
 		&i.PathSettings,
 		&i.RequestContentType,
+		&i.BodySettings,
 		&i.ResponseFilter,
+		&i.OutputSchema,
+		&i.XmlResponseSchema,
+		&i.ResponseBudget,
+		&i.RetryPolicy,
+		&i.CachePolicy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
@@ -268,7 +290,7 @@ WITH deployment AS (
     ORDER BY seq DESC
     LIMIT 1
 )
-SELECT http_tool_definitions.id, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, response_filter, created_at, updated_at, deleted_at, deleted, deployment.id
+SELECT http_tool_definitions.id, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, body_settings, response_filter, output_schema, xml_response_schema, response_budget, retry_policy, cache_policy, created_at, updated_at, deleted_at, deleted, deployment.id
 FROM http_tool_definitions
 INNER JOIN deployment ON http_tool_definitions.deployment_id = deployment.id
 WHERE http_tool_definitions.project_id = $1 
@@ -291,6 +313,11 @@ type ListFirstPartyHTTPToolsRow struct {
 	Confirm             pgtype.Text
 	ConfirmPrompt       pgtype.Text
 	Summarizer          pgtype.Text
+	Title               pgtype.Text
+	ReadOnlyHint        pgtype.Bool
+	DestructiveHint     pgtype.Bool
+	IdempotentHint      pgtype.Bool
+	OpenWorldHint       pgtype.Bool
 	Name                string
 	UntruncatedName     pgtype.Text
 	Summary             string
@@ -312,7 +339,13 @@ type ListFirstPartyHTTPToolsRow struct {
 	QuerySettings       []byte
 	PathSettings        []byte
 	RequestContentType  pgtype.Text
+	BodySettings        []byte
 	ResponseFilter      *models.ResponseFilter
+	OutputSchema        []byte
+	XmlResponseSchema   []byte
+	ResponseBudget      pgtype.Int8
+	RetryPolicy         *models.RetryPolicy
+	CachePolicy         *models.CachePolicy
 	CreatedAt           pgtype.Timestamptz
 	UpdatedAt           pgtype.Timestamptz
 	DeletedAt           pgtype.Timestamptz
@@ -337,6 +370,11 @@ func (q *Queries) ListFirstPartyHTTPTools(ctx context.Context, arg ListFirstPart
 			&i.Confirm,
 			&i.ConfirmPrompt,
 			&i.Summarizer,
+			&i.Title,
+			&i.ReadOnlyHint,
+			&i.DestructiveHint,
+			&i.IdempotentHint,
+			&i.OpenWorldHint,
 			&i.Name,
 			&i.UntruncatedName,
 			&i.Summary,
@@ -358,7 +396,13 @@ func (q *Queries) ListFirstPartyHTTPTools(ctx context.Context, arg ListFirstPart
 			&i.QuerySettings,
 			&i.PathSettings,
 			&i.RequestContentType,
+			&i.BodySettings,
 			&i.ResponseFilter,
+			&i.OutputSchema,
+			&i.XmlResponseSchema,
+			&i.ResponseBudget,
+			&i.RetryPolicy,
+			&i.CachePolicy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -405,7 +449,15 @@ SELECT
   htd.confirm,
   htd.confirm_prompt,
   htd.summarizer,
+  htd.title,
+  htd.read_only_hint,
+  htd.destructive_hint,
+  htd.idempotent_hint,
+  htd.open_world_hint,
   htd.response_filter,
+  htd.output_schema,
+  htd.response_budget,
+  htd.retry_policy,
   htd.path,
   htd.openapiv3_document_id,
   htd.openapiv3_operation,
@@ -448,7 +500,15 @@ type ListToolsRow struct {
 	Confirm             pgtype.Text
 	ConfirmPrompt       pgtype.Text
 	Summarizer          pgtype.Text
+	Title               pgtype.Text
+	ReadOnlyHint        pgtype.Bool
+	DestructiveHint     pgtype.Bool
+	IdempotentHint      pgtype.Bool
+	OpenWorldHint       pgtype.Bool
 	ResponseFilter      *models.ResponseFilter
+	OutputSchema        []byte
+	ResponseBudget      pgtype.Int8
+	RetryPolicy         *models.RetryPolicy
 	Path                string
 	Openapiv3DocumentID uuid.NullUUID
 	Openapiv3Operation  pgtype.Text
@@ -486,7 +546,15 @@ func (q *Queries) ListTools(ctx context.Context, arg ListToolsParams) ([]ListToo
 			&i.Confirm,
 			&i.ConfirmPrompt,
 			&i.Summarizer,
+			&i.Title,
+			&i.ReadOnlyHint,
+			&i.DestructiveHint,
+			&i.IdempotentHint,
+			&i.OpenWorldHint,
 			&i.ResponseFilter,
+			&i.OutputSchema,
+			&i.ResponseBudget,
+			&i.RetryPolicy,
 			&i.Path,
 			&i.Openapiv3DocumentID,
 			&i.Openapiv3Operation,
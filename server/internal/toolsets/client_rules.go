@@ -0,0 +1,87 @@
+package toolsets
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+)
+
+// replaceToolsetClientRules validates the MCP client rules submitted for a
+// toolset and replaces the toolset's existing rules with them.
+func replaceToolsetClientRules(ctx context.Context, logger *slog.Logger, tr *repo.Queries, projectID uuid.UUID, toolsetID uuid.UUID, rules []*types.ToolsetClientRule) error {
+	type ruleKey struct{ name, kind string }
+	seen := make(map[ruleKey]struct{}, len(rules))
+
+	additions := make([]repo.AddToolsetClientRulesParams, 0, len(rules))
+	for _, rule := range rules {
+		name := strings.TrimSpace(rule.ClientName)
+		if name == "" {
+			return oops.E(oops.CodeBadRequest, nil, "client rules must name a client")
+		}
+
+		key := ruleKey{name: strings.ToLower(name), kind: rule.Kind}
+		if _, ok := seen[key]; ok {
+			return oops.E(oops.CodeBadRequest, nil, "duplicate %s rule for client %s", rule.Kind, name)
+		}
+		seen[key] = struct{}{}
+
+		conflict := ruleKey{name: key.name, kind: mv.ClientRuleAllow}
+		if rule.Kind == mv.ClientRuleAllow {
+			conflict.kind = mv.ClientRuleBlock
+		}
+		if _, ok := seen[conflict]; ok && rule.Kind != mv.ClientRuleMinimumVersion {
+			return oops.E(oops.CodeBadRequest, nil, "client %s cannot be both allowed and blocked", name)
+		}
+
+		minVersion := pgtype.Text{String: "", Valid: false}
+		switch rule.Kind {
+		case mv.ClientRuleMinimumVersion:
+			if rule.MinVersion == nil {
+				return oops.E(oops.CodeBadRequest, nil, "minimum_version rule for client %s requires a min_version", name)
+			}
+			if _, err := semver.NewVersion(*rule.MinVersion); err != nil {
+				return oops.E(oops.CodeBadRequest, err, "minimum_version rule for client %s: invalid version %q", name, *rule.MinVersion)
+			}
+			minVersion = pgtype.Text{String: *rule.MinVersion, Valid: true}
+		default:
+			if rule.MinVersion != nil {
+				return oops.E(oops.CodeBadRequest, nil, "%s rule for client %s cannot set a min_version", rule.Kind, name)
+			}
+		}
+
+		additions = append(additions, repo.AddToolsetClientRulesParams{
+			ProjectID:  projectID,
+			ToolsetID:  toolsetID,
+			ClientName: name,
+			Kind:       rule.Kind,
+			MinVersion: minVersion,
+		})
+	}
+
+	err := tr.ClearToolsetClientRules(ctx, repo.ClearToolsetClientRulesParams{
+		ProjectID: projectID,
+		ToolsetID: toolsetID,
+	})
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "error resetting client rules for toolset").Log(ctx, logger)
+	}
+
+	if len(additions) == 0 {
+		return nil
+	}
+
+	if _, err := tr.AddToolsetClientRules(ctx, additions); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "error adding client rules to toolset").Log(ctx, logger)
+	}
+
+	return nil
+}
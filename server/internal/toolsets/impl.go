@@ -30,6 +30,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	domainsRepo "github.com/speakeasy-api/gram/server/internal/customdomains/repo"
 	environmentsRepo "github.com/speakeasy-api/gram/server/internal/environments/repo"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
 	"github.com/speakeasy-api/gram/server/internal/middleware"
 	"github.com/speakeasy-api/gram/server/internal/mv"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
@@ -56,11 +57,12 @@ type Service struct {
 	domainsRepo     *domainsRepo.Queries
 	usageRepo       *usageRepo.Queries
 	oauthRepo       *oauthRepo.Queries
+	listChanged     *relay.Broker
 }
 
 var _ gen.Service = (*Service)(nil)
 
-func NewService(logger *slog.Logger, db *pgxpool.Pool, sessions *sessions.Manager) *Service {
+func NewService(logger *slog.Logger, db *pgxpool.Pool, sessions *sessions.Manager, listChanged *relay.Broker) *Service {
 	logger = logger.With(attr.SlogComponent("toolsets"))
 
 	return &Service{
@@ -74,6 +76,7 @@ func NewService(logger *slog.Logger, db *pgxpool.Pool, sessions *sessions.Manage
 		domainsRepo:     domainsRepo.New(db),
 		usageRepo:       usageRepo.New(db),
 		oauthRepo:       oauthRepo.New(db),
+		listChanged:     listChanged,
 	}
 }
 
@@ -221,6 +224,9 @@ func (s *Service) UpdateToolset(ctx context.Context, payload *gen.UpdateToolsetP
 		McpEnabled:             existingToolset.McpEnabled,
 		CustomDomainID:         existingToolset.CustomDomainID,
 		McpIsPublic:            existingToolset.McpIsPublic,
+		ToolSelectionMode:      existingToolset.ToolSelectionMode,
+		ResourceLinkThreshold:  existingToolset.ResourceLinkThreshold,
+		ResponseBudget:         existingToolset.ResponseBudget,
 	}
 	if payload.Name != nil {
 		updateParams.Name = *payload.Name
@@ -300,6 +306,18 @@ func (s *Service) UpdateToolset(ctx context.Context, payload *gen.UpdateToolsetP
 		updateParams.McpIsPublic = *payload.McpIsPublic
 	}
 
+	if payload.ToolSelectionMode != nil {
+		updateParams.ToolSelectionMode = *payload.ToolSelectionMode
+	}
+
+	if payload.ResourceLinkThreshold != nil {
+		updateParams.ResourceLinkThreshold = conv.PtrToPGInt8(payload.ResourceLinkThreshold)
+	}
+
+	if payload.ResponseBudget != nil {
+		updateParams.ResponseBudget = conv.PtrToPGInt8(payload.ResponseBudget)
+	}
+
 	// Convert set back to slice
 	if payload.HTTPToolNames != nil {
 		updateParams.HttpToolNames = make([]string, 0, len(payload.HTTPToolNames))
@@ -311,6 +329,7 @@ func (s *Service) UpdateToolset(ctx context.Context, payload *gen.UpdateToolsetP
 		return nil, oops.E(oops.CodeUnexpected, err, "error updating toolset").Log(ctx, logger)
 	}
 
+	promptsChanged := false
 	if payload.PromptTemplateNames != nil {
 		ptrows, err := tplr.PeekTemplatesByNames(ctx, tplRepo.PeekTemplatesByNamesParams{
 			ProjectID: *authCtx.ProjectID,
@@ -320,6 +339,24 @@ func (s *Service) UpdateToolset(ctx context.Context, payload *gen.UpdateToolsetP
 			return nil, oops.E(oops.CodeUnexpected, err, "error validating prompt templates").Log(ctx, logger)
 		}
 
+		current, err := tr.GetPromptTemplatesForToolset(ctx, repo.GetPromptTemplatesForToolsetParams{
+			ProjectID: *authCtx.ProjectID,
+			ToolsetID: existingToolset.ID,
+		})
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "error loading prompt templates for toolset").Log(ctx, logger)
+		}
+
+		currentNames := make([]string, 0, len(current))
+		for _, row := range current {
+			currentNames = append(currentNames, row.Name)
+		}
+		updatedNames := make([]string, 0, len(ptrows))
+		for _, ptrow := range ptrows {
+			updatedNames = append(updatedNames, ptrow.Name)
+		}
+		promptsChanged = !sameNames(currentNames, updatedNames)
+
 		err = tr.ClearToolsetPromptTemplates(ctx, repo.ClearToolsetPromptTemplatesParams{
 			ProjectID: *authCtx.ProjectID,
 			ToolsetID: existingToolset.ID,
@@ -345,10 +382,62 @@ func (s *Service) UpdateToolset(ctx context.Context, payload *gen.UpdateToolsetP
 		}
 	}
 
+	if updatedToolset.ToolSelectionMode == mv.ToolSelectionModeDynamic {
+		if err := checkMetaToolNameClashes(ctx, logger, tr, updatedToolset); err != nil {
+			return nil, err
+		}
+	}
+
+	if payload.Instructions != nil {
+		err = reviseToolsetInstructions(ctx, tr, *authCtx.ProjectID, existingToolset.ID, *payload.Instructions)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "error updating toolset instructions").Log(ctx, logger)
+		}
+	}
+
+	if payload.Resources != nil {
+		err = replaceToolsetResources(ctx, logger, dbtx, replaceResourcesParams{
+			projectID:   *authCtx.ProjectID,
+			toolsetID:   existingToolset.ID,
+			toolsetSlug: updatedToolset.Slug,
+			forms:       payload.Resources,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if payload.ClientRules != nil {
+		err = replaceToolsetClientRules(ctx, logger, tr, *authCtx.ProjectID, existingToolset.ID, payload.ClientRules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err := dbtx.Commit(ctx); err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "error saving updated toolset").Log(ctx, logger)
 	}
 
+	toolsChanged := (payload.HTTPToolNames != nil && !sameNames(existingToolset.HttpToolNames, updatedToolset.HttpToolNames)) ||
+		existingToolset.ToolSelectionMode != updatedToolset.ToolSelectionMode ||
+		existingToolset.ResponseBudget.Valid != updatedToolset.ResponseBudget.Valid
+	var changed []relay.Kind
+	if toolsChanged {
+		changed = append(changed, relay.KindToolsListChanged)
+	}
+	if promptsChanged {
+		changed = append(changed, relay.KindPromptsListChanged)
+	}
+	if payload.Resources != nil {
+		changed = append(changed, relay.KindResourcesUpdated)
+	}
+	if len(changed) > 0 {
+		err := s.listChanged.PublishListChanged(ctx, authCtx.ProjectID.String(), updatedToolset.Slug, changed...)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to announce toolset changes to mcp clients", attr.SlogError(err))
+		}
+	}
+
 	toolsetDetails, err := mv.DescribeToolset(ctx, logger, s.db, mv.ProjectID(*authCtx.ProjectID), mv.ToolsetSlug(updatedToolset.Slug))
 	if err != nil {
 		return nil, err
@@ -514,3 +603,52 @@ func (s *Service) RemoveOAuthServer(ctx context.Context, payload *gen.RemoveOAut
 
 	return toolsetDetails, nil
 }
+
+// checkMetaToolNameClashes rejects a toolset in dynamic tool selection mode
+// that has a tool named after one of the meta-tools it lists, since calls to
+// that name would reach the meta-tool instead.
+func checkMetaToolNameClashes(ctx context.Context, logger *slog.Logger, tr *repo.Queries, toolset repo.Toolset) error {
+	names := slices.Clone(toolset.HttpToolNames)
+
+	prompts, err := tr.GetPromptTemplatesForToolset(ctx, repo.GetPromptTemplatesForToolsetParams{
+		ProjectID: toolset.ProjectID,
+		ToolsetID: toolset.ID,
+	})
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "error loading prompt templates for toolset").Log(ctx, logger)
+	}
+	for _, prompt := range prompts {
+		if prompt.Kind.String == "higher_order_tool" {
+			names = append(names, prompt.Name)
+		}
+	}
+
+	for _, name := range names {
+		switch name {
+		case mv.MetaToolSearchTools, mv.MetaToolDescribeTool, mv.MetaToolExecuteTool:
+			return oops.E(oops.CodeBadRequest, nil, "tool %s has the same name as a meta-tool of dynamic toolsets: rename it or use static tool selection", name)
+		}
+	}
+
+	return nil
+}
+
+// sameNames reports whether two lists hold the same names in any order.
+func sameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, name := range a {
+		counts[name]++
+	}
+	for _, name := range b {
+		counts[name]--
+		if counts[name] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,48 @@
+package toolsets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+)
+
+// reviseToolsetInstructions records a new revision of a toolset's MCP server
+// instructions. An empty value removes the instructions. No revision is
+// created when the content is unchanged so that saving a toolset without
+// touching its instructions does not bump the version.
+func reviseToolsetInstructions(ctx context.Context, tr *repo.Queries, projectID uuid.UUID, toolsetID uuid.UUID, instructions string) error {
+	content := conv.PtrToPGText(conv.PtrEmpty(strings.TrimSpace(instructions)))
+
+	latest, err := tr.GetLatestToolsetInstructions(ctx, repo.GetLatestToolsetInstructionsParams{
+		ProjectID: projectID,
+		ToolsetID: toolsetID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		if !content.Valid {
+			return nil
+		}
+	case err != nil:
+		return fmt.Errorf("get latest toolset instructions: %w", err)
+	case latest.Content == content:
+		return nil
+	}
+
+	_, err = tr.CreateToolsetInstructions(ctx, repo.CreateToolsetInstructionsParams{
+		ProjectID: projectID,
+		ToolsetID: toolsetID,
+		Content:   content,
+	})
+	if err != nil {
+		return fmt.Errorf("create toolset instructions: %w", err)
+	}
+
+	return nil
+}
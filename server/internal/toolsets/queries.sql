@@ -45,6 +45,9 @@ SET
   , mcp_is_public = COALESCE(@mcp_is_public, mcp_is_public)
   , custom_domain_id = COALESCE(@custom_domain_id, custom_domain_id)
   , mcp_enabled = COALESCE(@mcp_enabled, mcp_enabled)
+  , tool_selection_mode = COALESCE(@tool_selection_mode, tool_selection_mode)
+  , resource_link_threshold = COALESCE(@resource_link_threshold, resource_link_threshold)
+  , response_budget = COALESCE(@response_budget, response_budget)
   , updated_at = clock_timestamp()
 WHERE slug = @slug AND project_id = @project_id
 RETURNING *;
@@ -142,4 +145,94 @@ SET
   , oauth_proxy_server_id = NULL
   , updated_at = clock_timestamp()
 WHERE slug = @slug AND project_id = @project_id
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+-- name: ListToolsetResources :many
+SELECT
+    tr.id
+  , tr.kind
+  , tr.name
+  , tr.description
+  , tr.mime_type
+  , tr.asset_id
+  , tr.http_tool_name
+  , tr.uri_template
+  , tr.created_at
+  , tr.updated_at
+  , a.url AS asset_url
+  , a.content_type AS asset_content_type
+  , a.content_length AS asset_content_length
+FROM toolset_resources tr
+LEFT JOIN assets a ON a.id = tr.asset_id AND a.project_id = tr.project_id AND a.deleted IS FALSE
+WHERE tr.toolset_id = @toolset_id
+  AND tr.project_id = @project_id
+ORDER BY tr.name;
+
+-- name: ClearToolsetResources :exec
+DELETE FROM toolset_resources
+WHERE project_id = @project_id
+  AND toolset_id = @toolset_id;
+
+-- name: AddToolsetResources :copyfrom
+INSERT INTO toolset_resources (
+    project_id
+  , toolset_id
+  , kind
+  , name
+  , description
+  , mime_type
+  , asset_id
+  , http_tool_name
+  , uri_template
+) VALUES (@project_id, @toolset_id, @kind, @name, @description, @mime_type, @asset_id, @http_tool_name, @uri_template);
+
+-- name: PeekAssetsByIDs :many
+SELECT id, kind, content_type
+FROM assets
+WHERE project_id = @project_id
+  AND id = ANY(@ids::uuid[])
+  AND deleted IS FALSE;
+
+-- name: GetLatestToolsetInstructions :one
+SELECT id, version, content, created_at
+FROM toolset_instructions
+WHERE project_id = @project_id
+  AND toolset_id = @toolset_id
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: CreateToolsetInstructions :one
+INSERT INTO toolset_instructions (
+    project_id
+  , toolset_id
+  , version
+  , content
+)
+SELECT
+    @project_id
+  , @toolset_id
+  , COALESCE(MAX(version), 0) + 1
+  , @content
+FROM toolset_instructions
+WHERE toolset_id = @toolset_id
+RETURNING id, version, content, created_at;
+
+-- name: ListToolsetClientRules :many
+SELECT id, client_name, kind, min_version
+FROM toolset_client_rules
+WHERE toolset_id = @toolset_id
+  AND project_id = @project_id
+ORDER BY client_name, kind;
+
+-- name: ClearToolsetClientRules :exec
+DELETE FROM toolset_client_rules
+WHERE project_id = @project_id
+  AND toolset_id = @toolset_id;
+
+-- name: AddToolsetClientRules :copyfrom
+INSERT INTO toolset_client_rules (
+    project_id
+  , toolset_id
+  , client_name
+  , kind
+  , min_version
+) VALUES (@project_id, @toolset_id, @client_name, @kind, @min_version);
@@ -9,6 +9,42 @@ import (
 	"context"
 )
 
+// iteratorForAddToolsetClientRules implements pgx.CopyFromSource.
+type iteratorForAddToolsetClientRules struct {
+	rows                 []AddToolsetClientRulesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForAddToolsetClientRules) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForAddToolsetClientRules) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ProjectID,
+		r.rows[0].ToolsetID,
+		r.rows[0].ClientName,
+		r.rows[0].Kind,
+		r.rows[0].MinVersion,
+	}, nil
+}
+
+func (r iteratorForAddToolsetClientRules) Err() error {
+	return nil
+}
+
+func (q *Queries) AddToolsetClientRules(ctx context.Context, arg []AddToolsetClientRulesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"toolset_client_rules"}, []string{"project_id", "toolset_id", "client_name", "kind", "min_version"}, &iteratorForAddToolsetClientRules{rows: arg})
+}
+
 // iteratorForAddToolsetPromptTemplates implements pgx.CopyFromSource.
 type iteratorForAddToolsetPromptTemplates struct {
 	rows                 []AddToolsetPromptTemplatesParams
@@ -44,3 +80,43 @@ func (r iteratorForAddToolsetPromptTemplates) Err() error {
 func (q *Queries) AddToolsetPromptTemplates(ctx context.Context, arg []AddToolsetPromptTemplatesParams) (int64, error) {
 	return q.db.CopyFrom(ctx, []string{"toolset_prompts"}, []string{"project_id", "toolset_id", "prompt_history_id", "prompt_template_id", "prompt_name"}, &iteratorForAddToolsetPromptTemplates{rows: arg})
 }
+
+// iteratorForAddToolsetResources implements pgx.CopyFromSource.
+type iteratorForAddToolsetResources struct {
+	rows                 []AddToolsetResourcesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForAddToolsetResources) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForAddToolsetResources) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ProjectID,
+		r.rows[0].ToolsetID,
+		r.rows[0].Kind,
+		r.rows[0].Name,
+		r.rows[0].Description,
+		r.rows[0].MimeType,
+		r.rows[0].AssetID,
+		r.rows[0].HttpToolName,
+		r.rows[0].UriTemplate,
+	}, nil
+}
+
+func (r iteratorForAddToolsetResources) Err() error {
+	return nil
+}
+
+func (q *Queries) AddToolsetResources(ctx context.Context, arg []AddToolsetResourcesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"toolset_resources"}, []string{"project_id", "toolset_id", "kind", "name", "description", "mime_type", "asset_id", "http_tool_name", "uri_template"}, &iteratorForAddToolsetResources{rows: arg})
+}
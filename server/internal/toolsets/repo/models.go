@@ -41,6 +41,9 @@ type Toolset struct {
 	McpSlug                pgtype.Text
 	McpIsPublic            bool
 	McpEnabled             bool
+	ToolSelectionMode      string
+	ResourceLinkThreshold  pgtype.Int8
+	ResponseBudget         pgtype.Int8
 	CustomDomainID         uuid.NullUUID
 	ExternalOauthServerID  uuid.NullUUID
 	OauthProxyServerID     uuid.NullUUID
@@ -12,6 +12,14 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AddToolsetClientRulesParams struct {
+	ProjectID  uuid.UUID
+	ToolsetID  uuid.UUID
+	ClientName string
+	Kind       string
+	MinVersion pgtype.Text
+}
+
 type AddToolsetPromptTemplatesParams struct {
 	ProjectID        uuid.UUID
 	ToolsetID        uuid.UUID
@@ -20,6 +28,18 @@ type AddToolsetPromptTemplatesParams struct {
 	PromptName       string
 }
 
+type AddToolsetResourcesParams struct {
+	ProjectID    uuid.UUID
+	ToolsetID    uuid.UUID
+	Kind         string
+	Name         string
+	Description  pgtype.Text
+	MimeType     pgtype.Text
+	AssetID      uuid.NullUUID
+	HttpToolName pgtype.Text
+	UriTemplate  pgtype.Text
+}
+
 const checkMCPSlugAvailability = `-- name: CheckMCPSlugAvailability :one
 SELECT EXISTS (
   SELECT 1
@@ -36,6 +56,22 @@ func (q *Queries) CheckMCPSlugAvailability(ctx context.Context, mcpSlug pgtype.T
 	return exists, err
 }
 
+const clearToolsetClientRules = `-- name: ClearToolsetClientRules :exec
+DELETE FROM toolset_client_rules
+WHERE project_id = $1
+  AND toolset_id = $2
+`
+
+type ClearToolsetClientRulesParams struct {
+	ProjectID uuid.UUID
+	ToolsetID uuid.UUID
+}
+
+func (q *Queries) ClearToolsetClientRules(ctx context.Context, arg ClearToolsetClientRulesParams) error {
+	_, err := q.db.Exec(ctx, clearToolsetClientRules, arg.ProjectID, arg.ToolsetID)
+	return err
+}
+
 const clearToolsetOAuthServers = `-- name: ClearToolsetOAuthServers :one
 UPDATE toolsets
 SET 
@@ -43,7 +79,7 @@ SET
   , oauth_proxy_server_id = NULL
   , updated_at = clock_timestamp()
 WHERE slug = $1 AND project_id = $2
-RETURNING id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
+RETURNING id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, tool_selection_mode, resource_link_threshold, response_budget, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
 `
 
 type ClearToolsetOAuthServersParams struct {
@@ -66,6 +102,9 @@ func (q *Queries) ClearToolsetOAuthServers(ctx context.Context, arg ClearToolset
 		&i.McpSlug,
 		&i.McpIsPublic,
 		&i.McpEnabled,
+		&i.ToolSelectionMode,
+		&i.ResourceLinkThreshold,
+		&i.ResponseBudget,
 		&i.CustomDomainID,
 		&i.ExternalOauthServerID,
 		&i.OauthProxyServerID,
@@ -93,6 +132,22 @@ func (q *Queries) ClearToolsetPromptTemplates(ctx context.Context, arg ClearTool
 	return err
 }
 
+const clearToolsetResources = `-- name: ClearToolsetResources :exec
+DELETE FROM toolset_resources
+WHERE project_id = $1
+  AND toolset_id = $2
+`
+
+type ClearToolsetResourcesParams struct {
+	ProjectID uuid.UUID
+	ToolsetID uuid.UUID
+}
+
+func (q *Queries) ClearToolsetResources(ctx context.Context, arg ClearToolsetResourcesParams) error {
+	_, err := q.db.Exec(ctx, clearToolsetResources, arg.ProjectID, arg.ToolsetID)
+	return err
+}
+
 const createToolset = `-- name: CreateToolset :one
 INSERT INTO toolsets (
     organization_id
@@ -115,7 +170,7 @@ INSERT INTO toolsets (
   , $8
   , $9
 )
-RETURNING id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
+RETURNING id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, tool_selection_mode, resource_link_threshold, response_budget, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
 `
 
 type CreateToolsetParams struct {
@@ -155,6 +210,9 @@ func (q *Queries) CreateToolset(ctx context.Context, arg CreateToolsetParams) (T
 		&i.McpSlug,
 		&i.McpIsPublic,
 		&i.McpEnabled,
+		&i.ToolSelectionMode,
+		&i.ResourceLinkThreshold,
+		&i.ResponseBudget,
 		&i.CustomDomainID,
 		&i.ExternalOauthServerID,
 		&i.OauthProxyServerID,
@@ -166,6 +224,48 @@ func (q *Queries) CreateToolset(ctx context.Context, arg CreateToolsetParams) (T
 	return i, err
 }
 
+const createToolsetInstructions = `-- name: CreateToolsetInstructions :one
+INSERT INTO toolset_instructions (
+    project_id
+  , toolset_id
+  , version
+  , content
+)
+SELECT
+    $1
+  , $2
+  , COALESCE(MAX(version), 0) + 1
+  , $3
+FROM toolset_instructions
+WHERE toolset_id = $2
+RETURNING id, version, content, created_at
+`
+
+type CreateToolsetInstructionsParams struct {
+	ProjectID uuid.UUID
+	ToolsetID uuid.UUID
+	Content   pgtype.Text
+}
+
+type CreateToolsetInstructionsRow struct {
+	ID        uuid.UUID
+	Version   int32
+	Content   pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateToolsetInstructions(ctx context.Context, arg CreateToolsetInstructionsParams) (CreateToolsetInstructionsRow, error) {
+	row := q.db.QueryRow(ctx, createToolsetInstructions, arg.ProjectID, arg.ToolsetID, arg.Content)
+	var i CreateToolsetInstructionsRow
+	err := row.Scan(
+		&i.ID,
+		&i.Version,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const deleteToolset = `-- name: DeleteToolset :exec
 UPDATE toolsets
 SET deleted_at = clock_timestamp()
@@ -232,6 +332,39 @@ func (q *Queries) GetHTTPSecurityDefinitions(ctx context.Context, arg GetHTTPSec
 	return items, nil
 }
 
+const getLatestToolsetInstructions = `-- name: GetLatestToolsetInstructions :one
+SELECT id, version, content, created_at
+FROM toolset_instructions
+WHERE project_id = $1
+  AND toolset_id = $2
+ORDER BY version DESC
+LIMIT 1
+`
+
+type GetLatestToolsetInstructionsParams struct {
+	ProjectID uuid.UUID
+	ToolsetID uuid.UUID
+}
+
+type GetLatestToolsetInstructionsRow struct {
+	ID        uuid.UUID
+	Version   int32
+	Content   pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetLatestToolsetInstructions(ctx context.Context, arg GetLatestToolsetInstructionsParams) (GetLatestToolsetInstructionsRow, error) {
+	row := q.db.QueryRow(ctx, getLatestToolsetInstructions, arg.ProjectID, arg.ToolsetID)
+	var i GetLatestToolsetInstructionsRow
+	err := row.Scan(
+		&i.ID,
+		&i.Version,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getPromptTemplatesForToolset = `-- name: GetPromptTemplatesForToolset :many
 WITH ranked_templates AS (
   SELECT 
@@ -317,7 +450,7 @@ func (q *Queries) GetPromptTemplatesForToolset(ctx context.Context, arg GetPromp
 }
 
 const getToolset = `-- name: GetToolset :one
-SELECT id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
+SELECT id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, tool_selection_mode, resource_link_threshold, response_budget, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
 FROM toolsets
 WHERE slug = $1 AND project_id = $2 AND deleted IS FALSE
 `
@@ -342,6 +475,9 @@ func (q *Queries) GetToolset(ctx context.Context, arg GetToolsetParams) (Toolset
 		&i.McpSlug,
 		&i.McpIsPublic,
 		&i.McpEnabled,
+		&i.ToolSelectionMode,
+		&i.ResourceLinkThreshold,
+		&i.ResponseBudget,
 		&i.CustomDomainID,
 		&i.ExternalOauthServerID,
 		&i.OauthProxyServerID,
@@ -354,7 +490,7 @@ func (q *Queries) GetToolset(ctx context.Context, arg GetToolsetParams) (Toolset
 }
 
 const getToolsetByMcpSlug = `-- name: GetToolsetByMcpSlug :one
-SELECT id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
+SELECT id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, tool_selection_mode, resource_link_threshold, response_budget, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
 FROM toolsets
 WHERE mcp_slug = $1
   AND custom_domain_id IS NULL
@@ -376,6 +512,9 @@ func (q *Queries) GetToolsetByMcpSlug(ctx context.Context, mcpSlug pgtype.Text)
 		&i.McpSlug,
 		&i.McpIsPublic,
 		&i.McpEnabled,
+		&i.ToolSelectionMode,
+		&i.ResourceLinkThreshold,
+		&i.ResponseBudget,
 		&i.CustomDomainID,
 		&i.ExternalOauthServerID,
 		&i.OauthProxyServerID,
@@ -388,7 +527,7 @@ func (q *Queries) GetToolsetByMcpSlug(ctx context.Context, mcpSlug pgtype.Text)
 }
 
 const getToolsetByMcpSlugAndCustomDomain = `-- name: GetToolsetByMcpSlugAndCustomDomain :one
-SELECT id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
+SELECT id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, tool_selection_mode, resource_link_threshold, response_budget, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
 FROM toolsets
 WHERE mcp_slug = $1
   AND custom_domain_id = $2
@@ -415,6 +554,9 @@ func (q *Queries) GetToolsetByMcpSlugAndCustomDomain(ctx context.Context, arg Ge
 		&i.McpSlug,
 		&i.McpIsPublic,
 		&i.McpEnabled,
+		&i.ToolSelectionMode,
+		&i.ResourceLinkThreshold,
+		&i.ResponseBudget,
 		&i.CustomDomainID,
 		&i.ExternalOauthServerID,
 		&i.OauthProxyServerID,
@@ -427,7 +569,7 @@ func (q *Queries) GetToolsetByMcpSlugAndCustomDomain(ctx context.Context, arg Ge
 }
 
 const listPublicToolsetsByOrganization = `-- name: ListPublicToolsetsByOrganization :many
-SELECT t.id, t.organization_id, t.project_id, t.name, t.slug, t.description, t.default_environment_slug, t.http_tool_names, t.mcp_slug, t.mcp_is_public, t.mcp_enabled, t.custom_domain_id, t.external_oauth_server_id, t.oauth_proxy_server_id, t.created_at, t.updated_at, t.deleted_at, t.deleted
+SELECT t.id, t.organization_id, t.project_id, t.name, t.slug, t.description, t.default_environment_slug, t.http_tool_names, t.mcp_slug, t.mcp_is_public, t.mcp_enabled, t.tool_selection_mode, t.resource_link_threshold, t.response_budget, t.custom_domain_id, t.external_oauth_server_id, t.oauth_proxy_server_id, t.created_at, t.updated_at, t.deleted_at, t.deleted
 FROM toolsets t
 JOIN projects p ON t.project_id = p.id
 WHERE p.organization_id = $1
@@ -458,6 +600,9 @@ func (q *Queries) ListPublicToolsetsByOrganization(ctx context.Context, organiza
 			&i.McpSlug,
 			&i.McpIsPublic,
 			&i.McpEnabled,
+			&i.ToolSelectionMode,
+			&i.ResourceLinkThreshold,
+			&i.ResponseBudget,
 			&i.CustomDomainID,
 			&i.ExternalOauthServerID,
 			&i.OauthProxyServerID,
@@ -476,8 +621,130 @@ func (q *Queries) ListPublicToolsetsByOrganization(ctx context.Context, organiza
 	return items, nil
 }
 
+const listToolsetClientRules = `-- name: ListToolsetClientRules :many
+SELECT id, client_name, kind, min_version
+FROM toolset_client_rules
+WHERE toolset_id = $1
+  AND project_id = $2
+ORDER BY client_name, kind
+`
+
+type ListToolsetClientRulesParams struct {
+	ToolsetID uuid.UUID
+	ProjectID uuid.UUID
+}
+
+type ListToolsetClientRulesRow struct {
+	ID         uuid.UUID
+	ClientName string
+	Kind       string
+	MinVersion pgtype.Text
+}
+
+func (q *Queries) ListToolsetClientRules(ctx context.Context, arg ListToolsetClientRulesParams) ([]ListToolsetClientRulesRow, error) {
+	rows, err := q.db.Query(ctx, listToolsetClientRules, arg.ToolsetID, arg.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListToolsetClientRulesRow
+	for rows.Next() {
+		var i ListToolsetClientRulesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientName,
+			&i.Kind,
+			&i.MinVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listToolsetResources = `-- name: ListToolsetResources :many
+SELECT
+    tr.id
+  , tr.kind
+  , tr.name
+  , tr.description
+  , tr.mime_type
+  , tr.asset_id
+  , tr.http_tool_name
+  , tr.uri_template
+  , tr.created_at
+  , tr.updated_at
+  , a.url AS asset_url
+  , a.content_type AS asset_content_type
+  , a.content_length AS asset_content_length
+FROM toolset_resources tr
+LEFT JOIN assets a ON a.id = tr.asset_id AND a.project_id = tr.project_id AND a.deleted IS FALSE
+WHERE tr.toolset_id = $1
+  AND tr.project_id = $2
+ORDER BY tr.name
+`
+
+type ListToolsetResourcesParams struct {
+	ToolsetID uuid.UUID
+	ProjectID uuid.UUID
+}
+
+type ListToolsetResourcesRow struct {
+	ID                 uuid.UUID
+	Kind               string
+	Name               string
+	Description        pgtype.Text
+	MimeType           pgtype.Text
+	AssetID            uuid.NullUUID
+	HttpToolName       pgtype.Text
+	UriTemplate        pgtype.Text
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+	AssetUrl           pgtype.Text
+	AssetContentType   pgtype.Text
+	AssetContentLength pgtype.Int8
+}
+
+func (q *Queries) ListToolsetResources(ctx context.Context, arg ListToolsetResourcesParams) ([]ListToolsetResourcesRow, error) {
+	rows, err := q.db.Query(ctx, listToolsetResources, arg.ToolsetID, arg.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListToolsetResourcesRow
+	for rows.Next() {
+		var i ListToolsetResourcesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Name,
+			&i.Description,
+			&i.MimeType,
+			&i.AssetID,
+			&i.HttpToolName,
+			&i.UriTemplate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AssetUrl,
+			&i.AssetContentType,
+			&i.AssetContentLength,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listToolsetsByProject = `-- name: ListToolsetsByProject :many
-SELECT id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
+SELECT id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, tool_selection_mode, resource_link_threshold, response_budget, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
 FROM toolsets
 WHERE project_id = $1
   AND deleted IS FALSE
@@ -505,6 +772,9 @@ func (q *Queries) ListToolsetsByProject(ctx context.Context, projectID uuid.UUID
 			&i.McpSlug,
 			&i.McpIsPublic,
 			&i.McpEnabled,
+			&i.ToolSelectionMode,
+			&i.ResourceLinkThreshold,
+			&i.ResponseBudget,
 			&i.CustomDomainID,
 			&i.ExternalOauthServerID,
 			&i.OauthProxyServerID,
@@ -523,6 +793,45 @@ func (q *Queries) ListToolsetsByProject(ctx context.Context, projectID uuid.UUID
 	return items, nil
 }
 
+const peekAssetsByIDs = `-- name: PeekAssetsByIDs :many
+SELECT id, kind, content_type
+FROM assets
+WHERE project_id = $1
+  AND id = ANY($2::uuid[])
+  AND deleted IS FALSE
+`
+
+type PeekAssetsByIDsParams struct {
+	ProjectID uuid.UUID
+	Ids       []uuid.UUID
+}
+
+type PeekAssetsByIDsRow struct {
+	ID          uuid.UUID
+	Kind        string
+	ContentType string
+}
+
+func (q *Queries) PeekAssetsByIDs(ctx context.Context, arg PeekAssetsByIDsParams) ([]PeekAssetsByIDsRow, error) {
+	rows, err := q.db.Query(ctx, peekAssetsByIDs, arg.ProjectID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PeekAssetsByIDsRow
+	for rows.Next() {
+		var i PeekAssetsByIDsRow
+		if err := rows.Scan(&i.ID, &i.Kind, &i.ContentType); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateToolset = `-- name: UpdateToolset :one
 UPDATE toolsets
 SET 
@@ -534,9 +843,12 @@ SET
   , mcp_is_public = COALESCE($6, mcp_is_public)
   , custom_domain_id = COALESCE($7, custom_domain_id)
   , mcp_enabled = COALESCE($8, mcp_enabled)
+  , tool_selection_mode = COALESCE($9, tool_selection_mode)
+  , resource_link_threshold = COALESCE($10, resource_link_threshold)
+  , response_budget = COALESCE($11, response_budget)
   , updated_at = clock_timestamp()
-WHERE slug = $9 AND project_id = $10
-RETURNING id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
+WHERE slug = $12 AND project_id = $13
+RETURNING id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, tool_selection_mode, resource_link_threshold, response_budget, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
 `
 
 type UpdateToolsetParams struct {
@@ -548,6 +860,9 @@ type UpdateToolsetParams struct {
 	McpIsPublic            bool
 	CustomDomainID         uuid.NullUUID
 	McpEnabled             bool
+	ToolSelectionMode      string
+	ResourceLinkThreshold  pgtype.Int8
+	ResponseBudget         pgtype.Int8
 	Slug                   string
 	ProjectID              uuid.UUID
 }
@@ -562,6 +877,9 @@ func (q *Queries) UpdateToolset(ctx context.Context, arg UpdateToolsetParams) (T
 		arg.McpIsPublic,
 		arg.CustomDomainID,
 		arg.McpEnabled,
+		arg.ToolSelectionMode,
+		arg.ResourceLinkThreshold,
+		arg.ResponseBudget,
 		arg.Slug,
 		arg.ProjectID,
 	)
@@ -578,6 +896,9 @@ func (q *Queries) UpdateToolset(ctx context.Context, arg UpdateToolsetParams) (T
 		&i.McpSlug,
 		&i.McpIsPublic,
 		&i.McpEnabled,
+		&i.ToolSelectionMode,
+		&i.ResourceLinkThreshold,
+		&i.ResponseBudget,
 		&i.CustomDomainID,
 		&i.ExternalOauthServerID,
 		&i.OauthProxyServerID,
@@ -595,7 +916,7 @@ SET
     external_oauth_server_id = $1
   , updated_at = clock_timestamp()
 WHERE slug = $2 AND project_id = $3
-RETURNING id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
+RETURNING id, organization_id, project_id, name, slug, description, default_environment_slug, http_tool_names, mcp_slug, mcp_is_public, mcp_enabled, tool_selection_mode, resource_link_threshold, response_budget, custom_domain_id, external_oauth_server_id, oauth_proxy_server_id, created_at, updated_at, deleted_at, deleted
 `
 
 type UpdateToolsetExternalOAuthServerParams struct {
@@ -619,6 +940,9 @@ func (q *Queries) UpdateToolsetExternalOAuthServer(ctx context.Context, arg Upda
 		&i.McpSlug,
 		&i.McpIsPublic,
 		&i.McpEnabled,
+		&i.ToolSelectionMode,
+		&i.ResourceLinkThreshold,
+		&i.ResponseBudget,
 		&i.CustomDomainID,
 		&i.ExternalOauthServerID,
 		&i.OauthProxyServerID,
@@ -0,0 +1,208 @@
+package toolsets
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	toolsRepo "github.com/speakeasy-api/gram/server/internal/tools/repo"
+	"github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+	"github.com/speakeasy-api/gram/server/internal/uritemplate"
+)
+
+var pathParamPattern = regexp.MustCompile(`\{([^}/]+)\}`)
+
+type replaceResourcesParams struct {
+	projectID   uuid.UUID
+	toolsetID   uuid.UUID
+	toolsetSlug string
+	forms       []*types.ToolsetResourceForm
+}
+
+// replaceToolsetResources validates the resources submitted for a toolset and
+// replaces the toolset's existing resources with them.
+func replaceToolsetResources(ctx context.Context, logger *slog.Logger, tx pgx.Tx, params replaceResourcesParams) error {
+	tr := repo.New(tx)
+
+	seen := make(map[types.Slug]struct{}, len(params.forms))
+	assetIDs := make([]uuid.UUID, 0, len(params.forms))
+	toolNames := make([]string, 0, len(params.forms))
+	for _, form := range params.forms {
+		if _, ok := seen[form.Name]; ok {
+			return oops.E(oops.CodeBadRequest, nil, "duplicate resource name: %s", form.Name)
+		}
+		seen[form.Name] = struct{}{}
+
+		switch form.Kind {
+		case mv.ResourceKindAsset:
+			if form.AssetID == nil || form.HTTPToolName != nil || form.URITemplate != nil {
+				return oops.E(oops.CodeBadRequest, nil, "resource %s: asset resources require an asset id and cannot set a tool or uri template", form.Name)
+			}
+			id, err := uuid.Parse(*form.AssetID)
+			if err != nil {
+				return oops.E(oops.CodeBadRequest, err, "resource %s: invalid asset id", form.Name)
+			}
+			assetIDs = append(assetIDs, id)
+		case mv.ResourceKindHTTPTemplate:
+			if form.HTTPToolName == nil || form.AssetID != nil {
+				return oops.E(oops.CodeBadRequest, nil, "resource %s: http_template resources require a tool name and cannot set an asset id", form.Name)
+			}
+			toolNames = append(toolNames, *form.HTTPToolName)
+		default:
+			return oops.E(oops.CodeBadRequest, nil, "resource %s: unsupported kind %q", form.Name, form.Kind)
+		}
+	}
+
+	if len(assetIDs) > 0 {
+		assets, err := tr.PeekAssetsByIDs(ctx, repo.PeekAssetsByIDsParams{
+			ProjectID: params.projectID,
+			Ids:       assetIDs,
+		})
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "error validating resource assets").Log(ctx, logger)
+		}
+
+		found := make(map[uuid.UUID]struct{}, len(assets))
+		for _, asset := range assets {
+			found[asset.ID] = struct{}{}
+		}
+		for _, id := range assetIDs {
+			if _, ok := found[id]; !ok {
+				return oops.E(oops.CodeBadRequest, nil, "asset not found: %s", id)
+			}
+		}
+	}
+
+	tools := make(map[string]toolsRepo.HttpToolDefinition, len(toolNames))
+	if len(toolNames) > 0 {
+		rows, err := toolsRepo.New(tx).FindToolsByName(ctx, toolsRepo.FindToolsByNameParams{
+			ProjectID:    params.projectID,
+			Names:        toolNames,
+			DeploymentID: uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		})
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "error validating resource tools").Log(ctx, logger)
+		}
+
+		for _, row := range rows {
+			tools[row.HttpToolDefinition.Name] = row.HttpToolDefinition
+		}
+	}
+
+	additions := make([]repo.AddToolsetResourcesParams, 0, len(params.forms))
+	for _, form := range params.forms {
+		addition := repo.AddToolsetResourcesParams{
+			ProjectID:    params.projectID,
+			ToolsetID:    params.toolsetID,
+			Kind:         form.Kind,
+			Name:         string(form.Name),
+			Description:  conv.PtrToPGTextEmpty(form.Description),
+			MimeType:     conv.PtrToPGTextEmpty(form.MimeType),
+			AssetID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+			HttpToolName: conv.PtrToPGTextEmpty(form.HTTPToolName),
+			UriTemplate:  conv.PtrToPGText(nil),
+		}
+
+		switch form.Kind {
+		case mv.ResourceKindAsset:
+			addition.AssetID = uuid.NullUUID{UUID: uuid.MustParse(*form.AssetID), Valid: true}
+		case mv.ResourceKindHTTPTemplate:
+			tool, ok := tools[*form.HTTPToolName]
+			if !ok {
+				return oops.E(oops.CodeBadRequest, nil, "resource %s: tool not found: %s", form.Name, *form.HTTPToolName)
+			}
+			if tool.HttpMethod != http.MethodGet {
+				return oops.E(oops.CodeBadRequest, nil, "resource %s: tool %s must be a GET operation", form.Name, *form.HTTPToolName)
+			}
+
+			tpl, err := resourceURITemplate(params.toolsetSlug, form, tool)
+			if err != nil {
+				return err
+			}
+			addition.UriTemplate = conv.ToPGText(tpl)
+		}
+
+		additions = append(additions, addition)
+	}
+
+	err := tr.ClearToolsetResources(ctx, repo.ClearToolsetResourcesParams{
+		ProjectID: params.projectID,
+		ToolsetID: params.toolsetID,
+	})
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "error resetting resources for toolset").Log(ctx, logger)
+	}
+
+	if len(additions) == 0 {
+		return nil
+	}
+
+	if _, err := tr.AddToolsetResources(ctx, additions); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "error adding resources to toolset").Log(ctx, logger)
+	}
+
+	return nil
+}
+
+// resourceURITemplate returns the URI template for a resource backed by a
+// GET tool. A template supplied by the user is checked against the tool's
+// parameters. Otherwise one is built that exposes every path parameter as a
+// segment and every query parameter as an optional query expansion.
+func resourceURITemplate(toolsetSlug string, form *types.ToolsetResourceForm, tool toolsRepo.HttpToolDefinition) (string, error) {
+	pathParams, err := UnmarshalParameterSettings(tool.PathSettings)
+	if err != nil {
+		return "", oops.E(oops.CodeUnexpected, err, "resource %s: error reading tool path parameters", form.Name)
+	}
+	queryParams, err := UnmarshalParameterSettings(tool.QuerySettings)
+	if err != nil {
+		return "", oops.E(oops.CodeUnexpected, err, "resource %s: error reading tool query parameters", form.Name)
+	}
+
+	pathVars := make([]string, 0, len(pathParams))
+	for _, m := range pathParamPattern.FindAllStringSubmatch(tool.Path, -1) {
+		if _, ok := pathParams[m[1]]; ok && !slices.Contains(pathVars, m[1]) {
+			pathVars = append(pathVars, m[1])
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(pathParams)) {
+		if !slices.Contains(pathVars, name) {
+			pathVars = append(pathVars, name)
+		}
+	}
+
+	if form.URITemplate == nil {
+		queryVars := slices.Sorted(maps.Keys(queryParams))
+		return uritemplate.Build(mv.StaticResourceURI(toolsetSlug, string(form.Name)), pathVars, queryVars), nil
+	}
+
+	tpl, err := uritemplate.Parse(*form.URITemplate)
+	if err != nil {
+		return "", oops.E(oops.CodeBadRequest, err, "resource %s: %s", form.Name, err.Error())
+	}
+
+	vars := tpl.Variables()
+	for _, name := range vars {
+		_, isPath := pathParams[name]
+		_, isQuery := queryParams[name]
+		if !isPath && !isQuery {
+			return "", oops.E(oops.CodeBadRequest, nil, "resource %s: template variable %q is not a path or query parameter of %s", form.Name, name, tool.Name)
+		}
+	}
+	for _, name := range pathVars {
+		if !slices.Contains(vars, name) {
+			return "", oops.E(oops.CodeBadRequest, nil, "resource %s: template must include path parameter %q", form.Name, name)
+		}
+	}
+
+	return tpl.String(), nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/sdk/client"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/cache"
 	"github.com/speakeasy-api/gram/server/internal/deployments"
 	"github.com/speakeasy-api/gram/server/internal/feature"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
 	packages "github.com/speakeasy-api/gram/server/internal/packages"
 	"github.com/speakeasy-api/gram/server/internal/testenv"
 	"github.com/speakeasy-api/gram/server/internal/toolsets"
@@ -61,6 +63,7 @@ type testInstance struct {
 	temporal       client.Client
 	sessionManager *sessions.Manager
 	assetStorage   assets.BlobStore
+	redisClient    *redis.Client
 }
 
 func newTestToolsetsService(t *testing.T) (context.Context, *testInstance) {
@@ -99,7 +102,7 @@ func newTestToolsetsService(t *testing.T) (context.Context, *testInstance) {
 
 	ctx = testenv.InitAuthContext(t, ctx, conn, sessionManager)
 
-	svc := toolsets.NewService(logger, conn, sessionManager)
+	svc := toolsets.NewService(logger, conn, sessionManager, relay.NewBroker(logger, redisClient))
 	deploymentsSvc := deployments.NewService(logger, tracerProvider, conn, temporal, sessionManager, assetStorage)
 	assetsSvc := assets.NewService(logger, conn, sessionManager, assetStorage)
 	packagesSvc := packages.NewService(logger, conn, sessionManager)
@@ -114,6 +117,7 @@ func newTestToolsetsService(t *testing.T) (context.Context, *testInstance) {
 		temporal:       temporal,
 		sessionManager: sessionManager,
 		assetStorage:   assetStorage,
+		redisClient:    redisClient,
 	}
 }
 
@@ -6,28 +6,35 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/mv"
 	"github.com/speakeasy-api/gram/server/internal/openapi"
 	projectsRepo "github.com/speakeasy-api/gram/server/internal/projects/repo"
 	toolsRepo "github.com/speakeasy-api/gram/server/internal/tools/repo"
+	"github.com/speakeasy-api/gram/server/internal/tools/repo/models"
 	"github.com/speakeasy-api/gram/server/internal/tools/security"
 	"github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+	variationsRepo "github.com/speakeasy-api/gram/server/internal/variations/repo"
 )
 
 // Shared service for aggregating toolset details
 type Toolsets struct {
-	repo      *repo.Queries
-	toolsRepo *toolsRepo.Queries
-	projects  *projectsRepo.Queries
+	repo       *repo.Queries
+	toolsRepo  *toolsRepo.Queries
+	projects   *projectsRepo.Queries
+	variations *variationsRepo.Queries
 }
 
 func NewToolsets(tx repo.DBTX) *Toolsets {
 	return &Toolsets{
-		repo:      repo.New(tx),
-		toolsRepo: toolsRepo.New(tx),
-		projects:  projectsRepo.New(tx),
+		repo:       repo.New(tx),
+		toolsRepo:  toolsRepo.New(tx),
+		projects:   projectsRepo.New(tx),
+		variations: variationsRepo.New(tx),
 	}
 }
 
@@ -35,6 +42,9 @@ type HTTPToolExecutionInfo struct {
 	Tool             *gateway.HTTPTool
 	OrganizationSlug string
 	ProjectSlug      string
+	// Confirm is the confirmation mode enforced for the tool, taking its
+	// variations into account.
+	Confirm mv.Confirm
 }
 
 func (t *Toolsets) GetHTTPToolExecutionInfoByID(ctx context.Context, id uuid.UUID, projectID uuid.UUID) (*HTTPToolExecutionInfo, error) {
@@ -98,6 +108,25 @@ func (t *Toolsets) GetHTTPToolExecutionInfoByID(ctx context.Context, id uuid.UUI
 		}
 	}
 
+	// Retry settings from the project's tool variations take precedence over
+	// those in the tool's source document.
+	retryPolicy := tool.RetryPolicy
+	variations, err := t.variations.FindGlobalVariationsByToolNames(ctx, variationsRepo.FindGlobalVariationsByToolNamesParams{
+		ToolNames: []string{tool.Name},
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get tool variations: %w", err)
+	}
+	confirmRaw := conv.PtrValOr(conv.FromPGText[string](tool.Confirm), "")
+	for _, variation := range variations {
+		retryPolicy = models.MergeRetryPolicies(retryPolicy, variation.RetryPolicy)
+		if variation.Confirm.Valid && variation.Confirm.String != "" {
+			confirmRaw = variation.Confirm.String
+		}
+	}
+	confirm := mv.EffectiveConfirm(confirmRaw)
+
 	pathParams, err := UnmarshalParameterSettings(tool.PathSettings)
 	if err != nil {
 		return nil, fmt.Errorf("parse path settings: %w", err)
@@ -112,6 +141,11 @@ func (t *Toolsets) GetHTTPToolExecutionInfoByID(ctx context.Context, id uuid.UUI
 		return nil, fmt.Errorf("parse path settings: %w", err)
 	}
 
+	bodyEncoding, err := UnmarshalBodySettings(tool.BodySettings)
+	if err != nil {
+		return nil, fmt.Errorf("parse body settings: %w", err)
+	}
+
 	gatewayTool := &gateway.HTTPTool{
 		ID:                 tool.ID.String(),
 		DeploymentID:       tool.DeploymentID.String(),
@@ -127,15 +161,20 @@ func (t *Toolsets) GetHTTPToolExecutionInfoByID(ctx context.Context, id uuid.UUI
 		QueryParams:        queryParams,
 		HeaderParams:       headerParams,
 		RequestContentType: gateway.NullString{Valid: tool.RequestContentType.Valid, Value: tool.RequestContentType.String},
+		BodyEncoding:       bodyEncoding,
 		Security:           sec,
 		SecurityScopes:     securityScopes,
 		ResponseFilter:     filter,
+		RetryPolicy:        gatewayRetryPolicy(retryPolicy),
+		CachePolicy:        gatewayCachePolicy(tool.CachePolicy),
+		XMLResponseSchema:  tool.XmlResponseSchema,
 	}
 
 	return &HTTPToolExecutionInfo{
 		Tool:             gatewayTool,
 		OrganizationSlug: orgData.Slug,
 		ProjectSlug:      orgData.ProjectSlug,
+		Confirm:          confirm,
 	}, nil
 }
 
@@ -161,3 +200,59 @@ func UnmarshalParameterSettings(settings []byte) (map[string]*gateway.HTTPParame
 
 	return out, nil
 }
+
+func UnmarshalBodySettings(settings []byte) (map[string]*gateway.HTTPEncoding, error) {
+	if len(settings) == 0 {
+		return map[string]*gateway.HTTPEncoding{}, nil
+	}
+
+	parsed := make(map[string]*openapi.OpenapiV3EncodingProxy)
+	if err := json.Unmarshal(settings, &parsed); err != nil {
+		return nil, fmt.Errorf("parse body settings: %w", err)
+	}
+
+	out := make(map[string]*gateway.HTTPEncoding, len(parsed))
+	for k, v := range parsed {
+		out[k] = &gateway.HTTPEncoding{
+			ContentType: v.ContentType,
+			File:        v.File,
+		}
+	}
+
+	return out, nil
+}
+
+func gatewayCachePolicy(policy *models.CachePolicy) *gateway.CachePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &gateway.CachePolicy{
+		TTL: time.Duration(conv.PtrValOr(policy.TTLMs, 0)) * time.Millisecond,
+	}
+}
+
+func gatewayRetryPolicy(policy *models.RetryPolicy) *gateway.RetryPolicy {
+	if policy.IsEmpty() {
+		return nil
+	}
+
+	millis := func(ms *int64) time.Duration {
+		if ms == nil {
+			return 0
+		}
+		return time.Duration(*ms) * time.Millisecond
+	}
+
+	return &gateway.RetryPolicy{
+		MaxAttempts:           conv.PtrValOr(policy.MaxAttempts, 0),
+		StatusCodes:           policy.StatusCodes,
+		Methods:               policy.Methods,
+		RetryConnectionErrors: policy.RetryConnectionErrors,
+		InitialInterval:       millis(policy.InitialIntervalMs),
+		MaxInterval:           millis(policy.MaxIntervalMs),
+		Exponent:              conv.PtrValOr(policy.Exponent, 0),
+		MaxElapsedTime:        millis(policy.MaxElapsedTimeMs),
+		Timeout:               millis(policy.TimeoutMs),
+	}
+}
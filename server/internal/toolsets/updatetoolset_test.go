@@ -1,7 +1,9 @@
 package toolsets_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
@@ -11,6 +13,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	environmentsRepo "github.com/speakeasy-api/gram/server/internal/environments/repo"
+	"github.com/speakeasy-api/gram/server/internal/mcp/relay"
 )
 
 func TestToolsetsService_UpdateToolset_Success(t *testing.T) {
@@ -43,6 +46,12 @@ func TestToolsetsService_UpdateToolset_Success(t *testing.T) {
 		McpEnabled:             nil,
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -82,6 +91,12 @@ func TestToolsetsService_UpdateToolset_PartialUpdate(t *testing.T) {
 		McpIsPublic:            nil,
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -135,6 +150,12 @@ func TestToolsetsService_UpdateToolset_WithEnvironment(t *testing.T) {
 		McpIsPublic:            nil,
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -159,6 +180,12 @@ func TestToolsetsService_UpdateToolset_NotFound(t *testing.T) {
 		McpIsPublic:            nil,
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "toolset not found")
@@ -194,6 +221,12 @@ func TestToolsetsService_UpdateToolset_InvalidEnvironment(t *testing.T) {
 		McpIsPublic:            nil,
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "error finding environment")
@@ -220,6 +253,12 @@ func TestToolsetsService_UpdateToolset_Unauthorized(t *testing.T) {
 		McpIsPublic:            nil,
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unauthorized")
@@ -249,6 +288,12 @@ func TestToolsetsService_UpdateToolset_NoProjectID(t *testing.T) {
 		McpIsPublic:            nil,
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unauthorized")
@@ -284,6 +329,12 @@ func TestToolsetsService_UpdateToolset_EmptyHTTPToolNames(t *testing.T) {
 		McpIsPublic:            nil,
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -320,8 +371,338 @@ func TestToolsetsService_UpdateToolset_McpEnabled(t *testing.T) {
 		McpEnabled:             conv.Ptr(true),
 		CustomDomainID:         nil,
 		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
 	})
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	require.True(t, *result.McpEnabled)
 }
+
+func TestToolsetsService_UpdateToolset_ToolSelectionMode(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+
+	created, err := ti.service.CreateToolset(ctx, &gen.CreateToolsetPayload{
+		SessionToken:           nil,
+		Name:                   "Large Toolset",
+		Description:            nil,
+		HTTPToolNames:          []string{"listPets"},
+		DefaultEnvironmentSlug: nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "static", *created.ToolSelectionMode)
+
+	result, err := ti.service.UpdateToolset(ctx, &gen.UpdateToolsetPayload{
+		SessionToken:           nil,
+		Slug:                   created.Slug,
+		Name:                   nil,
+		Description:            nil,
+		DefaultEnvironmentSlug: nil,
+		HTTPToolNames:          nil,
+		PromptTemplateNames:    nil,
+		McpSlug:                nil,
+		McpIsPublic:            nil,
+		McpEnabled:             nil,
+		CustomDomainID:         nil,
+		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      conv.Ptr("dynamic"),
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, "dynamic", *result.ToolSelectionMode)
+}
+
+func TestToolsetsService_UpdateToolset_DynamicRejectsMetaToolNames(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+
+	created, err := ti.service.CreateToolset(ctx, &gen.CreateToolsetPayload{
+		SessionToken:           nil,
+		Name:                   "Clashing Toolset",
+		Description:            nil,
+		HTTPToolNames:          []string{"listPets", "search_tools"},
+		DefaultEnvironmentSlug: nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+
+	_, err = ti.service.UpdateToolset(ctx, &gen.UpdateToolsetPayload{
+		SessionToken:           nil,
+		Slug:                   created.Slug,
+		Name:                   nil,
+		Description:            nil,
+		DefaultEnvironmentSlug: nil,
+		HTTPToolNames:          nil,
+		PromptTemplateNames:    nil,
+		McpSlug:                nil,
+		McpIsPublic:            nil,
+		McpEnabled:             nil,
+		CustomDomainID:         nil,
+		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      conv.Ptr("dynamic"),
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "same name as a meta-tool")
+
+	unchanged, err := ti.service.GetToolset(ctx, &gen.GetToolsetPayload{
+		SessionToken:     nil,
+		Slug:             created.Slug,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "static", *unchanged.ToolSelectionMode)
+}
+
+func TestToolsetsService_UpdateToolset_ResourceLinkThreshold(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+
+	created, err := ti.service.CreateToolset(ctx, &gen.CreateToolsetPayload{
+		SessionToken:           nil,
+		Name:                   "Document Toolset",
+		Description:            nil,
+		HTTPToolNames:          []string{"listPets"},
+		DefaultEnvironmentSlug: nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+	require.Nil(t, created.ResourceLinkThreshold)
+
+	result, err := ti.service.UpdateToolset(ctx, &gen.UpdateToolsetPayload{
+		SessionToken:           nil,
+		Slug:                   created.Slug,
+		Name:                   nil,
+		Description:            nil,
+		DefaultEnvironmentSlug: nil,
+		HTTPToolNames:          nil,
+		PromptTemplateNames:    nil,
+		McpSlug:                nil,
+		McpIsPublic:            nil,
+		McpEnabled:             nil,
+		CustomDomainID:         nil,
+		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  conv.Ptr(int64(256 << 10)),
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.ResourceLinkThreshold)
+	require.Equal(t, int64(256<<10), *result.ResourceLinkThreshold)
+}
+
+func TestToolsetsService_UpdateToolset_Instructions(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+
+	created, err := ti.service.CreateToolset(ctx, &gen.CreateToolsetPayload{
+		SessionToken:           nil,
+		Name:                   "Guided Toolset",
+		Description:            nil,
+		HTTPToolNames:          []string{"listPets"},
+		DefaultEnvironmentSlug: nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+	require.Nil(t, created.Instructions)
+	require.Nil(t, created.InstructionsVersion)
+
+	update := func(instructions *string) *types.Toolset {
+		result, err := ti.service.UpdateToolset(ctx, &gen.UpdateToolsetPayload{
+			SessionToken:           nil,
+			Slug:                   created.Slug,
+			Name:                   nil,
+			Description:            nil,
+			Instructions:           instructions,
+			DefaultEnvironmentSlug: nil,
+			HTTPToolNames:          nil,
+			PromptTemplateNames:    nil,
+			McpSlug:                nil,
+			McpIsPublic:            nil,
+			McpEnabled:             nil,
+			CustomDomainID:         nil,
+			ProjectSlugInput:       nil,
+			Resources:              nil,
+			ToolSelectionMode:      nil,
+			ResourceLinkThreshold:  nil,
+			ResponseBudget:         nil,
+			ClientRules:            nil,
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := update(conv.Ptr("Call listPets before getPet."))
+	require.Equal(t, "Call listPets before getPet.", *result.Instructions)
+	require.Equal(t, int32(1), *result.InstructionsVersion)
+
+	// Saving identical instructions or leaving them out does not create a
+	// new revision.
+	result = update(conv.Ptr("Call listPets before getPet."))
+	require.Equal(t, int32(1), *result.InstructionsVersion)
+	result = update(nil)
+	require.Equal(t, int32(1), *result.InstructionsVersion)
+
+	result = update(conv.Ptr("Always call listPets first."))
+	require.Equal(t, "Always call listPets first.", *result.Instructions)
+	require.Equal(t, int32(2), *result.InstructionsVersion)
+
+	result = update(conv.Ptr(""))
+	require.Nil(t, result.Instructions)
+	require.Equal(t, int32(3), *result.InstructionsVersion)
+}
+
+func TestToolsetsService_UpdateToolset_ClientRules(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+
+	created, err := ti.service.CreateToolset(ctx, &gen.CreateToolsetPayload{
+		SessionToken:           nil,
+		Name:                   "Restricted Toolset",
+		Description:            nil,
+		HTTPToolNames:          []string{"listPets"},
+		DefaultEnvironmentSlug: nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+	require.Empty(t, created.ClientRules)
+
+	update := func(rules []*types.ToolsetClientRule) (*types.Toolset, error) {
+		return ti.service.UpdateToolset(ctx, &gen.UpdateToolsetPayload{
+			SessionToken:           nil,
+			Slug:                   created.Slug,
+			Name:                   nil,
+			Description:            nil,
+			Instructions:           nil,
+			DefaultEnvironmentSlug: nil,
+			HTTPToolNames:          nil,
+			PromptTemplateNames:    nil,
+			McpSlug:                nil,
+			McpIsPublic:            nil,
+			McpEnabled:             nil,
+			CustomDomainID:         nil,
+			ProjectSlugInput:       nil,
+			Resources:              nil,
+			ClientRules:            rules,
+			ToolSelectionMode:      nil,
+			ResourceLinkThreshold:  nil,
+			ResponseBudget:         nil,
+		})
+	}
+
+	result, err := update([]*types.ToolsetClientRule{
+		{ClientName: "claude-ai", Kind: "allow", MinVersion: nil},
+		{ClientName: "cursor-vscode", Kind: "minimum_version", MinVersion: conv.Ptr("1.2.0")},
+		{ClientName: "cursor-vscode", Kind: "allow", MinVersion: nil},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.ClientRules, 3)
+	require.Equal(t, "claude-ai", result.ClientRules[0].ClientName)
+	require.Equal(t, "allow", result.ClientRules[0].Kind)
+	require.Equal(t, "1.2.0", *result.ClientRules[2].MinVersion)
+
+	_, err = update([]*types.ToolsetClientRule{
+		{ClientName: "cursor-vscode", Kind: "minimum_version", MinVersion: conv.Ptr("latest")},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid version")
+
+	_, err = update([]*types.ToolsetClientRule{
+		{ClientName: "cursor-vscode", Kind: "allow", MinVersion: nil},
+		{ClientName: "Cursor-VSCode", Kind: "block", MinVersion: nil},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "both allowed and blocked")
+
+	result, err = update([]*types.ToolsetClientRule{})
+	require.NoError(t, err)
+	require.Empty(t, result.ClientRules)
+}
+
+func TestToolsetsService_UpdateToolset_PublishesToolsListChanged(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	created, err := ti.service.CreateToolset(ctx, &gen.CreateToolsetPayload{
+		SessionToken:           nil,
+		Name:                   "Announced Toolset",
+		Description:            nil,
+		HTTPToolNames:          []string{"listPets"},
+		DefaultEnvironmentSlug: nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+
+	sub := ti.redisClient.Subscribe(ctx, relay.Channel)
+	t.Cleanup(func() { _ = sub.Close() })
+	// Wait for the subscription to be confirmed so that no message is missed.
+	_, err = sub.Receive(ctx)
+	require.NoError(t, err)
+
+	_, err = ti.service.UpdateToolset(ctx, &gen.UpdateToolsetPayload{
+		SessionToken:           nil,
+		Slug:                   created.Slug,
+		Name:                   nil,
+		Description:            nil,
+		DefaultEnvironmentSlug: nil,
+		HTTPToolNames:          []string{"listPets", "createPets"},
+		PromptTemplateNames:    nil,
+		McpSlug:                nil,
+		McpIsPublic:            nil,
+		McpEnabled:             nil,
+		CustomDomainID:         nil,
+		ProjectSlugInput:       nil,
+		Resources:              nil,
+		ToolSelectionMode:      nil,
+		ResourceLinkThreshold:  nil,
+		ResponseBudget:         nil,
+		Instructions:           nil,
+		ClientRules:            nil,
+	})
+	require.NoError(t, err)
+
+	// Other tests publish on the same channel, so wait for this toolset's
+	// message.
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case <-timeout:
+			require.FailNow(t, "tools list change was not published")
+		case raw := <-sub.Channel():
+			var msg relay.Message
+			require.NoError(t, json.Unmarshal([]byte(raw.Payload), &msg))
+			if msg.ProjectID != authCtx.ProjectID.String() || msg.Toolset != string(created.Slug) {
+				continue
+			}
+
+			require.Equal(t, relay.KindToolsListChanged, msg.Kind)
+			return
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Package uritemplate implements the subset of RFC 6570 URI templates used to
+// describe MCP resource templates: simple string expansion ({var}) in the
+// path and form-style query expansion ({?var1,var2}) at the end of the
+// template.
+package uritemplate
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidTemplate = errors.New("invalid uri template")
+	varNamePattern     = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+)
+
+// Template is a parsed URI template.
+type Template struct {
+	raw       string
+	pattern   *regexp.Regexp
+	pathVars  []string
+	queryVars []string
+}
+
+// Parse parses a URI template. Query expressions must come last.
+func Parse(raw string) (*Template, error) {
+	if raw == "" {
+		return nil, fmt.Errorf("%w: template is empty", ErrInvalidTemplate)
+	}
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+
+	t := &Template{
+		raw:       raw,
+		pattern:   nil,
+		pathVars:  []string{},
+		queryVars: []string{},
+	}
+
+	rest := raw
+	for rest != "" {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			if strings.ContainsRune(rest, '}') {
+				return nil, fmt.Errorf("%w: unmatched '}'", ErrInvalidTemplate)
+			}
+			if len(t.queryVars) > 0 {
+				return nil, fmt.Errorf("%w: query expressions must be at the end of the template", ErrInvalidTemplate)
+			}
+			pattern.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+
+		literal := rest[:open]
+		if strings.ContainsRune(literal, '}') {
+			return nil, fmt.Errorf("%w: unmatched '}'", ErrInvalidTemplate)
+		}
+		if literal != "" && len(t.queryVars) > 0 {
+			return nil, fmt.Errorf("%w: query expressions must be at the end of the template", ErrInvalidTemplate)
+		}
+		pattern.WriteString(regexp.QuoteMeta(literal))
+
+		end := strings.IndexByte(rest[open:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unmatched '{'", ErrInvalidTemplate)
+		}
+		expr := rest[open+1 : open+end]
+		rest = rest[open+end+1:]
+
+		switch {
+		case strings.HasPrefix(expr, "?"), strings.HasPrefix(expr, "&"):
+			names, err := parseVarList(expr[1:])
+			if err != nil {
+				return nil, err
+			}
+			t.queryVars = append(t.queryVars, names...)
+		default:
+			if len(t.queryVars) > 0 {
+				return nil, fmt.Errorf("%w: query expressions must be at the end of the template", ErrInvalidTemplate)
+			}
+			if !varNamePattern.MatchString(expr) {
+				return nil, fmt.Errorf("%w: unsupported expression {%s}", ErrInvalidTemplate, expr)
+			}
+			t.pathVars = append(t.pathVars, expr)
+			pattern.WriteString("([^/?#]+)")
+		}
+	}
+
+	if len(t.queryVars) > 0 {
+		pattern.WriteString(`(?:\?([^#]*))?`)
+	}
+	pattern.WriteString("$")
+
+	seen := make(map[string]struct{})
+	for _, name := range t.Variables() {
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("%w: variable %q appears more than once", ErrInvalidTemplate, name)
+		}
+		seen[name] = struct{}{}
+	}
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	t.pattern = re
+
+	return t, nil
+}
+
+func parseVarList(list string) ([]string, error) {
+	names := strings.Split(list, ",")
+	for _, name := range names {
+		if !varNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("%w: invalid variable name %q", ErrInvalidTemplate, name)
+		}
+	}
+
+	return names, nil
+}
+
+// String returns the template as it was parsed.
+func (t *Template) String() string {
+	return t.raw
+}
+
+// Variables returns the names of all variables in the template in the order
+// they appear.
+func (t *Template) Variables() []string {
+	return slices.Concat(t.pathVars, t.queryVars)
+}
+
+// Match reports whether uri is an expansion of the template and, if so,
+// returns the values of the variables that were present. Query variables
+// that are absent from uri are omitted from the result.
+func (t *Template) Match(uri string) (map[string]string, bool) {
+	m := t.pattern.FindStringSubmatch(uri)
+	if m == nil {
+		return nil, false
+	}
+
+	values := make(map[string]string, len(t.pathVars)+len(t.queryVars))
+	for i, name := range t.pathVars {
+		v, err := url.PathUnescape(m[i+1])
+		if err != nil {
+			return nil, false
+		}
+		values[name] = v
+	}
+
+	if len(t.queryVars) > 0 && len(m) > len(t.pathVars)+1 {
+		query, err := url.ParseQuery(m[len(t.pathVars)+1])
+		if err != nil {
+			return nil, false
+		}
+
+		for _, name := range t.queryVars {
+			if query.Has(name) {
+				values[name] = query.Get(name)
+			}
+		}
+	}
+
+	return values, true
+}
+
+// Build constructs a template from a prefix and the names of path and query
+// variables. Path variables are appended as segments in the order given.
+func Build(prefix string, pathVars []string, queryVars []string) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimSuffix(prefix, "/"))
+	for _, name := range pathVars {
+		b.WriteString("/{")
+		b.WriteString(name)
+		b.WriteString("}")
+	}
+	if len(queryVars) > 0 {
+		b.WriteString("{?")
+		b.WriteString(strings.Join(queryVars, ","))
+		b.WriteString("}")
+	}
+
+	return b.String()
+}
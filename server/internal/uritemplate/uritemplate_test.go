@@ -0,0 +1,115 @@
+package uritemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		template string
+	}{
+		{name: "empty", template: ""},
+		{name: "unclosed expression", template: "gram://pets/{id"},
+		{name: "unopened expression", template: "gram://pets/id}"},
+		{name: "unsupported operator", template: "gram://pets/{+id}"},
+		{name: "literal after query", template: "gram://pets{?limit}/more"},
+		{name: "path after query", template: "gram://pets{?limit}{id}"},
+		{name: "duplicate variable", template: "gram://pets/{id}{?id}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Parse(tt.template)
+			require.ErrorIs(t, err, ErrInvalidTemplate)
+		})
+	}
+}
+
+func TestTemplate_Match(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		template string
+		uri      string
+		expected map[string]string
+		matches  bool
+	}{
+		{
+			name:     "static",
+			template: "gram://petstore/readme",
+			uri:      "gram://petstore/readme",
+			expected: map[string]string{},
+			matches:  true,
+		},
+		{
+			name:     "path variables",
+			template: "gram://petstore/owners/{ownerId}/pets/{petId}",
+			uri:      "gram://petstore/owners/42/pets/rex",
+			expected: map[string]string{"ownerId": "42", "petId": "rex"},
+			matches:  true,
+		},
+		{
+			name:     "escaped path value",
+			template: "gram://petstore/pets/{name}",
+			uri:      "gram://petstore/pets/mr%20whiskers",
+			expected: map[string]string{"name": "mr whiskers"},
+			matches:  true,
+		},
+		{
+			name:     "path value cannot span segments",
+			template: "gram://petstore/pets/{id}",
+			uri:      "gram://petstore/pets/1/toys",
+			expected: nil,
+			matches:  false,
+		},
+		{
+			name:     "query variables",
+			template: "gram://petstore/pets{?status,limit}",
+			uri:      "gram://petstore/pets?limit=10&status=sold&other=x",
+			expected: map[string]string{"status": "sold", "limit": "10"},
+			matches:  true,
+		},
+		{
+			name:     "query variables are optional",
+			template: "gram://petstore/pets/{id}{?expand}",
+			uri:      "gram://petstore/pets/7",
+			expected: map[string]string{"id": "7"},
+			matches:  true,
+		},
+		{
+			name:     "different prefix",
+			template: "gram://petstore/pets/{id}",
+			uri:      "gram://other/pets/7",
+			expected: nil,
+			matches:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tpl, err := Parse(tt.template)
+			require.NoError(t, err)
+
+			values, ok := tpl.Match(tt.uri)
+			require.Equal(t, tt.matches, ok)
+			require.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	raw := Build("gram://petstore/get-pet/", []string{"petId"}, []string{"expand", "fields"})
+	require.Equal(t, "gram://petstore/get-pet/{petId}{?expand,fields}", raw)
+
+	tpl, err := Parse(raw)
+	require.NoError(t, err)
+	require.Equal(t, []string{"petId", "expand", "fields"}, tpl.Variables())
+}
@@ -29,6 +29,9 @@ func TestVariationsService_DeleteGlobal_Success(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	}))
 	require.NoError(t, err, "upsert variation should not error")
 	require.NotNil(t, created, "created variation should not be nil")
@@ -189,6 +192,9 @@ func TestVariationsService_DeleteGlobal_MultipleDeleteSameID(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert variation should not error")
 	require.NotNil(t, created, "created variation should not be nil")
@@ -239,6 +245,9 @@ func TestVariationsService_DeleteGlobal_DeleteMultipleVariations(t *testing.T) {
 			Description:      nil,
 			Tags:             nil,
 			Summarizer:       nil,
+			Annotations:      nil,
+			ResponseBudget:   nil,
+			RetryPolicy:      nil,
 		})
 		require.NoError(t, err, "upsert variation should not error for %s", toolName)
 		createdVars[i] = created
@@ -292,6 +301,9 @@ func TestVariationsService_DeleteGlobal_SoftDelete(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert variation should not error")
 	require.NotNil(t, created, "created variation should not be nil")
@@ -321,6 +333,9 @@ func TestVariationsService_DeleteGlobal_SoftDelete(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert variation with same tool name should not error after delete")
 	require.NotNil(t, recreated, "recreated variation should not be nil")
@@ -365,6 +380,9 @@ func createUpsertPayload(srcToolName string, overrides *gen.UpsertGlobalPayload)
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	}
 
 	if overrides != nil {
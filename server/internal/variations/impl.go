@@ -23,6 +23,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/middleware"
+	"github.com/speakeasy-api/gram/server/internal/mv"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oops"
 	"github.com/speakeasy-api/gram/server/internal/variations/repo"
@@ -78,18 +79,21 @@ func (s *Service) ListGlobal(ctx context.Context, payload *gen.ListGlobalPayload
 	variations := make([]*types.ToolVariation, 0, len(rows))
 	for _, row := range rows {
 		variations = append(variations, &types.ToolVariation{
-			ID:            row.ToolVariation.ID.String(),
-			GroupID:       row.ToolVariation.GroupID.String(),
-			SrcToolName:   row.ToolVariation.SrcToolName,
-			Confirm:       conv.FromPGText[string](row.ToolVariation.Confirm),
-			ConfirmPrompt: conv.FromPGText[string](row.ToolVariation.ConfirmPrompt),
-			Name:          conv.FromPGText[string](row.ToolVariation.Name),
-			Summary:       conv.FromPGText[string](row.ToolVariation.Summary),
-			Description:   conv.FromPGText[string](row.ToolVariation.Description),
-			Tags:          row.ToolVariation.Tags,
-			Summarizer:    conv.FromPGText[string](row.ToolVariation.Summarizer),
-			CreatedAt:     row.ToolVariation.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt:     row.ToolVariation.UpdatedAt.Time.Format(time.RFC3339),
+			ID:             row.ToolVariation.ID.String(),
+			GroupID:        row.ToolVariation.GroupID.String(),
+			SrcToolName:    row.ToolVariation.SrcToolName,
+			Confirm:        conv.FromPGText[string](row.ToolVariation.Confirm),
+			ConfirmPrompt:  conv.FromPGText[string](row.ToolVariation.ConfirmPrompt),
+			Name:           conv.FromPGText[string](row.ToolVariation.Name),
+			Summary:        conv.FromPGText[string](row.ToolVariation.Summary),
+			Description:    conv.FromPGText[string](row.ToolVariation.Description),
+			Tags:           row.ToolVariation.Tags,
+			Summarizer:     conv.FromPGText[string](row.ToolVariation.Summarizer),
+			Annotations:    mv.ToolAnnotationsFromColumns(row.ToolVariation.Title, row.ToolVariation.ReadOnlyHint, row.ToolVariation.DestructiveHint, row.ToolVariation.IdempotentHint, row.ToolVariation.OpenWorldHint),
+			ResponseBudget: conv.FromPGInt8(row.ToolVariation.ResponseBudget),
+			RetryPolicy:    mv.DescribeToolRetryPolicy(row.ToolVariation.RetryPolicy),
+			CreatedAt:      row.ToolVariation.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt:      row.ToolVariation.UpdatedAt.Time.Format(time.RFC3339),
 		})
 	}
 
@@ -104,6 +108,11 @@ func (s *Service) UpsertGlobal(ctx context.Context, payload *gen.UpsertGlobalPay
 		return nil, oops.C(oops.CodeUnauthorized)
 	}
 
+	retryPolicy := mv.ToolRetryPolicyModel(payload.RetryPolicy)
+	if err := retryPolicy.Validate(); err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid retry policy: %s", err.Error())
+	}
+
 	dbtx, err := s.db.Begin(ctx)
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "error beginning transaction").Log(ctx, s.logger)
@@ -130,16 +139,31 @@ func (s *Service) UpsertGlobal(ctx context.Context, payload *gen.UpsertGlobalPay
 		}
 	}
 
+	annotations := conv.PtrValOr(payload.Annotations, types.ToolAnnotations{
+		Title:           nil,
+		ReadOnlyHint:    nil,
+		DestructiveHint: nil,
+		IdempotentHint:  nil,
+		OpenWorldHint:   nil,
+	})
+
 	row, err := tx.UpsertToolVariation(ctx, repo.UpsertToolVariationParams{
-		GroupID:       groupID,
-		SrcToolName:   payload.SrcToolName,
-		Confirm:       conv.PtrToPGText(payload.Confirm),
-		ConfirmPrompt: conv.PtrToPGText(payload.ConfirmPrompt),
-		Name:          conv.PtrToPGText(payload.Name),
-		Summary:       conv.PtrToPGText(payload.Summary),
-		Description:   conv.PtrToPGText(payload.Description),
-		Tags:          payload.Tags,
-		Summarizer:    conv.PtrToPGText(payload.Summarizer),
+		GroupID:         groupID,
+		SrcToolName:     payload.SrcToolName,
+		Confirm:         conv.PtrToPGText(payload.Confirm),
+		ConfirmPrompt:   conv.PtrToPGText(payload.ConfirmPrompt),
+		Name:            conv.PtrToPGText(payload.Name),
+		Summary:         conv.PtrToPGText(payload.Summary),
+		Description:     conv.PtrToPGText(payload.Description),
+		Tags:            payload.Tags,
+		Summarizer:      conv.PtrToPGText(payload.Summarizer),
+		Title:           conv.PtrToPGTextEmpty(annotations.Title),
+		ReadOnlyHint:    conv.PtrToPGBool(annotations.ReadOnlyHint),
+		DestructiveHint: conv.PtrToPGBool(annotations.DestructiveHint),
+		IdempotentHint:  conv.PtrToPGBool(annotations.IdempotentHint),
+		OpenWorldHint:   conv.PtrToPGBool(annotations.OpenWorldHint),
+		ResponseBudget:  conv.PtrToPGInt8(payload.ResponseBudget),
+		RetryPolicy:     retryPolicy,
 	})
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "error upserting global tool variation").Log(ctx, s.logger)
@@ -151,18 +175,21 @@ func (s *Service) UpsertGlobal(ctx context.Context, payload *gen.UpsertGlobalPay
 
 	return &gen.UpsertGlobalToolVariationResult{
 		Variation: &types.ToolVariation{
-			ID:            row.ID.String(),
-			GroupID:       row.GroupID.String(),
-			SrcToolName:   row.SrcToolName,
-			Confirm:       conv.FromPGText[string](row.Confirm),
-			ConfirmPrompt: conv.FromPGText[string](row.ConfirmPrompt),
-			Name:          conv.FromPGText[string](row.Name),
-			Summary:       conv.FromPGText[string](row.Summary),
-			Description:   conv.FromPGText[string](row.Description),
-			Tags:          row.Tags,
-			Summarizer:    conv.FromPGText[string](row.Summarizer),
-			CreatedAt:     row.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt:     row.UpdatedAt.Time.Format(time.RFC3339),
+			ID:             row.ID.String(),
+			GroupID:        row.GroupID.String(),
+			SrcToolName:    row.SrcToolName,
+			Confirm:        conv.FromPGText[string](row.Confirm),
+			ConfirmPrompt:  conv.FromPGText[string](row.ConfirmPrompt),
+			Name:           conv.FromPGText[string](row.Name),
+			Summary:        conv.FromPGText[string](row.Summary),
+			Description:    conv.FromPGText[string](row.Description),
+			Tags:           row.Tags,
+			Summarizer:     conv.FromPGText[string](row.Summarizer),
+			Annotations:    mv.ToolAnnotationsFromColumns(row.Title, row.ReadOnlyHint, row.DestructiveHint, row.IdempotentHint, row.OpenWorldHint),
+			ResponseBudget: conv.FromPGInt8(row.ResponseBudget),
+			RetryPolicy:    mv.DescribeToolRetryPolicy(row.RetryPolicy),
+			CreatedAt:      row.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt:      row.UpdatedAt.Time.Format(time.RFC3339),
 		},
 	}, nil
 }
@@ -70,6 +70,9 @@ func TestVariationsService_ListGlobal_WithVariations(t *testing.T) {
 		Description:      &description,
 		Tags:             tags,
 		Summarizer:       &summarizer,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert variation should not error")
 	require.NotNil(t, created, "created variation should not be nil")
@@ -182,6 +185,9 @@ func TestVariationsService_ListGlobal_MultipleVariations(t *testing.T) {
 			Description:      &description,
 			Tags:             []string{toolName},
 			Summarizer:       nil,
+			Annotations:      nil,
+			ResponseBudget:   nil,
+			RetryPolicy:      nil,
 		})
 		require.NoError(t, err, "upsert variation should not error for %s", toolName)
 		createdVars[i] = created
@@ -237,6 +243,9 @@ func TestVariationsService_ListGlobal_OrderedByID(t *testing.T) {
 		Description:      conv.Ptr("first description"),
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "create first variation")
 
@@ -252,6 +261,9 @@ func TestVariationsService_ListGlobal_OrderedByID(t *testing.T) {
 		Description:      conv.Ptr("second description"),
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "create second variation")
 
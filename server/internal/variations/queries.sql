@@ -33,7 +33,14 @@ INSERT INTO tool_variations (
   summary,
   description,
   tags,
-  summarizer
+  summarizer,
+  title,
+  read_only_hint,
+  destructive_hint,
+  idempotent_hint,
+  open_world_hint,
+  response_budget,
+  retry_policy
 ) VALUES (
   @group_id,
   @src_tool_name,
@@ -43,7 +50,14 @@ INSERT INTO tool_variations (
   @summary,
   @description,
   @tags,
-  @summarizer
+  @summarizer,
+  @title,
+  @read_only_hint,
+  @destructive_hint,
+  @idempotent_hint,
+  @open_world_hint,
+  @response_budget,
+  @retry_policy
 ) ON CONFLICT (group_id, src_tool_name) WHERE deleted IS FALSE DO UPDATE SET
   confirm = EXCLUDED.confirm,
   confirm_prompt = EXCLUDED.confirm_prompt,
@@ -52,6 +66,13 @@ INSERT INTO tool_variations (
   description = EXCLUDED.description,
   tags = EXCLUDED.tags,
   summarizer = EXCLUDED.summarizer,
+  title = EXCLUDED.title,
+  read_only_hint = EXCLUDED.read_only_hint,
+  destructive_hint = EXCLUDED.destructive_hint,
+  idempotent_hint = EXCLUDED.idempotent_hint,
+  open_world_hint = EXCLUDED.open_world_hint,
+  response_budget = EXCLUDED.response_budget,
+  retry_policy = EXCLUDED.retry_policy,
   updated_at = clock_timestamp()
 RETURNING *;
 
@@ -87,6 +108,13 @@ SELECT
   description,
   tags,
   summarizer,
+  title,
+  read_only_hint,
+  destructive_hint,
+  idempotent_hint,
+  open_world_hint,
+  response_budget,
+  retry_policy,
   created_at,
   updated_at
 FROM tool_variations
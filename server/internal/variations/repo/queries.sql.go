@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/speakeasy-api/gram/server/internal/tools/repo/models"
 )
 
 const deleteGlobalToolVariation = `-- name: DeleteGlobalToolVariation :one
@@ -57,6 +58,13 @@ SELECT
   description,
   tags,
   summarizer,
+  title,
+  read_only_hint,
+  destructive_hint,
+  idempotent_hint,
+  open_world_hint,
+  response_budget,
+  retry_policy,
   created_at,
   updated_at
 FROM tool_variations
@@ -72,18 +80,25 @@ type FindGlobalVariationsByToolNamesParams struct {
 }
 
 type FindGlobalVariationsByToolNamesRow struct {
-	ID            uuid.UUID
-	GroupID       uuid.UUID
-	SrcToolName   string
-	Confirm       pgtype.Text
-	ConfirmPrompt pgtype.Text
-	Name          pgtype.Text
-	Summary       pgtype.Text
-	Description   pgtype.Text
-	Tags          []string
-	Summarizer    pgtype.Text
-	CreatedAt     pgtype.Timestamptz
-	UpdatedAt     pgtype.Timestamptz
+	ID              uuid.UUID
+	GroupID         uuid.UUID
+	SrcToolName     string
+	Confirm         pgtype.Text
+	ConfirmPrompt   pgtype.Text
+	Name            pgtype.Text
+	Summary         pgtype.Text
+	Description     pgtype.Text
+	Tags            []string
+	Summarizer      pgtype.Text
+	Title           pgtype.Text
+	ReadOnlyHint    pgtype.Bool
+	DestructiveHint pgtype.Bool
+	IdempotentHint  pgtype.Bool
+	OpenWorldHint   pgtype.Bool
+	ResponseBudget  pgtype.Int8
+	RetryPolicy     *models.RetryPolicy
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
 }
 
 func (q *Queries) FindGlobalVariationsByToolNames(ctx context.Context, arg FindGlobalVariationsByToolNamesParams) ([]FindGlobalVariationsByToolNamesRow, error) {
@@ -106,6 +121,13 @@ func (q *Queries) FindGlobalVariationsByToolNames(ctx context.Context, arg FindG
 			&i.Description,
 			&i.Tags,
 			&i.Summarizer,
+			&i.Title,
+			&i.ReadOnlyHint,
+			&i.DestructiveHint,
+			&i.IdempotentHint,
+			&i.OpenWorldHint,
+			&i.ResponseBudget,
+			&i.RetryPolicy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -153,7 +175,7 @@ func (q *Queries) InitGlobalToolVariationsGroup(ctx context.Context, arg InitGlo
 }
 
 const listGlobalToolVariations = `-- name: ListGlobalToolVariations :many
-SELECT tool_variations.id, tool_variations.group_id, tool_variations.src_tool_name, tool_variations.confirm, tool_variations.confirm_prompt, tool_variations.name, tool_variations.summary, tool_variations.description, tool_variations.tags, tool_variations.summarizer, tool_variations.created_at, tool_variations.updated_at, tool_variations.deleted_at, tool_variations.deleted
+SELECT tool_variations.id, tool_variations.group_id, tool_variations.src_tool_name, tool_variations.confirm, tool_variations.confirm_prompt, tool_variations.name, tool_variations.summary, tool_variations.description, tool_variations.tags, tool_variations.summarizer, tool_variations.title, tool_variations.read_only_hint, tool_variations.destructive_hint, tool_variations.idempotent_hint, tool_variations.open_world_hint, tool_variations.response_budget, tool_variations.retry_policy, tool_variations.created_at, tool_variations.updated_at, tool_variations.deleted_at, tool_variations.deleted
 FROM tool_variations
 INNER JOIN tool_variations_groups
   ON tool_variations.group_id = tool_variations_groups.id
@@ -189,6 +211,13 @@ func (q *Queries) ListGlobalToolVariations(ctx context.Context, projectID uuid.U
 			&i.ToolVariation.Description,
 			&i.ToolVariation.Tags,
 			&i.ToolVariation.Summarizer,
+			&i.ToolVariation.Title,
+			&i.ToolVariation.ReadOnlyHint,
+			&i.ToolVariation.DestructiveHint,
+			&i.ToolVariation.IdempotentHint,
+			&i.ToolVariation.OpenWorldHint,
+			&i.ToolVariation.ResponseBudget,
+			&i.ToolVariation.RetryPolicy,
 			&i.ToolVariation.CreatedAt,
 			&i.ToolVariation.UpdatedAt,
 			&i.ToolVariation.DeletedAt,
@@ -228,7 +257,14 @@ INSERT INTO tool_variations (
   summary,
   description,
   tags,
-  summarizer
+  summarizer,
+  title,
+  read_only_hint,
+  destructive_hint,
+  idempotent_hint,
+  open_world_hint,
+  response_budget,
+  retry_policy
 ) VALUES (
   $1,
   $2,
@@ -238,7 +274,14 @@ INSERT INTO tool_variations (
   $6,
   $7,
   $8,
-  $9
+  $9,
+  $10,
+  $11,
+  $12,
+  $13,
+  $14,
+  $15,
+  $16
 ) ON CONFLICT (group_id, src_tool_name) WHERE deleted IS FALSE DO UPDATE SET
   confirm = EXCLUDED.confirm,
   confirm_prompt = EXCLUDED.confirm_prompt,
@@ -247,20 +290,34 @@ INSERT INTO tool_variations (
   description = EXCLUDED.description,
   tags = EXCLUDED.tags,
   summarizer = EXCLUDED.summarizer,
+  title = EXCLUDED.title,
+  read_only_hint = EXCLUDED.read_only_hint,
+  destructive_hint = EXCLUDED.destructive_hint,
+  idempotent_hint = EXCLUDED.idempotent_hint,
+  open_world_hint = EXCLUDED.open_world_hint,
+  response_budget = EXCLUDED.response_budget,
+  retry_policy = EXCLUDED.retry_policy,
   updated_at = clock_timestamp()
-RETURNING id, group_id, src_tool_name, confirm, confirm_prompt, name, summary, description, tags, summarizer, created_at, updated_at, deleted_at, deleted
+RETURNING id, group_id, src_tool_name, confirm, confirm_prompt, name, summary, description, tags, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, response_budget, retry_policy, created_at, updated_at, deleted_at, deleted
 `
 
 type UpsertToolVariationParams struct {
-	GroupID       uuid.UUID
-	SrcToolName   string
-	Confirm       pgtype.Text
-	ConfirmPrompt pgtype.Text
-	Name          pgtype.Text
-	Summary       pgtype.Text
-	Description   pgtype.Text
-	Tags          []string
-	Summarizer    pgtype.Text
+	GroupID         uuid.UUID
+	SrcToolName     string
+	Confirm         pgtype.Text
+	ConfirmPrompt   pgtype.Text
+	Name            pgtype.Text
+	Summary         pgtype.Text
+	Description     pgtype.Text
+	Tags            []string
+	Summarizer      pgtype.Text
+	Title           pgtype.Text
+	ReadOnlyHint    pgtype.Bool
+	DestructiveHint pgtype.Bool
+	IdempotentHint  pgtype.Bool
+	OpenWorldHint   pgtype.Bool
+	ResponseBudget  pgtype.Int8
+	RetryPolicy     *models.RetryPolicy
 }
 
 func (q *Queries) UpsertToolVariation(ctx context.Context, arg UpsertToolVariationParams) (ToolVariation, error) {
@@ -274,6 +331,13 @@ func (q *Queries) UpsertToolVariation(ctx context.Context, arg UpsertToolVariati
 		arg.Description,
 		arg.Tags,
 		arg.Summarizer,
+		arg.Title,
+		arg.ReadOnlyHint,
+		arg.DestructiveHint,
+		arg.IdempotentHint,
+		arg.OpenWorldHint,
+		arg.ResponseBudget,
+		arg.RetryPolicy,
 	)
 	var i ToolVariation
 	err := row.Scan(
@@ -287,6 +351,13 @@ func (q *Queries) UpsertToolVariation(ctx context.Context, arg UpsertToolVariati
 		&i.Description,
 		&i.Tags,
 		&i.Summarizer,
+		&i.Title,
+		&i.ReadOnlyHint,
+		&i.DestructiveHint,
+		&i.IdempotentHint,
+		&i.OpenWorldHint,
+		&i.ResponseBudget,
+		&i.RetryPolicy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
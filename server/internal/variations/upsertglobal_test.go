@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/speakeasy-api/gram/server/gen/types"
 	gen "github.com/speakeasy-api/gram/server/gen/variations"
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/conv"
@@ -35,6 +36,9 @@ func TestVariationsService_UpsertGlobal_Create(t *testing.T) {
 		Description:      &description,
 		Tags:             tags,
 		Summarizer:       &summarizer,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert global variation should not error")
 	require.NotNil(t, result, "result should not be nil")
@@ -76,6 +80,9 @@ func TestVariationsService_UpsertGlobal_Update(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "first upsert should not error")
 	require.NotNil(t, first, "first result should not be nil")
@@ -99,6 +106,9 @@ func TestVariationsService_UpsertGlobal_Update(t *testing.T) {
 		Description:      &updatedDescription,
 		Tags:             updatedTags,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "second upsert should not error")
 	require.NotNil(t, second, "second result should not be nil")
@@ -137,6 +147,9 @@ func TestVariationsService_UpsertGlobal_MinimalPayload(t *testing.T) {
 		Tags:             nil,
 		Summarizer:       nil,
 		// Only required field provided, all optional fields nil
+		Annotations:    nil,
+		ResponseBudget: nil,
+		RetryPolicy:    nil,
 	})
 	require.NoError(t, err, "upsert with minimal payload should not error")
 	require.NotNil(t, result, "result should not be nil")
@@ -174,6 +187,9 @@ func TestVariationsService_UpsertGlobal_EmptyTags(t *testing.T) {
 		Description:      nil,
 		Tags:             []string{}, // Empty tags array
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert with empty tags should not error")
 	require.NotNil(t, result, "result should not be nil")
@@ -200,6 +216,9 @@ func TestVariationsService_UpsertGlobal_NilTags(t *testing.T) {
 		Description:      nil,
 		Tags:             nil, // Nil tags
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert with nil tags should not error")
 	require.NotNil(t, result, "result should not be nil")
@@ -229,6 +248,9 @@ func TestVariationsService_UpsertGlobal_Unauthorized(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unauthorized")
@@ -265,6 +287,9 @@ func TestVariationsService_UpsertGlobal_NoProjectID(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unauthorized")
@@ -288,6 +313,9 @@ func TestVariationsService_UpsertGlobal_CreatesGroup(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "first upsert should not error")
 	require.NotNil(t, first, "first result should not be nil")
@@ -305,6 +333,9 @@ func TestVariationsService_UpsertGlobal_CreatesGroup(t *testing.T) {
 		Description:      nil,
 		Tags:             nil,
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "second upsert should not error")
 	require.NotNil(t, second, "second result should not be nil")
@@ -337,6 +368,9 @@ func TestVariationsService_UpsertGlobal_MultipleToolsSameGroup(t *testing.T) {
 			Description:      nil,
 			Tags:             nil,
 			Summarizer:       nil,
+			Annotations:      nil,
+			ResponseBudget:   nil,
+			RetryPolicy:      nil,
 		})
 		require.NoError(t, err, "upsert should not error for %s", toolName)
 		require.NotNil(t, result, "result should not be nil for %s", toolName)
@@ -374,6 +408,9 @@ func TestVariationsService_UpsertGlobal_LongValues(t *testing.T) {
 		Description:      &longDescription,
 		Tags:             []string{"long", "values", "test", "comprehensive", "detailed"},
 		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert with long values should not error")
 	require.NotNil(t, result, "result should not be nil")
@@ -405,6 +442,9 @@ func TestVariationsService_UpsertGlobal_EmptyStrings(t *testing.T) {
 		Description:      &emptyString,
 		Tags:             nil,
 		Summarizer:       &emptyString,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
 	})
 	require.NoError(t, err, "upsert with empty strings should not error")
 	require.NotNil(t, result, "result should not be nil")
@@ -418,3 +458,56 @@ func TestVariationsService_UpsertGlobal_EmptyStrings(t *testing.T) {
 	require.Equal(t, &emptyString, result.Variation.Description, "empty description should be preserved")
 	require.Equal(t, &emptyString, result.Variation.Summarizer, "empty summarizer should be preserved")
 }
+
+func TestVariationsService_UpsertGlobal_Annotations(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestVariationsService(t)
+
+	annotations := &types.ToolAnnotations{
+		Title:           conv.Ptr("Search pets"),
+		ReadOnlyHint:    conv.Ptr(true),
+		DestructiveHint: conv.Ptr(false),
+		IdempotentHint:  nil,
+		OpenWorldHint:   conv.Ptr(false),
+	}
+
+	result, err := ti.service.UpsertGlobal(ctx, &gen.UpsertGlobalPayload{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		SrcToolName:      "annotated-tool",
+		Confirm:          nil,
+		ConfirmPrompt:    nil,
+		Name:             nil,
+		Summary:          nil,
+		Description:      nil,
+		Tags:             nil,
+		Summarizer:       nil,
+		Annotations:      annotations,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
+	})
+	require.NoError(t, err, "upsert with annotations should not error")
+	require.NotNil(t, result.Variation.Annotations, "annotations should be returned")
+	require.Equal(t, annotations, result.Variation.Annotations, "annotations should match")
+
+	result, err = ti.service.UpsertGlobal(ctx, &gen.UpsertGlobalPayload{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		SrcToolName:      "annotated-tool",
+		Confirm:          nil,
+		ConfirmPrompt:    nil,
+		Name:             nil,
+		Summary:          nil,
+		Description:      nil,
+		Tags:             nil,
+		Summarizer:       nil,
+		Annotations:      nil,
+		ResponseBudget:   nil,
+		RetryPolicy:      nil,
+	})
+	require.NoError(t, err, "upsert without annotations should not error")
+	require.Nil(t, result.Variation.Annotations, "annotations should be cleared")
+}
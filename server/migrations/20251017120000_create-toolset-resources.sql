@@ -0,0 +1,4 @@
+-- Create "toolset_resources" table
+CREATE TABLE "toolset_resources" ("id" uuid NOT NULL DEFAULT generate_uuidv7(), "project_id" uuid NOT NULL, "toolset_id" uuid NOT NULL, "kind" text NOT NULL, "name" text NOT NULL, "description" text NULL, "mime_type" text NULL, "asset_id" uuid NULL, "http_tool_name" text NULL, "uri_template" text NULL, "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(), "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(), PRIMARY KEY ("id"), CONSTRAINT "toolset_resources_asset_id_fkey" FOREIGN KEY ("asset_id") REFERENCES "assets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "toolset_resources_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "toolset_resources_toolset_id_fkey" FOREIGN KEY ("toolset_id") REFERENCES "toolsets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "toolset_resources_description_check" CHECK ((description <> ''::text) AND (char_length(description) <= 1000)), CONSTRAINT "toolset_resources_http_tool_name_check" CHECK ((http_tool_name <> ''::text) AND (char_length(http_tool_name) <= 100)), CONSTRAINT "toolset_resources_kind_check" CHECK (kind = ANY (ARRAY['asset'::text, 'http_template'::text])), CONSTRAINT "toolset_resources_mime_type_check" CHECK ((mime_type <> ''::text) AND (char_length(mime_type) <= 100)), CONSTRAINT "toolset_resources_name_check" CHECK ((name <> ''::text) AND (char_length(name) <= 60)), CONSTRAINT "toolset_resources_source_check" CHECK (((kind = 'asset'::text) AND (asset_id IS NOT NULL) AND (http_tool_name IS NULL) AND (uri_template IS NULL)) OR ((kind = 'http_template'::text) AND (asset_id IS NULL) AND (http_tool_name IS NOT NULL) AND (uri_template IS NOT NULL))), CONSTRAINT "toolset_resources_uri_template_check" CHECK ((uri_template <> ''::text) AND (char_length(uri_template) <= 500)));
+-- Create index "toolset_resources_toolset_id_name_key" to table: "toolset_resources"
+CREATE UNIQUE INDEX "toolset_resources_toolset_id_name_key" ON "toolset_resources" ("toolset_id", "name");
@@ -0,0 +1,4 @@
+-- Modify "http_tool_definitions" table
+ALTER TABLE "http_tool_definitions" ADD CONSTRAINT "http_tool_definitions_title_check" CHECK ((title <> ''::text) AND (char_length(title) <= 100)), ADD COLUMN "title" text NULL, ADD COLUMN "read_only_hint" boolean NULL, ADD COLUMN "destructive_hint" boolean NULL, ADD COLUMN "idempotent_hint" boolean NULL, ADD COLUMN "open_world_hint" boolean NULL;
+-- Modify "tool_variations" table
+ALTER TABLE "tool_variations" ADD CONSTRAINT "tool_variations_title_check" CHECK ((title <> ''::text) AND (char_length(title) <= 100)), ADD COLUMN "title" text NULL, ADD COLUMN "read_only_hint" boolean NULL, ADD COLUMN "destructive_hint" boolean NULL, ADD COLUMN "idempotent_hint" boolean NULL, ADD COLUMN "open_world_hint" boolean NULL;
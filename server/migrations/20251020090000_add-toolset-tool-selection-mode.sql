@@ -0,0 +1,2 @@
+-- Modify "toolsets" table
+ALTER TABLE "toolsets" ADD CONSTRAINT "toolsets_tool_selection_mode_check" CHECK (tool_selection_mode = ANY (ARRAY['static'::text, 'dynamic'::text])), ADD COLUMN "tool_selection_mode" text NOT NULL DEFAULT 'static';
@@ -0,0 +1,2 @@
+-- Modify "toolsets" table
+ALTER TABLE "toolsets" ADD CONSTRAINT "toolsets_resource_link_threshold_check" CHECK ((resource_link_threshold IS NULL) OR (resource_link_threshold >= 0)), ADD COLUMN "resource_link_threshold" bigint NULL;
@@ -0,0 +1,6 @@
+-- Modify "http_tool_definitions" table
+ALTER TABLE "http_tool_definitions" ADD CONSTRAINT "http_tool_definitions_response_budget_check" CHECK ((response_budget IS NULL) OR (response_budget > 0)), ADD COLUMN "response_budget" bigint NULL;
+-- Modify "tool_variations" table
+ALTER TABLE "tool_variations" ADD CONSTRAINT "tool_variations_response_budget_check" CHECK ((response_budget IS NULL) OR (response_budget > 0)), ADD COLUMN "response_budget" bigint NULL;
+-- Modify "toolsets" table
+ALTER TABLE "toolsets" ADD CONSTRAINT "toolsets_response_budget_check" CHECK ((response_budget IS NULL) OR (response_budget > 0)), ADD COLUMN "response_budget" bigint NULL;
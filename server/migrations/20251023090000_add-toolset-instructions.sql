@@ -0,0 +1,4 @@
+-- Create "toolset_instructions" table
+CREATE TABLE "toolset_instructions" ("id" uuid NOT NULL DEFAULT generate_uuidv7(), "project_id" uuid NOT NULL, "toolset_id" uuid NOT NULL, "version" integer NOT NULL, "content" text NULL, "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(), PRIMARY KEY ("id"), CONSTRAINT "toolset_instructions_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "toolset_instructions_toolset_id_fkey" FOREIGN KEY ("toolset_id") REFERENCES "toolsets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "toolset_instructions_content_check" CHECK ((content <> ''::text) AND (char_length(content) <= 20000)), CONSTRAINT "toolset_instructions_version_check" CHECK (version > 0));
+-- Create index "toolset_instructions_toolset_id_version_key" to table: "toolset_instructions"
+CREATE UNIQUE INDEX "toolset_instructions_toolset_id_version_key" ON "toolset_instructions" ("toolset_id", "version" DESC);
@@ -0,0 +1,4 @@
+-- Create "toolset_client_rules" table
+CREATE TABLE "toolset_client_rules" ("id" uuid NOT NULL DEFAULT generate_uuidv7(), "project_id" uuid NOT NULL, "toolset_id" uuid NOT NULL, "client_name" text NOT NULL, "kind" text NOT NULL, "min_version" text NULL, "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(), PRIMARY KEY ("id"), CONSTRAINT "toolset_client_rules_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "toolset_client_rules_toolset_id_fkey" FOREIGN KEY ("toolset_id") REFERENCES "toolsets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "toolset_client_rules_client_name_check" CHECK ((client_name <> ''::text) AND (char_length(client_name) <= 100)), CONSTRAINT "toolset_client_rules_kind_check" CHECK (kind = ANY (ARRAY['allow'::text, 'block'::text, 'minimum_version'::text])), CONSTRAINT "toolset_client_rules_min_version_check" CHECK ((min_version <> ''::text) AND (char_length(min_version) <= 50)), CONSTRAINT "toolset_client_rules_version_kind_check" CHECK ((kind = 'minimum_version'::text) = (min_version IS NOT NULL)));
+-- Create index "toolset_client_rules_toolset_id_client_name_kind_key" to table: "toolset_client_rules"
+CREATE UNIQUE INDEX "toolset_client_rules_toolset_id_client_name_kind_key" ON "toolset_client_rules" ("toolset_id", "client_name", "kind");
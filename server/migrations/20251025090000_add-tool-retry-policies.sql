@@ -0,0 +1,4 @@
+-- Modify "http_tool_definitions" table
+ALTER TABLE "http_tool_definitions" ADD COLUMN "retry_policy" jsonb NULL;
+-- Modify "tool_variations" table
+ALTER TABLE "tool_variations" ADD COLUMN "retry_policy" jsonb NULL;
@@ -0,0 +1,2 @@
+-- Modify "http_tool_definitions" table
+ALTER TABLE "http_tool_definitions" ADD COLUMN "xml_response_schema" jsonb NULL;
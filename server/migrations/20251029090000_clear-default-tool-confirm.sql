@@ -0,0 +1,7 @@
+-- Tools used to store "always" when no confirmation mode was configured and
+-- the MCP server did not enforce stored modes. Clear those defaults so that
+-- tools deployed before modes were enforced keep running without asking. A
+-- stored "always" without a prompt cannot be told apart from an explicitly
+-- configured one, so it is also cleared and restored from the "x-gram"
+-- extension by the project's next deployment.
+UPDATE "http_tool_definitions" SET "confirm" = NULL WHERE "confirm" = 'always' AND "confirm_prompt" IS NULL AND "deleted_at" IS NULL;